@@ -28,6 +28,7 @@ package admin
 import (
 	bytes "bytes"
 	base64 "encoding/base64"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
 	shared "github.com/uber/cadence/.gen/go/shared"
@@ -35,11 +36,14 @@ import (
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	strconv "strconv"
 	strings "strings"
 )
 
 type AddSearchAttributeRequest struct {
 	SearchAttribute map[string]shared.IndexedValueType `json:"searchAttribute,omitempty"`
+	Domain          *string                            `json:"domain,omitempty"`
 }
 
 type _Map_String_IndexedValueType_MapItemList map[string]shared.IndexedValueType
@@ -94,7 +98,7 @@ func (_Map_String_IndexedValueType_MapItemList) Close() {}
 //   }
 func (v *AddSearchAttributeRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -108,6 +112,14 @@ func (v *AddSearchAttributeRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -175,6 +187,16 @@ func (v *AddSearchAttributeRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -189,12 +211,16 @@ func (v *AddSearchAttributeRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	if v.SearchAttribute != nil {
 		fields[i] = fmt.Sprintf("SearchAttribute: %v", v.SearchAttribute)
 		i++
 	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
 
 	return fmt.Sprintf("AddSearchAttributeRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -216,6 +242,16 @@ func _Map_String_IndexedValueType_Equals(lhs, rhs map[string]shared.IndexedValue
 	return true
 }
 
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this AddSearchAttributeRequest match the
 // provided AddSearchAttributeRequest.
 //
@@ -229,6 +265,9 @@ func (v *AddSearchAttributeRequest) Equals(rhs *AddSearchAttributeRequest) bool
 	if !((v.SearchAttribute == nil && rhs.SearchAttribute == nil) || (v.SearchAttribute != nil && rhs.SearchAttribute != nil && _Map_String_IndexedValueType_Equals(v.SearchAttribute, rhs.SearchAttribute))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
 
 	return true
 }
@@ -253,6 +292,9 @@ func (v *AddSearchAttributeRequest) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.SearchAttribute != nil {
 		err = multierr.Append(err, enc.AddObject("searchAttribute", (_Map_String_IndexedValueType_Zapper)(v.SearchAttribute)))
 	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
 	return err
 }
 
@@ -271,6 +313,205 @@ func (v *AddSearchAttributeRequest) IsSetSearchAttribute() bool {
 	return v != nil && v.SearchAttribute != nil
 }
 
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *AddSearchAttributeRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *AddSearchAttributeRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+type ArchivalVerificationStatus int32
+
+const (
+	ArchivalVerificationStatusVerified  ArchivalVerificationStatus = 0
+	ArchivalVerificationStatusMissing   ArchivalVerificationStatus = 1
+	ArchivalVerificationStatusCorrupted ArchivalVerificationStatus = 2
+)
+
+// ArchivalVerificationStatus_Values returns all recognized values of ArchivalVerificationStatus.
+func ArchivalVerificationStatus_Values() []ArchivalVerificationStatus {
+	return []ArchivalVerificationStatus{
+		ArchivalVerificationStatusVerified,
+		ArchivalVerificationStatusMissing,
+		ArchivalVerificationStatusCorrupted,
+	}
+}
+
+// UnmarshalText tries to decode ArchivalVerificationStatus from a byte slice
+// containing its name.
+//
+//   var v ArchivalVerificationStatus
+//   err := v.UnmarshalText([]byte("VERIFIED"))
+func (v *ArchivalVerificationStatus) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "VERIFIED":
+		*v = ArchivalVerificationStatusVerified
+		return nil
+	case "MISSING":
+		*v = ArchivalVerificationStatusMissing
+		return nil
+	case "CORRUPTED":
+		*v = ArchivalVerificationStatusCorrupted
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ArchivalVerificationStatus", err)
+		}
+		*v = ArchivalVerificationStatus(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ArchivalVerificationStatus to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v ArchivalVerificationStatus) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("VERIFIED"), nil
+	case 1:
+		return []byte("MISSING"), nil
+	case 2:
+		return []byte("CORRUPTED"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ArchivalVerificationStatus.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v ArchivalVerificationStatus) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "VERIFIED")
+	case 1:
+		enc.AddString("name", "MISSING")
+	case 2:
+		enc.AddString("name", "CORRUPTED")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v ArchivalVerificationStatus) Ptr() *ArchivalVerificationStatus {
+	return &v
+}
+
+// ToWire translates ArchivalVerificationStatus into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v ArchivalVerificationStatus) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes ArchivalVerificationStatus from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return ArchivalVerificationStatus(0), err
+//   }
+//
+//   var v ArchivalVerificationStatus
+//   if err := v.FromWire(x); err != nil {
+//     return ArchivalVerificationStatus(0), err
+//   }
+//   return v, nil
+func (v *ArchivalVerificationStatus) FromWire(w wire.Value) error {
+	*v = (ArchivalVerificationStatus)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of ArchivalVerificationStatus.
+func (v ArchivalVerificationStatus) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "VERIFIED"
+	case 1:
+		return "MISSING"
+	case 2:
+		return "CORRUPTED"
+	}
+	return fmt.Sprintf("ArchivalVerificationStatus(%d)", w)
+}
+
+// Equals returns true if this ArchivalVerificationStatus value matches the provided
+// value.
+func (v ArchivalVerificationStatus) Equals(rhs ArchivalVerificationStatus) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes ArchivalVerificationStatus into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v ArchivalVerificationStatus) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"VERIFIED\""), nil
+	case 1:
+		return ([]byte)("\"MISSING\""), nil
+	case 2:
+		return ([]byte)("\"CORRUPTED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode ArchivalVerificationStatus from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *ArchivalVerificationStatus) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ArchivalVerificationStatus")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ArchivalVerificationStatus")
+		}
+		*v = (ArchivalVerificationStatus)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ArchivalVerificationStatus")
+	}
+}
+
 type DescribeWorkflowExecutionRequest struct {
 	Domain    *string                   `json:"domain,omitempty"`
 	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
@@ -392,16 +633,6 @@ func (v *DescribeWorkflowExecutionRequest) String() string {
 	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
 // provided DescribeWorkflowExecutionRequest.
 //
@@ -1554,28 +1785,13 @@ func (v *GetWorkflowExecutionRawHistoryResponse) IsSetEventStoreVersion() bool {
 	return v != nil && v.EventStoreVersion != nil
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "admin",
-	Package:  "github.com/uber/cadence/.gen/go/admin",
-	FilePath: "admin.thrift",
-	SHA1:     "82b7ca0371394313ca7da19e03df4ed862ce90cb",
-	Includes: []*thriftreflect.ThriftModule{
-		shared.ThriftModule,
-	},
-	Raw: rawIDL,
-}
-
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void CloseShard(1: shared.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void RemoveTask(1: shared.RemoveTaskRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n}\n"
-
-// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
-//
-// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Args struct {
-	Request *AddSearchAttributeRequest `json:"request,omitempty"`
+type ImportWorkflowExecutionRequest struct {
+	Domain         *string                   `json:"domain,omitempty"`
+	Execution      *shared.WorkflowExecution `json:"execution,omitempty"`
+	HistoryBatches []*shared.DataBlob        `json:"historyBatches,omitempty"`
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
+// ToWire translates a ImportWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1590,37 +1806,47 @@ type AdminService_AddSearchAttribute_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
+func (v *ImportWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HistoryBatches != nil {
+		w, err = wire.NewValueList(_List_DataBlob_ValueList(v.HistoryBatches)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
-	var v AddSearchAttributeRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
+// FromWire deserializes a ImportWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
+// An error is returned if we were unable to build a ImportWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1628,19 +1854,37 @@ func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest,
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Args
+//   var v ImportWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
+func (v *ImportWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.HistoryBatches, err = _List_DataBlob_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -1652,34 +1896,48 @@ func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
+// String returns a readable string representation of a ImportWorkflowExecutionRequest
 // struct.
-func (v *AdminService_AddSearchAttribute_Args) String() string {
+func (v *ImportWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [3]string
 	i := 0
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.HistoryBatches != nil {
+		fields[i] = fmt.Sprintf("HistoryBatches: %v", v.HistoryBatches)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ImportWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
-// provided AdminService_AddSearchAttribute_Args.
+// Equals returns true if all the fields of this ImportWorkflowExecutionRequest match the
+// provided ImportWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
+func (v *ImportWorkflowExecutionRequest) Equals(rhs *ImportWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
 		return false
 	}
 
@@ -1687,141 +1945,7940 @@ func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Args.
-func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ImportWorkflowExecutionRequest.
+func (v *ImportWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Request != nil {
-		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.HistoryBatches != nil {
+		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
 	}
 	return err
 }
 
-// GetRequest returns the value of Request if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
-	if v != nil && v.Request != nil {
-		return v.Request
+func (v *ImportWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetRequest returns true if Request is not nil.
-func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
-	return v != nil && v.Request != nil
-}
+// IsSetDomain returns true if Domain is not nil.
+func (v *ImportWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *ImportWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *ImportWorkflowExecutionRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// zero value if it is unset.
+func (v *ImportWorkflowExecutionRequest) GetHistoryBatches() (o []*shared.DataBlob) {
+	if v != nil && v.HistoryBatches != nil {
+		return v.HistoryBatches
+	}
+
+	return
+}
+
+// IsSetHistoryBatches returns true if HistoryBatches is not nil.
+func (v *ImportWorkflowExecutionRequest) IsSetHistoryBatches() bool {
+	return v != nil && v.HistoryBatches != nil
+}
+
+type ImportWorkflowExecutionResponse struct {
+	RunId *string `json:"runId,omitempty"`
+}
+
+// ToWire translates a ImportWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ImportWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ImportWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ImportWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ImportWorkflowExecutionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ImportWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ImportWorkflowExecutionResponse
+// struct.
+func (v *ImportWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+
+	return fmt.Sprintf("ImportWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ImportWorkflowExecutionResponse match the
+// provided ImportWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *ImportWorkflowExecutionResponse) Equals(rhs *ImportWorkflowExecutionResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ImportWorkflowExecutionResponse.
+func (v *ImportWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	return err
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *ImportWorkflowExecutionResponse) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *ImportWorkflowExecutionResponse) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+type ListSearchAttributesRequest struct {
+	Domain *string `json:"domain,omitempty"`
+}
+
+// ToWire translates a ListSearchAttributesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ListSearchAttributesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ListSearchAttributesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListSearchAttributesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListSearchAttributesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListSearchAttributesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListSearchAttributesRequest
+// struct.
+func (v *ListSearchAttributesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+
+	return fmt.Sprintf("ListSearchAttributesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ListSearchAttributesRequest match the
+// provided ListSearchAttributesRequest.
+//
+// This function performs a deep comparison.
+func (v *ListSearchAttributesRequest) Equals(rhs *ListSearchAttributesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListSearchAttributesRequest.
+func (v *ListSearchAttributesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ListSearchAttributesRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ListSearchAttributesRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+type RearchiveWorkflowExecutionRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a RearchiveWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RearchiveWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RearchiveWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RearchiveWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RearchiveWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RearchiveWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RearchiveWorkflowExecutionRequest
+// struct.
+func (v *RearchiveWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("RearchiveWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RearchiveWorkflowExecutionRequest match the
+// provided RearchiveWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *RearchiveWorkflowExecutionRequest) Equals(rhs *RearchiveWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RearchiveWorkflowExecutionRequest.
+func (v *RearchiveWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *RearchiveWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *RearchiveWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *RearchiveWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *RearchiveWorkflowExecutionRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type RemoveSearchAttributeRequest struct {
+	Keys   []string `json:"keys,omitempty"`
+	Domain *string  `json:"domain,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a RemoveSearchAttributeRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RemoveSearchAttributeRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Keys != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Keys)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a RemoveSearchAttributeRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RemoveSearchAttributeRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RemoveSearchAttributeRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RemoveSearchAttributeRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Keys, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RemoveSearchAttributeRequest
+// struct.
+func (v *RemoveSearchAttributeRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Keys != nil {
+		fields[i] = fmt.Sprintf("Keys: %v", v.Keys)
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+
+	return fmt.Sprintf("RemoveSearchAttributeRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this RemoveSearchAttributeRequest match the
+// provided RemoveSearchAttributeRequest.
+//
+// This function performs a deep comparison.
+func (v *RemoveSearchAttributeRequest) Equals(rhs *RemoveSearchAttributeRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Keys == nil && rhs.Keys == nil) || (v.Keys != nil && rhs.Keys != nil && _List_String_Equals(v.Keys, rhs.Keys))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RemoveSearchAttributeRequest.
+func (v *RemoveSearchAttributeRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Keys != nil {
+		err = multierr.Append(err, enc.AddArray("keys", (_List_String_Zapper)(v.Keys)))
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	return err
+}
+
+// GetKeys returns the value of Keys if it is set or its
+// zero value if it is unset.
+func (v *RemoveSearchAttributeRequest) GetKeys() (o []string) {
+	if v != nil && v.Keys != nil {
+		return v.Keys
+	}
+
+	return
+}
+
+// IsSetKeys returns true if Keys is not nil.
+func (v *RemoveSearchAttributeRequest) IsSetKeys() bool {
+	return v != nil && v.Keys != nil
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *RemoveSearchAttributeRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *RemoveSearchAttributeRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+type VerifyArchivedWorkflowExecutionRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a VerifyArchivedWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *VerifyArchivedWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a VerifyArchivedWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a VerifyArchivedWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v VerifyArchivedWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *VerifyArchivedWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a VerifyArchivedWorkflowExecutionRequest
+// struct.
+func (v *VerifyArchivedWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("VerifyArchivedWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this VerifyArchivedWorkflowExecutionRequest match the
+// provided VerifyArchivedWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *VerifyArchivedWorkflowExecutionRequest) Equals(rhs *VerifyArchivedWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of VerifyArchivedWorkflowExecutionRequest.
+func (v *VerifyArchivedWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *VerifyArchivedWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *VerifyArchivedWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *VerifyArchivedWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *VerifyArchivedWorkflowExecutionRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type VerifyArchivedWorkflowExecutionResponse struct {
+	Status *ArchivalVerificationStatus `json:"status,omitempty"`
+}
+
+// ToWire translates a VerifyArchivedWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *VerifyArchivedWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Status != nil {
+		w, err = v.Status.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ArchivalVerificationStatus_Read(w wire.Value) (ArchivalVerificationStatus, error) {
+	var v ArchivalVerificationStatus
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a VerifyArchivedWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a VerifyArchivedWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v VerifyArchivedWorkflowExecutionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *VerifyArchivedWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x ArchivalVerificationStatus
+				x, err = _ArchivalVerificationStatus_Read(field.Value)
+				v.Status = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a VerifyArchivedWorkflowExecutionResponse
+// struct.
+func (v *VerifyArchivedWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+		i++
+	}
+
+	return fmt.Sprintf("VerifyArchivedWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _ArchivalVerificationStatus_EqualsPtr(lhs, rhs *ArchivalVerificationStatus) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this VerifyArchivedWorkflowExecutionResponse match the
+// provided VerifyArchivedWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *VerifyArchivedWorkflowExecutionResponse) Equals(rhs *VerifyArchivedWorkflowExecutionResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_ArchivalVerificationStatus_EqualsPtr(v.Status, rhs.Status) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of VerifyArchivedWorkflowExecutionResponse.
+func (v *VerifyArchivedWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Status != nil {
+		err = multierr.Append(err, enc.AddObject("status", *v.Status))
+	}
+	return err
+}
+
+// GetStatus returns the value of Status if it is set or its
+// zero value if it is unset.
+func (v *VerifyArchivedWorkflowExecutionResponse) GetStatus() (o ArchivalVerificationStatus) {
+	if v != nil && v.Status != nil {
+		return *v.Status
+	}
+
+	return
+}
+
+// IsSetStatus returns true if Status is not nil.
+func (v *VerifyArchivedWorkflowExecutionResponse) IsSetStatus() bool {
+	return v != nil && v.Status != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "admin",
+	Package:  "github.com/uber/cadence/.gen/go/admin",
+	FilePath: "admin.thrift",
+	SHA1:     "6632c44a1a449c7cc49bfac5b796501f7d7b5b3d",
+	Includes: []*thriftreflect.ThriftModule{
+		shared.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void CloseShard(1: shared.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  void RemoveTask(1: shared.RemoveTaskRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request. The search attributes are registered\n  * for the domain in request, or cluster wide when no domain is given.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.AccessDeniedError accessDeniedError,\n      5: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * RemoveSearchAttribute removes search attributes registered for a domain.\n  **/\n  void RemoveSearchAttribute(1: RemoveSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.AccessDeniedError accessDeniedError,\n      5: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * ListSearchAttributes returns the search attributes registered for a domain.\n  **/\n  shared.GetSearchAttributesResponse ListSearchAttributes(1: ListSearchAttributesRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.AccessDeniedError accessDeniedError,\n      5: shared.EntityNotExistsError entityNotExistError,\n    )\n\n  /**\n  * PauseActivity stops dispatching the pending activity of a workflow to workers until it is unpaused\n  **/\n  void PauseActivity(1: shared.PauseActivityRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * UnpauseActivity resumes dispatching a paused activity of a workflow\n  **/\n  void UnpauseActivity(1: shared.UnpauseActivityRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * ResetActivity resets the attempt counter and the retry state of a pending activity of a workflow\n  **/\n  void ResetActivity(1: shared.ResetActivityRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * UpdateActivityTimeouts updates the timeouts of a pending activity of a workflow\n  **/\n  void UpdateActivityTimeouts(1: shared.UpdateActivityTimeoutsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * UpdateTaskListLimits pins the dispatch rate and the max outstanding tasks of a task list,\n  * overriding the limits sent by pollers\n  **/\n  void UpdateTaskListLimits(1: shared.UpdateTaskListLimitsRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * VerifyArchivedWorkflowExecution checks that the archived history of a workflow execution still matches\n  * the checksum stored when it was archived and can be read back\n  **/\n  VerifyArchivedWorkflowExecutionResponse VerifyArchivedWorkflowExecution(1: VerifyArchivedWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * RearchiveWorkflowExecution archives the history of a closed workflow execution again. It fails with\n  * 'EntityNotExistError' if the history of the workflow execution has already been deleted.\n  **/\n  void RearchiveWorkflowExecution(1: RearchiveWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.AccessDeniedError accessDeniedError,\n    )\n\n  /**\n  * ImportWorkflowExecution creates a workflow execution from history batches returned by\n  * GetWorkflowExecutionRawHistory, e.g. to move a workflow execution from another cluster. The run ID of the\n  * execution is kept unless it is empty, in which case a new run ID is generated.\n  **/\n  ImportWorkflowExecutionResponse ImportWorkflowExecution(1: ImportWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,\n      4: shared.ServiceBusyError serviceBusyError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.AccessDeniedError accessDeniedError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n  20: optional string domain\n}\n\nstruct RemoveSearchAttributeRequest {\n  10: optional list<string> keys\n  20: optional string domain\n}\n\nstruct ListSearchAttributesRequest {\n  10: optional string domain\n}\n\nenum ArchivalVerificationStatus {\n  VERIFIED,\n  MISSING,\n  CORRUPTED,\n}\n\nstruct VerifyArchivedWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n}\n\nstruct VerifyArchivedWorkflowExecutionResponse {\n  10: optional ArchivalVerificationStatus status\n}\n\nstruct RearchiveWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n}\n\nstruct ImportWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional list<shared.DataBlob> historyBatches\n}\n\nstruct ImportWorkflowExecutionResponse {\n  10: optional string runId\n}\n"
+
+// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
+//
+// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Args struct {
+	Request *AddSearchAttributeRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
+	var v AddSearchAttributeRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddSearchAttribute_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
+// struct.
+func (v *AdminService_AddSearchAttribute_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
+// provided AdminService_AddSearchAttribute_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddSearchAttribute_Args.
+func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
+	return "AddSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.AddSearchAttribute
+// function.
+var AdminService_AddSearchAttribute_Helper = struct {
+	// Args accepts the parameters of AddSearchAttribute in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddSearchAttribute.
+	//
+	// An error can be thrown by AddSearchAttribute only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddSearchAttribute
+	// given the error returned by it. The provided error may
+	// be nil if AddSearchAttribute did not fail.
+	//
+	// This allows mapping errors returned by AddSearchAttribute into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddSearchAttribute
+	//
+	//   err := AddSearchAttribute(args)
+	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+
+	// UnwrapResponse takes the result struct for AddSearchAttribute
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddSearchAttribute threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+}{}
+
+func init() {
+	AdminService_AddSearchAttribute_Helper.Args = func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args {
+		return &AdminService_AddSearchAttribute_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+		if err == nil {
+			return &AdminService_AddSearchAttribute_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+			}
+			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+			}
+			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+			}
+			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.AccessDeniedError")
+			}
+			return &AdminService_AddSearchAttribute_Result{AccessDeniedError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.EntityNotExistError")
+			}
+			return &AdminService_AddSearchAttribute_Result{EntityNotExistError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+//
+// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+}
+
+// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
+	var v shared.AccessDeniedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddSearchAttribute_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// struct.
+func (v *AdminService_AddSearchAttribute_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
+// provided AdminService_AddSearchAttribute_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddSearchAttribute_Result.
+func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
+	return "AddSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_CloseShard_Args represents the arguments for the AdminService.CloseShard function.
+//
+// The arguments for CloseShard are sent and received over the wire as this struct.
+type AdminService_CloseShard_Args struct {
+	Request *shared.CloseShardRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_CloseShard_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
+	var v shared.CloseShardRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_CloseShard_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_CloseShard_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_CloseShard_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _CloseShardRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_CloseShard_Args
+// struct.
+func (v *AdminService_CloseShard_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_CloseShard_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_CloseShard_Args match the
+// provided AdminService_CloseShard_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_CloseShard_Args.
+func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_CloseShard_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "CloseShard" for this struct.
+func (v *AdminService_CloseShard_Args) MethodName() string {
+	return "CloseShard"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_CloseShard_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_CloseShard_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.CloseShard
+// function.
+var AdminService_CloseShard_Helper = struct {
+	// Args accepts the parameters of CloseShard in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.CloseShardRequest,
+	) *AdminService_CloseShard_Args
+
+	// IsException returns true if the given error can be thrown
+	// by CloseShard.
+	//
+	// An error can be thrown by CloseShard only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for CloseShard
+	// given the error returned by it. The provided error may
+	// be nil if CloseShard did not fail.
+	//
+	// This allows mapping errors returned by CloseShard into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// CloseShard
+	//
+	//   err := CloseShard(args)
+	//   result, err := AdminService_CloseShard_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from CloseShard: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_CloseShard_Result, error)
+
+	// UnwrapResponse takes the result struct for CloseShard
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if CloseShard threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_CloseShard_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_CloseShard_Result) error
+}{}
+
+func init() {
+	AdminService_CloseShard_Helper.Args = func(
+		request *shared.CloseShardRequest,
+	) *AdminService_CloseShard_Args {
+		return &AdminService_CloseShard_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_CloseShard_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_CloseShard_Helper.WrapResponse = func(err error) (*AdminService_CloseShard_Result, error) {
+		if err == nil {
+			return &AdminService_CloseShard_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.BadRequestError")
+			}
+			return &AdminService_CloseShard_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.InternalServiceError")
+			}
+			return &AdminService_CloseShard_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.AccessDeniedError")
+			}
+			return &AdminService_CloseShard_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_CloseShard_Helper.UnwrapResponse = func(result *AdminService_CloseShard_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_CloseShard_Result represents the result of a AdminService.CloseShard function call.
+//
+// The result of a CloseShard execution is sent and received over the wire as this struct.
+type AdminService_CloseShard_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_CloseShard_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_CloseShard_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AdminService_CloseShard_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_CloseShard_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_CloseShard_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_CloseShard_Result
+// struct.
+func (v *AdminService_CloseShard_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_CloseShard_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_CloseShard_Result match the
+// provided AdminService_CloseShard_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_CloseShard_Result.
+func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "CloseShard" for this struct.
+func (v *AdminService_CloseShard_Result) MethodName() string {
+	return "CloseShard"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_CloseShard_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+//
+// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
+type AdminService_DescribeHistoryHost_Args struct {
+	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
+	var v shared.DescribeHistoryHostRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeHistoryHost_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
+// struct.
+func (v *AdminService_DescribeHistoryHost_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
+// provided AdminService_DescribeHistoryHost_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeHistoryHost_Args.
+func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
+	return "DescribeHistoryHost"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeHistoryHost
+// function.
+var AdminService_DescribeHistoryHost_Helper = struct {
+	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeHistoryHost.
+	//
+	// An error can be thrown by DescribeHistoryHost only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeHistoryHost
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeHistoryHost into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeHistoryHost
+	//
+	//   value, err := DescribeHistoryHost(args)
+	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeHistoryHost
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeHistoryHost threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeHistoryHost_Helper.Args = func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args {
+		return &AdminService_DescribeHistoryHost_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
+//
+// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeHistoryHost_Result struct {
+	// Value returned by DescribeHistoryHost after a successful execution.
+	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
+	var v shared.DescribeHistoryHostResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeHistoryHost_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
+// struct.
+func (v *AdminService_DescribeHistoryHost_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
+// provided AdminService_DescribeHistoryHost_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeHistoryHost_Result.
+func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
+	return "DescribeHistoryHost"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
+//
+// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_DescribeWorkflowExecution_Args struct {
+	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
+	var v DescribeWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeWorkflowExecution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
+// struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
+// provided AdminService_DescribeWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeWorkflowExecution_Args.
+func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
+	return "DescribeWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeWorkflowExecution
+// function.
+var AdminService_DescribeWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeWorkflowExecution.
+	//
+	// An error can be thrown by DescribeWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeWorkflowExecution
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeWorkflowExecution into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeWorkflowExecution
+	//
+	//   value, err := DescribeWorkflowExecution(args)
+	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeWorkflowExecution_Helper.Args = func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args {
+		return &AdminService_DescribeWorkflowExecution_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
+//
+// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeWorkflowExecution_Result struct {
+	// Value returned by DescribeWorkflowExecution after a successful execution.
+	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
+	var v DescribeWorkflowExecutionResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeWorkflowExecution_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
+// struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
+// provided AdminService_DescribeWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeWorkflowExecution_Result.
+func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
+	return "DescribeWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_GetWorkflowExecutionRawHistory_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistory function.
+//
+// The arguments for GetWorkflowExecutionRawHistory are sent and received over the wire as this struct.
+type AdminService_GetWorkflowExecutionRawHistory_Args struct {
+	GetRequest *GetWorkflowExecutionRawHistoryRequest `json:"getRequest,omitempty"`
+}
+
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.GetRequest != nil {
+		w, err = v.GetRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryRequest, error) {
+	var v GetWorkflowExecutionRawHistoryRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_GetWorkflowExecutionRawHistory_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.GetRequest, err = _GetWorkflowExecutionRawHistoryRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Args
+// struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.GetRequest != nil {
+		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Args match the
+// provided AdminService_GetWorkflowExecutionRawHistory_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetWorkflowExecutionRawHistory_Args.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.GetRequest != nil {
+		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	}
+	return err
+}
+
+// GetGetRequest returns the value of GetRequest if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryRequest) {
+	if v != nil && v.GetRequest != nil {
+		return v.GetRequest
+	}
+
+	return
+}
+
+// IsSetGetRequest returns true if GetRequest is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) IsSetGetRequest() bool {
+	return v != nil && v.GetRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "GetWorkflowExecutionRawHistory" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MethodName() string {
+	return "GetWorkflowExecutionRawHistory"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_GetWorkflowExecutionRawHistory_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetWorkflowExecutionRawHistory
+// function.
+var AdminService_GetWorkflowExecutionRawHistory_Helper = struct {
+	// Args accepts the parameters of GetWorkflowExecutionRawHistory in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		getRequest *GetWorkflowExecutionRawHistoryRequest,
+	) *AdminService_GetWorkflowExecutionRawHistory_Args
+
+	// IsException returns true if the given error can be thrown
+	// by GetWorkflowExecutionRawHistory.
+	//
+	// An error can be thrown by GetWorkflowExecutionRawHistory only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistory
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// GetWorkflowExecutionRawHistory into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by GetWorkflowExecutionRawHistory
+	//
+	//   value, err := GetWorkflowExecutionRawHistory(args)
+	//   result, err := AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistory: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*GetWorkflowExecutionRawHistoryResponse, error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error)
+
+	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistory
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if GetWorkflowExecutionRawHistory threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistory_Result) (*GetWorkflowExecutionRawHistoryResponse, error)
+}{}
+
+func init() {
+	AdminService_GetWorkflowExecutionRawHistory_Helper.Args = func(
+		getRequest *GetWorkflowExecutionRawHistoryRequest,
+	) *AdminService_GetWorkflowExecutionRawHistory_Args {
+		return &AdminService_GetWorkflowExecutionRawHistory_Args{
+			GetRequest: getRequest,
+		}
+	}
+
+	AdminService_GetWorkflowExecutionRawHistory_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryResponse, err error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error) {
+		if err == nil {
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.BadRequestError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.InternalServiceError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.EntityNotExistError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.ServiceBusyError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{ServiceBusyError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.AccessDeniedError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistory_Result) (success *GetWorkflowExecutionRawHistoryResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_GetWorkflowExecutionRawHistory_Result represents the result of a AdminService.GetWorkflowExecutionRawHistory function call.
+//
+// The result of a GetWorkflowExecutionRawHistory execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_GetWorkflowExecutionRawHistory_Result struct {
+	// Value returned by GetWorkflowExecutionRawHistory after a successful execution.
+	Success              *GetWorkflowExecutionRawHistoryResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                 `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError            `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError            `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError                `json:"serviceBusyError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError               `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryResponse, error) {
+	var v GetWorkflowExecutionRawHistoryResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_GetWorkflowExecutionRawHistory_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GetWorkflowExecutionRawHistoryResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Result
+// struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Result match the
+// provided AdminService_GetWorkflowExecutionRawHistory_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_GetWorkflowExecutionRawHistory_Result.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "GetWorkflowExecutionRawHistory" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MethodName() string {
+	return "GetWorkflowExecutionRawHistory"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_ImportWorkflowExecution_Args represents the arguments for the AdminService.ImportWorkflowExecution function.
+//
+// The arguments for ImportWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_ImportWorkflowExecution_Args struct {
+	Request *ImportWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_ImportWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ImportWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ImportWorkflowExecutionRequest_Read(w wire.Value) (*ImportWorkflowExecutionRequest, error) {
+	var v ImportWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ImportWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ImportWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_ImportWorkflowExecution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_ImportWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _ImportWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ImportWorkflowExecution_Args
+// struct.
+func (v *AdminService_ImportWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ImportWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ImportWorkflowExecution_Args match the
+// provided AdminService_ImportWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ImportWorkflowExecution_Args) Equals(rhs *AdminService_ImportWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ImportWorkflowExecution_Args.
+func (v *AdminService_ImportWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Args) GetRequest() (o *ImportWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_ImportWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "ImportWorkflowExecution" for this struct.
+func (v *AdminService_ImportWorkflowExecution_Args) MethodName() string {
+	return "ImportWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_ImportWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_ImportWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ImportWorkflowExecution
+// function.
+var AdminService_ImportWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of ImportWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *ImportWorkflowExecutionRequest,
+	) *AdminService_ImportWorkflowExecution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by ImportWorkflowExecution.
+	//
+	// An error can be thrown by ImportWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for ImportWorkflowExecution
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// ImportWorkflowExecution into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by ImportWorkflowExecution
+	//
+	//   value, err := ImportWorkflowExecution(args)
+	//   result, err := AdminService_ImportWorkflowExecution_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from ImportWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*ImportWorkflowExecutionResponse, error) (*AdminService_ImportWorkflowExecution_Result, error)
+
+	// UnwrapResponse takes the result struct for ImportWorkflowExecution
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if ImportWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_ImportWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ImportWorkflowExecution_Result) (*ImportWorkflowExecutionResponse, error)
+}{}
+
+func init() {
+	AdminService_ImportWorkflowExecution_Helper.Args = func(
+		request *ImportWorkflowExecutionRequest,
+	) *AdminService_ImportWorkflowExecution_Args {
+		return &AdminService_ImportWorkflowExecution_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_ImportWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_ImportWorkflowExecution_Helper.WrapResponse = func(success *ImportWorkflowExecutionResponse, err error) (*AdminService_ImportWorkflowExecution_Result, error) {
+		if err == nil {
+			return &AdminService_ImportWorkflowExecution_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.InternalServiceError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.WorkflowAlreadyStartedError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{WorkflowAlreadyStartedError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.ServiceBusyError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{ServiceBusyError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.DomainNotActiveError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{DomainNotActiveError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ImportWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &AdminService_ImportWorkflowExecution_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_ImportWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_ImportWorkflowExecution_Result) (success *ImportWorkflowExecutionResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.WorkflowAlreadyStartedError != nil {
+			err = result.WorkflowAlreadyStartedError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_ImportWorkflowExecution_Result represents the result of a AdminService.ImportWorkflowExecution function call.
+//
+// The result of a ImportWorkflowExecution execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_ImportWorkflowExecution_Result struct {
+	// Value returned by ImportWorkflowExecution after a successful execution.
+	Success                     *ImportWorkflowExecutionResponse             `json:"success,omitempty"`
+	BadRequestError             *shared.BadRequestError                      `json:"badRequestError,omitempty"`
+	InternalServiceError        *shared.InternalServiceError                 `json:"internalServiceError,omitempty"`
+	WorkflowAlreadyStartedError *shared.WorkflowExecutionAlreadyStartedError `json:"workflowAlreadyStartedError,omitempty"`
+	ServiceBusyError            *shared.ServiceBusyError                     `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError        *shared.DomainNotActiveError                 `json:"domainNotActiveError,omitempty"`
+	AccessDeniedError           *shared.AccessDeniedError                    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_ImportWorkflowExecution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ImportWorkflowExecution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.WorkflowAlreadyStartedError != nil {
+		w, err = v.WorkflowAlreadyStartedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ImportWorkflowExecution_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ImportWorkflowExecutionResponse_Read(w wire.Value) (*ImportWorkflowExecutionResponse, error) {
+	var v ImportWorkflowExecutionResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionAlreadyStartedError_Read(w wire.Value) (*shared.WorkflowExecutionAlreadyStartedError, error) {
+	var v shared.WorkflowExecutionAlreadyStartedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ImportWorkflowExecution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ImportWorkflowExecution_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_ImportWorkflowExecution_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_ImportWorkflowExecution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _ImportWorkflowExecutionResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowAlreadyStartedError, err = _WorkflowExecutionAlreadyStartedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.WorkflowAlreadyStartedError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_ImportWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ImportWorkflowExecution_Result
+// struct.
+func (v *AdminService_ImportWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.WorkflowAlreadyStartedError != nil {
+		fields[i] = fmt.Sprintf("WorkflowAlreadyStartedError: %v", v.WorkflowAlreadyStartedError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ImportWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ImportWorkflowExecution_Result match the
+// provided AdminService_ImportWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ImportWorkflowExecution_Result) Equals(rhs *AdminService_ImportWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.WorkflowAlreadyStartedError == nil && rhs.WorkflowAlreadyStartedError == nil) || (v.WorkflowAlreadyStartedError != nil && rhs.WorkflowAlreadyStartedError != nil && v.WorkflowAlreadyStartedError.Equals(rhs.WorkflowAlreadyStartedError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ImportWorkflowExecution_Result.
+func (v *AdminService_ImportWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.WorkflowAlreadyStartedError != nil {
+		err = multierr.Append(err, enc.AddObject("workflowAlreadyStartedError", v.WorkflowAlreadyStartedError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetSuccess() (o *ImportWorkflowExecutionResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetWorkflowAlreadyStartedError returns the value of WorkflowAlreadyStartedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetWorkflowAlreadyStartedError() (o *shared.WorkflowExecutionAlreadyStartedError) {
+	if v != nil && v.WorkflowAlreadyStartedError != nil {
+		return v.WorkflowAlreadyStartedError
+	}
+
+	return
+}
+
+// IsSetWorkflowAlreadyStartedError returns true if WorkflowAlreadyStartedError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetWorkflowAlreadyStartedError() bool {
+	return v != nil && v.WorkflowAlreadyStartedError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ImportWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_ImportWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "ImportWorkflowExecution" for this struct.
+func (v *AdminService_ImportWorkflowExecution_Result) MethodName() string {
+	return "ImportWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_ImportWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_ListSearchAttributes_Args represents the arguments for the AdminService.ListSearchAttributes function.
+//
+// The arguments for ListSearchAttributes are sent and received over the wire as this struct.
+type AdminService_ListSearchAttributes_Args struct {
+	Request *ListSearchAttributesRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_ListSearchAttributes_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ListSearchAttributes_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ListSearchAttributesRequest_Read(w wire.Value) (*ListSearchAttributesRequest, error) {
+	var v ListSearchAttributesRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListSearchAttributes_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListSearchAttributes_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_ListSearchAttributes_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_ListSearchAttributes_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _ListSearchAttributesRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListSearchAttributes_Args
+// struct.
+func (v *AdminService_ListSearchAttributes_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListSearchAttributes_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListSearchAttributes_Args match the
+// provided AdminService_ListSearchAttributes_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListSearchAttributes_Args) Equals(rhs *AdminService_ListSearchAttributes_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListSearchAttributes_Args.
+func (v *AdminService_ListSearchAttributes_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListSearchAttributes_Args) GetRequest() (o *ListSearchAttributesRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_ListSearchAttributes_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "ListSearchAttributes" for this struct.
+func (v *AdminService_ListSearchAttributes_Args) MethodName() string {
+	return "ListSearchAttributes"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_ListSearchAttributes_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_ListSearchAttributes_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ListSearchAttributes
+// function.
+var AdminService_ListSearchAttributes_Helper = struct {
+	// Args accepts the parameters of ListSearchAttributes in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *ListSearchAttributesRequest,
+	) *AdminService_ListSearchAttributes_Args
+
+	// IsException returns true if the given error can be thrown
+	// by ListSearchAttributes.
+	//
+	// An error can be thrown by ListSearchAttributes only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for ListSearchAttributes
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// ListSearchAttributes into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by ListSearchAttributes
+	//
+	//   value, err := ListSearchAttributes(args)
+	//   result, err := AdminService_ListSearchAttributes_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from ListSearchAttributes: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.GetSearchAttributesResponse, error) (*AdminService_ListSearchAttributes_Result, error)
+
+	// UnwrapResponse takes the result struct for ListSearchAttributes
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if ListSearchAttributes threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_ListSearchAttributes_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ListSearchAttributes_Result) (*shared.GetSearchAttributesResponse, error)
+}{}
+
+func init() {
+	AdminService_ListSearchAttributes_Helper.Args = func(
+		request *ListSearchAttributesRequest,
+	) *AdminService_ListSearchAttributes_Args {
+		return &AdminService_ListSearchAttributes_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_ListSearchAttributes_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_ListSearchAttributes_Helper.WrapResponse = func(success *shared.GetSearchAttributesResponse, err error) (*AdminService_ListSearchAttributes_Result, error) {
+		if err == nil {
+			return &AdminService_ListSearchAttributes_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListSearchAttributes_Result.BadRequestError")
+			}
+			return &AdminService_ListSearchAttributes_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListSearchAttributes_Result.InternalServiceError")
+			}
+			return &AdminService_ListSearchAttributes_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListSearchAttributes_Result.ServiceBusyError")
+			}
+			return &AdminService_ListSearchAttributes_Result{ServiceBusyError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListSearchAttributes_Result.AccessDeniedError")
+			}
+			return &AdminService_ListSearchAttributes_Result{AccessDeniedError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListSearchAttributes_Result.EntityNotExistError")
+			}
+			return &AdminService_ListSearchAttributes_Result{EntityNotExistError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_ListSearchAttributes_Helper.UnwrapResponse = func(result *AdminService_ListSearchAttributes_Result) (success *shared.GetSearchAttributesResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_ListSearchAttributes_Result represents the result of a AdminService.ListSearchAttributes function call.
+//
+// The result of a ListSearchAttributes execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_ListSearchAttributes_Result struct {
+	// Value returned by ListSearchAttributes after a successful execution.
+	Success              *shared.GetSearchAttributesResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError        `json:"entityNotExistError,omitempty"`
+}
+
+// ToWire translates a AdminService_ListSearchAttributes_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_ListSearchAttributes_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_ListSearchAttributes_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _GetSearchAttributesResponse_Read(w wire.Value) (*shared.GetSearchAttributesResponse, error) {
+	var v shared.GetSearchAttributesResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_ListSearchAttributes_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_ListSearchAttributes_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_ListSearchAttributes_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_ListSearchAttributes_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GetSearchAttributesResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_ListSearchAttributes_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_ListSearchAttributes_Result
+// struct.
+func (v *AdminService_ListSearchAttributes_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_ListSearchAttributes_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_ListSearchAttributes_Result match the
+// provided AdminService_ListSearchAttributes_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_ListSearchAttributes_Result) Equals(rhs *AdminService_ListSearchAttributes_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_ListSearchAttributes_Result.
+func (v *AdminService_ListSearchAttributes_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListSearchAttributes_Result) GetSuccess() (o *shared.GetSearchAttributesResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_ListSearchAttributes_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListSearchAttributes_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_ListSearchAttributes_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListSearchAttributes_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_ListSearchAttributes_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListSearchAttributes_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_ListSearchAttributes_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListSearchAttributes_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_ListSearchAttributes_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ListSearchAttributes_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_ListSearchAttributes_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "ListSearchAttributes" for this struct.
+func (v *AdminService_ListSearchAttributes_Result) MethodName() string {
+	return "ListSearchAttributes"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_ListSearchAttributes_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_PauseActivity_Args represents the arguments for the AdminService.PauseActivity function.
+//
+// The arguments for PauseActivity are sent and received over the wire as this struct.
+type AdminService_PauseActivity_Args struct {
+	Request *shared.PauseActivityRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_PauseActivity_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_PauseActivity_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PauseActivityRequest_Read(w wire.Value) (*shared.PauseActivityRequest, error) {
+	var v shared.PauseActivityRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_PauseActivity_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_PauseActivity_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_PauseActivity_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_PauseActivity_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _PauseActivityRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_PauseActivity_Args
+// struct.
+func (v *AdminService_PauseActivity_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_PauseActivity_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_PauseActivity_Args match the
+// provided AdminService_PauseActivity_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_PauseActivity_Args) Equals(rhs *AdminService_PauseActivity_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_PauseActivity_Args.
+func (v *AdminService_PauseActivity_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_PauseActivity_Args) GetRequest() (o *shared.PauseActivityRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_PauseActivity_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "PauseActivity" for this struct.
+func (v *AdminService_PauseActivity_Args) MethodName() string {
+	return "PauseActivity"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_PauseActivity_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_PauseActivity_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.PauseActivity
+// function.
+var AdminService_PauseActivity_Helper = struct {
+	// Args accepts the parameters of PauseActivity in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.PauseActivityRequest,
+	) *AdminService_PauseActivity_Args
+
+	// IsException returns true if the given error can be thrown
+	// by PauseActivity.
+	//
+	// An error can be thrown by PauseActivity only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for PauseActivity
+	// given the error returned by it. The provided error may
+	// be nil if PauseActivity did not fail.
+	//
+	// This allows mapping errors returned by PauseActivity into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// PauseActivity
+	//
+	//   err := PauseActivity(args)
+	//   result, err := AdminService_PauseActivity_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from PauseActivity: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_PauseActivity_Result, error)
+
+	// UnwrapResponse takes the result struct for PauseActivity
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if PauseActivity threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_PauseActivity_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_PauseActivity_Result) error
+}{}
+
+func init() {
+	AdminService_PauseActivity_Helper.Args = func(
+		request *shared.PauseActivityRequest,
+	) *AdminService_PauseActivity_Args {
+		return &AdminService_PauseActivity_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_PauseActivity_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_PauseActivity_Helper.WrapResponse = func(err error) (*AdminService_PauseActivity_Result, error) {
+		if err == nil {
+			return &AdminService_PauseActivity_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.BadRequestError")
+			}
+			return &AdminService_PauseActivity_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.InternalServiceError")
+			}
+			return &AdminService_PauseActivity_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.EntityNotExistError")
+			}
+			return &AdminService_PauseActivity_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.ServiceBusyError")
+			}
+			return &AdminService_PauseActivity_Result{ServiceBusyError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.DomainNotActiveError")
+			}
+			return &AdminService_PauseActivity_Result{DomainNotActiveError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_PauseActivity_Result.AccessDeniedError")
+			}
+			return &AdminService_PauseActivity_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_PauseActivity_Helper.UnwrapResponse = func(result *AdminService_PauseActivity_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_PauseActivity_Result represents the result of a AdminService.PauseActivity function call.
+//
+// The result of a PauseActivity execution is sent and received over the wire as this struct.
+type AdminService_PauseActivity_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_PauseActivity_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_PauseActivity_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_PauseActivity_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AdminService_PauseActivity_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_PauseActivity_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_PauseActivity_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_PauseActivity_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_PauseActivity_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_PauseActivity_Result
+// struct.
+func (v *AdminService_PauseActivity_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_PauseActivity_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_PauseActivity_Result match the
+// provided AdminService_PauseActivity_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_PauseActivity_Result) Equals(rhs *AdminService_PauseActivity_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_PauseActivity_Result.
+func (v *AdminService_PauseActivity_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_PauseActivity_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_PauseActivity_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_PauseActivity_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_PauseActivity_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_PauseActivity_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_PauseActivity_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_PauseActivity_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "PauseActivity" for this struct.
+func (v *AdminService_PauseActivity_Result) MethodName() string {
+	return "PauseActivity"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_PauseActivity_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_RearchiveWorkflowExecution_Args represents the arguments for the AdminService.RearchiveWorkflowExecution function.
+//
+// The arguments for RearchiveWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_RearchiveWorkflowExecution_Args struct {
+	Request *RearchiveWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_RearchiveWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_RearchiveWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RearchiveWorkflowExecutionRequest_Read(w wire.Value) (*RearchiveWorkflowExecutionRequest, error) {
+	var v RearchiveWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_RearchiveWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_RearchiveWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_RearchiveWorkflowExecution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_RearchiveWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _RearchiveWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_RearchiveWorkflowExecution_Args
+// struct.
+func (v *AdminService_RearchiveWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_RearchiveWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_RearchiveWorkflowExecution_Args match the
+// provided AdminService_RearchiveWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_RearchiveWorkflowExecution_Args) Equals(rhs *AdminService_RearchiveWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_RearchiveWorkflowExecution_Args.
+func (v *AdminService_RearchiveWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RearchiveWorkflowExecution_Args) GetRequest() (o *RearchiveWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_RearchiveWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "RearchiveWorkflowExecution" for this struct.
+func (v *AdminService_RearchiveWorkflowExecution_Args) MethodName() string {
+	return "RearchiveWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_RearchiveWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_RearchiveWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RearchiveWorkflowExecution
+// function.
+var AdminService_RearchiveWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of RearchiveWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *RearchiveWorkflowExecutionRequest,
+	) *AdminService_RearchiveWorkflowExecution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by RearchiveWorkflowExecution.
+	//
+	// An error can be thrown by RearchiveWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for RearchiveWorkflowExecution
+	// given the error returned by it. The provided error may
+	// be nil if RearchiveWorkflowExecution did not fail.
+	//
+	// This allows mapping errors returned by RearchiveWorkflowExecution into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RearchiveWorkflowExecution
+	//
+	//   err := RearchiveWorkflowExecution(args)
+	//   result, err := AdminService_RearchiveWorkflowExecution_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from RearchiveWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_RearchiveWorkflowExecution_Result, error)
+
+	// UnwrapResponse takes the result struct for RearchiveWorkflowExecution
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if RearchiveWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_RearchiveWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RearchiveWorkflowExecution_Result) error
+}{}
+
+func init() {
+	AdminService_RearchiveWorkflowExecution_Helper.Args = func(
+		request *RearchiveWorkflowExecutionRequest,
+	) *AdminService_RearchiveWorkflowExecution_Args {
+		return &AdminService_RearchiveWorkflowExecution_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_RearchiveWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_RearchiveWorkflowExecution_Helper.WrapResponse = func(err error) (*AdminService_RearchiveWorkflowExecution_Result, error) {
+		if err == nil {
+			return &AdminService_RearchiveWorkflowExecution_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RearchiveWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_RearchiveWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RearchiveWorkflowExecution_Result.InternalServiceError")
+			}
+			return &AdminService_RearchiveWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RearchiveWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &AdminService_RearchiveWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RearchiveWorkflowExecution_Result.ServiceBusyError")
+			}
+			return &AdminService_RearchiveWorkflowExecution_Result{ServiceBusyError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RearchiveWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &AdminService_RearchiveWorkflowExecution_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_RearchiveWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_RearchiveWorkflowExecution_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_RearchiveWorkflowExecution_Result represents the result of a AdminService.RearchiveWorkflowExecution function call.
+//
+// The result of a RearchiveWorkflowExecution execution is sent and received over the wire as this struct.
+type AdminService_RearchiveWorkflowExecution_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_RearchiveWorkflowExecution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_RearchiveWorkflowExecution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_RearchiveWorkflowExecution_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AdminService_RearchiveWorkflowExecution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_RearchiveWorkflowExecution_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_RearchiveWorkflowExecution_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_RearchiveWorkflowExecution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_RearchiveWorkflowExecution_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_RearchiveWorkflowExecution_Result
+// struct.
+func (v *AdminService_RearchiveWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_RearchiveWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_RearchiveWorkflowExecution_Result match the
+// provided AdminService_RearchiveWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_RearchiveWorkflowExecution_Result) Equals(rhs *AdminService_RearchiveWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_RearchiveWorkflowExecution_Result.
+func (v *AdminService_RearchiveWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RearchiveWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_RearchiveWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RearchiveWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_RearchiveWorkflowExecution_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RearchiveWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_RearchiveWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RearchiveWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_RearchiveWorkflowExecution_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RearchiveWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_RearchiveWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "RearchiveWorkflowExecution" for this struct.
+func (v *AdminService_RearchiveWorkflowExecution_Result) MethodName() string {
+	return "RearchiveWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_RearchiveWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_RemoveSearchAttribute_Args represents the arguments for the AdminService.RemoveSearchAttribute function.
+//
+// The arguments for RemoveSearchAttribute are sent and received over the wire as this struct.
+type AdminService_RemoveSearchAttribute_Args struct {
+	Request *RemoveSearchAttributeRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_RemoveSearchAttribute_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_RemoveSearchAttribute_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RemoveSearchAttributeRequest_Read(w wire.Value) (*RemoveSearchAttributeRequest, error) {
+	var v RemoveSearchAttributeRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_RemoveSearchAttribute_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_RemoveSearchAttribute_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_RemoveSearchAttribute_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_RemoveSearchAttribute_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _RemoveSearchAttributeRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_RemoveSearchAttribute_Args
+// struct.
+func (v *AdminService_RemoveSearchAttribute_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_RemoveSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_RemoveSearchAttribute_Args match the
+// provided AdminService_RemoveSearchAttribute_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_RemoveSearchAttribute_Args) Equals(rhs *AdminService_RemoveSearchAttribute_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_RemoveSearchAttribute_Args.
+func (v *AdminService_RemoveSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RemoveSearchAttribute_Args) GetRequest() (o *RemoveSearchAttributeRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_RemoveSearchAttribute_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "RemoveSearchAttribute" for this struct.
+func (v *AdminService_RemoveSearchAttribute_Args) MethodName() string {
+	return "RemoveSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_RemoveSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_RemoveSearchAttribute_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RemoveSearchAttribute
+// function.
+var AdminService_RemoveSearchAttribute_Helper = struct {
+	// Args accepts the parameters of RemoveSearchAttribute in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *RemoveSearchAttributeRequest,
+	) *AdminService_RemoveSearchAttribute_Args
+
+	// IsException returns true if the given error can be thrown
+	// by RemoveSearchAttribute.
+	//
+	// An error can be thrown by RemoveSearchAttribute only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for RemoveSearchAttribute
+	// given the error returned by it. The provided error may
+	// be nil if RemoveSearchAttribute did not fail.
+	//
+	// This allows mapping errors returned by RemoveSearchAttribute into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RemoveSearchAttribute
+	//
+	//   err := RemoveSearchAttribute(args)
+	//   result, err := AdminService_RemoveSearchAttribute_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from RemoveSearchAttribute: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_RemoveSearchAttribute_Result, error)
+
+	// UnwrapResponse takes the result struct for RemoveSearchAttribute
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if RemoveSearchAttribute threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_RemoveSearchAttribute_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RemoveSearchAttribute_Result) error
+}{}
+
+func init() {
+	AdminService_RemoveSearchAttribute_Helper.Args = func(
+		request *RemoveSearchAttributeRequest,
+	) *AdminService_RemoveSearchAttribute_Args {
+		return &AdminService_RemoveSearchAttribute_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_RemoveSearchAttribute_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_RemoveSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_RemoveSearchAttribute_Result, error) {
+		if err == nil {
+			return &AdminService_RemoveSearchAttribute_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveSearchAttribute_Result.BadRequestError")
+			}
+			return &AdminService_RemoveSearchAttribute_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveSearchAttribute_Result.InternalServiceError")
+			}
+			return &AdminService_RemoveSearchAttribute_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveSearchAttribute_Result.ServiceBusyError")
+			}
+			return &AdminService_RemoveSearchAttribute_Result{ServiceBusyError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveSearchAttribute_Result.AccessDeniedError")
+			}
+			return &AdminService_RemoveSearchAttribute_Result{AccessDeniedError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveSearchAttribute_Result.EntityNotExistError")
+			}
+			return &AdminService_RemoveSearchAttribute_Result{EntityNotExistError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_RemoveSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_RemoveSearchAttribute_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_RemoveSearchAttribute_Result represents the result of a AdminService.RemoveSearchAttribute function call.
+//
+// The result of a RemoveSearchAttribute execution is sent and received over the wire as this struct.
+type AdminService_RemoveSearchAttribute_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+}
+
+// ToWire translates a AdminService_RemoveSearchAttribute_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_RemoveSearchAttribute_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_RemoveSearchAttribute_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AdminService_RemoveSearchAttribute_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_RemoveSearchAttribute_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_RemoveSearchAttribute_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_RemoveSearchAttribute_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_RemoveSearchAttribute_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_RemoveSearchAttribute_Result
+// struct.
+func (v *AdminService_RemoveSearchAttribute_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_RemoveSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_RemoveSearchAttribute_Result match the
+// provided AdminService_RemoveSearchAttribute_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_RemoveSearchAttribute_Result) Equals(rhs *AdminService_RemoveSearchAttribute_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_RemoveSearchAttribute_Result.
+func (v *AdminService_RemoveSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RemoveSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_RemoveSearchAttribute_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RemoveSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_RemoveSearchAttribute_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RemoveSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_RemoveSearchAttribute_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RemoveSearchAttribute_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_RemoveSearchAttribute_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RemoveSearchAttribute_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_RemoveSearchAttribute_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "RemoveSearchAttribute" for this struct.
+func (v *AdminService_RemoveSearchAttribute_Result) MethodName() string {
+	return "RemoveSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_RemoveSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_RemoveTask_Args represents the arguments for the AdminService.RemoveTask function.
+//
+// The arguments for RemoveTask are sent and received over the wire as this struct.
+type AdminService_RemoveTask_Args struct {
+	Request *shared.RemoveTaskRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_RemoveTask_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_RemoveTask_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RemoveTaskRequest_Read(w wire.Value) (*shared.RemoveTaskRequest, error) {
+	var v shared.RemoveTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_RemoveTask_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_RemoveTask_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_RemoveTask_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_RemoveTask_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _RemoveTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_RemoveTask_Args
+// struct.
+func (v *AdminService_RemoveTask_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_RemoveTask_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_RemoveTask_Args match the
+// provided AdminService_RemoveTask_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_RemoveTask_Args) Equals(rhs *AdminService_RemoveTask_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_RemoveTask_Args.
+func (v *AdminService_RemoveTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_RemoveTask_Args) GetRequest() (o *shared.RemoveTaskRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_RemoveTask_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "RemoveTask" for this struct.
+func (v *AdminService_RemoveTask_Args) MethodName() string {
+	return "RemoveTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RemoveTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.AddSearchAttribute
+// AdminService_RemoveTask_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RemoveTask
 // function.
-var AdminService_AddSearchAttribute_Helper = struct {
-	// Args accepts the parameters of AddSearchAttribute in-order and returns
+var AdminService_RemoveTask_Helper = struct {
+	// Args accepts the parameters of RemoveTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args
+		request *shared.RemoveTaskRequest,
+	) *AdminService_RemoveTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddSearchAttribute.
+	// by RemoveTask.
 	//
-	// An error can be thrown by AddSearchAttribute only if the
+	// An error can be thrown by RemoveTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddSearchAttribute
+	// WrapResponse returns the result struct for RemoveTask
 	// given the error returned by it. The provided error may
-	// be nil if AddSearchAttribute did not fail.
+	// be nil if RemoveTask did not fail.
 	//
-	// This allows mapping errors returned by AddSearchAttribute into a
+	// This allows mapping errors returned by RemoveTask into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// AddSearchAttribute
+	// RemoveTask
 	//
-	//   err := AddSearchAttribute(args)
-	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   err := RemoveTask(args)
+	//   result, err := AdminService_RemoveTask_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//     return fmt.Errorf("unexpected error from RemoveTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+	WrapResponse func(error) (*AdminService_RemoveTask_Result, error)
 
-	// UnwrapResponse takes the result struct for AddSearchAttribute
+	// UnwrapResponse takes the result struct for RemoveTask
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if AddSearchAttribute threw an
+	// The error is non-nil only if RemoveTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+	//   err := AdminService_RemoveTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RemoveTask_Result) error
 }{}
 
 func init() {
-	AdminService_AddSearchAttribute_Helper.Args = func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args {
-		return &AdminService_AddSearchAttribute_Args{
+	AdminService_RemoveTask_Helper.Args = func(
+		request *shared.RemoveTaskRequest,
+	) *AdminService_RemoveTask_Args {
+		return &AdminService_RemoveTask_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+	AdminService_RemoveTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.ServiceBusyError:
+		case *shared.AccessDeniedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+	AdminService_RemoveTask_Helper.WrapResponse = func(err error) (*AdminService_RemoveTask_Result, error) {
 		if err == nil {
-			return &AdminService_AddSearchAttribute_Result{}, nil
+			return &AdminService_RemoveTask_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.BadRequestError")
 			}
-			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+			return &AdminService_RemoveTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.InternalServiceError")
 			}
-			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_RemoveTask_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.AccessDeniedError")
 			}
-			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+			return &AdminService_RemoveTask_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+	AdminService_RemoveTask_Helper.UnwrapResponse = func(result *AdminService_RemoveTask_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -1830,8 +9887,8 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
 			return
 		}
 		return
@@ -1839,16 +9896,16 @@ func init() {
 
 }
 
-// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+// AdminService_RemoveTask_Result represents the result of a AdminService.RemoveTask function call.
 //
-// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Result struct {
+// The result of a RemoveTask execution is sent and received over the wire as this struct.
+type AdminService_RemoveTask_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RemoveTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1863,7 +9920,7 @@ type AdminService_AddSearchAttribute_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
@@ -1887,8 +9944,8 @@ func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -1897,35 +9954,17 @@ func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_RemoveTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
+// An error is returned if we were unable to build a AdminService_RemoveTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1933,12 +9972,12 @@ func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Result
+//   var v AdminService_RemoveTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1961,7 +10000,7 @@ func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1977,19 +10016,19 @@ func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// String returns a readable string representation of a AdminService_RemoveTask_Result
 // struct.
-func (v *AdminService_AddSearchAttribute_Result) String() string {
+func (v *AdminService_RemoveTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2004,19 +10043,19 @@ func (v *AdminService_AddSearchAttribute_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RemoveTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
-// provided AdminService_AddSearchAttribute_Result.
+// Equals returns true if all the fields of this AdminService_RemoveTask_Result match the
+// provided AdminService_RemoveTask_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
+func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2028,7 +10067,7 @@ func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSea
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
 
@@ -2036,8 +10075,8 @@ func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSea
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Result.
-func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RemoveTask_Result.
+func (v *AdminService_RemoveTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2047,15 +10086,15 @@ func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.Ob
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RemoveTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -2064,13 +10103,13 @@ func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RemoveTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_RemoveTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -2079,48 +10118,48 @@ func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_RemoveTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
+func (v *AdminService_RemoveTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
 
 	return
 }
 
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_RemoveTask_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "RemoveTask" for this struct.
+func (v *AdminService_RemoveTask_Result) MethodName() string {
+	return "RemoveTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RemoveTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_CloseShard_Args represents the arguments for the AdminService.CloseShard function.
+// AdminService_ResetActivity_Args represents the arguments for the AdminService.ResetActivity function.
 //
-// The arguments for CloseShard are sent and received over the wire as this struct.
-type AdminService_CloseShard_Args struct {
-	Request *shared.CloseShardRequest `json:"request,omitempty"`
+// The arguments for ResetActivity are sent and received over the wire as this struct.
+type AdminService_ResetActivity_Args struct {
+	Request *shared.ResetActivityRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_CloseShard_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResetActivity_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2135,7 +10174,7 @@ type AdminService_CloseShard_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ResetActivity_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -2155,17 +10194,17 @@ func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
-	var v shared.CloseShardRequest
+func _ResetActivityRequest_Read(w wire.Value) (*shared.ResetActivityRequest, error) {
+	var v shared.ResetActivityRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_CloseShard_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ResetActivity_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_CloseShard_Args struct
+// An error is returned if we were unable to build a AdminService_ResetActivity_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2173,19 +10212,19 @@ func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_CloseShard_Args
+//   var v AdminService_ResetActivity_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ResetActivity_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _CloseShardRequest_Read(field.Value)
+				v.Request, err = _ResetActivityRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2197,9 +10236,9 @@ func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_CloseShard_Args
+// String returns a readable string representation of a AdminService_ResetActivity_Args
 // struct.
-func (v *AdminService_CloseShard_Args) String() string {
+func (v *AdminService_ResetActivity_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2211,14 +10250,14 @@ func (v *AdminService_CloseShard_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_CloseShard_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResetActivity_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_CloseShard_Args match the
-// provided AdminService_CloseShard_Args.
+// Equals returns true if all the fields of this AdminService_ResetActivity_Args match the
+// provided AdminService_ResetActivity_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args) bool {
+func (v *AdminService_ResetActivity_Args) Equals(rhs *AdminService_ResetActivity_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2232,8 +10271,8 @@ func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args)
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_CloseShard_Args.
-func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResetActivity_Args.
+func (v *AdminService_ResetActivity_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2245,7 +10284,7 @@ func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncode
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest) {
+func (v *AdminService_ResetActivity_Args) GetRequest() (o *shared.ResetActivityRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -2254,86 +10293,92 @@ func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_CloseShard_Args) IsSetRequest() bool {
+func (v *AdminService_ResetActivity_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "CloseShard" for this struct.
-func (v *AdminService_CloseShard_Args) MethodName() string {
-	return "CloseShard"
+// This will always be "ResetActivity" for this struct.
+func (v *AdminService_ResetActivity_Args) MethodName() string {
+	return "ResetActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_CloseShard_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResetActivity_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_CloseShard_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.CloseShard
+// AdminService_ResetActivity_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ResetActivity
 // function.
-var AdminService_CloseShard_Helper = struct {
-	// Args accepts the parameters of CloseShard in-order and returns
+var AdminService_ResetActivity_Helper = struct {
+	// Args accepts the parameters of ResetActivity in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.CloseShardRequest,
-	) *AdminService_CloseShard_Args
+		request *shared.ResetActivityRequest,
+	) *AdminService_ResetActivity_Args
 
 	// IsException returns true if the given error can be thrown
-	// by CloseShard.
+	// by ResetActivity.
 	//
-	// An error can be thrown by CloseShard only if the
+	// An error can be thrown by ResetActivity only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for CloseShard
+	// WrapResponse returns the result struct for ResetActivity
 	// given the error returned by it. The provided error may
-	// be nil if CloseShard did not fail.
+	// be nil if ResetActivity did not fail.
 	//
-	// This allows mapping errors returned by CloseShard into a
+	// This allows mapping errors returned by ResetActivity into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// CloseShard
+	// ResetActivity
 	//
-	//   err := CloseShard(args)
-	//   result, err := AdminService_CloseShard_Helper.WrapResponse(err)
+	//   err := ResetActivity(args)
+	//   result, err := AdminService_ResetActivity_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from CloseShard: %v", err)
+	//     return fmt.Errorf("unexpected error from ResetActivity: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_CloseShard_Result, error)
+	WrapResponse func(error) (*AdminService_ResetActivity_Result, error)
 
-	// UnwrapResponse takes the result struct for CloseShard
+	// UnwrapResponse takes the result struct for ResetActivity
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if CloseShard threw an
+	// The error is non-nil only if ResetActivity threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_CloseShard_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_CloseShard_Result) error
+	//   err := AdminService_ResetActivity_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ResetActivity_Result) error
 }{}
 
 func init() {
-	AdminService_CloseShard_Helper.Args = func(
-		request *shared.CloseShardRequest,
-	) *AdminService_CloseShard_Args {
-		return &AdminService_CloseShard_Args{
+	AdminService_ResetActivity_Helper.Args = func(
+		request *shared.ResetActivityRequest,
+	) *AdminService_ResetActivity_Args {
+		return &AdminService_ResetActivity_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_CloseShard_Helper.IsException = func(err error) bool {
+	AdminService_ResetActivity_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
 		case *shared.AccessDeniedError:
 			return true
 		default:
@@ -2341,32 +10386,47 @@ func init() {
 		}
 	}
 
-	AdminService_CloseShard_Helper.WrapResponse = func(err error) (*AdminService_CloseShard_Result, error) {
+	AdminService_ResetActivity_Helper.WrapResponse = func(err error) (*AdminService_ResetActivity_Result, error) {
 		if err == nil {
-			return &AdminService_CloseShard_Result{}, nil
+			return &AdminService_ResetActivity_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.BadRequestError")
 			}
-			return &AdminService_CloseShard_Result{BadRequestError: e}, nil
+			return &AdminService_ResetActivity_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.InternalServiceError")
 			}
-			return &AdminService_CloseShard_Result{InternalServiceError: e}, nil
+			return &AdminService_ResetActivity_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.EntityNotExistError")
+			}
+			return &AdminService_ResetActivity_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.ServiceBusyError")
+			}
+			return &AdminService_ResetActivity_Result{ServiceBusyError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.DomainNotActiveError")
+			}
+			return &AdminService_ResetActivity_Result{DomainNotActiveError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ResetActivity_Result.AccessDeniedError")
 			}
-			return &AdminService_CloseShard_Result{AccessDeniedError: e}, nil
+			return &AdminService_ResetActivity_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_CloseShard_Helper.UnwrapResponse = func(result *AdminService_CloseShard_Result) (err error) {
+	AdminService_ResetActivity_Helper.UnwrapResponse = func(result *AdminService_ResetActivity_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -2375,6 +10435,18 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
 		if result.AccessDeniedError != nil {
 			err = result.AccessDeniedError
 			return
@@ -2384,16 +10456,19 @@ func init() {
 
 }
 
-// AdminService_CloseShard_Result represents the result of a AdminService.CloseShard function call.
+// AdminService_ResetActivity_Result represents the result of a AdminService.ResetActivity function call.
 //
-// The result of a CloseShard execution is sent and received over the wire as this struct.
-type AdminService_CloseShard_Result struct {
+// The result of a ResetActivity execution is sent and received over the wire as this struct.
+type AdminService_ResetActivity_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
 	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_CloseShard_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ResetActivity_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2408,9 +10483,9 @@ type AdminService_CloseShard_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_CloseShard_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ResetActivity_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2432,33 +10507,51 @@ func (v *AdminService_CloseShard_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 	if v.AccessDeniedError != nil {
 		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_ResetActivity_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
-	var v shared.AccessDeniedError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_CloseShard_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ResetActivity_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_CloseShard_Result struct
+// An error is returned if we were unable to build a AdminService_ResetActivity_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2466,12 +10559,12 @@ func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_CloseShard_Result
+//   var v AdminService_ResetActivity_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ResetActivity_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -2493,6 +10586,30 @@ func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
 
 			}
 		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
 			if field.Value.Type() == wire.TStruct {
 				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
@@ -2510,24 +10627,33 @@ func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
 	if v.AccessDeniedError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ResetActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_CloseShard_Result
+// String returns a readable string representation of a AdminService_ResetActivity_Result
 // struct.
-func (v *AdminService_CloseShard_Result) String() string {
+func (v *AdminService_ResetActivity_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [6]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -2537,19 +10663,31 @@ func (v *AdminService_CloseShard_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
 	if v.AccessDeniedError != nil {
 		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_CloseShard_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ResetActivity_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_CloseShard_Result match the
-// provided AdminService_CloseShard_Result.
+// Equals returns true if all the fields of this AdminService_ResetActivity_Result match the
+// provided AdminService_ResetActivity_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Result) bool {
+func (v *AdminService_ResetActivity_Result) Equals(rhs *AdminService_ResetActivity_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2561,6 +10699,15 @@ func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Res
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
 	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
@@ -2569,8 +10716,8 @@ func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Res
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_CloseShard_Result.
-func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ResetActivity_Result.
+func (v *AdminService_ResetActivity_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2580,6 +10727,15 @@ func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
 	if v.AccessDeniedError != nil {
 		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
@@ -2588,7 +10744,7 @@ func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEnco
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_ResetActivity_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -2597,13 +10753,13 @@ func (v *AdminService_CloseShard_Result) GetBadRequestError() (o *shared.BadRequ
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_CloseShard_Result) IsSetBadRequestError() bool {
+func (v *AdminService_ResetActivity_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_ResetActivity_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -2612,13 +10768,58 @@ func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.In
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_CloseShard_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_ResetActivity_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ResetActivity_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_ResetActivity_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ResetActivity_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_ResetActivity_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_ResetActivity_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_ResetActivity_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_CloseShard_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_ResetActivity_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -2627,33 +10828,33 @@ func (v *AdminService_CloseShard_Result) GetAccessDeniedError() (o *shared.Acces
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_CloseShard_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_ResetActivity_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "CloseShard" for this struct.
-func (v *AdminService_CloseShard_Result) MethodName() string {
-	return "CloseShard"
+// This will always be "ResetActivity" for this struct.
+func (v *AdminService_ResetActivity_Result) MethodName() string {
+	return "ResetActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_CloseShard_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ResetActivity_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+// AdminService_UnpauseActivity_Args represents the arguments for the AdminService.UnpauseActivity function.
 //
-// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
-type AdminService_DescribeHistoryHost_Args struct {
-	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+// The arguments for UnpauseActivity are sent and received over the wire as this struct.
+type AdminService_UnpauseActivity_Args struct {
+	Request *shared.UnpauseActivityRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UnpauseActivity_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2668,7 +10869,7 @@ type AdminService_DescribeHistoryHost_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_UnpauseActivity_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -2688,17 +10889,17 @@ func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
-	var v shared.DescribeHistoryHostRequest
+func _UnpauseActivityRequest_Read(w wire.Value) (*shared.UnpauseActivityRequest, error) {
+	var v shared.UnpauseActivityRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_UnpauseActivity_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
+// An error is returned if we were unable to build a AdminService_UnpauseActivity_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2706,19 +10907,19 @@ func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHost
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Args
+//   var v AdminService_UnpauseActivity_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
+func (v *AdminService_UnpauseActivity_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
+				v.Request, err = _UnpauseActivityRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2730,9 +10931,9 @@ func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
+// String returns a readable string representation of a AdminService_UnpauseActivity_Args
 // struct.
-func (v *AdminService_DescribeHistoryHost_Args) String() string {
+func (v *AdminService_UnpauseActivity_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2744,14 +10945,14 @@ func (v *AdminService_DescribeHistoryHost_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UnpauseActivity_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
-// provided AdminService_DescribeHistoryHost_Args.
+// Equals returns true if all the fields of this AdminService_UnpauseActivity_Args match the
+// provided AdminService_UnpauseActivity_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
+func (v *AdminService_UnpauseActivity_Args) Equals(rhs *AdminService_UnpauseActivity_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2765,8 +10966,8 @@ func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_Describ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Args.
-func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UnpauseActivity_Args.
+func (v *AdminService_UnpauseActivity_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2778,7 +10979,7 @@ func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.Obj
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+func (v *AdminService_UnpauseActivity_Args) GetRequest() (o *shared.UnpauseActivityRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -2787,85 +10988,92 @@ func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.Describe
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+func (v *AdminService_UnpauseActivity_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "UnpauseActivity" for this struct.
+func (v *AdminService_UnpauseActivity_Args) MethodName() string {
+	return "UnpauseActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UnpauseActivity_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeHistoryHost
+// AdminService_UnpauseActivity_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.UnpauseActivity
 // function.
-var AdminService_DescribeHistoryHost_Helper = struct {
-	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+var AdminService_UnpauseActivity_Helper = struct {
+	// Args accepts the parameters of UnpauseActivity in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args
+		request *shared.UnpauseActivityRequest,
+	) *AdminService_UnpauseActivity_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeHistoryHost.
+	// by UnpauseActivity.
 	//
-	// An error can be thrown by DescribeHistoryHost only if the
+	// An error can be thrown by UnpauseActivity only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeHistoryHost
-	// given its return value and error.
+	// WrapResponse returns the result struct for UnpauseActivity
+	// given the error returned by it. The provided error may
+	// be nil if UnpauseActivity did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// DescribeHistoryHost into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeHistoryHost
+	// This allows mapping errors returned by UnpauseActivity into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// UnpauseActivity
 	//
-	//   value, err := DescribeHistoryHost(args)
-	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   err := UnpauseActivity(args)
+	//   result, err := AdminService_UnpauseActivity_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//     return fmt.Errorf("unexpected error from UnpauseActivity: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
+	WrapResponse func(error) (*AdminService_UnpauseActivity_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeHistoryHost
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for UnpauseActivity
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if DescribeHistoryHost threw an
+	// The error is non-nil only if UnpauseActivity threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+	//   err := AdminService_UnpauseActivity_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_UnpauseActivity_Result) error
 }{}
 
 func init() {
-	AdminService_DescribeHistoryHost_Helper.Args = func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args {
-		return &AdminService_DescribeHistoryHost_Args{
+	AdminService_UnpauseActivity_Helper.Args = func(
+		request *shared.UnpauseActivityRequest,
+	) *AdminService_UnpauseActivity_Args {
+		return &AdminService_UnpauseActivity_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+	AdminService_UnpauseActivity_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
 		case *shared.AccessDeniedError:
 			return true
 		default:
@@ -2873,32 +11081,47 @@ func init() {
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+	AdminService_UnpauseActivity_Helper.WrapResponse = func(err error) (*AdminService_UnpauseActivity_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
+			return &AdminService_UnpauseActivity_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.BadRequestError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+			return &AdminService_UnpauseActivity_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
+			return &AdminService_UnpauseActivity_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.EntityNotExistError")
+			}
+			return &AdminService_UnpauseActivity_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.ServiceBusyError")
+			}
+			return &AdminService_UnpauseActivity_Result{ServiceBusyError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.DomainNotActiveError")
+			}
+			return &AdminService_UnpauseActivity_Result{DomainNotActiveError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UnpauseActivity_Result.AccessDeniedError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
+			return &AdminService_UnpauseActivity_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+	AdminService_UnpauseActivity_Helper.UnwrapResponse = func(result *AdminService_UnpauseActivity_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -2907,36 +11130,40 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.AccessDeniedError != nil {
-			err = result.AccessDeniedError
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
-//
-// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
+// AdminService_UnpauseActivity_Result represents the result of a AdminService.UnpauseActivity function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_DescribeHistoryHost_Result struct {
-	// Value returned by DescribeHistoryHost after a successful execution.
-	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+// The result of a UnpauseActivity execution is sent and received over the wire as this struct.
+type AdminService_UnpauseActivity_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UnpauseActivity_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2951,22 +11178,14 @@ type AdminService_DescribeHistoryHost_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_UnpauseActivity_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -2983,33 +11202,51 @@ func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 	if v.AccessDeniedError != nil {
 		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_UnpauseActivity_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
-	var v shared.DescribeHistoryHostResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_UnpauseActivity_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
+// An error is returned if we were unable to build a AdminService_UnpauseActivity_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3017,41 +11254,57 @@ func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHos
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Result
+//   var v AdminService_UnpauseActivity_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
+func (v *AdminService_UnpauseActivity_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 1:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 2:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 3:
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
 			if field.Value.Type() == wire.TStruct {
 				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
@@ -3063,38 +11316,40 @@ func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
 	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_UnpauseActivity_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
+// String returns a readable string representation of a AdminService_UnpauseActivity_Result
 // struct.
-func (v *AdminService_DescribeHistoryHost_Result) String() string {
+func (v *AdminService_UnpauseActivity_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -3103,33 +11358,51 @@ func (v *AdminService_DescribeHistoryHost_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
 	if v.AccessDeniedError != nil {
 		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UnpauseActivity_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
-// provided AdminService_DescribeHistoryHost_Result.
+// Equals returns true if all the fields of this AdminService_UnpauseActivity_Result match the
+// provided AdminService_UnpauseActivity_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
+func (v *AdminService_UnpauseActivity_Result) Equals(rhs *AdminService_UnpauseActivity_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
 	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
@@ -3138,44 +11411,35 @@ func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_Descr
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Result.
-func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UnpauseActivity_Result.
+func (v *AdminService_UnpauseActivity_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
 	if v.AccessDeniedError != nil {
 		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_UnpauseActivity_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -3184,13 +11448,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *share
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+func (v *AdminService_UnpauseActivity_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_UnpauseActivity_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -3199,13 +11463,58 @@ func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_UnpauseActivity_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UnpauseActivity_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_UnpauseActivity_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UnpauseActivity_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_UnpauseActivity_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UnpauseActivity_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_UnpauseActivity_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_UnpauseActivity_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -3214,33 +11523,33 @@ func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *sha
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_UnpauseActivity_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "UnpauseActivity" for this struct.
+func (v *AdminService_UnpauseActivity_Result) MethodName() string {
+	return "UnpauseActivity"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UnpauseActivity_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
+// AdminService_UpdateActivityTimeouts_Args represents the arguments for the AdminService.UpdateActivityTimeouts function.
 //
-// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
-type AdminService_DescribeWorkflowExecution_Args struct {
-	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+// The arguments for UpdateActivityTimeouts are sent and received over the wire as this struct.
+type AdminService_UpdateActivityTimeouts_Args struct {
+	Request *shared.UpdateActivityTimeoutsRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UpdateActivityTimeouts_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3255,7 +11564,7 @@ type AdminService_DescribeWorkflowExecution_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_UpdateActivityTimeouts_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -3275,17 +11584,17 @@ func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
-	var v DescribeWorkflowExecutionRequest
+func _UpdateActivityTimeoutsRequest_Read(w wire.Value) (*shared.UpdateActivityTimeoutsRequest, error) {
+	var v shared.UpdateActivityTimeoutsRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_UpdateActivityTimeouts_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
+// An error is returned if we were unable to build a AdminService_UpdateActivityTimeouts_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3293,19 +11602,19 @@ func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExec
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Args
+//   var v AdminService_UpdateActivityTimeouts_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+func (v *AdminService_UpdateActivityTimeouts_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				v.Request, err = _UpdateActivityTimeoutsRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3317,9 +11626,9 @@ func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
+// String returns a readable string representation of a AdminService_UpdateActivityTimeouts_Args
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
+func (v *AdminService_UpdateActivityTimeouts_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -3331,14 +11640,14 @@ func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UpdateActivityTimeouts_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
-// provided AdminService_DescribeWorkflowExecution_Args.
+// Equals returns true if all the fields of this AdminService_UpdateActivityTimeouts_Args match the
+// provided AdminService_UpdateActivityTimeouts_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
+func (v *AdminService_UpdateActivityTimeouts_Args) Equals(rhs *AdminService_UpdateActivityTimeouts_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -3352,8 +11661,8 @@ func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_D
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Args.
-func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UpdateActivityTimeouts_Args.
+func (v *AdminService_UpdateActivityTimeouts_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -3365,7 +11674,7 @@ func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapco
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+func (v *AdminService_UpdateActivityTimeouts_Args) GetRequest() (o *shared.UpdateActivityTimeoutsRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -3374,80 +11683,81 @@ func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeW
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+func (v *AdminService_UpdateActivityTimeouts_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
-	return "DescribeWorkflowExecution"
+// This will always be "UpdateActivityTimeouts" for this struct.
+func (v *AdminService_UpdateActivityTimeouts_Args) MethodName() string {
+	return "UpdateActivityTimeouts"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UpdateActivityTimeouts_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeWorkflowExecution
+// AdminService_UpdateActivityTimeouts_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.UpdateActivityTimeouts
 // function.
-var AdminService_DescribeWorkflowExecution_Helper = struct {
-	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
+var AdminService_UpdateActivityTimeouts_Helper = struct {
+	// Args accepts the parameters of UpdateActivityTimeouts in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args
+		request *shared.UpdateActivityTimeoutsRequest,
+	) *AdminService_UpdateActivityTimeouts_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeWorkflowExecution.
+	// by UpdateActivityTimeouts.
 	//
-	// An error can be thrown by DescribeWorkflowExecution only if the
+	// An error can be thrown by UpdateActivityTimeouts only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeWorkflowExecution
-	// given its return value and error.
+	// WrapResponse returns the result struct for UpdateActivityTimeouts
+	// given the error returned by it. The provided error may
+	// be nil if UpdateActivityTimeouts did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// DescribeWorkflowExecution into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeWorkflowExecution
+	// This allows mapping errors returned by UpdateActivityTimeouts into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// UpdateActivityTimeouts
 	//
-	//   value, err := DescribeWorkflowExecution(args)
-	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
+	//   err := UpdateActivityTimeouts(args)
+	//   result, err := AdminService_UpdateActivityTimeouts_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
+	//     return fmt.Errorf("unexpected error from UpdateActivityTimeouts: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
+	WrapResponse func(error) (*AdminService_UpdateActivityTimeouts_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for UpdateActivityTimeouts
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if DescribeWorkflowExecution threw an
+	// The error is non-nil only if UpdateActivityTimeouts threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
+	//   err := AdminService_UpdateActivityTimeouts_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_UpdateActivityTimeouts_Result) error
 }{}
 
 func init() {
-	AdminService_DescribeWorkflowExecution_Helper.Args = func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args {
-		return &AdminService_DescribeWorkflowExecution_Args{
+	AdminService_UpdateActivityTimeouts_Helper.Args = func(
+		request *shared.UpdateActivityTimeoutsRequest,
+	) *AdminService_UpdateActivityTimeouts_Args {
+		return &AdminService_UpdateActivityTimeouts_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
+	AdminService_UpdateActivityTimeouts_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -3455,6 +11765,10 @@ func init() {
 			return true
 		case *shared.EntityNotExistsError:
 			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
 		case *shared.AccessDeniedError:
 			return true
 		default:
@@ -3462,37 +11776,47 @@ func init() {
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
+	AdminService_UpdateActivityTimeouts_Helper.WrapResponse = func(err error) (*AdminService_UpdateActivityTimeouts_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
+			return &AdminService_UpdateActivityTimeouts_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityTimeouts_Result.BadRequestError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
+			return &AdminService_UpdateActivityTimeouts_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityTimeouts_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
+			return &AdminService_UpdateActivityTimeouts_Result{InternalServiceError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityTimeouts_Result.EntityNotExistError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
+			return &AdminService_UpdateActivityTimeouts_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityTimeouts_Result.ServiceBusyError")
+			}
+			return &AdminService_UpdateActivityTimeouts_Result{ServiceBusyError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityTimeouts_Result.DomainNotActiveError")
+			}
+			return &AdminService_UpdateActivityTimeouts_Result{DomainNotActiveError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateActivityTimeouts_Result.AccessDeniedError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
+			return &AdminService_UpdateActivityTimeouts_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+	AdminService_UpdateActivityTimeouts_Helper.UnwrapResponse = func(result *AdminService_UpdateActivityTimeouts_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -3505,37 +11829,36 @@ func init() {
 			err = result.EntityNotExistError
 			return
 		}
-		if result.AccessDeniedError != nil {
-			err = result.AccessDeniedError
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
-//
-// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
+// AdminService_UpdateActivityTimeouts_Result represents the result of a AdminService.UpdateActivityTimeouts function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_DescribeWorkflowExecution_Result struct {
-	// Value returned by DescribeWorkflowExecution after a successful execution.
-	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+// The result of a UpdateActivityTimeouts execution is sent and received over the wire as this struct.
+type AdminService_UpdateActivityTimeouts_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UpdateActivityTimeouts_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3550,22 +11873,14 @@ type AdminService_DescribeWorkflowExecution_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_UpdateActivityTimeouts_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -3590,39 +11905,43 @@ func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 	if v.AccessDeniedError != nil {
 		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_UpdateActivityTimeouts_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
-	var v DescribeWorkflowExecutionResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_UpdateActivityTimeouts_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
+// An error is returned if we were unable to build a AdminService_UpdateActivityTimeouts_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3630,24 +11949,16 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Result
+//   var v AdminService_UpdateActivityTimeouts_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
+func (v *AdminService_UpdateActivityTimeouts_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -3673,6 +11984,22 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 
 			}
 		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
 			if field.Value.Type() == wire.TStruct {
 				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
@@ -3684,9 +12011,6 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -3696,29 +12020,31 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 	if v.EntityNotExistError != nil {
 		count++
 	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
 	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_UpdateActivityTimeouts_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
+// String returns a readable string representation of a AdminService_UpdateActivityTimeouts_Result
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
+func (v *AdminService_UpdateActivityTimeouts_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -3731,27 +12057,32 @@ func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
 		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
 	if v.AccessDeniedError != nil {
 		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UpdateActivityTimeouts_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
-// provided AdminService_DescribeWorkflowExecution_Result.
+// Equals returns true if all the fields of this AdminService_UpdateActivityTimeouts_Result match the
+// provided AdminService_UpdateActivityTimeouts_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
+func (v *AdminService_UpdateActivityTimeouts_Result) Equals(rhs *AdminService_UpdateActivityTimeouts_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -3761,6 +12092,12 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService
 	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
 	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
@@ -3769,14 +12106,11 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Result.
-func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UpdateActivityTimeouts_Result.
+func (v *AdminService_UpdateActivityTimeouts_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -3786,30 +12120,21 @@ func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zap
 	if v.EntityNotExistError != nil {
 		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
 	if v.AccessDeniedError != nil {
 		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_UpdateActivityTimeouts_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -3818,13 +12143,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+func (v *AdminService_UpdateActivityTimeouts_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_UpdateActivityTimeouts_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -3833,13 +12158,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError(
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_UpdateActivityTimeouts_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_UpdateActivityTimeouts_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -3848,13 +12173,43 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError()
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_UpdateActivityTimeouts_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UpdateActivityTimeouts_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_UpdateActivityTimeouts_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UpdateActivityTimeouts_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *AdminService_UpdateActivityTimeouts_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_UpdateActivityTimeouts_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -3863,33 +12218,33 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_UpdateActivityTimeouts_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
-//
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
-	return "DescribeWorkflowExecution"
+//
+// This will always be "UpdateActivityTimeouts" for this struct.
+func (v *AdminService_UpdateActivityTimeouts_Result) MethodName() string {
+	return "UpdateActivityTimeouts"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UpdateActivityTimeouts_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistory function.
+// AdminService_UpdateTaskListLimits_Args represents the arguments for the AdminService.UpdateTaskListLimits function.
 //
-// The arguments for GetWorkflowExecutionRawHistory are sent and received over the wire as this struct.
-type AdminService_GetWorkflowExecutionRawHistory_Args struct {
-	GetRequest *GetWorkflowExecutionRawHistoryRequest `json:"getRequest,omitempty"`
+// The arguments for UpdateTaskListLimits are sent and received over the wire as this struct.
+type AdminService_UpdateTaskListLimits_Args struct {
+	Request *shared.UpdateTaskListLimitsRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UpdateTaskListLimits_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3904,7 +12259,7 @@ type AdminService_GetWorkflowExecutionRawHistory_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_UpdateTaskListLimits_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -3912,8 +12267,8 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value,
 		err    error
 	)
 
-	if v.GetRequest != nil {
-		w, err = v.GetRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3924,17 +12279,17 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value,
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryRequest, error) {
-	var v GetWorkflowExecutionRawHistoryRequest
+func _UpdateTaskListLimitsRequest_Read(w wire.Value) (*shared.UpdateTaskListLimitsRequest, error) {
+	var v shared.UpdateTaskListLimitsRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_UpdateTaskListLimits_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Args struct
+// An error is returned if we were unable to build a AdminService_UpdateTaskListLimits_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3942,19 +12297,19 @@ func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExec
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistory_Args
+//   var v AdminService_UpdateTaskListLimits_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value) error {
+func (v *AdminService_UpdateTaskListLimits_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.GetRequest, err = _GetWorkflowExecutionRawHistoryRequest_Read(field.Value)
+				v.Request, err = _UpdateTaskListLimitsRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3966,34 +12321,34 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Args
+// String returns a readable string representation of a AdminService_UpdateTaskListLimits_Args
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) String() string {
+func (v *AdminService_UpdateTaskListLimits_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.GetRequest != nil {
-		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UpdateTaskListLimits_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Args match the
-// provided AdminService_GetWorkflowExecutionRawHistory_Args.
+// Equals returns true if all the fields of this AdminService_UpdateTaskListLimits_Args match the
+// provided AdminService_UpdateTaskListLimits_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Args) bool {
+func (v *AdminService_UpdateTaskListLimits_Args) Equals(rhs *AdminService_UpdateTaskListLimits_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -4001,102 +12356,103 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminServ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistory_Args.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UpdateTaskListLimits_Args.
+func (v *AdminService_UpdateTaskListLimits_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.GetRequest != nil {
-		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetGetRequest returns the value of GetRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryRequest) {
-	if v != nil && v.GetRequest != nil {
-		return v.GetRequest
+func (v *AdminService_UpdateTaskListLimits_Args) GetRequest() (o *shared.UpdateTaskListLimitsRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetGetRequest returns true if GetRequest is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) IsSetGetRequest() bool {
-	return v != nil && v.GetRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_UpdateTaskListLimits_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetWorkflowExecutionRawHistory" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MethodName() string {
-	return "GetWorkflowExecutionRawHistory"
+// This will always be "UpdateTaskListLimits" for this struct.
+func (v *AdminService_UpdateTaskListLimits_Args) MethodName() string {
+	return "UpdateTaskListLimits"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UpdateTaskListLimits_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetWorkflowExecutionRawHistory
+// AdminService_UpdateTaskListLimits_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.UpdateTaskListLimits
 // function.
-var AdminService_GetWorkflowExecutionRawHistory_Helper = struct {
-	// Args accepts the parameters of GetWorkflowExecutionRawHistory in-order and returns
+var AdminService_UpdateTaskListLimits_Helper = struct {
+	// Args accepts the parameters of UpdateTaskListLimits in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		getRequest *GetWorkflowExecutionRawHistoryRequest,
-	) *AdminService_GetWorkflowExecutionRawHistory_Args
+		request *shared.UpdateTaskListLimitsRequest,
+	) *AdminService_UpdateTaskListLimits_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetWorkflowExecutionRawHistory.
+	// by UpdateTaskListLimits.
 	//
-	// An error can be thrown by GetWorkflowExecutionRawHistory only if the
+	// An error can be thrown by UpdateTaskListLimits only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistory
-	// given its return value and error.
+	// WrapResponse returns the result struct for UpdateTaskListLimits
+	// given the error returned by it. The provided error may
+	// be nil if UpdateTaskListLimits did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// GetWorkflowExecutionRawHistory into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetWorkflowExecutionRawHistory
+	// This allows mapping errors returned by UpdateTaskListLimits into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// UpdateTaskListLimits
 	//
-	//   value, err := GetWorkflowExecutionRawHistory(args)
-	//   result, err := AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse(value, err)
+	//   err := UpdateTaskListLimits(args)
+	//   result, err := AdminService_UpdateTaskListLimits_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistory: %v", err)
+	//     return fmt.Errorf("unexpected error from UpdateTaskListLimits: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GetWorkflowExecutionRawHistoryResponse, error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error)
+	WrapResponse func(error) (*AdminService_UpdateTaskListLimits_Result, error)
 
-	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistory
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for UpdateTaskListLimits
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if GetWorkflowExecutionRawHistory threw an
+	// The error is non-nil only if UpdateTaskListLimits threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistory_Result) (*GetWorkflowExecutionRawHistoryResponse, error)
+	//   err := AdminService_UpdateTaskListLimits_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_UpdateTaskListLimits_Result) error
 }{}
 
 func init() {
-	AdminService_GetWorkflowExecutionRawHistory_Helper.Args = func(
-		getRequest *GetWorkflowExecutionRawHistoryRequest,
-	) *AdminService_GetWorkflowExecutionRawHistory_Args {
-		return &AdminService_GetWorkflowExecutionRawHistory_Args{
-			GetRequest: getRequest,
+	AdminService_UpdateTaskListLimits_Helper.Args = func(
+		request *shared.UpdateTaskListLimitsRequest,
+	) *AdminService_UpdateTaskListLimits_Args {
+		return &AdminService_UpdateTaskListLimits_Args{
+			Request: request,
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistory_Helper.IsException = func(err error) bool {
+	AdminService_UpdateTaskListLimits_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -4106,42 +12462,49 @@ func init() {
 			return true
 		case *shared.ServiceBusyError:
 			return true
+		case *shared.AccessDeniedError:
+			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryResponse, err error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error) {
+	AdminService_UpdateTaskListLimits_Helper.WrapResponse = func(err error) (*AdminService_UpdateTaskListLimits_Result, error) {
 		if err == nil {
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{Success: success}, nil
+			return &AdminService_UpdateTaskListLimits_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateTaskListLimits_Result.BadRequestError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{BadRequestError: e}, nil
+			return &AdminService_UpdateTaskListLimits_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateTaskListLimits_Result.InternalServiceError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{InternalServiceError: e}, nil
+			return &AdminService_UpdateTaskListLimits_Result{InternalServiceError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateTaskListLimits_Result.EntityNotExistError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{EntityNotExistError: e}, nil
+			return &AdminService_UpdateTaskListLimits_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateTaskListLimits_Result.ServiceBusyError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{ServiceBusyError: e}, nil
+			return &AdminService_UpdateTaskListLimits_Result{ServiceBusyError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_UpdateTaskListLimits_Result.AccessDeniedError")
+			}
+			return &AdminService_UpdateTaskListLimits_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistory_Result) (success *GetWorkflowExecutionRawHistoryResponse, err error) {
+	AdminService_UpdateTaskListLimits_Helper.UnwrapResponse = func(result *AdminService_UpdateTaskListLimits_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -4158,33 +12521,27 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Result represents the result of a AdminService.GetWorkflowExecutionRawHistory function call.
-//
-// The result of a GetWorkflowExecutionRawHistory execution is sent and received over the wire as this struct.
+// AdminService_UpdateTaskListLimits_Result represents the result of a AdminService.UpdateTaskListLimits function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_GetWorkflowExecutionRawHistory_Result struct {
-	// Value returned by GetWorkflowExecutionRawHistory after a successful execution.
-	Success              *GetWorkflowExecutionRawHistoryResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError                 `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError            `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError            `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError                `json:"serviceBusyError,omitempty"`
+// The result of a UpdateTaskListLimits execution is sent and received over the wire as this struct.
+type AdminService_UpdateTaskListLimits_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_UpdateTaskListLimits_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4199,7 +12556,7 @@ type AdminService_GetWorkflowExecutionRawHistory_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_UpdateTaskListLimits_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -4207,14 +12564,6 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Valu
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -4247,25 +12596,27 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Valu
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_UpdateTaskListLimits_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryResponse, error) {
-	var v GetWorkflowExecutionRawHistoryResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_UpdateTaskListLimits_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Result struct
+// An error is returned if we were unable to build a AdminService_UpdateTaskListLimits_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4273,24 +12624,16 @@ func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExe
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistory_Result
+//   var v AdminService_UpdateTaskListLimits_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Value) error {
+func (v *AdminService_UpdateTaskListLimits_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetWorkflowExecutionRawHistoryResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -4322,14 +12665,19 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Val
 					return err
 				}
 
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -4342,26 +12690,25 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Val
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", count)
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_UpdateTaskListLimits_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Result
+// String returns a readable string representation of a AdminService_UpdateTaskListLimits_Result
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
+func (v *AdminService_UpdateTaskListLimits_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [5]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -4378,23 +12725,24 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_UpdateTaskListLimits_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Result match the
-// provided AdminService_GetWorkflowExecutionRawHistory_Result.
+// Equals returns true if all the fields of this AdminService_UpdateTaskListLimits_Result match the
+// provided AdminService_UpdateTaskListLimits_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Result) bool {
+func (v *AdminService_UpdateTaskListLimits_Result) Equals(rhs *AdminService_UpdateTaskListLimits_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -4407,19 +12755,19 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminSe
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistory_Result.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_UpdateTaskListLimits_Result.
+func (v *AdminService_UpdateTaskListLimits_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -4432,27 +12780,15 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(en
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	return err
-}
-
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_UpdateTaskListLimits_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -4461,13 +12797,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError(
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetBadRequestError() bool {
+func (v *AdminService_UpdateTaskListLimits_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_UpdateTaskListLimits_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -4476,13 +12812,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceE
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_UpdateTaskListLimits_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_UpdateTaskListLimits_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -4491,13 +12827,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistEr
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_UpdateTaskListLimits_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_UpdateTaskListLimits_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -4506,33 +12842,48 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_UpdateTaskListLimits_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_UpdateTaskListLimits_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_UpdateTaskListLimits_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetWorkflowExecutionRawHistory" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MethodName() string {
-	return "GetWorkflowExecutionRawHistory"
+// This will always be "UpdateTaskListLimits" for this struct.
+func (v *AdminService_UpdateTaskListLimits_Result) MethodName() string {
+	return "UpdateTaskListLimits"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_UpdateTaskListLimits_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_RemoveTask_Args represents the arguments for the AdminService.RemoveTask function.
+// AdminService_VerifyArchivedWorkflowExecution_Args represents the arguments for the AdminService.VerifyArchivedWorkflowExecution function.
 //
-// The arguments for RemoveTask are sent and received over the wire as this struct.
-type AdminService_RemoveTask_Args struct {
-	Request *shared.RemoveTaskRequest `json:"request,omitempty"`
+// The arguments for VerifyArchivedWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_VerifyArchivedWorkflowExecution_Args struct {
+	Request *VerifyArchivedWorkflowExecutionRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_RemoveTask_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_VerifyArchivedWorkflowExecution_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4547,7 +12898,7 @@ type AdminService_RemoveTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_RemoveTask_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -4567,17 +12918,17 @@ func (v *AdminService_RemoveTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RemoveTaskRequest_Read(w wire.Value) (*shared.RemoveTaskRequest, error) {
-	var v shared.RemoveTaskRequest
+func _VerifyArchivedWorkflowExecutionRequest_Read(w wire.Value) (*VerifyArchivedWorkflowExecutionRequest, error) {
+	var v VerifyArchivedWorkflowExecutionRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_RemoveTask_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_VerifyArchivedWorkflowExecution_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RemoveTask_Args struct
+// An error is returned if we were unable to build a AdminService_VerifyArchivedWorkflowExecution_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4585,19 +12936,19 @@ func _RemoveTaskRequest_Read(w wire.Value) (*shared.RemoveTaskRequest, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_RemoveTask_Args
+//   var v AdminService_VerifyArchivedWorkflowExecution_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_RemoveTask_Args) FromWire(w wire.Value) error {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _RemoveTaskRequest_Read(field.Value)
+				v.Request, err = _VerifyArchivedWorkflowExecutionRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4609,9 +12960,9 @@ func (v *AdminService_RemoveTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RemoveTask_Args
+// String returns a readable string representation of a AdminService_VerifyArchivedWorkflowExecution_Args
 // struct.
-func (v *AdminService_RemoveTask_Args) String() string {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -4623,14 +12974,14 @@ func (v *AdminService_RemoveTask_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RemoveTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_VerifyArchivedWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RemoveTask_Args match the
-// provided AdminService_RemoveTask_Args.
+// Equals returns true if all the fields of this AdminService_VerifyArchivedWorkflowExecution_Args match the
+// provided AdminService_VerifyArchivedWorkflowExecution_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RemoveTask_Args) Equals(rhs *AdminService_RemoveTask_Args) bool {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Args) Equals(rhs *AdminService_VerifyArchivedWorkflowExecution_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -4644,8 +12995,8 @@ func (v *AdminService_RemoveTask_Args) Equals(rhs *AdminService_RemoveTask_Args)
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RemoveTask_Args.
-func (v *AdminService_RemoveTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_VerifyArchivedWorkflowExecution_Args.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -4657,7 +13008,7 @@ func (v *AdminService_RemoveTask_Args) MarshalLogObject(enc zapcore.ObjectEncode
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Args) GetRequest() (o *shared.RemoveTaskRequest) {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Args) GetRequest() (o *VerifyArchivedWorkflowExecutionRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -4666,86 +13017,89 @@ func (v *AdminService_RemoveTask_Args) GetRequest() (o *shared.RemoveTaskRequest
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_RemoveTask_Args) IsSetRequest() bool {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "RemoveTask" for this struct.
-func (v *AdminService_RemoveTask_Args) MethodName() string {
-	return "RemoveTask"
+// This will always be "VerifyArchivedWorkflowExecution" for this struct.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Args) MethodName() string {
+	return "VerifyArchivedWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_RemoveTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_RemoveTask_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.RemoveTask
+// AdminService_VerifyArchivedWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.VerifyArchivedWorkflowExecution
 // function.
-var AdminService_RemoveTask_Helper = struct {
-	// Args accepts the parameters of RemoveTask in-order and returns
+var AdminService_VerifyArchivedWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of VerifyArchivedWorkflowExecution in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.RemoveTaskRequest,
-	) *AdminService_RemoveTask_Args
+		request *VerifyArchivedWorkflowExecutionRequest,
+	) *AdminService_VerifyArchivedWorkflowExecution_Args
 
 	// IsException returns true if the given error can be thrown
-	// by RemoveTask.
+	// by VerifyArchivedWorkflowExecution.
 	//
-	// An error can be thrown by RemoveTask only if the
+	// An error can be thrown by VerifyArchivedWorkflowExecution only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for RemoveTask
-	// given the error returned by it. The provided error may
-	// be nil if RemoveTask did not fail.
+	// WrapResponse returns the result struct for VerifyArchivedWorkflowExecution
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by RemoveTask into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// RemoveTask
+	// This allows mapping values and errors returned by
+	// VerifyArchivedWorkflowExecution into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by VerifyArchivedWorkflowExecution
 	//
-	//   err := RemoveTask(args)
-	//   result, err := AdminService_RemoveTask_Helper.WrapResponse(err)
+	//   value, err := VerifyArchivedWorkflowExecution(args)
+	//   result, err := AdminService_VerifyArchivedWorkflowExecution_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from RemoveTask: %v", err)
+	//     return fmt.Errorf("unexpected error from VerifyArchivedWorkflowExecution: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_RemoveTask_Result, error)
+	WrapResponse func(*VerifyArchivedWorkflowExecutionResponse, error) (*AdminService_VerifyArchivedWorkflowExecution_Result, error)
 
-	// UnwrapResponse takes the result struct for RemoveTask
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for VerifyArchivedWorkflowExecution
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if RemoveTask threw an
+	// The error is non-nil only if VerifyArchivedWorkflowExecution threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_RemoveTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_RemoveTask_Result) error
+	//   value, err := AdminService_VerifyArchivedWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_VerifyArchivedWorkflowExecution_Result) (*VerifyArchivedWorkflowExecutionResponse, error)
 }{}
 
 func init() {
-	AdminService_RemoveTask_Helper.Args = func(
-		request *shared.RemoveTaskRequest,
-	) *AdminService_RemoveTask_Args {
-		return &AdminService_RemoveTask_Args{
+	AdminService_VerifyArchivedWorkflowExecution_Helper.Args = func(
+		request *VerifyArchivedWorkflowExecutionRequest,
+	) *AdminService_VerifyArchivedWorkflowExecution_Args {
+		return &AdminService_VerifyArchivedWorkflowExecution_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_RemoveTask_Helper.IsException = func(err error) bool {
+	AdminService_VerifyArchivedWorkflowExecution_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
 		case *shared.AccessDeniedError:
 			return true
 		default:
@@ -4753,32 +13107,42 @@ func init() {
 		}
 	}
 
-	AdminService_RemoveTask_Helper.WrapResponse = func(err error) (*AdminService_RemoveTask_Result, error) {
+	AdminService_VerifyArchivedWorkflowExecution_Helper.WrapResponse = func(success *VerifyArchivedWorkflowExecutionResponse, err error) (*AdminService_VerifyArchivedWorkflowExecution_Result, error) {
 		if err == nil {
-			return &AdminService_RemoveTask_Result{}, nil
+			return &AdminService_VerifyArchivedWorkflowExecution_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_VerifyArchivedWorkflowExecution_Result.BadRequestError")
 			}
-			return &AdminService_RemoveTask_Result{BadRequestError: e}, nil
+			return &AdminService_VerifyArchivedWorkflowExecution_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_VerifyArchivedWorkflowExecution_Result.InternalServiceError")
 			}
-			return &AdminService_RemoveTask_Result{InternalServiceError: e}, nil
+			return &AdminService_VerifyArchivedWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_VerifyArchivedWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &AdminService_VerifyArchivedWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_VerifyArchivedWorkflowExecution_Result.ServiceBusyError")
+			}
+			return &AdminService_VerifyArchivedWorkflowExecution_Result{ServiceBusyError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveTask_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_VerifyArchivedWorkflowExecution_Result.AccessDeniedError")
 			}
-			return &AdminService_RemoveTask_Result{AccessDeniedError: e}, nil
+			return &AdminService_VerifyArchivedWorkflowExecution_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_RemoveTask_Helper.UnwrapResponse = func(result *AdminService_RemoveTask_Result) (err error) {
+	AdminService_VerifyArchivedWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_VerifyArchivedWorkflowExecution_Result) (success *VerifyArchivedWorkflowExecutionResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -4787,25 +13151,46 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
 		if result.AccessDeniedError != nil {
 			err = result.AccessDeniedError
 			return
 		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_RemoveTask_Result represents the result of a AdminService.RemoveTask function call.
+// AdminService_VerifyArchivedWorkflowExecution_Result represents the result of a AdminService.VerifyArchivedWorkflowExecution function call.
 //
-// The result of a RemoveTask execution is sent and received over the wire as this struct.
-type AdminService_RemoveTask_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+// The result of a VerifyArchivedWorkflowExecution execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_VerifyArchivedWorkflowExecution_Result struct {
+	// Value returned by VerifyArchivedWorkflowExecution after a successful execution.
+	Success              *VerifyArchivedWorkflowExecutionResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                  `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError             `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError             `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError                 `json:"serviceBusyError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError                `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_RemoveTask_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_VerifyArchivedWorkflowExecution_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4820,14 +13205,22 @@ type AdminService_RemoveTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -4844,27 +13237,49 @@ func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 	if v.AccessDeniedError != nil {
 		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_VerifyArchivedWorkflowExecution_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a AdminService_RemoveTask_Result struct from its Thrift-level
+func _VerifyArchivedWorkflowExecutionResponse_Read(w wire.Value) (*VerifyArchivedWorkflowExecutionResponse, error) {
+	var v VerifyArchivedWorkflowExecutionResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_VerifyArchivedWorkflowExecution_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_RemoveTask_Result struct
+// An error is returned if we were unable to build a AdminService_VerifyArchivedWorkflowExecution_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4872,16 +13287,24 @@ func (v *AdminService_RemoveTask_Result) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_RemoveTask_Result
+//   var v AdminService_VerifyArchivedWorkflowExecution_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _VerifyArchivedWorkflowExecutionResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -4899,6 +13322,22 @@ func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
 
 			}
 		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
 			if field.Value.Type() == wire.TStruct {
 				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
@@ -4910,31 +13349,44 @@ func (v *AdminService_RemoveTask_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
 	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_RemoveTask_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_VerifyArchivedWorkflowExecution_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_RemoveTask_Result
+// String returns a readable string representation of a AdminService_VerifyArchivedWorkflowExecution_Result
 // struct.
-func (v *AdminService_RemoveTask_Result) String() string {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [6]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -4943,30 +13395,47 @@ func (v *AdminService_RemoveTask_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
 	if v.AccessDeniedError != nil {
 		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_RemoveTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_VerifyArchivedWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_RemoveTask_Result match the
-// provided AdminService_RemoveTask_Result.
+// Equals returns true if all the fields of this AdminService_VerifyArchivedWorkflowExecution_Result match the
+// provided AdminService_VerifyArchivedWorkflowExecution_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Result) bool {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) Equals(rhs *AdminService_VerifyArchivedWorkflowExecution_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
 	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
@@ -4975,26 +13444,50 @@ func (v *AdminService_RemoveTask_Result) Equals(rhs *AdminService_RemoveTask_Res
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_RemoveTask_Result.
-func (v *AdminService_RemoveTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_VerifyArchivedWorkflowExecution_Result.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
 	if v.AccessDeniedError != nil {
 		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) GetSuccess() (o *VerifyArchivedWorkflowExecutionResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -5003,13 +13496,13 @@ func (v *AdminService_RemoveTask_Result) GetBadRequestError() (o *shared.BadRequ
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_RemoveTask_Result) IsSetBadRequestError() bool {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -5018,13 +13511,43 @@ func (v *AdminService_RemoveTask_Result) GetInternalServiceError() (o *shared.In
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_RemoveTask_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_RemoveTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -5033,21 +13556,21 @@ func (v *AdminService_RemoveTask_Result) GetAccessDeniedError() (o *shared.Acces
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_RemoveTask_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "RemoveTask" for this struct.
-func (v *AdminService_RemoveTask_Result) MethodName() string {
-	return "RemoveTask"
+// This will always be "VerifyArchivedWorkflowExecution" for this struct.
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) MethodName() string {
+	return "VerifyArchivedWorkflowExecution"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_RemoveTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_VerifyArchivedWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
@@ -68,11 +68,71 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
 
+	ImportWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ImportWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ImportWorkflowExecutionResponse, error)
+
+	ListSearchAttributes(
+		ctx context.Context,
+		Request *admin.ListSearchAttributesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetSearchAttributesResponse, error)
+
+	PauseActivity(
+		ctx context.Context,
+		Request *shared.PauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RearchiveWorkflowExecution(
+		ctx context.Context,
+		Request *admin.RearchiveWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RemoveSearchAttribute(
+		ctx context.Context,
+		Request *admin.RemoveSearchAttributeRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	ResetActivity(
+		ctx context.Context,
+		Request *shared.ResetActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		Request *shared.UnpauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateActivityTimeouts(
+		ctx context.Context,
+		Request *shared.UpdateActivityTimeoutsRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateTaskListLimits(
+		ctx context.Context,
+		Request *shared.UpdateTaskListLimitsRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	VerifyArchivedWorkflowExecution(
+		ctx context.Context,
+		Request *admin.VerifyArchivedWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.VerifyArchivedWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the AdminService service.
@@ -214,6 +274,121 @@ func (c client) GetWorkflowExecutionRawHistory(
 	return
 }
 
+func (c client) ImportWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ImportWorkflowExecutionResponse, err error) {
+
+	args := admin.AdminService_ImportWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ImportWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ImportWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListSearchAttributes(
+	ctx context.Context,
+	_Request *admin.ListSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetSearchAttributesResponse, err error) {
+
+	args := admin.AdminService_ListSearchAttributes_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListSearchAttributes_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListSearchAttributes_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseActivity(
+	ctx context.Context,
+	_Request *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PauseActivity_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PauseActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RearchiveWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.RearchiveWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RearchiveWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RearchiveWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RearchiveWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RemoveSearchAttribute(
+	ctx context.Context,
+	_Request *admin.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RemoveSearchAttribute_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RemoveSearchAttribute_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RemoveSearchAttribute_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveTask(
 	ctx context.Context,
 	_Request *shared.RemoveTaskRequest,
@@ -236,3 +411,118 @@ func (c client) RemoveTask(
 	err = admin.AdminService_RemoveTask_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ResetActivity(
+	ctx context.Context,
+	_Request *shared.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ResetActivity_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ResetActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ResetActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UnpauseActivity(
+	ctx context.Context,
+	_Request *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UnpauseActivity_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UnpauseActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UnpauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateActivityTimeouts(
+	ctx context.Context,
+	_Request *shared.UpdateActivityTimeoutsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UpdateActivityTimeouts_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UpdateActivityTimeouts_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UpdateActivityTimeouts_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateTaskListLimits(
+	ctx context.Context,
+	_Request *shared.UpdateTaskListLimitsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UpdateTaskListLimits_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UpdateTaskListLimits_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UpdateTaskListLimits_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) VerifyArchivedWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.VerifyArchivedWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.VerifyArchivedWorkflowExecutionResponse, err error) {
+
+	args := admin.AdminService_VerifyArchivedWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_VerifyArchivedWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_VerifyArchivedWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
@@ -61,10 +61,60 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
 
+	ImportWorkflowExecution(
+		ctx context.Context,
+		Request *admin.ImportWorkflowExecutionRequest,
+	) (*admin.ImportWorkflowExecutionResponse, error)
+
+	ListSearchAttributes(
+		ctx context.Context,
+		Request *admin.ListSearchAttributesRequest,
+	) (*shared.GetSearchAttributesResponse, error)
+
+	PauseActivity(
+		ctx context.Context,
+		Request *shared.PauseActivityRequest,
+	) error
+
+	RearchiveWorkflowExecution(
+		ctx context.Context,
+		Request *admin.RearchiveWorkflowExecutionRequest,
+	) error
+
+	RemoveSearchAttribute(
+		ctx context.Context,
+		Request *admin.RemoveSearchAttributeRequest,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 	) error
+
+	ResetActivity(
+		ctx context.Context,
+		Request *shared.ResetActivityRequest,
+	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		Request *shared.UnpauseActivityRequest,
+	) error
+
+	UpdateActivityTimeouts(
+		ctx context.Context,
+		Request *shared.UpdateActivityTimeoutsRequest,
+	) error
+
+	UpdateTaskListLimits(
+		ctx context.Context,
+		Request *shared.UpdateTaskListLimitsRequest,
+	) error
+
+	VerifyArchivedWorkflowExecution(
+		ctx context.Context,
+		Request *admin.VerifyArchivedWorkflowExecutionRequest,
+	) (*admin.VerifyArchivedWorkflowExecutionResponse, error)
 }
 
 // New prepares an implementation of the AdminService service for
@@ -133,6 +183,61 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ImportWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ImportWorkflowExecution),
+				},
+				Signature:    "ImportWorkflowExecution(Request *admin.ImportWorkflowExecutionRequest) (*admin.ImportWorkflowExecutionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListSearchAttributes",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListSearchAttributes),
+				},
+				Signature:    "ListSearchAttributes(Request *admin.ListSearchAttributesRequest) (*shared.GetSearchAttributesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseActivity),
+				},
+				Signature:    "PauseActivity(Request *shared.PauseActivityRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RearchiveWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RearchiveWorkflowExecution),
+				},
+				Signature:    "RearchiveWorkflowExecution(Request *admin.RearchiveWorkflowExecutionRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RemoveSearchAttribute",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RemoveSearchAttribute),
+				},
+				Signature:    "RemoveSearchAttribute(Request *admin.RemoveSearchAttributeRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RemoveTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -143,10 +248,65 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "RemoveTask(Request *shared.RemoveTaskRequest)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "ResetActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResetActivity),
+				},
+				Signature:    "ResetActivity(Request *shared.ResetActivityRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UnpauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UnpauseActivity),
+				},
+				Signature:    "UnpauseActivity(Request *shared.UnpauseActivityRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateActivityTimeouts",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateActivityTimeouts),
+				},
+				Signature:    "UpdateActivityTimeouts(Request *shared.UpdateActivityTimeoutsRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateTaskListLimits",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateTaskListLimits),
+				},
+				Signature:    "UpdateTaskListLimits(Request *shared.UpdateTaskListLimitsRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "VerifyArchivedWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.VerifyArchivedWorkflowExecution),
+				},
+				Signature:    "VerifyArchivedWorkflowExecution(Request *admin.VerifyArchivedWorkflowExecutionRequest) (*admin.VerifyArchivedWorkflowExecutionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 6)
+	procedures := make([]transport.Procedure, 0, 16)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -248,6 +408,101 @@ func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.V
 	return response, err
 }
 
+func (h handler) ImportWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ImportWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ImportWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ImportWorkflowExecution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListSearchAttributes_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListSearchAttributes(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListSearchAttributes_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PauseActivity(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_PauseActivity_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RearchiveWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RearchiveWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RearchiveWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RearchiveWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RemoveSearchAttribute(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveSearchAttribute_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RemoveSearchAttribute(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RemoveSearchAttribute_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_RemoveTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -266,3 +521,98 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	}
 	return response, err
 }
+
+func (h handler) ResetActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResetActivity(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ResetActivity_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UnpauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UnpauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UnpauseActivity(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_UnpauseActivity_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateActivityTimeouts(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateActivityTimeouts_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateActivityTimeouts(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_UpdateActivityTimeouts_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateTaskListLimits(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateTaskListLimits_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateTaskListLimits(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_UpdateTaskListLimits_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) VerifyArchivedWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_VerifyArchivedWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.VerifyArchivedWorkflowExecution(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_VerifyArchivedWorkflowExecution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
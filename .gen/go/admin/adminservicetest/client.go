@@ -226,6 +226,165 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistory(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistory", args...)
 }
 
+// ImportWorkflowExecution responds to a ImportWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ImportWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.ImportWorkflowExecution(...)
+func (m *MockClient) ImportWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ImportWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", args...)
+	success, _ = ret[i].(*admin.ImportWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ImportWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ImportWorkflowExecution", args...)
+}
+
+// ListSearchAttributes responds to a ListSearchAttributes call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListSearchAttributes(gomock.Any(), ...).Return(...)
+// 	... := client.ListSearchAttributes(...)
+func (m *MockClient) ListSearchAttributes(
+	ctx context.Context,
+	_Request *admin.ListSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetSearchAttributesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListSearchAttributes", args...)
+	success, _ = ret[i].(*shared.GetSearchAttributesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListSearchAttributes(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListSearchAttributes", args...)
+}
+
+// PauseActivity responds to a PauseActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PauseActivity(gomock.Any(), ...).Return(...)
+// 	... := client.PauseActivity(...)
+func (m *MockClient) PauseActivity(
+	ctx context.Context,
+	_Request *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseActivity(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseActivity", args...)
+}
+
+// RearchiveWorkflowExecution responds to a RearchiveWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RearchiveWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.RearchiveWorkflowExecution(...)
+func (m *MockClient) RearchiveWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.RearchiveWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RearchiveWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RearchiveWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RearchiveWorkflowExecution", args...)
+}
+
+// RemoveSearchAttribute responds to a RemoveSearchAttribute call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RemoveSearchAttribute(gomock.Any(), ...).Return(...)
+// 	... := client.RemoveSearchAttribute(...)
+func (m *MockClient) RemoveSearchAttribute(
+	ctx context.Context,
+	_Request *admin.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RemoveSearchAttribute", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RemoveSearchAttribute(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveSearchAttribute", args...)
+}
+
 // RemoveTask responds to a RemoveTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -256,3 +415,160 @@ func (mr *_MockClientRecorder) RemoveTask(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
+
+// ResetActivity responds to a ResetActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ResetActivity(gomock.Any(), ...).Return(...)
+// 	... := client.ResetActivity(...)
+func (m *MockClient) ResetActivity(
+	ctx context.Context,
+	_Request *shared.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetActivity(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetActivity", args...)
+}
+
+// UnpauseActivity responds to a UnpauseActivity call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UnpauseActivity(gomock.Any(), ...).Return(...)
+// 	... := client.UnpauseActivity(...)
+func (m *MockClient) UnpauseActivity(
+	ctx context.Context,
+	_Request *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UnpauseActivity", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UnpauseActivity(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UnpauseActivity", args...)
+}
+
+// UpdateActivityTimeouts responds to a UpdateActivityTimeouts call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateActivityTimeouts(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateActivityTimeouts(...)
+func (m *MockClient) UpdateActivityTimeouts(
+	ctx context.Context,
+	_Request *shared.UpdateActivityTimeoutsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateActivityTimeouts", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateActivityTimeouts(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateActivityTimeouts", args...)
+}
+
+// UpdateTaskListLimits responds to a UpdateTaskListLimits call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateTaskListLimits(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateTaskListLimits(...)
+func (m *MockClient) UpdateTaskListLimits(
+	ctx context.Context,
+	_Request *shared.UpdateTaskListLimitsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListLimits", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListLimits(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListLimits", args...)
+}
+
+// VerifyArchivedWorkflowExecution responds to a VerifyArchivedWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().VerifyArchivedWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.VerifyArchivedWorkflowExecution(...)
+func (m *MockClient) VerifyArchivedWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.VerifyArchivedWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.VerifyArchivedWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "VerifyArchivedWorkflowExecution", args...)
+	success, _ = ret[i].(*admin.VerifyArchivedWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) VerifyArchivedWorkflowExecution(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "VerifyArchivedWorkflowExecution", args...)
+}
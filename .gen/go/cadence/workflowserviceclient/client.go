@@ -39,12 +39,30 @@ import (
 
 // Interface is a client for the WorkflowService service.
 type Interface interface {
+	BackfillSchedule(
+		ctx context.Context,
+		BackfillRequest *shared.BackfillScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	CountWorkflowExecutions(
 		ctx context.Context,
 		CountRequest *shared.CountWorkflowExecutionsRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.CountWorkflowExecutionsResponse, error)
 
+	CreateSchedule(
+		ctx context.Context,
+		CreateRequest *shared.CreateScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DeleteSchedule(
+		ctx context.Context,
+		DeleteRequest *shared.DeleteScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DeprecateDomain(
 		ctx context.Context,
 		DeprecateRequest *shared.DeprecateDomainRequest,
@@ -57,6 +75,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeDomainResponse, error)
 
+	DescribeSchedule(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeScheduleRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeScheduleResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *shared.DescribeTaskListRequest,
@@ -83,6 +107,7 @@ type Interface interface {
 
 	GetSearchAttributes(
 		ctx context.Context,
+		Request *shared.GetSearchAttributesRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.GetSearchAttributesResponse, error)
 
@@ -116,12 +141,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListSchedules(
+		ctx context.Context,
+		ListRequest *shared.ListSchedulesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListSchedulesResponse, error)
+
 	ListWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	PauseSchedule(
+		ctx context.Context,
+		PauseRequest *shared.PauseScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -266,11 +303,29 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	UnpauseSchedule(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateSchedule(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the WorkflowService service.
@@ -297,6 +352,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) BackfillSchedule(
+	ctx context.Context,
+	_BackfillRequest *shared.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_BackfillSchedule_Helper.Args(_BackfillRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_BackfillSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_BackfillSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CountWorkflowExecutions(
 	ctx context.Context,
 	_CountRequest *shared.CountWorkflowExecutionsRequest,
@@ -320,6 +398,52 @@ func (c client) CountWorkflowExecutions(
 	return
 }
 
+func (c client) CreateSchedule(
+	ctx context.Context,
+	_CreateRequest *shared.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_CreateSchedule_Helper.Args(_CreateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_CreateSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_CreateSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DeleteSchedule(
+	ctx context.Context,
+	_DeleteRequest *shared.DeleteScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_DeleteSchedule_Helper.Args(_DeleteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_DeleteSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_DeleteSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DeprecateDomain(
 	ctx context.Context,
 	_DeprecateRequest *shared.DeprecateDomainRequest,
@@ -366,6 +490,29 @@ func (c client) DescribeDomain(
 	return
 }
 
+func (c client) DescribeSchedule(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeScheduleResponse, err error) {
+
+	args := cadence.WorkflowService_DescribeSchedule_Helper.Args(_DescribeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_DescribeSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_DescribeSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeTaskList(
 	ctx context.Context,
 	_Request *shared.DescribeTaskListRequest,
@@ -460,10 +607,11 @@ func (c client) GetReplicationMessages(
 
 func (c client) GetSearchAttributes(
 	ctx context.Context,
+	_Request *shared.GetSearchAttributesRequest,
 	opts ...yarpc.CallOption,
 ) (success *shared.GetSearchAttributesResponse, err error) {
 
-	args := cadence.WorkflowService_GetSearchAttributes_Helper.Args()
+	args := cadence.WorkflowService_GetSearchAttributes_Helper.Args(_Request)
 
 	var body wire.Value
 	body, err = c.c.Call(ctx, args, opts...)
@@ -595,6 +743,29 @@ func (c client) ListOpenWorkflowExecutions(
 	return
 }
 
+func (c client) ListSchedules(
+	ctx context.Context,
+	_ListRequest *shared.ListSchedulesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListSchedulesResponse, err error) {
+
+	args := cadence.WorkflowService_ListSchedules_Helper.Args(_ListRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_ListSchedules_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_ListSchedules_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -618,6 +789,29 @@ func (c client) ListWorkflowExecutions(
 	return
 }
 
+func (c client) PauseSchedule(
+	ctx context.Context,
+	_PauseRequest *shared.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_PauseSchedule_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_PauseSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_PauseSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *shared.PollForActivityTaskRequest,
@@ -1170,6 +1364,29 @@ func (c client) TerminateWorkflowExecution(
 	return
 }
 
+func (c client) UnpauseSchedule(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_UnpauseSchedule_Helper.Args(_UnpauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UnpauseSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_UnpauseSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomain(
 	ctx context.Context,
 	_UpdateRequest *shared.UpdateDomainRequest,
@@ -1192,3 +1409,49 @@ func (c client) UpdateDomain(
 	success, err = cadence.WorkflowService_UpdateDomain_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateSchedule(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_UpdateSchedule_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UpdateSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_UpdateSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := cadence.WorkflowService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UpdateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
@@ -37,11 +37,26 @@ import (
 
 // Interface is the server-side interface for the WorkflowService service.
 type Interface interface {
+	BackfillSchedule(
+		ctx context.Context,
+		BackfillRequest *shared.BackfillScheduleRequest,
+	) error
+
 	CountWorkflowExecutions(
 		ctx context.Context,
 		CountRequest *shared.CountWorkflowExecutionsRequest,
 	) (*shared.CountWorkflowExecutionsResponse, error)
 
+	CreateSchedule(
+		ctx context.Context,
+		CreateRequest *shared.CreateScheduleRequest,
+	) error
+
+	DeleteSchedule(
+		ctx context.Context,
+		DeleteRequest *shared.DeleteScheduleRequest,
+	) error
+
 	DeprecateDomain(
 		ctx context.Context,
 		DeprecateRequest *shared.DeprecateDomainRequest,
@@ -52,6 +67,11 @@ type Interface interface {
 		DescribeRequest *shared.DescribeDomainRequest,
 	) (*shared.DescribeDomainResponse, error)
 
+	DescribeSchedule(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeScheduleRequest,
+	) (*shared.DescribeScheduleResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *shared.DescribeTaskListRequest,
@@ -74,6 +94,7 @@ type Interface interface {
 
 	GetSearchAttributes(
 		ctx context.Context,
+		Request *shared.GetSearchAttributesRequest,
 	) (*shared.GetSearchAttributesResponse, error)
 
 	GetWorkflowExecutionHistory(
@@ -101,11 +122,21 @@ type Interface interface {
 		ListRequest *shared.ListOpenWorkflowExecutionsRequest,
 	) (*shared.ListOpenWorkflowExecutionsResponse, error)
 
+	ListSchedules(
+		ctx context.Context,
+		ListRequest *shared.ListSchedulesRequest,
+	) (*shared.ListSchedulesResponse, error)
+
 	ListWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	PauseSchedule(
+		ctx context.Context,
+		PauseRequest *shared.PauseScheduleRequest,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -226,10 +257,25 @@ type Interface interface {
 		TerminateRequest *shared.TerminateWorkflowExecutionRequest,
 	) error
 
+	UnpauseSchedule(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseScheduleRequest,
+	) error
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateSchedule(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateScheduleRequest,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New prepares an implementation of the WorkflowService service for
@@ -243,6 +289,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "WorkflowService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "BackfillSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.BackfillSchedule),
+				},
+				Signature:    "BackfillSchedule(BackfillRequest *shared.BackfillScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CountWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -254,6 +311,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "CreateSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CreateSchedule),
+				},
+				Signature:    "CreateSchedule(CreateRequest *shared.CreateScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "DeleteSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DeleteSchedule),
+				},
+				Signature:    "DeleteSchedule(DeleteRequest *shared.DeleteScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DeprecateDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -276,6 +355,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeSchedule),
+				},
+				Signature:    "DescribeSchedule(DescribeRequest *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -327,7 +417,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 					Type:  transport.Unary,
 					Unary: thrift.UnaryHandler(h.GetSearchAttributes),
 				},
-				Signature:    "GetSearchAttributes() (*shared.GetSearchAttributesResponse)",
+				Signature:    "GetSearchAttributes(Request *shared.GetSearchAttributesRequest) (*shared.GetSearchAttributesResponse)",
 				ThriftModule: cadence.ThriftModule,
 			},
 
@@ -386,6 +476,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListSchedules",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListSchedules),
+				},
+				Signature:    "ListSchedules(ListRequest *shared.ListSchedulesRequest) (*shared.ListSchedulesResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -397,6 +498,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "PauseSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseSchedule),
+				},
+				Signature:    "PauseSchedule(PauseRequest *shared.PauseScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -661,6 +773,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "UnpauseSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UnpauseSchedule),
+				},
+				Signature:    "UnpauseSchedule(UnpauseRequest *shared.UnpauseScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -671,16 +794,57 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateDomain(UpdateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse)",
 				ThriftModule: cadence.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateSchedule",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateSchedule),
+				},
+				Signature:    "UpdateSchedule(UpdateRequest *shared.UpdateScheduleRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateWorkflowExecution),
+				},
+				Signature:    "UpdateWorkflowExecution(UpdateRequest *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 39)
+	procedures := make([]transport.Procedure, 0, 48)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) BackfillSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_BackfillSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.BackfillSchedule(ctx, args.BackfillRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_BackfillSchedule_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) CountWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_CountWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
@@ -700,6 +864,44 @@ func (h handler) CountWorkflowExecutions(ctx context.Context, body wire.Value) (
 	return response, err
 }
 
+func (h handler) CreateSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_CreateSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.CreateSchedule(ctx, args.CreateRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_CreateSchedule_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) DeleteSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DeleteSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.DeleteSchedule(ctx, args.DeleteRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_DeleteSchedule_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DeprecateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DeprecateDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -738,6 +940,25 @@ func (h handler) DescribeDomain(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
+func (h handler) DescribeSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeSchedule(ctx, args.DescribeRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_DescribeSchedule_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DescribeTaskList_Args
 	if err := args.FromWire(body); err != nil {
@@ -820,7 +1041,7 @@ func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thri
 		return thrift.Response{}, err
 	}
 
-	success, err := h.impl.GetSearchAttributes(ctx)
+	success, err := h.impl.GetSearchAttributes(ctx, args.Request)
 
 	hadError := err != nil
 	result, err := cadence.WorkflowService_GetSearchAttributes_Helper.WrapResponse(success, err)
@@ -928,6 +1149,25 @@ func (h handler) ListOpenWorkflowExecutions(ctx context.Context, body wire.Value
 	return response, err
 }
 
+func (h handler) ListSchedules(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListSchedules_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListSchedules(ctx, args.ListRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_ListSchedules_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_ListWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
@@ -947,6 +1187,25 @@ func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) PauseSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_PauseSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PauseSchedule(ctx, args.PauseRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_PauseSchedule_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -1403,6 +1662,25 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	return response, err
 }
 
+func (h handler) UnpauseSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UnpauseSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UnpauseSchedule(ctx, args.UnpauseRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_UnpauseSchedule_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_UpdateDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -1421,3 +1699,41 @@ func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Resp
 	}
 	return response, err
 }
+
+func (h handler) UpdateSchedule(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateSchedule_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateSchedule(ctx, args.UpdateRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_UpdateSchedule_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_UpdateWorkflowExecution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
@@ -65,6 +65,37 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// BackfillSchedule responds to a BackfillSchedule call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().BackfillSchedule(gomock.Any(), ...).Return(...)
+// 	... := client.BackfillSchedule(...)
+func (m *MockClient) BackfillSchedule(
+	ctx context.Context,
+	_BackfillRequest *shared.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _BackfillRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "BackfillSchedule", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) BackfillSchedule(
+	ctx interface{},
+	_BackfillRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _BackfillRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "BackfillSchedule", args...)
+}
+
 // CountWorkflowExecutions responds to a CountWorkflowExecutions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -98,6 +129,68 @@ func (mr *_MockClientRecorder) CountWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "CountWorkflowExecutions", args...)
 }
 
+// CreateSchedule responds to a CreateSchedule call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().CreateSchedule(gomock.Any(), ...).Return(...)
+// 	... := client.CreateSchedule(...)
+func (m *MockClient) CreateSchedule(
+	ctx context.Context,
+	_CreateRequest *shared.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _CreateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "CreateSchedule", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) CreateSchedule(
+	ctx interface{},
+	_CreateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _CreateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "CreateSchedule", args...)
+}
+
+// DeleteSchedule responds to a DeleteSchedule call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DeleteSchedule(gomock.Any(), ...).Return(...)
+// 	... := client.DeleteSchedule(...)
+func (m *MockClient) DeleteSchedule(
+	ctx context.Context,
+	_DeleteRequest *shared.DeleteScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _DeleteRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DeleteSchedule", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DeleteSchedule(
+	ctx interface{},
+	_DeleteRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _DeleteRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteSchedule", args...)
+}
+
 // DeprecateDomain responds to a DeprecateDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -162,6 +255,39 @@ func (mr *_MockClientRecorder) DescribeDomain(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeDomain", args...)
 }
 
+// DescribeSchedule responds to a DescribeSchedule call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeSchedule(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeSchedule(...)
+func (m *MockClient) DescribeSchedule(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeScheduleResponse, err error) {
+
+	args := []interface{}{ctx, _DescribeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeSchedule", args...)
+	success, _ = ret[i].(*shared.DescribeScheduleResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeSchedule(
+	ctx interface{},
+	_DescribeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _DescribeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeSchedule", args...)
+}
+
 // DescribeTaskList responds to a DescribeTaskList call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -302,10 +428,11 @@ func (mr *_MockClientRecorder) GetReplicationMessages(
 // 	... := client.GetSearchAttributes(...)
 func (m *MockClient) GetSearchAttributes(
 	ctx context.Context,
+	_Request *shared.GetSearchAttributesRequest,
 	opts ...yarpc.CallOption,
 ) (success *shared.GetSearchAttributesResponse, err error) {
 
-	args := []interface{}{ctx}
+	args := []interface{}{ctx, _Request}
 	for _, o := range opts {
 		args = append(args, o)
 	}
@@ -319,9 +446,10 @@ func (m *MockClient) GetSearchAttributes(
 
 func (mr *_MockClientRecorder) GetSearchAttributes(
 	ctx interface{},
+	_Request interface{},
 	opts ...interface{},
 ) *gomock.Call {
-	args := append([]interface{}{ctx}, opts...)
+	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetSearchAttributes", args...)
 }
 
@@ -490,6 +618,39 @@ func (mr *_MockClientRecorder) ListOpenWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListOpenWorkflowExecutions", args...)
 }
 
+// ListSchedules responds to a ListSchedules call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListSchedules(gomock.Any(), ...).Return(...)
+// 	... := client.ListSchedules(...)
+func (m *MockClient) ListSchedules(
+	ctx context.Context,
+	_ListRequest *shared.ListSchedulesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListSchedulesResponse, err error) {
+
+	args := []interface{}{ctx, _ListRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListSchedules", args...)
+	success, _ = ret[i].(*shared.ListSchedulesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListSchedules(
+	ctx interface{},
+	_ListRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _ListRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListSchedules", args...)
+}
+
 // ListWorkflowExecutions responds to a ListWorkflowExecutions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -523,6 +684,37 @@ func (mr *_MockClientRecorder) ListWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListWorkflowExecutions", args...)
 }
 
+// PauseSchedule responds to a PauseSchedule call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().PauseSchedule(gomock.Any(), ...).Return(...)
+// 	... := client.PauseSchedule(...)
+func (m *MockClient) PauseSchedule(
+	ctx context.Context,
+	_PauseRequest *shared.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _PauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PauseSchedule", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PauseSchedule(
+	ctx interface{},
+	_PauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _PauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PauseSchedule", args...)
+}
+
 // PollForActivityTask responds to a PollForActivityTask call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1289,6 +1481,37 @@ func (mr *_MockClientRecorder) TerminateWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "TerminateWorkflowExecution", args...)
 }
 
+// UnpauseSchedule responds to a UnpauseSchedule call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UnpauseSchedule(gomock.Any(), ...).Return(...)
+// 	... := client.UnpauseSchedule(...)
+func (m *MockClient) UnpauseSchedule(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UnpauseRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UnpauseSchedule", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UnpauseSchedule(
+	ctx interface{},
+	_UnpauseRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UnpauseRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UnpauseSchedule", args...)
+}
+
 // UpdateDomain responds to a UpdateDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1321,3 +1544,67 @@ func (mr *_MockClientRecorder) UpdateDomain(
 	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateDomain", args...)
 }
+
+// UpdateSchedule responds to a UpdateSchedule call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateSchedule(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateSchedule(...)
+func (m *MockClient) UpdateSchedule(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateSchedule", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateSchedule(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateSchedule", args...)
+}
+
+// UpdateWorkflowExecution responds to a UpdateWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateWorkflowExecution(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateWorkflowExecution(...)
+func (m *MockClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", args...)
+	success, _ = ret[i].(*shared.UpdateWorkflowExecutionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateWorkflowExecution(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateWorkflowExecution", args...)
+}
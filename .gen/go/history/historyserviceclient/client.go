@@ -45,6 +45,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *history.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -75,6 +81,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	ImportWorkflowExecution(
+		ctx context.Context,
+		ImportRequest *history.ImportWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *history.PauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollMutableState(
 		ctx context.Context,
 		PollRequest *history.PollMutableStateRequest,
@@ -153,6 +171,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetActivity(
+		ctx context.Context,
+		ResetRequest *history.ResetActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *history.ResetStickyTaskListRequest,
@@ -236,6 +260,30 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseActivityRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateActivityTimeouts(
+		ctx context.Context,
+		UpdateRequest *history.UpdateActivityTimeoutsRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
+
+	UpdateWorkflowSearchAttributes(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowSearchAttributesRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the HistoryService service.
@@ -285,6 +333,29 @@ func (c client) CloseShard(
 	return
 }
 
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_DeleteRequest *history.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_DeleteWorkflowExecution_Helper.Args(_DeleteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DeleteWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -400,6 +471,52 @@ func (c client) GetReplicationMessages(
 	return
 }
 
+func (c client) ImportWorkflowExecution(
+	ctx context.Context,
+	_ImportRequest *history.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ImportWorkflowExecution_Helper.Args(_ImportRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ImportWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ImportWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseActivity(
+	ctx context.Context,
+	_PauseRequest *history.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_PauseActivity_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_PauseActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_PauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollMutableState(
 	ctx context.Context,
 	_PollRequest *history.PollMutableStateRequest,
@@ -699,6 +816,29 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) ResetActivity(
+	ctx context.Context,
+	_ResetRequest *history.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ResetActivity_Helper.Args(_ResetRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ResetActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ResetActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *history.ResetStickyTaskListRequest,
@@ -1020,3 +1160,95 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UnpauseActivity(
+	ctx context.Context,
+	_UnpauseRequest *history.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_UnpauseActivity_Helper.Args(_UnpauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UnpauseActivity_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_UnpauseActivity_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateActivityTimeouts(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateActivityTimeoutsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_UpdateActivityTimeouts_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UpdateActivityTimeouts_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_UpdateActivityTimeouts_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := history.HistoryService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UpdateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowSearchAttributes(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_UpdateWorkflowSearchAttributes_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_UpdateWorkflowSearchAttributes_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_UpdateWorkflowSearchAttributes_Helper.UnwrapResponse(&result)
+	return
+}
@@ -42,6 +42,11 @@ type Interface interface {
 		Request *shared.CloseShardRequest,
 	) error
 
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		DeleteRequest *history.DeleteWorkflowExecutionRequest,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -67,6 +72,16 @@ type Interface interface {
 		Request *replicator.GetReplicationMessagesRequest,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	ImportWorkflowExecution(
+		ctx context.Context,
+		ImportRequest *history.ImportWorkflowExecutionRequest,
+	) error
+
+	PauseActivity(
+		ctx context.Context,
+		PauseRequest *history.PauseActivityRequest,
+	) error
+
 	PollMutableState(
 		ctx context.Context,
 		PollRequest *history.PollMutableStateRequest,
@@ -132,6 +147,11 @@ type Interface interface {
 		CancelRequest *history.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	ResetActivity(
+		ctx context.Context,
+		ResetRequest *history.ResetActivityRequest,
+	) error
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *history.ResetStickyTaskListRequest,
@@ -201,6 +221,26 @@ type Interface interface {
 		ctx context.Context,
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 	) error
+
+	UnpauseActivity(
+		ctx context.Context,
+		UnpauseRequest *history.UnpauseActivityRequest,
+	) error
+
+	UpdateActivityTimeouts(
+		ctx context.Context,
+		UpdateRequest *history.UpdateActivityTimeoutsRequest,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowExecutionRequest,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
+
+	UpdateWorkflowSearchAttributes(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowSearchAttributesRequest,
+	) error
 }
 
 // New prepares an implementation of the HistoryService service for
@@ -225,6 +265,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DeleteWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DeleteWorkflowExecution),
+				},
+				Signature:    "DeleteWorkflowExecution(DeleteRequest *history.DeleteWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -280,6 +331,28 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ImportWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ImportWorkflowExecution),
+				},
+				Signature:    "ImportWorkflowExecution(ImportRequest *history.ImportWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.PauseActivity),
+				},
+				Signature:    "PauseActivity(PauseRequest *history.PauseActivityRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollMutableState",
 				HandlerSpec: thrift.HandlerSpec{
@@ -423,6 +496,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ResetActivity),
+				},
+				Signature:    "ResetActivity(ResetRequest *history.ResetActivityRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetStickyTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -576,10 +660,54 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "TerminateWorkflowExecution(TerminateRequest *history.TerminateWorkflowExecutionRequest)",
 				ThriftModule: history.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UnpauseActivity",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UnpauseActivity),
+				},
+				Signature:    "UnpauseActivity(UnpauseRequest *history.UnpauseActivityRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateActivityTimeouts",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateActivityTimeouts),
+				},
+				Signature:    "UpdateActivityTimeouts(UpdateRequest *history.UpdateActivityTimeoutsRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateWorkflowExecution),
+				},
+				Signature:    "UpdateWorkflowExecution(UpdateRequest *history.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowSearchAttributes",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateWorkflowSearchAttributes),
+				},
+				Signature:    "UpdateWorkflowSearchAttributes(UpdateRequest *history.UpdateWorkflowSearchAttributesRequest)",
+				ThriftModule: history.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 33)
+	procedures := make([]transport.Procedure, 0, 41)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -605,6 +733,25 @@ func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) DeleteWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DeleteWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.DeleteWorkflowExecution(ctx, args.DeleteRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_DeleteWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -700,6 +847,44 @@ func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) ImportWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ImportWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ImportWorkflowExecution(ctx, args.ImportRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ImportWorkflowExecution_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) PauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_PauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.PauseActivity(ctx, args.PauseRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_PauseActivity_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) PollMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_PollMutableState_Args
 	if err := args.FromWire(body); err != nil {
@@ -947,6 +1132,25 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
+func (h handler) ResetActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ResetActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ResetActivity(ctx, args.ResetRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ResetActivity_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ResetStickyTaskList_Args
 	if err := args.FromWire(body); err != nil {
@@ -1212,3 +1416,79 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	}
 	return response, err
 }
+
+func (h handler) UnpauseActivity(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UnpauseActivity_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UnpauseActivity(ctx, args.UnpauseRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_UnpauseActivity_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateActivityTimeouts(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UpdateActivityTimeouts_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateActivityTimeouts(ctx, args.UpdateRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_UpdateActivityTimeouts_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UpdateWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.UpdateWorkflowExecution(ctx, args.UpdateRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_UpdateWorkflowExecution_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) UpdateWorkflowSearchAttributes(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UpdateWorkflowSearchAttributes_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateWorkflowSearchAttributes(ctx, args.UpdateRequest)
+
+	hadError := err != nil
+	result, err := history.HistoryService_UpdateWorkflowSearchAttributes_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
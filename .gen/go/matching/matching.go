@@ -46,6 +46,8 @@ type AddActivityTaskRequest struct {
 	ScheduleId                    *int64                    `json:"scheduleId,omitempty"`
 	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	ForwardedFrom                 *string                   `json:"forwardedFrom,omitempty"`
+	Priority                      *int32                    `json:"priority,omitempty"`
+	FairnessKey                   *string                   `json:"fairnessKey,omitempty"`
 }
 
 // ToWire translates a AddActivityTaskRequest struct into a Thrift-level intermediate
@@ -65,7 +67,7 @@ type AddActivityTaskRequest struct {
 //   }
 func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -127,6 +129,22 @@ func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -230,6 +248,26 @@ func (v *AddActivityTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -244,7 +282,7 @@ func (v *AddActivityTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [9]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -274,6 +312,14 @@ func (v *AddActivityTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("AddActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -339,6 +385,12 @@ func (v *AddActivityTaskRequest) Equals(rhs *AddActivityTaskRequest) bool {
 	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -370,6 +422,12 @@ func (v *AddActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.ForwardedFrom != nil {
 		enc.AddString("forwardedFrom", *v.ForwardedFrom)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -478,6 +536,36 @@ func (v *AddActivityTaskRequest) IsSetForwardedFrom() bool {
 	return v != nil && v.ForwardedFrom != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *AddActivityTaskRequest) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *AddActivityTaskRequest) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type AddDecisionTaskRequest struct {
 	DomainUUID                    *string                   `json:"domainUUID,omitempty"`
 	Execution                     *shared.WorkflowExecution `json:"execution,omitempty"`
@@ -485,6 +573,8 @@ type AddDecisionTaskRequest struct {
 	ScheduleId                    *int64                    `json:"scheduleId,omitempty"`
 	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	ForwardedFrom                 *string                   `json:"forwardedFrom,omitempty"`
+	Priority                      *int32                    `json:"priority,omitempty"`
+	FairnessKey                   *string                   `json:"fairnessKey,omitempty"`
 }
 
 // ToWire translates a AddDecisionTaskRequest struct into a Thrift-level intermediate
@@ -504,7 +594,7 @@ type AddDecisionTaskRequest struct {
 //   }
 func (v *AddDecisionTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -558,6 +648,22 @@ func (v *AddDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -639,6 +745,26 @@ func (v *AddDecisionTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -653,7 +779,7 @@ func (v *AddDecisionTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [8]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -679,6 +805,14 @@ func (v *AddDecisionTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("AddDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -711,6 +845,12 @@ func (v *AddDecisionTaskRequest) Equals(rhs *AddDecisionTaskRequest) bool {
 	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -739,6 +879,12 @@ func (v *AddDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.ForwardedFrom != nil {
 		enc.AddString("forwardedFrom", *v.ForwardedFrom)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -832,6 +978,36 @@ func (v *AddDecisionTaskRequest) IsSetForwardedFrom() bool {
 	return v != nil && v.ForwardedFrom != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *AddDecisionTaskRequest) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *AddDecisionTaskRequest) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type CancelOutstandingPollRequest struct {
 	DomainUUID   *string          `json:"domainUUID,omitempty"`
 	TaskListType *int32           `json:"taskListType,omitempty"`
@@ -1835,24 +2011,93 @@ func (v *PollForDecisionTaskRequest) IsSetForwardedFrom() bool {
 }
 
 type PollForDecisionTaskResponse struct {
-	TaskToken                 []byte                        `json:"taskToken,omitempty"`
-	WorkflowExecution         *shared.WorkflowExecution     `json:"workflowExecution,omitempty"`
-	WorkflowType              *shared.WorkflowType          `json:"workflowType,omitempty"`
-	PreviousStartedEventId    *int64                        `json:"previousStartedEventId,omitempty"`
-	StartedEventId            *int64                        `json:"startedEventId,omitempty"`
-	Attempt                   *int64                        `json:"attempt,omitempty"`
-	NextEventId               *int64                        `json:"nextEventId,omitempty"`
-	BacklogCountHint          *int64                        `json:"backlogCountHint,omitempty"`
-	StickyExecutionEnabled    *bool                         `json:"stickyExecutionEnabled,omitempty"`
-	Query                     *shared.WorkflowQuery         `json:"query,omitempty"`
-	DecisionInfo              *shared.TransientDecisionInfo `json:"decisionInfo,omitempty"`
-	WorkflowExecutionTaskList *shared.TaskList              `json:"WorkflowExecutionTaskList,omitempty"`
-	EventStoreVersion         *int32                        `json:"eventStoreVersion,omitempty"`
-	BranchToken               []byte                        `json:"branchToken,omitempty"`
-	ScheduledTimestamp        *int64                        `json:"scheduledTimestamp,omitempty"`
-	StartedTimestamp          *int64                        `json:"startedTimestamp,omitempty"`
+	TaskToken                 []byte                           `json:"taskToken,omitempty"`
+	WorkflowExecution         *shared.WorkflowExecution        `json:"workflowExecution,omitempty"`
+	WorkflowType              *shared.WorkflowType             `json:"workflowType,omitempty"`
+	PreviousStartedEventId    *int64                           `json:"previousStartedEventId,omitempty"`
+	StartedEventId            *int64                           `json:"startedEventId,omitempty"`
+	Attempt                   *int64                           `json:"attempt,omitempty"`
+	NextEventId               *int64                           `json:"nextEventId,omitempty"`
+	BacklogCountHint          *int64                           `json:"backlogCountHint,omitempty"`
+	StickyExecutionEnabled    *bool                            `json:"stickyExecutionEnabled,omitempty"`
+	Query                     *shared.WorkflowQuery            `json:"query,omitempty"`
+	DecisionInfo              *shared.TransientDecisionInfo    `json:"decisionInfo,omitempty"`
+	WorkflowExecutionTaskList *shared.TaskList                 `json:"WorkflowExecutionTaskList,omitempty"`
+	EventStoreVersion         *int32                           `json:"eventStoreVersion,omitempty"`
+	BranchToken               []byte                           `json:"branchToken,omitempty"`
+	ScheduledTimestamp        *int64                           `json:"scheduledTimestamp,omitempty"`
+	StartedTimestamp          *int64                           `json:"startedTimestamp,omitempty"`
+	Updates                   []*shared.WorkflowUpdate         `json:"updates,omitempty"`
+	Queries                   map[string]*shared.WorkflowQuery `json:"queries,omitempty"`
+}
+
+type _List_WorkflowUpdate_ValueList []*shared.WorkflowUpdate
+
+func (v _List_WorkflowUpdate_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_WorkflowUpdate_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_WorkflowUpdate_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_WorkflowUpdate_ValueList) Close() {}
+
+type _Map_String_WorkflowQuery_MapItemList map[string]*shared.WorkflowQuery
+
+func (m _Map_String_WorkflowQuery_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_WorkflowQuery_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_WorkflowQuery_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
 }
 
+func (_Map_String_WorkflowQuery_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_WorkflowQuery_MapItemList) Close() {}
+
 // ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -1870,7 +2115,7 @@ type PollForDecisionTaskResponse struct {
 //   }
 func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [16]wire.Field
+		fields [18]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2004,6 +2249,22 @@ func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 140, Value: w}
 		i++
 	}
+	if v.Updates != nil {
+		w, err = wire.NewValueList(_List_WorkflowUpdate_ValueList(v.Updates)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.Queries != nil {
+		w, err = wire.NewValueMap(_Map_String_WorkflowQuery_MapItemList(v.Queries)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2026,6 +2287,58 @@ func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, e
 	return &v, err
 }
 
+func _WorkflowUpdate_Read(w wire.Value) (*shared.WorkflowUpdate, error) {
+	var v shared.WorkflowUpdate
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_WorkflowUpdate_Read(l wire.ValueList) ([]*shared.WorkflowUpdate, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.WorkflowUpdate, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _WorkflowUpdate_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_WorkflowQuery_Read(m wire.MapItemList) (map[string]*shared.WorkflowQuery, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.WorkflowQuery, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _WorkflowQuery_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -2193,6 +2506,22 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 150:
+			if field.Value.Type() == wire.TList {
+				v.Updates, err = _List_WorkflowUpdate_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 160:
+			if field.Value.Type() == wire.TMap {
+				v.Queries, err = _Map_String_WorkflowQuery_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2207,7 +2536,7 @@ func (v *PollForDecisionTaskResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [16]string
+	var fields [18]string
 	i := 0
 	if v.TaskToken != nil {
 		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
@@ -2273,6 +2602,14 @@ func (v *PollForDecisionTaskResponse) String() string {
 		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
 		i++
 	}
+	if v.Updates != nil {
+		fields[i] = fmt.Sprintf("Updates: %v", v.Updates)
+		i++
+	}
+	if v.Queries != nil {
+		fields[i] = fmt.Sprintf("Queries: %v", v.Queries)
+		i++
+	}
 
 	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2287,6 +2624,38 @@ func _Bool_EqualsPtr(lhs, rhs *bool) bool {
 	return lhs == nil && rhs == nil
 }
 
+func _List_WorkflowUpdate_Equals(lhs, rhs []*shared.WorkflowUpdate) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_WorkflowQuery_Equals(lhs, rhs map[string]*shared.WorkflowQuery) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this PollForDecisionTaskResponse match the
 // provided PollForDecisionTaskResponse.
 //
@@ -2345,10 +2714,38 @@ func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) b
 	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
 		return false
 	}
+	if !((v.Updates == nil && rhs.Updates == nil) || (v.Updates != nil && rhs.Updates != nil && _List_WorkflowUpdate_Equals(v.Updates, rhs.Updates))) {
+		return false
+	}
+	if !((v.Queries == nil && rhs.Queries == nil) || (v.Queries != nil && rhs.Queries != nil && _Map_String_WorkflowQuery_Equals(v.Queries, rhs.Queries))) {
+		return false
+	}
 
 	return true
 }
 
+type _List_WorkflowUpdate_Zapper []*shared.WorkflowUpdate
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_WorkflowUpdate_Zapper.
+func (l _List_WorkflowUpdate_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_WorkflowQuery_Zapper map[string]*shared.WorkflowQuery
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_WorkflowQuery_Zapper.
+func (m _Map_String_WorkflowQuery_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of PollForDecisionTaskResponse.
 func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2403,6 +2800,12 @@ func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder
 	if v.StartedTimestamp != nil {
 		enc.AddInt64("startedTimestamp", *v.StartedTimestamp)
 	}
+	if v.Updates != nil {
+		err = multierr.Append(err, enc.AddArray("updates", (_List_WorkflowUpdate_Zapper)(v.Updates)))
+	}
+	if v.Queries != nil {
+		err = multierr.Append(err, enc.AddObject("queries", (_Map_String_WorkflowQuery_Zapper)(v.Queries)))
+	}
 	return err
 }
 
@@ -2646,6 +3049,36 @@ func (v *PollForDecisionTaskResponse) IsSetStartedTimestamp() bool {
 	return v != nil && v.StartedTimestamp != nil
 }
 
+// GetUpdates returns the value of Updates if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetUpdates() (o []*shared.WorkflowUpdate) {
+	if v != nil && v.Updates != nil {
+		return v.Updates
+	}
+
+	return
+}
+
+// IsSetUpdates returns true if Updates is not nil.
+func (v *PollForDecisionTaskResponse) IsSetUpdates() bool {
+	return v != nil && v.Updates != nil
+}
+
+// GetQueries returns the value of Queries if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetQueries() (o map[string]*shared.WorkflowQuery) {
+	if v != nil && v.Queries != nil {
+		return v.Queries
+	}
+
+	return
+}
+
+// IsSetQueries returns true if Queries is not nil.
+func (v *PollForDecisionTaskResponse) IsSetQueries() bool {
+	return v != nil && v.Queries != nil
+}
+
 type QueryWorkflowRequest struct {
 	DomainUUID    *string                      `json:"domainUUID,omitempty"`
 	TaskList      *shared.TaskList             `json:"taskList,omitempty"`
@@ -2918,14 +3351,16 @@ func (v *QueryWorkflowRequest) IsSetForwardedFrom() bool {
 	return v != nil && v.ForwardedFrom != nil
 }
 
-type RespondQueryTaskCompletedRequest struct {
-	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
-	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
-	TaskID           *string                                  `json:"taskID,omitempty"`
-	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
+type ReleaseTaskRequest struct {
+	DomainUUID        *string                   `json:"domainUUID,omitempty"`
+	TaskList          *shared.TaskList          `json:"taskList,omitempty"`
+	TaskListType      *int32                    `json:"taskListType,omitempty"`
+	WorkflowExecution *shared.WorkflowExecution `json:"workflowExecution,omitempty"`
+	ScheduleId        *int64                    `json:"scheduleId,omitempty"`
+	AllPartitions     *bool                     `json:"allPartitions,omitempty"`
 }
 
-// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a ReleaseTaskRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2940,9 +3375,9 @@ type RespondQueryTaskCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *ReleaseTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2964,37 +3399,47 @@ func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.TaskID != nil {
-		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
+	if v.TaskListType != nil {
+		w, err = wire.NewValueI32(*(v.TaskListType)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.CompletedRequest != nil {
-		w, err = v.CompletedRequest.ToWire()
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.ScheduleId != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.AllPartitions != nil {
+		w, err = wire.NewValueBool(*(v.AllPartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
-	var v shared.RespondQueryTaskCompletedRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+// FromWire deserializes a ReleaseTaskRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// An error is returned if we were unable to build a ReleaseTaskRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3002,12 +3447,12 @@ func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryT
 //     return nil, err
 //   }
 //
-//   var v RespondQueryTaskCompletedRequest
+//   var v ReleaseTaskRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *ReleaseTaskRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -3031,10 +3476,10 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.TaskID = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskListType = &x
 				if err != nil {
 					return err
 				}
@@ -3042,7 +3487,27 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 			}
 		case 40:
 			if field.Value.Type() == wire.TStruct {
-				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduleId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.AllPartitions = &x
 				if err != nil {
 					return err
 				}
@@ -3054,14 +3519,14 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// String returns a readable string representation of a ReleaseTaskRequest
 // struct.
-func (v *RespondQueryTaskCompletedRequest) String() string {
+func (v *ReleaseTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -3071,23 +3536,31 @@ func (v *RespondQueryTaskCompletedRequest) String() string {
 		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
 		i++
 	}
-	if v.TaskID != nil {
-		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
 		i++
 	}
-	if v.CompletedRequest != nil {
-		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.ScheduleId != nil {
+		fields[i] = fmt.Sprintf("ScheduleId: %v", *(v.ScheduleId))
+		i++
+	}
+	if v.AllPartitions != nil {
+		fields[i] = fmt.Sprintf("AllPartitions: %v", *(v.AllPartitions))
 		i++
 	}
 
-	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReleaseTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
-// provided RespondQueryTaskCompletedRequest.
+// Equals returns true if all the fields of this ReleaseTaskRequest match the
+// provided ReleaseTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+func (v *ReleaseTaskRequest) Equals(rhs *ReleaseTaskRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -3099,10 +3572,16 @@ func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompleted
 	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
+	if !_I32_EqualsPtr(v.TaskListType, rhs.TaskListType) {
 		return false
 	}
-	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduleId, rhs.ScheduleId) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.AllPartitions, rhs.AllPartitions) {
 		return false
 	}
 
@@ -3110,8 +3589,8 @@ func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompleted
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondQueryTaskCompletedRequest.
-func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ReleaseTaskRequest.
+func (v *ReleaseTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -3121,18 +3600,24 @@ func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEn
 	if v.TaskList != nil {
 		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
 	}
-	if v.TaskID != nil {
-		enc.AddString("taskID", *v.TaskID)
+	if v.TaskListType != nil {
+		enc.AddInt32("taskListType", *v.TaskListType)
 	}
-	if v.CompletedRequest != nil {
-		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.ScheduleId != nil {
+		enc.AddInt64("scheduleId", *v.ScheduleId)
+	}
+	if v.AllPartitions != nil {
+		enc.AddBool("allPartitions", *v.AllPartitions)
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
+func (v *ReleaseTaskRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -3141,13 +3626,13 @@ func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
+func (v *ReleaseTaskRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
 // GetTaskList returns the value of TaskList if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
+func (v *ReleaseTaskRequest) GetTaskList() (o *shared.TaskList) {
 	if v != nil && v.TaskList != nil {
 		return v.TaskList
 	}
@@ -3156,62 +3641,78 @@ func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
 }
 
 // IsSetTaskList returns true if TaskList is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
+func (v *ReleaseTaskRequest) IsSetTaskList() bool {
 	return v != nil && v.TaskList != nil
 }
 
-// GetTaskID returns the value of TaskID if it is set or its
+// GetTaskListType returns the value of TaskListType if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
-	if v != nil && v.TaskID != nil {
-		return *v.TaskID
+func (v *ReleaseTaskRequest) GetTaskListType() (o int32) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
 	}
 
 	return
 }
 
-// IsSetTaskID returns true if TaskID is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
-	return v != nil && v.TaskID != nil
+// IsSetTaskListType returns true if TaskListType is not nil.
+func (v *ReleaseTaskRequest) IsSetTaskListType() bool {
+	return v != nil && v.TaskListType != nil
 }
 
-// GetCompletedRequest returns the value of CompletedRequest if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
-	if v != nil && v.CompletedRequest != nil {
-		return v.CompletedRequest
+func (v *ReleaseTaskRequest) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetCompletedRequest returns true if CompletedRequest is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
-	return v != nil && v.CompletedRequest != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *ReleaseTaskRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "matching",
-	Package:  "github.com/uber/cadence/.gen/go/matching",
-	FilePath: "matching.thrift",
-	SHA1:     "21a363ca26baf80feee2da1bcda8fb15259c8617",
-	Includes: []*thriftreflect.ThriftModule{
-		shared.ThriftModule,
-	},
-	Raw: rawIDL,
+// GetScheduleId returns the value of ScheduleId if it is set or its
+// zero value if it is unset.
+func (v *ReleaseTaskRequest) GetScheduleId() (o int64) {
+	if v != nil && v.ScheduleId != nil {
+		return *v.ScheduleId
+	}
+
+	return
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence.matching\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForDecisionTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional shared.WorkflowExecution workflowExecution\n  30: optional shared.WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = \"Long\") attempt\n  60: optional i64 (js.type = \"Long\") nextEventId\n  65: optional i64 (js.type = \"Long\") backlogCountHint\n  70: optional bool stickyExecutionEnabled\n  80: optional shared.WorkflowQuery query\n  90: optional shared.TransientDecisionInfo decisionInfo\n  100: optional shared.TaskList WorkflowExecutionTaskList\n  110: optional i32 eventStoreVersion\n  120: optional binary branchToken\n  130:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  140:  optional i64 (js.type = \"Long\") startedTimestamp\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForActivityTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct AddDecisionTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional shared.TaskList taskList\n  40: optional i64 (js.type = \"Long\") scheduleId\n  50: optional i32 scheduleToStartTimeoutSeconds\n  60: optional string forwardedFrom\n}\n\nstruct AddActivityTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional string sourceDomainUUID\n  40: optional shared.TaskList taskList\n  50: optional i64 (js.type = \"Long\") scheduleId\n  60: optional i32 scheduleToStartTimeoutSeconds\n  70: optional string forwardedFrom\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.QueryWorkflowRequest queryRequest\n  40: optional string forwardedFrom\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional string taskID\n  40: optional shared.RespondQueryTaskCompletedRequest completedRequest\n}\n\nstruct CancelOutstandingPollRequest {\n  10: optional string domainUUID\n  20: optional i32 taskListType\n  30: optional shared.TaskList taskList\n  40: optional string pollerID\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.DescribeTaskListRequest descRequest\n}\n\n/**\n* MatchingService API is exposed to provide support for polling from long running applications.\n* Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.\n**/\nservice MatchingService {\n  /**\n  * PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  **/\n  PollForDecisionTaskResponse PollForDecisionTask(1: PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddDecisionTask(1: AddDecisionTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n    )\n\n  /**\n  * AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddActivityTask(1: AddActivityTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n    )\n\n  /**\n  * QueryWorkflow is called by frontend to query a workflow.\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.QueryFailedError queryFailedError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by frontend to respond query completed.\n  **/\n  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n    * CancelOutstandingPoll is called by frontend to unblock long polls on matching for zombie pollers.\n    * Our rpc stack does not support context propagation, so when a client connection goes away frontend sees\n    * cancellation of context for that handler, but any corresponding calls (long-poll) to matching service does not\n    * see the cancellation propagated so it can unblock corresponding long-polls on its end.  This results is tasks\n    * being dispatched to zombie pollers in this situation.  This API is added so everytime frontend makes a long-poll\n    * api call to matching it passes in a pollerID and then calls this API when it detects client connection is closed\n    * to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.\n    **/\n  void CancelOutstandingPoll(1: CancelOutstandingPollRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: DescribeTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n}\n"
+// IsSetScheduleId returns true if ScheduleId is not nil.
+func (v *ReleaseTaskRequest) IsSetScheduleId() bool {
+	return v != nil && v.ScheduleId != nil
+}
 
-// MatchingService_AddActivityTask_Args represents the arguments for the MatchingService.AddActivityTask function.
-//
-// The arguments for AddActivityTask are sent and received over the wire as this struct.
-type MatchingService_AddActivityTask_Args struct {
-	AddRequest *AddActivityTaskRequest `json:"addRequest,omitempty"`
+// GetAllPartitions returns the value of AllPartitions if it is set or its
+// zero value if it is unset.
+func (v *ReleaseTaskRequest) GetAllPartitions() (o bool) {
+	if v != nil && v.AllPartitions != nil {
+		return *v.AllPartitions
+	}
+
+	return
 }
 
-// ToWire translates a MatchingService_AddActivityTask_Args struct into a Thrift-level intermediate
+// IsSetAllPartitions returns true if AllPartitions is not nil.
+func (v *ReleaseTaskRequest) IsSetAllPartitions() bool {
+	return v != nil && v.AllPartitions != nil
+}
+
+type RespondQueryTaskCompletedRequest struct {
+	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
+	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
+	TaskID           *string                                  `json:"taskID,omitempty"`
+	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
+}
+
+// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3226,37 +3727,61 @@ type MatchingService_AddActivityTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
+func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.AddRequest != nil {
-		w, err = v.AddRequest.ToWire()
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 1, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskID != nil {
+		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.CompletedRequest != nil {
+		w, err = v.CompletedRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error) {
-	var v AddActivityTaskRequest
+func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
+	var v shared.RespondQueryTaskCompletedRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddActivityTask_Args struct from its Thrift-level
+// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddActivityTask_Args struct
+// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3264,19 +3789,47 @@ func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error)
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddActivityTask_Args
+//   var v RespondQueryTaskCompletedRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
+func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.AddRequest, err = _AddActivityTaskRequest_Read(field.Value)
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3288,34 +3841,1762 @@ func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddActivityTask_Args
+// String returns a readable string representation of a RespondQueryTaskCompletedRequest
 // struct.
-func (v *MatchingService_AddActivityTask_Args) String() string {
+func (v *RespondQueryTaskCompletedRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.CompletedRequest != nil {
+		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+		i++
+	}
+
+	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
+// provided RespondQueryTaskCompletedRequest.
+//
+// This function performs a deep comparison.
+func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RespondQueryTaskCompletedRequest.
+func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskID != nil {
+		enc.AddString("taskID", *v.TaskID)
+	}
+	if v.CompletedRequest != nil {
+		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetTaskID returns the value of TaskID if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
+	}
+
+	return
+}
+
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetCompletedRequest returns the value of CompletedRequest if it is set or its
+// zero value if it is unset.
+func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
+	if v != nil && v.CompletedRequest != nil {
+		return v.CompletedRequest
+	}
+
+	return
+}
+
+// IsSetCompletedRequest returns true if CompletedRequest is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
+	return v != nil && v.CompletedRequest != nil
+}
+
+type UpdateTaskListLimitsRequest struct {
+	DomainUUID    *string                             `json:"domainUUID,omitempty"`
+	UpdateRequest *shared.UpdateTaskListLimitsRequest `json:"updateRequest,omitempty"`
+}
+
+// ToWire translates a UpdateTaskListLimitsRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UpdateTaskListLimitsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.UpdateRequest != nil {
+		w, err = v.UpdateRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UpdateTaskListLimitsRequest_Read(w wire.Value) (*shared.UpdateTaskListLimitsRequest, error) {
+	var v shared.UpdateTaskListLimitsRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a UpdateTaskListLimitsRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UpdateTaskListLimitsRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UpdateTaskListLimitsRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UpdateTaskListLimitsRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.UpdateRequest, err = _UpdateTaskListLimitsRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UpdateTaskListLimitsRequest
+// struct.
+func (v *UpdateTaskListLimitsRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.UpdateRequest != nil {
+		fields[i] = fmt.Sprintf("UpdateRequest: %v", v.UpdateRequest)
+		i++
+	}
+
+	return fmt.Sprintf("UpdateTaskListLimitsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UpdateTaskListLimitsRequest match the
+// provided UpdateTaskListLimitsRequest.
+//
+// This function performs a deep comparison.
+func (v *UpdateTaskListLimitsRequest) Equals(rhs *UpdateTaskListLimitsRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.UpdateRequest == nil && rhs.UpdateRequest == nil) || (v.UpdateRequest != nil && rhs.UpdateRequest != nil && v.UpdateRequest.Equals(rhs.UpdateRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UpdateTaskListLimitsRequest.
+func (v *UpdateTaskListLimitsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.UpdateRequest != nil {
+		err = multierr.Append(err, enc.AddObject("updateRequest", v.UpdateRequest))
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *UpdateTaskListLimitsRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *UpdateTaskListLimitsRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetUpdateRequest returns the value of UpdateRequest if it is set or its
+// zero value if it is unset.
+func (v *UpdateTaskListLimitsRequest) GetUpdateRequest() (o *shared.UpdateTaskListLimitsRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+
+	return
+}
+
+// IsSetUpdateRequest returns true if UpdateRequest is not nil.
+func (v *UpdateTaskListLimitsRequest) IsSetUpdateRequest() bool {
+	return v != nil && v.UpdateRequest != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "matching",
+	Package:  "github.com/uber/cadence/.gen/go/matching",
+	FilePath: "matching.thrift",
+	SHA1:     "2487ab7a80335f15fa4c37bcfb5480e4f85e4a0b",
+	Includes: []*thriftreflect.ThriftModule{
+		shared.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence.matching\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForDecisionTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional shared.WorkflowExecution workflowExecution\n  30: optional shared.WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = \"Long\") attempt\n  60: optional i64 (js.type = \"Long\") nextEventId\n  65: optional i64 (js.type = \"Long\") backlogCountHint\n  70: optional bool stickyExecutionEnabled\n  80: optional shared.WorkflowQuery query\n  90: optional shared.TransientDecisionInfo decisionInfo\n  100: optional shared.TaskList WorkflowExecutionTaskList\n  110: optional i32 eventStoreVersion\n  120: optional binary branchToken\n  130:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  140:  optional i64 (js.type = \"Long\") startedTimestamp\n  150:  optional list<shared.WorkflowUpdate> updates\n  160:  optional map<string, shared.WorkflowQuery> queries\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForActivityTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct AddDecisionTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional shared.TaskList taskList\n  40: optional i64 (js.type = \"Long\") scheduleId\n  50: optional i32 scheduleToStartTimeoutSeconds\n  60: optional string forwardedFrom\n  70: optional i32 priority\n  80: optional string fairnessKey\n}\n\nstruct AddActivityTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional string sourceDomainUUID\n  40: optional shared.TaskList taskList\n  50: optional i64 (js.type = \"Long\") scheduleId\n  60: optional i32 scheduleToStartTimeoutSeconds\n  70: optional string forwardedFrom\n  80: optional i32 priority\n  90: optional string fairnessKey\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.QueryWorkflowRequest queryRequest\n  40: optional string forwardedFrom\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional string taskID\n  40: optional shared.RespondQueryTaskCompletedRequest completedRequest\n}\n\nstruct CancelOutstandingPollRequest {\n  10: optional string domainUUID\n  20: optional i32 taskListType\n  30: optional shared.TaskList taskList\n  40: optional string pollerID\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.DescribeTaskListRequest descRequest\n}\n\nstruct UpdateTaskListLimitsRequest {\n  10: optional string domainUUID\n  20: optional shared.UpdateTaskListLimitsRequest updateRequest\n}\n\nstruct ReleaseTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional i32 taskListType\n  40: optional shared.WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") scheduleId\n  // the partition the task was dispatched from is unknown, e.g. the activity was completed by ID,\n  // so the root partition releases the slot on every partition of the task list\n  60: optional bool allPartitions\n}\n\n/**\n* MatchingService API is exposed to provide support for polling from long running applications.\n* Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.\n**/\nservice MatchingService {\n  /**\n  * PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  **/\n  PollForDecisionTaskResponse PollForDecisionTask(1: PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddDecisionTask(1: AddDecisionTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n    )\n\n  /**\n  * AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddActivityTask(1: AddActivityTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n    )\n\n  /**\n  * QueryWorkflow is called by frontend to query a workflow.\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.QueryFailedError queryFailedError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by frontend to respond query completed.\n  **/\n  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n    * CancelOutstandingPoll is called by frontend to unblock long polls on matching for zombie pollers.\n    * Our rpc stack does not support context propagation, so when a client connection goes away frontend sees\n    * cancellation of context for that handler, but any corresponding calls (long-poll) to matching service does not\n    * see the cancellation propagated so it can unblock corresponding long-polls on its end.  This results is tasks\n    * being dispatched to zombie pollers in this situation.  This API is added so everytime frontend makes a long-poll\n    * api call to matching it passes in a pollerID and then calls this API when it detects client connection is closed\n    * to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.\n    **/\n  void CancelOutstandingPoll(1: CancelOutstandingPollRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: DescribeTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * UpdateTaskListLimits persists the limits of a tasklist. When called on the root partition\n  * the limits are propagated to all the partitions of the tasklist.\n  **/\n  void UpdateTaskListLimits(1: UpdateTaskListLimitsRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * ReleaseTask frees the slot held by a task handed to a worker once the worker responds,\n  * this is only needed for tasklists with a cap on outstanding tasks.\n  **/\n  void ReleaseTask(1: ReleaseTaskRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.ServiceBusyError serviceBusyError,\n      )\n}\n"
+
+// MatchingService_AddActivityTask_Args represents the arguments for the MatchingService.AddActivityTask function.
+//
+// The arguments for AddActivityTask are sent and received over the wire as this struct.
+type MatchingService_AddActivityTask_Args struct {
+	AddRequest *AddActivityTaskRequest `json:"addRequest,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddActivityTask_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.AddRequest != nil {
+		w, err = v.AddRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error) {
+	var v AddActivityTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_AddActivityTask_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddActivityTask_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MatchingService_AddActivityTask_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.AddRequest, err = _AddActivityTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_AddActivityTask_Args
+// struct.
+func (v *MatchingService_AddActivityTask_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.AddRequest != nil {
+		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+		i++
+	}
+
+	return fmt.Sprintf("MatchingService_AddActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MatchingService_AddActivityTask_Args match the
+// provided MatchingService_AddActivityTask_Args.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddActivityTask_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddActivityTask_Args.
+func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.AddRequest != nil {
+		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	}
+	return err
+}
+
+// GetAddRequest returns the value of AddRequest if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTaskRequest) {
+	if v != nil && v.AddRequest != nil {
+		return v.AddRequest
+	}
+
+	return
+}
+
+// IsSetAddRequest returns true if AddRequest is not nil.
+func (v *MatchingService_AddActivityTask_Args) IsSetAddRequest() bool {
+	return v != nil && v.AddRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddActivityTask" for this struct.
+func (v *MatchingService_AddActivityTask_Args) MethodName() string {
+	return "AddActivityTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *MatchingService_AddActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// MatchingService_AddActivityTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.AddActivityTask
+// function.
+var MatchingService_AddActivityTask_Helper = struct {
+	// Args accepts the parameters of AddActivityTask in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		addRequest *AddActivityTaskRequest,
+	) *MatchingService_AddActivityTask_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddActivityTask.
+	//
+	// An error can be thrown by AddActivityTask only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddActivityTask
+	// given the error returned by it. The provided error may
+	// be nil if AddActivityTask did not fail.
+	//
+	// This allows mapping errors returned by AddActivityTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddActivityTask
+	//
+	//   err := AddActivityTask(args)
+	//   result, err := MatchingService_AddActivityTask_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddActivityTask: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*MatchingService_AddActivityTask_Result, error)
+
+	// UnwrapResponse takes the result struct for AddActivityTask
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddActivityTask threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := MatchingService_AddActivityTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_AddActivityTask_Result) error
+}{}
+
+func init() {
+	MatchingService_AddActivityTask_Helper.Args = func(
+		addRequest *AddActivityTaskRequest,
+	) *MatchingService_AddActivityTask_Args {
+		return &MatchingService_AddActivityTask_Args{
+			AddRequest: addRequest,
+		}
+	}
+
+	MatchingService_AddActivityTask_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	MatchingService_AddActivityTask_Helper.WrapResponse = func(err error) (*MatchingService_AddActivityTask_Result, error) {
+		if err == nil {
+			return &MatchingService_AddActivityTask_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.BadRequestError")
+			}
+			return &MatchingService_AddActivityTask_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.InternalServiceError")
+			}
+			return &MatchingService_AddActivityTask_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.ServiceBusyError")
+			}
+			return &MatchingService_AddActivityTask_Result{ServiceBusyError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.LimitExceededError")
+			}
+			return &MatchingService_AddActivityTask_Result{LimitExceededError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.DomainNotActiveError")
+			}
+			return &MatchingService_AddActivityTask_Result{DomainNotActiveError: e}, nil
+		}
+
+		return nil, err
+	}
+	MatchingService_AddActivityTask_Helper.UnwrapResponse = func(result *MatchingService_AddActivityTask_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		return
+	}
+
+}
+
+// MatchingService_AddActivityTask_Result represents the result of a MatchingService.AddActivityTask function call.
+//
+// The result of a AddActivityTask execution is sent and received over the wire as this struct.
+type MatchingService_AddActivityTask_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
+	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddActivityTask_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
+	var v shared.LimitExceededError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_AddActivityTask_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddActivityTask_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MatchingService_AddActivityTask_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_AddActivityTask_Result
+// struct.
+func (v *MatchingService_AddActivityTask_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+
+	return fmt.Sprintf("MatchingService_AddActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MatchingService_AddActivityTask_Result match the
+// provided MatchingService_AddActivityTask_Result.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_AddActivityTask_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddActivityTask_Result.
+func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddActivityTask" for this struct.
+func (v *MatchingService_AddActivityTask_Result) MethodName() string {
+	return "AddActivityTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *MatchingService_AddActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// MatchingService_AddDecisionTask_Args represents the arguments for the MatchingService.AddDecisionTask function.
+//
+// The arguments for AddDecisionTask are sent and received over the wire as this struct.
+type MatchingService_AddDecisionTask_Args struct {
+	AddRequest *AddDecisionTaskRequest `json:"addRequest,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddDecisionTask_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.AddRequest != nil {
+		w, err = v.AddRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error) {
+	var v AddDecisionTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_AddDecisionTask_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MatchingService_AddDecisionTask_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.AddRequest, err = _AddDecisionTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_AddDecisionTask_Args
+// struct.
+func (v *MatchingService_AddDecisionTask_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.AddRequest != nil {
+		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+		i++
+	}
+
+	return fmt.Sprintf("MatchingService_AddDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Args match the
+// provided MatchingService_AddDecisionTask_Args.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDecisionTask_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddDecisionTask_Args.
+func (v *MatchingService_AddDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.AddRequest != nil {
+		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	}
+	return err
+}
+
+// GetAddRequest returns the value of AddRequest if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddDecisionTask_Args) GetAddRequest() (o *AddDecisionTaskRequest) {
+	if v != nil && v.AddRequest != nil {
+		return v.AddRequest
+	}
+
+	return
+}
+
+// IsSetAddRequest returns true if AddRequest is not nil.
+func (v *MatchingService_AddDecisionTask_Args) IsSetAddRequest() bool {
+	return v != nil && v.AddRequest != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddDecisionTask" for this struct.
+func (v *MatchingService_AddDecisionTask_Args) MethodName() string {
+	return "AddDecisionTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *MatchingService_AddDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// MatchingService_AddDecisionTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.AddDecisionTask
+// function.
+var MatchingService_AddDecisionTask_Helper = struct {
+	// Args accepts the parameters of AddDecisionTask in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		addRequest *AddDecisionTaskRequest,
+	) *MatchingService_AddDecisionTask_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddDecisionTask.
+	//
+	// An error can be thrown by AddDecisionTask only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddDecisionTask
+	// given the error returned by it. The provided error may
+	// be nil if AddDecisionTask did not fail.
+	//
+	// This allows mapping errors returned by AddDecisionTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddDecisionTask
+	//
+	//   err := AddDecisionTask(args)
+	//   result, err := MatchingService_AddDecisionTask_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddDecisionTask: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*MatchingService_AddDecisionTask_Result, error)
+
+	// UnwrapResponse takes the result struct for AddDecisionTask
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddDecisionTask threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := MatchingService_AddDecisionTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_AddDecisionTask_Result) error
+}{}
+
+func init() {
+	MatchingService_AddDecisionTask_Helper.Args = func(
+		addRequest *AddDecisionTaskRequest,
+	) *MatchingService_AddDecisionTask_Args {
+		return &MatchingService_AddDecisionTask_Args{
+			AddRequest: addRequest,
+		}
+	}
+
+	MatchingService_AddDecisionTask_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	MatchingService_AddDecisionTask_Helper.WrapResponse = func(err error) (*MatchingService_AddDecisionTask_Result, error) {
+		if err == nil {
+			return &MatchingService_AddDecisionTask_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.BadRequestError")
+			}
+			return &MatchingService_AddDecisionTask_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.InternalServiceError")
+			}
+			return &MatchingService_AddDecisionTask_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.ServiceBusyError")
+			}
+			return &MatchingService_AddDecisionTask_Result{ServiceBusyError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.LimitExceededError")
+			}
+			return &MatchingService_AddDecisionTask_Result{LimitExceededError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.DomainNotActiveError")
+			}
+			return &MatchingService_AddDecisionTask_Result{DomainNotActiveError: e}, nil
+		}
+
+		return nil, err
+	}
+	MatchingService_AddDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_AddDecisionTask_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		return
+	}
+
+}
+
+// MatchingService_AddDecisionTask_Result represents the result of a MatchingService.AddDecisionTask function call.
+//
+// The result of a AddDecisionTask execution is sent and received over the wire as this struct.
+type MatchingService_AddDecisionTask_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
+	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddDecisionTask_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MatchingService_AddDecisionTask_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MatchingService_AddDecisionTask_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_AddDecisionTask_Result
+// struct.
+func (v *MatchingService_AddDecisionTask_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+
+	return fmt.Sprintf("MatchingService_AddDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Result match the
+// provided MatchingService_AddDecisionTask_Result.
+//
+// This function performs a deep comparison.
+func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_AddDecisionTask_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddDecisionTask_Result.
+func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddDecisionTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
+	}
+
+	return
+}
+
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *MatchingService_AddDecisionTask_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddDecisionTask" for this struct.
+func (v *MatchingService_AddDecisionTask_Result) MethodName() string {
+	return "AddDecisionTask"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *MatchingService_AddDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// MatchingService_CancelOutstandingPoll_Args represents the arguments for the MatchingService.CancelOutstandingPoll function.
+//
+// The arguments for CancelOutstandingPoll are sent and received over the wire as this struct.
+type MatchingService_CancelOutstandingPoll_Args struct {
+	Request *CancelOutstandingPollRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a MatchingService_CancelOutstandingPoll_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollRequest, error) {
+	var v CancelOutstandingPollRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_CancelOutstandingPoll_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MatchingService_CancelOutstandingPoll_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _CancelOutstandingPollRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Args
+// struct.
+func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.AddRequest != nil {
-		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddActivityTask_Args match the
-// provided MatchingService_AddActivityTask_Args.
+// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Args match the
+// provided MatchingService_CancelOutstandingPoll_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddActivityTask_Args) bool {
+func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService_CancelOutstandingPoll_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -3323,103 +5604,103 @@ func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddAc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddActivityTask_Args.
-func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_CancelOutstandingPoll_Args.
+func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.AddRequest != nil {
-		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetAddRequest returns the value of AddRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTaskRequest) {
-	if v != nil && v.AddRequest != nil {
-		return v.AddRequest
+func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOutstandingPollRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetAddRequest returns true if AddRequest is not nil.
-func (v *MatchingService_AddActivityTask_Args) IsSetAddRequest() bool {
-	return v != nil && v.AddRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_CancelOutstandingPoll_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddActivityTask" for this struct.
-func (v *MatchingService_AddActivityTask_Args) MethodName() string {
-	return "AddActivityTask"
+// This will always be "CancelOutstandingPoll" for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Args) MethodName() string {
+	return "CancelOutstandingPoll"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_AddActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_CancelOutstandingPoll_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_AddActivityTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.AddActivityTask
+// MatchingService_CancelOutstandingPoll_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.CancelOutstandingPoll
 // function.
-var MatchingService_AddActivityTask_Helper = struct {
-	// Args accepts the parameters of AddActivityTask in-order and returns
+var MatchingService_CancelOutstandingPoll_Helper = struct {
+	// Args accepts the parameters of CancelOutstandingPoll in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		addRequest *AddActivityTaskRequest,
-	) *MatchingService_AddActivityTask_Args
+		request *CancelOutstandingPollRequest,
+	) *MatchingService_CancelOutstandingPoll_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddActivityTask.
+	// by CancelOutstandingPoll.
 	//
-	// An error can be thrown by AddActivityTask only if the
+	// An error can be thrown by CancelOutstandingPoll only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddActivityTask
+	// WrapResponse returns the result struct for CancelOutstandingPoll
 	// given the error returned by it. The provided error may
-	// be nil if AddActivityTask did not fail.
+	// be nil if CancelOutstandingPoll did not fail.
 	//
-	// This allows mapping errors returned by AddActivityTask into a
+	// This allows mapping errors returned by CancelOutstandingPoll into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// AddActivityTask
+	// CancelOutstandingPoll
 	//
-	//   err := AddActivityTask(args)
-	//   result, err := MatchingService_AddActivityTask_Helper.WrapResponse(err)
+	//   err := CancelOutstandingPoll(args)
+	//   result, err := MatchingService_CancelOutstandingPoll_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddActivityTask: %v", err)
+	//     return fmt.Errorf("unexpected error from CancelOutstandingPoll: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_AddActivityTask_Result, error)
+	WrapResponse func(error) (*MatchingService_CancelOutstandingPoll_Result, error)
 
-	// UnwrapResponse takes the result struct for AddActivityTask
+	// UnwrapResponse takes the result struct for CancelOutstandingPoll
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if AddActivityTask threw an
+	// The error is non-nil only if CancelOutstandingPoll threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_AddActivityTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_AddActivityTask_Result) error
+	//   err := MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_CancelOutstandingPoll_Result) error
 }{}
 
 func init() {
-	MatchingService_AddActivityTask_Helper.Args = func(
-		addRequest *AddActivityTaskRequest,
-	) *MatchingService_AddActivityTask_Args {
-		return &MatchingService_AddActivityTask_Args{
-			AddRequest: addRequest,
+	MatchingService_CancelOutstandingPoll_Helper.Args = func(
+		request *CancelOutstandingPollRequest,
+	) *MatchingService_CancelOutstandingPoll_Args {
+		return &MatchingService_CancelOutstandingPoll_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_AddActivityTask_Helper.IsException = func(err error) bool {
+	MatchingService_CancelOutstandingPoll_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -3427,51 +5708,37 @@ func init() {
 			return true
 		case *shared.ServiceBusyError:
 			return true
-		case *shared.LimitExceededError:
-			return true
-		case *shared.DomainNotActiveError:
-			return true
 		default:
 			return false
 		}
 	}
 
-	MatchingService_AddActivityTask_Helper.WrapResponse = func(err error) (*MatchingService_AddActivityTask_Result, error) {
+	MatchingService_CancelOutstandingPoll_Helper.WrapResponse = func(err error) (*MatchingService_CancelOutstandingPoll_Result, error) {
 		if err == nil {
-			return &MatchingService_AddActivityTask_Result{}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.BadRequestError")
 			}
-			return &MatchingService_AddActivityTask_Result{BadRequestError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.InternalServiceError")
 			}
-			return &MatchingService_AddActivityTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.ServiceBusyError")
-			}
-			return &MatchingService_AddActivityTask_Result{ServiceBusyError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.LimitExceededError")
-			}
-			return &MatchingService_AddActivityTask_Result{LimitExceededError: e}, nil
-		case *shared.DomainNotActiveError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.ServiceBusyError")
 			}
-			return &MatchingService_AddActivityTask_Result{DomainNotActiveError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_AddActivityTask_Helper.UnwrapResponse = func(result *MatchingService_AddActivityTask_Result) (err error) {
+	MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse = func(result *MatchingService_CancelOutstandingPoll_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -3484,31 +5751,21 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
-			return
-		}
-		if result.DomainNotActiveError != nil {
-			err = result.DomainNotActiveError
-			return
-		}
 		return
 	}
 
 }
 
-// MatchingService_AddActivityTask_Result represents the result of a MatchingService.AddActivityTask function call.
+// MatchingService_CancelOutstandingPoll_Result represents the result of a MatchingService.CancelOutstandingPoll function call.
 //
-// The result of a AddActivityTask execution is sent and received over the wire as this struct.
-type MatchingService_AddActivityTask_Result struct {
+// The result of a CancelOutstandingPoll execution is sent and received over the wire as this struct.
+type MatchingService_CancelOutstandingPoll_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
 	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
-	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddActivityTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_CancelOutstandingPoll_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3523,9 +5780,9 @@ type MatchingService_AddActivityTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3555,65 +5812,19 @@ func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.DomainNotActiveError != nil {
-		w, err = v.DomainNotActiveError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
-	var v shared.LimitExceededError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a MatchingService_AddActivityTask_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_CancelOutstandingPoll_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddActivityTask_Result struct
+// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3621,12 +5832,12 @@ func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, err
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddActivityTask_Result
+//   var v MatchingService_CancelOutstandingPoll_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -3654,22 +5865,6 @@ func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
@@ -3684,27 +5879,21 @@ func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.DomainNotActiveError != nil {
-		count++
-	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddActivityTask_Result
+// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Result
 // struct.
-func (v *MatchingService_AddActivityTask_Result) String() string {
+func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -3718,23 +5907,15 @@ func (v *MatchingService_AddActivityTask_Result) String() string {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
-	if v.DomainNotActiveError != nil {
-		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
-		i++
-	}
 
-	return fmt.Sprintf("MatchingService_AddActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddActivityTask_Result match the
-// provided MatchingService_AddActivityTask_Result.
+// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Result match the
+// provided MatchingService_CancelOutstandingPoll_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_AddActivityTask_Result) bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingService_CancelOutstandingPoll_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -3749,19 +5930,13 @@ func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_Add
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
-	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddActivityTask_Result.
-func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_CancelOutstandingPoll_Result.
+func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -3771,21 +5946,15 @@ func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.Ob
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
-	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
-	if v.DomainNotActiveError != nil {
-		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -3794,13 +5963,13 @@ func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -3809,13 +5978,13 @@ func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -3824,63 +5993,33 @@ func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *share
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
-// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
-	if v != nil && v.DomainNotActiveError != nil {
-		return v.DomainNotActiveError
-	}
-
-	return
-}
-
-// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetDomainNotActiveError() bool {
-	return v != nil && v.DomainNotActiveError != nil
-}
-
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddActivityTask" for this struct.
-func (v *MatchingService_AddActivityTask_Result) MethodName() string {
-	return "AddActivityTask"
+// This will always be "CancelOutstandingPoll" for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Result) MethodName() string {
+	return "CancelOutstandingPoll"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_AddActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_CancelOutstandingPoll_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_AddDecisionTask_Args represents the arguments for the MatchingService.AddDecisionTask function.
+// MatchingService_DescribeTaskList_Args represents the arguments for the MatchingService.DescribeTaskList function.
 //
-// The arguments for AddDecisionTask are sent and received over the wire as this struct.
-type MatchingService_AddDecisionTask_Args struct {
-	AddRequest *AddDecisionTaskRequest `json:"addRequest,omitempty"`
+// The arguments for DescribeTaskList are sent and received over the wire as this struct.
+type MatchingService_DescribeTaskList_Args struct {
+	Request *DescribeTaskListRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddDecisionTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskList_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3895,7 +6034,7 @@ type MatchingService_AddDecisionTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -3903,8 +6042,8 @@ func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.AddRequest != nil {
-		w, err = v.AddRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3915,17 +6054,17 @@ func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error) {
-	var v AddDecisionTaskRequest
+func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, error) {
+	var v DescribeTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddDecisionTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_DescribeTaskList_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Args struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3933,19 +6072,19 @@ func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error)
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddDecisionTask_Args
+//   var v MatchingService_DescribeTaskList_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.AddRequest, err = _AddDecisionTaskRequest_Read(field.Value)
+				v.Request, err = _DescribeTaskListRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3957,34 +6096,34 @@ func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddDecisionTask_Args
+// String returns a readable string representation of a MatchingService_DescribeTaskList_Args
 // struct.
-func (v *MatchingService_AddDecisionTask_Args) String() string {
+func (v *MatchingService_DescribeTaskList_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.AddRequest != nil {
-		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskList_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Args match the
-// provided MatchingService_AddDecisionTask_Args.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Args match the
+// provided MatchingService_DescribeTaskList_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDecisionTask_Args) bool {
+func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_DescribeTaskList_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -3992,155 +6131,147 @@ func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddDecisionTask_Args.
-func (v *MatchingService_AddDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskList_Args.
+func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.AddRequest != nil {
-		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetAddRequest returns the value of AddRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Args) GetAddRequest() (o *AddDecisionTaskRequest) {
-	if v != nil && v.AddRequest != nil {
-		return v.AddRequest
+func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskListRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetAddRequest returns true if AddRequest is not nil.
-func (v *MatchingService_AddDecisionTask_Args) IsSetAddRequest() bool {
-	return v != nil && v.AddRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_DescribeTaskList_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddDecisionTask" for this struct.
-func (v *MatchingService_AddDecisionTask_Args) MethodName() string {
-	return "AddDecisionTask"
+// This will always be "DescribeTaskList" for this struct.
+func (v *MatchingService_DescribeTaskList_Args) MethodName() string {
+	return "DescribeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_AddDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskList_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_AddDecisionTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.AddDecisionTask
+// MatchingService_DescribeTaskList_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.DescribeTaskList
 // function.
-var MatchingService_AddDecisionTask_Helper = struct {
-	// Args accepts the parameters of AddDecisionTask in-order and returns
+var MatchingService_DescribeTaskList_Helper = struct {
+	// Args accepts the parameters of DescribeTaskList in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		addRequest *AddDecisionTaskRequest,
-	) *MatchingService_AddDecisionTask_Args
+		request *DescribeTaskListRequest,
+	) *MatchingService_DescribeTaskList_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddDecisionTask.
+	// by DescribeTaskList.
 	//
-	// An error can be thrown by AddDecisionTask only if the
+	// An error can be thrown by DescribeTaskList only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddDecisionTask
-	// given the error returned by it. The provided error may
-	// be nil if AddDecisionTask did not fail.
+	// WrapResponse returns the result struct for DescribeTaskList
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by AddDecisionTask into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// AddDecisionTask
+	// This allows mapping values and errors returned by
+	// DescribeTaskList into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeTaskList
 	//
-	//   err := AddDecisionTask(args)
-	//   result, err := MatchingService_AddDecisionTask_Helper.WrapResponse(err)
+	//   value, err := DescribeTaskList(args)
+	//   result, err := MatchingService_DescribeTaskList_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddDecisionTask: %v", err)
+	//     return fmt.Errorf("unexpected error from DescribeTaskList: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_AddDecisionTask_Result, error)
+	WrapResponse func(*shared.DescribeTaskListResponse, error) (*MatchingService_DescribeTaskList_Result, error)
 
-	// UnwrapResponse takes the result struct for AddDecisionTask
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for DescribeTaskList
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if AddDecisionTask threw an
+	// The error is non-nil only if DescribeTaskList threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_AddDecisionTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_AddDecisionTask_Result) error
+	//   value, err := MatchingService_DescribeTaskList_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_DescribeTaskList_Result) (*shared.DescribeTaskListResponse, error)
 }{}
 
 func init() {
-	MatchingService_AddDecisionTask_Helper.Args = func(
-		addRequest *AddDecisionTaskRequest,
-	) *MatchingService_AddDecisionTask_Args {
-		return &MatchingService_AddDecisionTask_Args{
-			AddRequest: addRequest,
+	MatchingService_DescribeTaskList_Helper.Args = func(
+		request *DescribeTaskListRequest,
+	) *MatchingService_DescribeTaskList_Args {
+		return &MatchingService_DescribeTaskList_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_AddDecisionTask_Helper.IsException = func(err error) bool {
+	MatchingService_DescribeTaskList_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.ServiceBusyError:
-			return true
-		case *shared.LimitExceededError:
+		case *shared.EntityNotExistsError:
 			return true
-		case *shared.DomainNotActiveError:
+		case *shared.ServiceBusyError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	MatchingService_AddDecisionTask_Helper.WrapResponse = func(err error) (*MatchingService_AddDecisionTask_Result, error) {
+	MatchingService_DescribeTaskList_Helper.WrapResponse = func(success *shared.DescribeTaskListResponse, err error) (*MatchingService_DescribeTaskList_Result, error) {
 		if err == nil {
-			return &MatchingService_AddDecisionTask_Result{}, nil
+			return &MatchingService_DescribeTaskList_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.BadRequestError")
 			}
-			return &MatchingService_AddDecisionTask_Result{BadRequestError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.InternalServiceError")
-			}
-			return &MatchingService_AddDecisionTask_Result{InternalServiceError: e}, nil
-		case *shared.ServiceBusyError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.InternalServiceError")
 			}
-			return &MatchingService_AddDecisionTask_Result{ServiceBusyError: e}, nil
-		case *shared.LimitExceededError:
+			return &MatchingService_DescribeTaskList_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.EntityNotExistError")
 			}
-			return &MatchingService_AddDecisionTask_Result{LimitExceededError: e}, nil
-		case *shared.DomainNotActiveError:
+			return &MatchingService_DescribeTaskList_Result{EntityNotExistError: e}, nil
+		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.ServiceBusyError")
 			}
-			return &MatchingService_AddDecisionTask_Result{DomainNotActiveError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_AddDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_AddDecisionTask_Result) (err error) {
+	MatchingService_DescribeTaskList_Helper.UnwrapResponse = func(result *MatchingService_DescribeTaskList_Result) (success *shared.DescribeTaskListResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -4149,35 +6280,41 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
 			return
 		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
 			return
 		}
-		if result.DomainNotActiveError != nil {
-			err = result.DomainNotActiveError
+
+		if result.Success != nil {
+			success = result.Success
 			return
 		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_AddDecisionTask_Result represents the result of a MatchingService.AddDecisionTask function call.
+// MatchingService_DescribeTaskList_Result represents the result of a MatchingService.DescribeTaskList function call.
 //
-// The result of a AddDecisionTask execution is sent and received over the wire as this struct.
-type MatchingService_AddDecisionTask_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
-	DomainNotActiveError *shared.DomainNotActiveError `json:"domainNotActiveError,omitempty"`
+// The result of a DescribeTaskList execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type MatchingService_DescribeTaskList_Result struct {
+	// Value returned by DescribeTaskList after a successful execution.
+	Success              *shared.DescribeTaskListResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError          `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError     `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError     `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError         `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddDecisionTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskList_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4192,7 +6329,7 @@ type MatchingService_AddDecisionTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -4200,6 +6337,14 @@ func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -4216,43 +6361,47 @@ func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
-	if v.DomainNotActiveError != nil {
-		w, err = v.DomainNotActiveError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MatchingService_AddDecisionTask_Result struct from its Thrift-level
+func _DescribeTaskListResponse_Read(w wire.Value) (*shared.DescribeTaskListResponse, error) {
+	var v shared.DescribeTaskListResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_DescribeTaskList_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Result struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4260,51 +6409,51 @@ func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddDecisionTask_Result
+//   var v MatchingService_DescribeTaskList_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				v.Success, err = _DescribeTaskListResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 2:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 3:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 4:
+		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 5:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4314,37 +6463,41 @@ func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.BadRequestError != nil {
+	if v.Success != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
+	if v.BadRequestError != nil {
 		count++
 	}
-	if v.ServiceBusyError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
+	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.DomainNotActiveError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddDecisionTask_Result
+// String returns a readable string representation of a MatchingService_DescribeTaskList_Result
 // struct.
-func (v *MatchingService_AddDecisionTask_Result) String() string {
+func (v *MatchingService_DescribeTaskList_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [5]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -4353,45 +6506,41 @@ func (v *MatchingService_AddDecisionTask_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
-		i++
-	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
-	if v.DomainNotActiveError != nil {
-		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskList_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Result match the
-// provided MatchingService_AddDecisionTask_Result.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Result match the
+// provided MatchingService_DescribeTaskList_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_AddDecisionTask_Result) bool {
+func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_DescribeTaskList_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
-	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
 
@@ -4399,32 +6548,47 @@ func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_Add
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddDecisionTask_Result.
-func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskList_Result.
+func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
-	if v.DomainNotActiveError != nil {
-		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
-	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.DescribeTaskListResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -4433,13 +6597,13 @@ func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -4448,78 +6612,63 @@ func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
-	}
-
-	return
-}
-
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
-}
-
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
+func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
-// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
-	if v != nil && v.DomainNotActiveError != nil {
-		return v.DomainNotActiveError
+func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
 
 	return
 }
 
-// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetDomainNotActiveError() bool {
-	return v != nil && v.DomainNotActiveError != nil
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddDecisionTask" for this struct.
-func (v *MatchingService_AddDecisionTask_Result) MethodName() string {
-	return "AddDecisionTask"
+// This will always be "DescribeTaskList" for this struct.
+func (v *MatchingService_DescribeTaskList_Result) MethodName() string {
+	return "DescribeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_AddDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskList_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_CancelOutstandingPoll_Args represents the arguments for the MatchingService.CancelOutstandingPoll function.
+// MatchingService_PollForActivityTask_Args represents the arguments for the MatchingService.PollForActivityTask function.
 //
-// The arguments for CancelOutstandingPoll are sent and received over the wire as this struct.
-type MatchingService_CancelOutstandingPoll_Args struct {
-	Request *CancelOutstandingPollRequest `json:"request,omitempty"`
+// The arguments for PollForActivityTask are sent and received over the wire as this struct.
+type MatchingService_PollForActivityTask_Args struct {
+	PollRequest *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
 }
 
-// ToWire translates a MatchingService_CancelOutstandingPoll_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForActivityTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4534,7 +6683,7 @@ type MatchingService_CancelOutstandingPoll_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -4542,8 +6691,8 @@ func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error
 		err    error
 	)
 
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -4554,17 +6703,17 @@ func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollRequest, error) {
-	var v CancelOutstandingPollRequest
+func _PollForActivityTaskRequest_1_Read(w wire.Value) (*PollForActivityTaskRequest, error) {
+	var v PollForActivityTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_CancelOutstandingPoll_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForActivityTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Args struct
+// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4572,19 +6721,19 @@ func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollReq
 //     return nil, err
 //   }
 //
-//   var v MatchingService_CancelOutstandingPoll_Args
+//   var v MatchingService_PollForActivityTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForActivityTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _CancelOutstandingPollRequest_Read(field.Value)
+				v.PollRequest, err = _PollForActivityTaskRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4596,34 +6745,34 @@ func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) erro
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Args
+// String returns a readable string representation of a MatchingService_PollForActivityTask_Args
 // struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
+func (v *MatchingService_PollForActivityTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Args match the
-// provided MatchingService_CancelOutstandingPoll_Args.
+// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Args match the
+// provided MatchingService_PollForActivityTask_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService_CancelOutstandingPoll_Args) bool {
+func (v *MatchingService_PollForActivityTask_Args) Equals(rhs *MatchingService_PollForActivityTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
 		return false
 	}
 
@@ -4631,108 +6780,109 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_CancelOutstandingPoll_Args.
-func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForActivityTask_Args.
+func (v *MatchingService_PollForActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Request != nil {
-		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
 	}
 	return err
 }
 
-// GetRequest returns the value of Request if it is set or its
+// GetPollRequest returns the value of PollRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOutstandingPollRequest) {
-	if v != nil && v.Request != nil {
-		return v.Request
+func (v *MatchingService_PollForActivityTask_Args) GetPollRequest() (o *PollForActivityTaskRequest) {
+	if v != nil && v.PollRequest != nil {
+		return v.PollRequest
 	}
 
 	return
 }
 
-// IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Args) IsSetRequest() bool {
-	return v != nil && v.Request != nil
+// IsSetPollRequest returns true if PollRequest is not nil.
+func (v *MatchingService_PollForActivityTask_Args) IsSetPollRequest() bool {
+	return v != nil && v.PollRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "CancelOutstandingPoll" for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) MethodName() string {
-	return "CancelOutstandingPoll"
+// This will always be "PollForActivityTask" for this struct.
+func (v *MatchingService_PollForActivityTask_Args) MethodName() string {
+	return "PollForActivityTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForActivityTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_CancelOutstandingPoll_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.CancelOutstandingPoll
+// MatchingService_PollForActivityTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.PollForActivityTask
 // function.
-var MatchingService_CancelOutstandingPoll_Helper = struct {
-	// Args accepts the parameters of CancelOutstandingPoll in-order and returns
+var MatchingService_PollForActivityTask_Helper = struct {
+	// Args accepts the parameters of PollForActivityTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *CancelOutstandingPollRequest,
-	) *MatchingService_CancelOutstandingPoll_Args
+		pollRequest *PollForActivityTaskRequest,
+	) *MatchingService_PollForActivityTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by CancelOutstandingPoll.
+	// by PollForActivityTask.
 	//
-	// An error can be thrown by CancelOutstandingPoll only if the
+	// An error can be thrown by PollForActivityTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for CancelOutstandingPoll
-	// given the error returned by it. The provided error may
-	// be nil if CancelOutstandingPoll did not fail.
+	// WrapResponse returns the result struct for PollForActivityTask
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by CancelOutstandingPoll into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// CancelOutstandingPoll
+	// This allows mapping values and errors returned by
+	// PollForActivityTask into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by PollForActivityTask
 	//
-	//   err := CancelOutstandingPoll(args)
-	//   result, err := MatchingService_CancelOutstandingPoll_Helper.WrapResponse(err)
+	//   value, err := PollForActivityTask(args)
+	//   result, err := MatchingService_PollForActivityTask_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from CancelOutstandingPoll: %v", err)
+	//     return fmt.Errorf("unexpected error from PollForActivityTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_CancelOutstandingPoll_Result, error)
+	WrapResponse func(*shared.PollForActivityTaskResponse, error) (*MatchingService_PollForActivityTask_Result, error)
 
-	// UnwrapResponse takes the result struct for CancelOutstandingPoll
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for PollForActivityTask
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if CancelOutstandingPoll threw an
+	// The error is non-nil only if PollForActivityTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_CancelOutstandingPoll_Result) error
+	//   value, err := MatchingService_PollForActivityTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_PollForActivityTask_Result) (*shared.PollForActivityTaskResponse, error)
 }{}
 
 func init() {
-	MatchingService_CancelOutstandingPoll_Helper.Args = func(
-		request *CancelOutstandingPollRequest,
-	) *MatchingService_CancelOutstandingPoll_Args {
-		return &MatchingService_CancelOutstandingPoll_Args{
-			Request: request,
+	MatchingService_PollForActivityTask_Helper.Args = func(
+		pollRequest *PollForActivityTaskRequest,
+	) *MatchingService_PollForActivityTask_Args {
+		return &MatchingService_PollForActivityTask_Args{
+			PollRequest: pollRequest,
 		}
 	}
 
-	MatchingService_CancelOutstandingPoll_Helper.IsException = func(err error) bool {
+	MatchingService_PollForActivityTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.LimitExceededError:
+			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -4740,32 +6890,37 @@ func init() {
 		}
 	}
 
-	MatchingService_CancelOutstandingPoll_Helper.WrapResponse = func(err error) (*MatchingService_CancelOutstandingPoll_Result, error) {
+	MatchingService_PollForActivityTask_Helper.WrapResponse = func(success *shared.PollForActivityTaskResponse, err error) (*MatchingService_PollForActivityTask_Result, error) {
 		if err == nil {
-			return &MatchingService_CancelOutstandingPoll_Result{}, nil
+			return &MatchingService_PollForActivityTask_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.BadRequestError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{BadRequestError: e}, nil
+			return &MatchingService_PollForActivityTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.InternalServiceError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{InternalServiceError: e}, nil
+			return &MatchingService_PollForActivityTask_Result{InternalServiceError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.LimitExceededError")
+			}
+			return &MatchingService_PollForActivityTask_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.ServiceBusyError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{ServiceBusyError: e}, nil
+			return &MatchingService_PollForActivityTask_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse = func(result *MatchingService_CancelOutstandingPoll_Result) (err error) {
+	MatchingService_PollForActivityTask_Helper.UnwrapResponse = func(result *MatchingService_PollForActivityTask_Result) (success *shared.PollForActivityTaskResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -4774,25 +6929,41 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_CancelOutstandingPoll_Result represents the result of a MatchingService.CancelOutstandingPoll function call.
+// MatchingService_PollForActivityTask_Result represents the result of a MatchingService.PollForActivityTask function call.
 //
-// The result of a CancelOutstandingPoll execution is sent and received over the wire as this struct.
-type MatchingService_CancelOutstandingPoll_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+// The result of a PollForActivityTask execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type MatchingService_PollForActivityTask_Result struct {
+	// Value returned by PollForActivityTask after a successful execution.
+	Success              *shared.PollForActivityTaskResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError          `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_CancelOutstandingPoll_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForActivityTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4807,14 +6978,22 @@ type MatchingService_CancelOutstandingPoll_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -4831,27 +7010,41 @@ func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, err
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MatchingService_CancelOutstandingPoll_Result struct from its Thrift-level
+func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTaskResponse, error) {
+	var v shared.PollForActivityTaskResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_PollForActivityTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Result struct
+// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4859,16 +7052,24 @@ func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, err
 //     return nil, err
 //   }
 //
-//   var v MatchingService_CancelOutstandingPoll_Result
+//   var v MatchingService_PollForActivityTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _PollForActivityTaskResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -4886,6 +7087,14 @@ func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) er
 
 			}
 		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -4897,31 +7106,41 @@ func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) er
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.LimitExceededError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Result
+// String returns a readable string representation of a MatchingService_PollForActivityTask_Result
 // struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
+func (v *MatchingService_PollForActivityTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -4930,30 +7149,40 @@ func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Result match the
-// provided MatchingService_CancelOutstandingPoll_Result.
+// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Result match the
+// provided MatchingService_PollForActivityTask_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingService_CancelOutstandingPoll_Result) bool {
+func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService_PollForActivityTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -4962,26 +7191,47 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingServi
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_CancelOutstandingPoll_Result.
-func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForActivityTask_Result.
+func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_PollForActivityTask_Result) GetSuccess() (o *shared.PollForActivityTaskResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *MatchingService_PollForActivityTask_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_PollForActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -4990,13 +7240,13 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_PollForActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -5005,13 +7255,28 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError()
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_PollForActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
+	}
+
+	return
+}
+
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_PollForActivityTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
+}
+
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_PollForActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -5020,33 +7285,33 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_PollForActivityTask_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "CancelOutstandingPoll" for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) MethodName() string {
-	return "CancelOutstandingPoll"
+// This will always be "PollForActivityTask" for this struct.
+func (v *MatchingService_PollForActivityTask_Result) MethodName() string {
+	return "PollForActivityTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForActivityTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_DescribeTaskList_Args represents the arguments for the MatchingService.DescribeTaskList function.
+// MatchingService_PollForDecisionTask_Args represents the arguments for the MatchingService.PollForDecisionTask function.
 //
-// The arguments for DescribeTaskList are sent and received over the wire as this struct.
-type MatchingService_DescribeTaskList_Args struct {
-	Request *DescribeTaskListRequest `json:"request,omitempty"`
+// The arguments for PollForDecisionTask are sent and received over the wire as this struct.
+type MatchingService_PollForDecisionTask_Args struct {
+	PollRequest *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
 }
 
-// ToWire translates a MatchingService_DescribeTaskList_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForDecisionTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5061,7 +7326,7 @@ type MatchingService_DescribeTaskList_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -5069,8 +7334,8 @@ func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -5081,17 +7346,17 @@ func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, error) {
-	var v DescribeTaskListRequest
+func _PollForDecisionTaskRequest_1_Read(w wire.Value) (*PollForDecisionTaskRequest, error) {
+	var v PollForDecisionTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_DescribeTaskList_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForDecisionTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Args struct
+// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5099,19 +7364,19 @@ func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, er
 //     return nil, err
 //   }
 //
-//   var v MatchingService_DescribeTaskList_Args
+//   var v MatchingService_PollForDecisionTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForDecisionTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeTaskListRequest_1_Read(field.Value)
+				v.PollRequest, err = _PollForDecisionTaskRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5123,34 +7388,34 @@ func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_DescribeTaskList_Args
+// String returns a readable string representation of a MatchingService_PollForDecisionTask_Args
 // struct.
-func (v *MatchingService_DescribeTaskList_Args) String() string {
+func (v *MatchingService_PollForDecisionTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_DescribeTaskList_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Args match the
-// provided MatchingService_DescribeTaskList_Args.
+// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Args match the
+// provided MatchingService_PollForDecisionTask_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_DescribeTaskList_Args) bool {
+func (v *MatchingService_PollForDecisionTask_Args) Equals(rhs *MatchingService_PollForDecisionTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
 		return false
 	}
 
@@ -5158,108 +7423,108 @@ func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_Desc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_DescribeTaskList_Args.
-func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForDecisionTask_Args.
+func (v *MatchingService_PollForDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Request != nil {
-		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
 	}
 	return err
 }
 
-// GetRequest returns the value of Request if it is set or its
+// GetPollRequest returns the value of PollRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskListRequest) {
-	if v != nil && v.Request != nil {
-		return v.Request
+func (v *MatchingService_PollForDecisionTask_Args) GetPollRequest() (o *PollForDecisionTaskRequest) {
+	if v != nil && v.PollRequest != nil {
+		return v.PollRequest
 	}
 
 	return
 }
 
-// IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_DescribeTaskList_Args) IsSetRequest() bool {
-	return v != nil && v.Request != nil
+// IsSetPollRequest returns true if PollRequest is not nil.
+func (v *MatchingService_PollForDecisionTask_Args) IsSetPollRequest() bool {
+	return v != nil && v.PollRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeTaskList" for this struct.
-func (v *MatchingService_DescribeTaskList_Args) MethodName() string {
-	return "DescribeTaskList"
+// This will always be "PollForDecisionTask" for this struct.
+func (v *MatchingService_PollForDecisionTask_Args) MethodName() string {
+	return "PollForDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_DescribeTaskList_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_DescribeTaskList_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.DescribeTaskList
+// MatchingService_PollForDecisionTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.PollForDecisionTask
 // function.
-var MatchingService_DescribeTaskList_Helper = struct {
-	// Args accepts the parameters of DescribeTaskList in-order and returns
+var MatchingService_PollForDecisionTask_Helper = struct {
+	// Args accepts the parameters of PollForDecisionTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *DescribeTaskListRequest,
-	) *MatchingService_DescribeTaskList_Args
+		pollRequest *PollForDecisionTaskRequest,
+	) *MatchingService_PollForDecisionTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeTaskList.
+	// by PollForDecisionTask.
 	//
-	// An error can be thrown by DescribeTaskList only if the
+	// An error can be thrown by PollForDecisionTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeTaskList
+	// WrapResponse returns the result struct for PollForDecisionTask
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeTaskList into a serializable result struct.
+	// PollForDecisionTask into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeTaskList
+	// error cannot be thrown by PollForDecisionTask
 	//
-	//   value, err := DescribeTaskList(args)
-	//   result, err := MatchingService_DescribeTaskList_Helper.WrapResponse(value, err)
+	//   value, err := PollForDecisionTask(args)
+	//   result, err := MatchingService_PollForDecisionTask_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeTaskList: %v", err)
+	//     return fmt.Errorf("unexpected error from PollForDecisionTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeTaskListResponse, error) (*MatchingService_DescribeTaskList_Result, error)
+	WrapResponse func(*PollForDecisionTaskResponse, error) (*MatchingService_PollForDecisionTask_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeTaskList
+	// UnwrapResponse takes the result struct for PollForDecisionTask
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeTaskList threw an
+	// The error is non-nil only if PollForDecisionTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_DescribeTaskList_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_DescribeTaskList_Result) (*shared.DescribeTaskListResponse, error)
+	//   value, err := MatchingService_PollForDecisionTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_PollForDecisionTask_Result) (*PollForDecisionTaskResponse, error)
 }{}
 
 func init() {
-	MatchingService_DescribeTaskList_Helper.Args = func(
-		request *DescribeTaskListRequest,
-	) *MatchingService_DescribeTaskList_Args {
-		return &MatchingService_DescribeTaskList_Args{
-			Request: request,
+	MatchingService_PollForDecisionTask_Helper.Args = func(
+		pollRequest *PollForDecisionTaskRequest,
+	) *MatchingService_PollForDecisionTask_Args {
+		return &MatchingService_PollForDecisionTask_Args{
+			PollRequest: pollRequest,
 		}
 	}
 
-	MatchingService_DescribeTaskList_Helper.IsException = func(err error) bool {
+	MatchingService_PollForDecisionTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
+		case *shared.LimitExceededError:
 			return true
 		case *shared.ServiceBusyError:
 			return true
@@ -5268,37 +7533,37 @@ func init() {
 		}
 	}
 
-	MatchingService_DescribeTaskList_Helper.WrapResponse = func(success *shared.DescribeTaskListResponse, err error) (*MatchingService_DescribeTaskList_Result, error) {
+	MatchingService_PollForDecisionTask_Helper.WrapResponse = func(success *PollForDecisionTaskResponse, err error) (*MatchingService_PollForDecisionTask_Result, error) {
 		if err == nil {
-			return &MatchingService_DescribeTaskList_Result{Success: success}, nil
+			return &MatchingService_PollForDecisionTask_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.BadRequestError")
 			}
-			return &MatchingService_DescribeTaskList_Result{BadRequestError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.InternalServiceError")
 			}
-			return &MatchingService_DescribeTaskList_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
+			return &MatchingService_PollForDecisionTask_Result{InternalServiceError: e}, nil
+		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.LimitExceededError")
 			}
-			return &MatchingService_DescribeTaskList_Result{EntityNotExistError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.ServiceBusyError")
 			}
-			return &MatchingService_DescribeTaskList_Result{ServiceBusyError: e}, nil
+			return &MatchingService_PollForDecisionTask_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_DescribeTaskList_Helper.UnwrapResponse = func(result *MatchingService_DescribeTaskList_Result) (success *shared.DescribeTaskListResponse, err error) {
+	MatchingService_PollForDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_PollForDecisionTask_Result) (success *PollForDecisionTaskResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -5307,8 +7572,8 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
 			return
 		}
 		if result.ServiceBusyError != nil {
@@ -5327,21 +7592,21 @@ func init() {
 
 }
 
-// MatchingService_DescribeTaskList_Result represents the result of a MatchingService.DescribeTaskList function call.
+// MatchingService_PollForDecisionTask_Result represents the result of a MatchingService.PollForDecisionTask function call.
 //
-// The result of a DescribeTaskList execution is sent and received over the wire as this struct.
+// The result of a PollForDecisionTask execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_DescribeTaskList_Result struct {
-	// Value returned by DescribeTaskList after a successful execution.
-	Success              *shared.DescribeTaskListResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError          `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError     `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError     `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError         `json:"serviceBusyError,omitempty"`
+type MatchingService_PollForDecisionTask_Result struct {
+	// Value returned by PollForDecisionTask after a successful execution.
+	Success              *PollForDecisionTaskResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_DescribeTaskList_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_PollForDecisionTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5356,7 +7621,7 @@ type MatchingService_DescribeTaskList_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -5388,8 +7653,8 @@ func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -5406,29 +7671,23 @@ func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListResponse_Read(w wire.Value) (*shared.DescribeTaskListResponse, error) {
-	var v shared.DescribeTaskListResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
+func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskResponse, error) {
+	var v PollForDecisionTaskResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_DescribeTaskList_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_PollForDecisionTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Result struct
+// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5436,19 +7695,19 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 //     return nil, err
 //   }
 //
-//   var v MatchingService_DescribeTaskList_Result
+//   var v MatchingService_PollForDecisionTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeTaskListResponse_Read(field.Value)
+				v.Success, err = _PollForDecisionTaskResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5472,7 +7731,7 @@ func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5499,22 +7758,22 @@ func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
+	if v.LimitExceededError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_DescribeTaskList_Result
+// String returns a readable string representation of a MatchingService_PollForDecisionTask_Result
 // struct.
-func (v *MatchingService_DescribeTaskList_Result) String() string {
+func (v *MatchingService_PollForDecisionTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -5533,8 +7792,8 @@ func (v *MatchingService_DescribeTaskList_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -5542,14 +7801,14 @@ func (v *MatchingService_DescribeTaskList_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_DescribeTaskList_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_PollForDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Result match the
-// provided MatchingService_DescribeTaskList_Result.
+// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Result match the
+// provided MatchingService_PollForDecisionTask_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_DescribeTaskList_Result) bool {
+func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService_PollForDecisionTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -5564,7 +7823,7 @@ func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_De
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
 		return false
 	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
@@ -5575,8 +7834,8 @@ func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_De
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_DescribeTaskList_Result.
-func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_PollForDecisionTask_Result.
+func (v *MatchingService_PollForDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -5589,8 +7848,8 @@ func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.O
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
 	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
@@ -5600,7 +7859,7 @@ func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.O
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.DescribeTaskListResponse) {
+func (v *MatchingService_PollForDecisionTask_Result) GetSuccess() (o *PollForDecisionTaskResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -5609,13 +7868,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.Descri
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetSuccess() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -5624,13 +7883,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *share
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -5639,28 +7898,28 @@ func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
+func (v *MatchingService_PollForDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
 	}
 
 	return
 }
 
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_PollForDecisionTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_PollForDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -5669,33 +7928,33 @@ func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shar
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_PollForDecisionTask_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeTaskList" for this struct.
-func (v *MatchingService_DescribeTaskList_Result) MethodName() string {
-	return "DescribeTaskList"
+// This will always be "PollForDecisionTask" for this struct.
+func (v *MatchingService_PollForDecisionTask_Result) MethodName() string {
+	return "PollForDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_DescribeTaskList_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_PollForDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_PollForActivityTask_Args represents the arguments for the MatchingService.PollForActivityTask function.
+// MatchingService_QueryWorkflow_Args represents the arguments for the MatchingService.QueryWorkflow function.
 //
-// The arguments for PollForActivityTask are sent and received over the wire as this struct.
-type MatchingService_PollForActivityTask_Args struct {
-	PollRequest *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+// The arguments for QueryWorkflow are sent and received over the wire as this struct.
+type MatchingService_QueryWorkflow_Args struct {
+	QueryRequest *QueryWorkflowRequest `json:"queryRequest,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForActivityTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_QueryWorkflow_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5710,7 +7969,7 @@ type MatchingService_PollForActivityTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -5718,8 +7977,8 @@ func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error)
 		err    error
 	)
 
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.QueryRequest != nil {
+		w, err = v.QueryRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -5730,17 +7989,17 @@ func (v *MatchingService_PollForActivityTask_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskRequest_1_Read(w wire.Value) (*PollForActivityTaskRequest, error) {
-	var v PollForActivityTaskRequest
+func _QueryWorkflowRequest_1_Read(w wire.Value) (*QueryWorkflowRequest, error) {
+	var v QueryWorkflowRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForActivityTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_QueryWorkflow_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Args struct
+// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5748,19 +8007,19 @@ func _PollForActivityTaskRequest_1_Read(w wire.Value) (*PollForActivityTaskReque
 //     return nil, err
 //   }
 //
-//   var v MatchingService_PollForActivityTask_Args
+//   var v MatchingService_QueryWorkflow_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_PollForActivityTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_QueryWorkflow_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForActivityTaskRequest_1_Read(field.Value)
+				v.QueryRequest, err = _QueryWorkflowRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5772,34 +8031,34 @@ func (v *MatchingService_PollForActivityTask_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForActivityTask_Args
+// String returns a readable string representation of a MatchingService_QueryWorkflow_Args
 // struct.
-func (v *MatchingService_PollForActivityTask_Args) String() string {
+func (v *MatchingService_QueryWorkflow_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.QueryRequest != nil {
+		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_QueryWorkflow_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Args match the
-// provided MatchingService_PollForActivityTask_Args.
+// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Args match the
+// provided MatchingService_QueryWorkflow_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForActivityTask_Args) Equals(rhs *MatchingService_PollForActivityTask_Args) bool {
+func (v *MatchingService_QueryWorkflow_Args) Equals(rhs *MatchingService_QueryWorkflow_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
 		return false
 	}
 
@@ -5807,107 +8066,111 @@ func (v *MatchingService_PollForActivityTask_Args) Equals(rhs *MatchingService_P
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForActivityTask_Args.
-func (v *MatchingService_PollForActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_QueryWorkflow_Args.
+func (v *MatchingService_QueryWorkflow_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.QueryRequest != nil {
+		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
 	}
 	return err
 }
 
-// GetPollRequest returns the value of PollRequest if it is set or its
+// GetQueryRequest returns the value of QueryRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Args) GetPollRequest() (o *PollForActivityTaskRequest) {
-	if v != nil && v.PollRequest != nil {
-		return v.PollRequest
+func (v *MatchingService_QueryWorkflow_Args) GetQueryRequest() (o *QueryWorkflowRequest) {
+	if v != nil && v.QueryRequest != nil {
+		return v.QueryRequest
 	}
 
 	return
 }
 
-// IsSetPollRequest returns true if PollRequest is not nil.
-func (v *MatchingService_PollForActivityTask_Args) IsSetPollRequest() bool {
-	return v != nil && v.PollRequest != nil
+// IsSetQueryRequest returns true if QueryRequest is not nil.
+func (v *MatchingService_QueryWorkflow_Args) IsSetQueryRequest() bool {
+	return v != nil && v.QueryRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "PollForActivityTask" for this struct.
-func (v *MatchingService_PollForActivityTask_Args) MethodName() string {
-	return "PollForActivityTask"
+// This will always be "QueryWorkflow" for this struct.
+func (v *MatchingService_QueryWorkflow_Args) MethodName() string {
+	return "QueryWorkflow"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_PollForActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_QueryWorkflow_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_PollForActivityTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.PollForActivityTask
+// MatchingService_QueryWorkflow_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.QueryWorkflow
 // function.
-var MatchingService_PollForActivityTask_Helper = struct {
-	// Args accepts the parameters of PollForActivityTask in-order and returns
+var MatchingService_QueryWorkflow_Helper = struct {
+	// Args accepts the parameters of QueryWorkflow in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		pollRequest *PollForActivityTaskRequest,
-	) *MatchingService_PollForActivityTask_Args
+		queryRequest *QueryWorkflowRequest,
+	) *MatchingService_QueryWorkflow_Args
 
 	// IsException returns true if the given error can be thrown
-	// by PollForActivityTask.
+	// by QueryWorkflow.
 	//
-	// An error can be thrown by PollForActivityTask only if the
+	// An error can be thrown by QueryWorkflow only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for PollForActivityTask
+	// WrapResponse returns the result struct for QueryWorkflow
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// PollForActivityTask into a serializable result struct.
+	// QueryWorkflow into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by PollForActivityTask
+	// error cannot be thrown by QueryWorkflow
 	//
-	//   value, err := PollForActivityTask(args)
-	//   result, err := MatchingService_PollForActivityTask_Helper.WrapResponse(value, err)
+	//   value, err := QueryWorkflow(args)
+	//   result, err := MatchingService_QueryWorkflow_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from PollForActivityTask: %v", err)
+	//     return fmt.Errorf("unexpected error from QueryWorkflow: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.PollForActivityTaskResponse, error) (*MatchingService_PollForActivityTask_Result, error)
+	WrapResponse func(*shared.QueryWorkflowResponse, error) (*MatchingService_QueryWorkflow_Result, error)
 
-	// UnwrapResponse takes the result struct for PollForActivityTask
+	// UnwrapResponse takes the result struct for QueryWorkflow
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if PollForActivityTask threw an
+	// The error is non-nil only if QueryWorkflow threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_PollForActivityTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_PollForActivityTask_Result) (*shared.PollForActivityTaskResponse, error)
+	//   value, err := MatchingService_QueryWorkflow_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_QueryWorkflow_Result) (*shared.QueryWorkflowResponse, error)
 }{}
 
 func init() {
-	MatchingService_PollForActivityTask_Helper.Args = func(
-		pollRequest *PollForActivityTaskRequest,
-	) *MatchingService_PollForActivityTask_Args {
-		return &MatchingService_PollForActivityTask_Args{
-			PollRequest: pollRequest,
+	MatchingService_QueryWorkflow_Helper.Args = func(
+		queryRequest *QueryWorkflowRequest,
+	) *MatchingService_QueryWorkflow_Args {
+		return &MatchingService_QueryWorkflow_Args{
+			QueryRequest: queryRequest,
 		}
 	}
 
-	MatchingService_PollForActivityTask_Helper.IsException = func(err error) bool {
+	MatchingService_QueryWorkflow_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.QueryFailedError:
+			return true
 		case *shared.LimitExceededError:
 			return true
 		case *shared.ServiceBusyError:
@@ -5917,37 +8180,47 @@ func init() {
 		}
 	}
 
-	MatchingService_PollForActivityTask_Helper.WrapResponse = func(success *shared.PollForActivityTaskResponse, err error) (*MatchingService_PollForActivityTask_Result, error) {
+	MatchingService_QueryWorkflow_Helper.WrapResponse = func(success *shared.QueryWorkflowResponse, err error) (*MatchingService_QueryWorkflow_Result, error) {
 		if err == nil {
-			return &MatchingService_PollForActivityTask_Result{Success: success}, nil
+			return &MatchingService_QueryWorkflow_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.BadRequestError")
 			}
-			return &MatchingService_PollForActivityTask_Result{BadRequestError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.InternalServiceError")
 			}
-			return &MatchingService_PollForActivityTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.EntityNotExistError")
+			}
+			return &MatchingService_QueryWorkflow_Result{EntityNotExistError: e}, nil
+		case *shared.QueryFailedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.QueryFailedError")
+			}
+			return &MatchingService_QueryWorkflow_Result{QueryFailedError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.LimitExceededError")
 			}
-			return &MatchingService_PollForActivityTask_Result{LimitExceededError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForActivityTask_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.ServiceBusyError")
 			}
-			return &MatchingService_PollForActivityTask_Result{ServiceBusyError: e}, nil
+			return &MatchingService_QueryWorkflow_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_PollForActivityTask_Helper.UnwrapResponse = func(result *MatchingService_PollForActivityTask_Result) (success *shared.PollForActivityTaskResponse, err error) {
+	MatchingService_QueryWorkflow_Helper.UnwrapResponse = func(result *MatchingService_QueryWorkflow_Result) (success *shared.QueryWorkflowResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -5956,6 +8229,14 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.QueryFailedError != nil {
+			err = result.QueryFailedError
+			return
+		}
 		if result.LimitExceededError != nil {
 			err = result.LimitExceededError
 			return
@@ -5976,21 +8257,23 @@ func init() {
 
 }
 
-// MatchingService_PollForActivityTask_Result represents the result of a MatchingService.PollForActivityTask function call.
+// MatchingService_QueryWorkflow_Result represents the result of a MatchingService.QueryWorkflow function call.
 //
-// The result of a PollForActivityTask execution is sent and received over the wire as this struct.
+// The result of a QueryWorkflow execution is sent and received over the wire as this struct.
 //
-// Success is set only if the function did not throw an exception.
-type MatchingService_PollForActivityTask_Result struct {
-	// Value returned by PollForActivityTask after a successful execution.
-	Success              *shared.PollForActivityTaskResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError          `json:"limitExceededError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError            `json:"serviceBusyError,omitempty"`
+// Success is set only if the function did not throw an exception.
+type MatchingService_QueryWorkflow_Result struct {
+	// Value returned by QueryWorkflow after a successful execution.
+	Success              *shared.QueryWorkflowResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError       `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError  `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError  `json:"entityNotExistError,omitempty"`
+	QueryFailedError     *shared.QueryFailedError      `json:"queryFailedError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError    `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError      `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForActivityTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_QueryWorkflow_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6005,9 +8288,9 @@ type MatchingService_PollForActivityTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6037,12 +8320,28 @@ func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.QueryFailedError != nil {
+		w, err = v.QueryFailedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 	if v.LimitExceededError != nil {
 		w, err = v.LimitExceededError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -6050,28 +8349,34 @@ func (v *MatchingService_PollForActivityTask_Result) ToWire() (wire.Value, error
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTaskResponse, error) {
-	var v shared.PollForActivityTaskResponse
+func _QueryWorkflowResponse_Read(w wire.Value) (*shared.QueryWorkflowResponse, error) {
+	var v shared.QueryWorkflowResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForActivityTask_Result struct from its Thrift-level
+func _QueryFailedError_Read(w wire.Value) (*shared.QueryFailedError, error) {
+	var v shared.QueryFailedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_QueryWorkflow_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForActivityTask_Result struct
+// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6079,19 +8384,19 @@ func _PollForActivityTaskResponse_Read(w wire.Value) (*shared.PollForActivityTas
 //     return nil, err
 //   }
 //
-//   var v MatchingService_PollForActivityTask_Result
+//   var v MatchingService_QueryWorkflow_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _PollForActivityTaskResponse_Read(field.Value)
+				v.Success, err = _QueryWorkflowResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6115,13 +8420,29 @@ func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) erro
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.QueryFailedError, err = _QueryFailedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -6142,6 +8463,12 @@ func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) erro
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.QueryFailedError != nil {
+		count++
+	}
 	if v.LimitExceededError != nil {
 		count++
 	}
@@ -6149,20 +8476,20 @@ func (v *MatchingService_PollForActivityTask_Result) FromWire(w wire.Value) erro
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForActivityTask_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForActivityTask_Result
+// String returns a readable string representation of a MatchingService_QueryWorkflow_Result
 // struct.
-func (v *MatchingService_PollForActivityTask_Result) String() string {
+func (v *MatchingService_QueryWorkflow_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.Success != nil {
 		fields[i] = fmt.Sprintf("Success: %v", v.Success)
@@ -6176,6 +8503,14 @@ func (v *MatchingService_PollForActivityTask_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.QueryFailedError != nil {
+		fields[i] = fmt.Sprintf("QueryFailedError: %v", v.QueryFailedError)
+		i++
+	}
 	if v.LimitExceededError != nil {
 		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
 		i++
@@ -6185,14 +8520,14 @@ func (v *MatchingService_PollForActivityTask_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_QueryWorkflow_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForActivityTask_Result match the
-// provided MatchingService_PollForActivityTask_Result.
+// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Result match the
+// provided MatchingService_QueryWorkflow_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService_PollForActivityTask_Result) bool {
+func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_QueryWorkflow_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6207,6 +8542,12 @@ func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.QueryFailedError == nil && rhs.QueryFailedError == nil) || (v.QueryFailedError != nil && rhs.QueryFailedError != nil && v.QueryFailedError.Equals(rhs.QueryFailedError))) {
+		return false
+	}
 	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
 		return false
 	}
@@ -6218,8 +8559,8 @@ func (v *MatchingService_PollForActivityTask_Result) Equals(rhs *MatchingService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForActivityTask_Result.
-func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_QueryWorkflow_Result.
+func (v *MatchingService_QueryWorkflow_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -6232,6 +8573,12 @@ func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcor
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.QueryFailedError != nil {
+		err = multierr.Append(err, enc.AddObject("queryFailedError", v.QueryFailedError))
+	}
 	if v.LimitExceededError != nil {
 		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
 	}
@@ -6243,7 +8590,7 @@ func (v *MatchingService_PollForActivityTask_Result) MarshalLogObject(enc zapcor
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetSuccess() (o *shared.PollForActivityTaskResponse) {
+func (v *MatchingService_QueryWorkflow_Result) GetSuccess() (o *shared.QueryWorkflowResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -6252,13 +8599,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetSuccess() (o *shared.Pol
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetSuccess() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_QueryWorkflow_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -6267,13 +8614,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetBadRequestError() (o *sh
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_QueryWorkflow_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -6282,13 +8629,43 @@ func (v *MatchingService_PollForActivityTask_Result) GetInternalServiceError() (
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_QueryWorkflow_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *MatchingService_QueryWorkflow_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetQueryFailedError returns the value of QueryFailedError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_QueryWorkflow_Result) GetQueryFailedError() (o *shared.QueryFailedError) {
+	if v != nil && v.QueryFailedError != nil {
+		return v.QueryFailedError
+	}
+
+	return
+}
+
+// IsSetQueryFailedError returns true if QueryFailedError is not nil.
+func (v *MatchingService_QueryWorkflow_Result) IsSetQueryFailedError() bool {
+	return v != nil && v.QueryFailedError != nil
+}
+
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *MatchingService_QueryWorkflow_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -6297,13 +8674,13 @@ func (v *MatchingService_PollForActivityTask_Result) GetLimitExceededError() (o
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetLimitExceededError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_QueryWorkflow_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -6312,33 +8689,33 @@ func (v *MatchingService_PollForActivityTask_Result) GetServiceBusyError() (o *s
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_PollForActivityTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_QueryWorkflow_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "PollForActivityTask" for this struct.
-func (v *MatchingService_PollForActivityTask_Result) MethodName() string {
-	return "PollForActivityTask"
+// This will always be "QueryWorkflow" for this struct.
+func (v *MatchingService_QueryWorkflow_Result) MethodName() string {
+	return "QueryWorkflow"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_PollForActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_QueryWorkflow_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_PollForDecisionTask_Args represents the arguments for the MatchingService.PollForDecisionTask function.
+// MatchingService_ReleaseTask_Args represents the arguments for the MatchingService.ReleaseTask function.
 //
-// The arguments for PollForDecisionTask are sent and received over the wire as this struct.
-type MatchingService_PollForDecisionTask_Args struct {
-	PollRequest *PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+// The arguments for ReleaseTask are sent and received over the wire as this struct.
+type MatchingService_ReleaseTask_Args struct {
+	Request *ReleaseTaskRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForDecisionTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_ReleaseTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6353,7 +8730,7 @@ type MatchingService_PollForDecisionTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_ReleaseTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -6361,8 +8738,8 @@ func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error)
 		err    error
 	)
 
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -6373,17 +8750,17 @@ func (v *MatchingService_PollForDecisionTask_Args) ToWire() (wire.Value, error)
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskRequest_1_Read(w wire.Value) (*PollForDecisionTaskRequest, error) {
-	var v PollForDecisionTaskRequest
+func _ReleaseTaskRequest_Read(w wire.Value) (*ReleaseTaskRequest, error) {
+	var v ReleaseTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_PollForDecisionTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_ReleaseTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Args struct
+// An error is returned if we were unable to build a MatchingService_ReleaseTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6391,19 +8768,19 @@ func _PollForDecisionTaskRequest_1_Read(w wire.Value) (*PollForDecisionTaskReque
 //     return nil, err
 //   }
 //
-//   var v MatchingService_PollForDecisionTask_Args
+//   var v MatchingService_ReleaseTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_PollForDecisionTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_ReleaseTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForDecisionTaskRequest_1_Read(field.Value)
+				v.Request, err = _ReleaseTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6415,34 +8792,34 @@ func (v *MatchingService_PollForDecisionTask_Args) FromWire(w wire.Value) error
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForDecisionTask_Args
+// String returns a readable string representation of a MatchingService_ReleaseTask_Args
 // struct.
-func (v *MatchingService_PollForDecisionTask_Args) String() string {
+func (v *MatchingService_ReleaseTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_ReleaseTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Args match the
-// provided MatchingService_PollForDecisionTask_Args.
+// Equals returns true if all the fields of this MatchingService_ReleaseTask_Args match the
+// provided MatchingService_ReleaseTask_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForDecisionTask_Args) Equals(rhs *MatchingService_PollForDecisionTask_Args) bool {
+func (v *MatchingService_ReleaseTask_Args) Equals(rhs *MatchingService_ReleaseTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -6450,109 +8827,108 @@ func (v *MatchingService_PollForDecisionTask_Args) Equals(rhs *MatchingService_P
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForDecisionTask_Args.
-func (v *MatchingService_PollForDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_ReleaseTask_Args.
+func (v *MatchingService_ReleaseTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetPollRequest returns the value of PollRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Args) GetPollRequest() (o *PollForDecisionTaskRequest) {
-	if v != nil && v.PollRequest != nil {
-		return v.PollRequest
+func (v *MatchingService_ReleaseTask_Args) GetRequest() (o *ReleaseTaskRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetPollRequest returns true if PollRequest is not nil.
-func (v *MatchingService_PollForDecisionTask_Args) IsSetPollRequest() bool {
-	return v != nil && v.PollRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_ReleaseTask_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "PollForDecisionTask" for this struct.
-func (v *MatchingService_PollForDecisionTask_Args) MethodName() string {
-	return "PollForDecisionTask"
+// This will always be "ReleaseTask" for this struct.
+func (v *MatchingService_ReleaseTask_Args) MethodName() string {
+	return "ReleaseTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_PollForDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_ReleaseTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_PollForDecisionTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.PollForDecisionTask
+// MatchingService_ReleaseTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.ReleaseTask
 // function.
-var MatchingService_PollForDecisionTask_Helper = struct {
-	// Args accepts the parameters of PollForDecisionTask in-order and returns
+var MatchingService_ReleaseTask_Helper = struct {
+	// Args accepts the parameters of ReleaseTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		pollRequest *PollForDecisionTaskRequest,
-	) *MatchingService_PollForDecisionTask_Args
+		request *ReleaseTaskRequest,
+	) *MatchingService_ReleaseTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by PollForDecisionTask.
+	// by ReleaseTask.
 	//
-	// An error can be thrown by PollForDecisionTask only if the
+	// An error can be thrown by ReleaseTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for PollForDecisionTask
-	// given its return value and error.
+	// WrapResponse returns the result struct for ReleaseTask
+	// given the error returned by it. The provided error may
+	// be nil if ReleaseTask did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// PollForDecisionTask into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by PollForDecisionTask
+	// This allows mapping errors returned by ReleaseTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// ReleaseTask
 	//
-	//   value, err := PollForDecisionTask(args)
-	//   result, err := MatchingService_PollForDecisionTask_Helper.WrapResponse(value, err)
+	//   err := ReleaseTask(args)
+	//   result, err := MatchingService_ReleaseTask_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from PollForDecisionTask: %v", err)
+	//     return fmt.Errorf("unexpected error from ReleaseTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*PollForDecisionTaskResponse, error) (*MatchingService_PollForDecisionTask_Result, error)
+	WrapResponse func(error) (*MatchingService_ReleaseTask_Result, error)
 
-	// UnwrapResponse takes the result struct for PollForDecisionTask
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for ReleaseTask
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if PollForDecisionTask threw an
+	// The error is non-nil only if ReleaseTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_PollForDecisionTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_PollForDecisionTask_Result) (*PollForDecisionTaskResponse, error)
+	//   err := MatchingService_ReleaseTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_ReleaseTask_Result) error
 }{}
 
 func init() {
-	MatchingService_PollForDecisionTask_Helper.Args = func(
-		pollRequest *PollForDecisionTaskRequest,
-	) *MatchingService_PollForDecisionTask_Args {
-		return &MatchingService_PollForDecisionTask_Args{
-			PollRequest: pollRequest,
+	MatchingService_ReleaseTask_Helper.Args = func(
+		request *ReleaseTaskRequest,
+	) *MatchingService_ReleaseTask_Args {
+		return &MatchingService_ReleaseTask_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_PollForDecisionTask_Helper.IsException = func(err error) bool {
+	MatchingService_ReleaseTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.LimitExceededError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -6560,37 +8936,32 @@ func init() {
 		}
 	}
 
-	MatchingService_PollForDecisionTask_Helper.WrapResponse = func(success *PollForDecisionTaskResponse, err error) (*MatchingService_PollForDecisionTask_Result, error) {
+	MatchingService_ReleaseTask_Helper.WrapResponse = func(err error) (*MatchingService_ReleaseTask_Result, error) {
 		if err == nil {
-			return &MatchingService_PollForDecisionTask_Result{Success: success}, nil
+			return &MatchingService_ReleaseTask_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ReleaseTask_Result.BadRequestError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{BadRequestError: e}, nil
+			return &MatchingService_ReleaseTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.InternalServiceError")
-			}
-			return &MatchingService_PollForDecisionTask_Result{InternalServiceError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ReleaseTask_Result.InternalServiceError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{LimitExceededError: e}, nil
+			return &MatchingService_ReleaseTask_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_PollForDecisionTask_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_ReleaseTask_Result.ServiceBusyError")
 			}
-			return &MatchingService_PollForDecisionTask_Result{ServiceBusyError: e}, nil
+			return &MatchingService_ReleaseTask_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_PollForDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_PollForDecisionTask_Result) (success *PollForDecisionTaskResponse, err error) {
+	MatchingService_ReleaseTask_Helper.UnwrapResponse = func(result *MatchingService_ReleaseTask_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -6599,41 +8970,25 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_PollForDecisionTask_Result represents the result of a MatchingService.PollForDecisionTask function call.
-//
-// The result of a PollForDecisionTask execution is sent and received over the wire as this struct.
+// MatchingService_ReleaseTask_Result represents the result of a MatchingService.ReleaseTask function call.
 //
-// Success is set only if the function did not throw an exception.
-type MatchingService_PollForDecisionTask_Result struct {
-	// Value returned by PollForDecisionTask after a successful execution.
-	Success              *PollForDecisionTaskResponse `json:"success,omitempty"`
+// The result of a ReleaseTask execution is sent and received over the wire as this struct.
+type MatchingService_ReleaseTask_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
 	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_PollForDecisionTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_ReleaseTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6648,22 +9003,14 @@ type MatchingService_PollForDecisionTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_ReleaseTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -6680,41 +9027,27 @@ func (v *MatchingService_PollForDecisionTask_Result) ToWire() (wire.Value, error
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 4, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_ReleaseTask_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskResponse, error) {
-	var v PollForDecisionTaskResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a MatchingService_PollForDecisionTask_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_ReleaseTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_PollForDecisionTask_Result struct
+// An error is returned if we were unable to build a MatchingService_ReleaseTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6722,24 +9055,16 @@ func _PollForDecisionTaskResponse_Read(w wire.Value) (*PollForDecisionTaskRespon
 //     return nil, err
 //   }
 //
-//   var v MatchingService_PollForDecisionTask_Result
+//   var v MatchingService_ReleaseTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_ReleaseTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _PollForDecisionTaskResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -6757,14 +9082,6 @@ func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) erro
 
 			}
 		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -6776,41 +9093,31 @@ func (v *MatchingService_PollForDecisionTask_Result) FromWire(w wire.Value) erro
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("MatchingService_PollForDecisionTask_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("MatchingService_ReleaseTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_PollForDecisionTask_Result
+// String returns a readable string representation of a MatchingService_ReleaseTask_Result
 // struct.
-func (v *MatchingService_PollForDecisionTask_Result) String() string {
+func (v *MatchingService_ReleaseTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -6819,40 +9126,30 @@ func (v *MatchingService_PollForDecisionTask_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_PollForDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_ReleaseTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_PollForDecisionTask_Result match the
-// provided MatchingService_PollForDecisionTask_Result.
+// Equals returns true if all the fields of this MatchingService_ReleaseTask_Result match the
+// provided MatchingService_ReleaseTask_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService_PollForDecisionTask_Result) bool {
+func (v *MatchingService_ReleaseTask_Result) Equals(rhs *MatchingService_ReleaseTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -6861,47 +9158,26 @@ func (v *MatchingService_PollForDecisionTask_Result) Equals(rhs *MatchingService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_PollForDecisionTask_Result.
-func (v *MatchingService_PollForDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_ReleaseTask_Result.
+func (v *MatchingService_ReleaseTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetSuccess() (o *PollForDecisionTaskResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_ReleaseTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -6910,13 +9186,13 @@ func (v *MatchingService_PollForDecisionTask_Result) GetBadRequestError() (o *sh
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_ReleaseTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_ReleaseTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -6925,28 +9201,13 @@ func (v *MatchingService_PollForDecisionTask_Result) GetInternalServiceError() (
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_ReleaseTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_PollForDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_ReleaseTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -6955,33 +9216,33 @@ func (v *MatchingService_PollForDecisionTask_Result) GetServiceBusyError() (o *s
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_PollForDecisionTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_ReleaseTask_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "PollForDecisionTask" for this struct.
-func (v *MatchingService_PollForDecisionTask_Result) MethodName() string {
-	return "PollForDecisionTask"
+// This will always be "ReleaseTask" for this struct.
+func (v *MatchingService_ReleaseTask_Result) MethodName() string {
+	return "ReleaseTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_PollForDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_ReleaseTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_QueryWorkflow_Args represents the arguments for the MatchingService.QueryWorkflow function.
+// MatchingService_RespondQueryTaskCompleted_Args represents the arguments for the MatchingService.RespondQueryTaskCompleted function.
 //
-// The arguments for QueryWorkflow are sent and received over the wire as this struct.
-type MatchingService_QueryWorkflow_Args struct {
-	QueryRequest *QueryWorkflowRequest `json:"queryRequest,omitempty"`
+// The arguments for RespondQueryTaskCompleted are sent and received over the wire as this struct.
+type MatchingService_RespondQueryTaskCompleted_Args struct {
+	Request *RespondQueryTaskCompletedRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_QueryWorkflow_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_RespondQueryTaskCompleted_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6996,7 +9257,7 @@ type MatchingService_QueryWorkflow_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -7004,8 +9265,8 @@ func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.QueryRequest != nil {
-		w, err = v.QueryRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -7016,17 +9277,17 @@ func (v *MatchingService_QueryWorkflow_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryWorkflowRequest_1_Read(w wire.Value) (*QueryWorkflowRequest, error) {
-	var v QueryWorkflowRequest
+func _RespondQueryTaskCompletedRequest_1_Read(w wire.Value) (*RespondQueryTaskCompletedRequest, error) {
+	var v RespondQueryTaskCompletedRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_QueryWorkflow_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_RespondQueryTaskCompleted_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Args struct
+// An error is returned if we were unable to build a MatchingService_RespondQueryTaskCompleted_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7034,19 +9295,19 @@ func _QueryWorkflowRequest_1_Read(w wire.Value) (*QueryWorkflowRequest, error) {
 //     return nil, err
 //   }
 //
-//   var v MatchingService_QueryWorkflow_Args
+//   var v MatchingService_RespondQueryTaskCompleted_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_QueryWorkflow_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.QueryRequest, err = _QueryWorkflowRequest_1_Read(field.Value)
+				v.Request, err = _RespondQueryTaskCompletedRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7058,34 +9319,34 @@ func (v *MatchingService_QueryWorkflow_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_QueryWorkflow_Args
+// String returns a readable string representation of a MatchingService_RespondQueryTaskCompleted_Args
 // struct.
-func (v *MatchingService_QueryWorkflow_Args) String() string {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.QueryRequest != nil {
-		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_QueryWorkflow_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_RespondQueryTaskCompleted_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Args match the
-// provided MatchingService_QueryWorkflow_Args.
+// Equals returns true if all the fields of this MatchingService_RespondQueryTaskCompleted_Args match the
+// provided MatchingService_RespondQueryTaskCompleted_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_QueryWorkflow_Args) Equals(rhs *MatchingService_QueryWorkflow_Args) bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) Equals(rhs *MatchingService_RespondQueryTaskCompleted_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -7093,102 +9354,103 @@ func (v *MatchingService_QueryWorkflow_Args) Equals(rhs *MatchingService_QueryWo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_QueryWorkflow_Args.
-func (v *MatchingService_QueryWorkflow_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_RespondQueryTaskCompleted_Args.
+func (v *MatchingService_RespondQueryTaskCompleted_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.QueryRequest != nil {
-		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetQueryRequest returns the value of QueryRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Args) GetQueryRequest() (o *QueryWorkflowRequest) {
-	if v != nil && v.QueryRequest != nil {
-		return v.QueryRequest
+func (v *MatchingService_RespondQueryTaskCompleted_Args) GetRequest() (o *RespondQueryTaskCompletedRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetQueryRequest returns true if QueryRequest is not nil.
-func (v *MatchingService_QueryWorkflow_Args) IsSetQueryRequest() bool {
-	return v != nil && v.QueryRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_RespondQueryTaskCompleted_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "QueryWorkflow" for this struct.
-func (v *MatchingService_QueryWorkflow_Args) MethodName() string {
-	return "QueryWorkflow"
+// This will always be "RespondQueryTaskCompleted" for this struct.
+func (v *MatchingService_RespondQueryTaskCompleted_Args) MethodName() string {
+	return "RespondQueryTaskCompleted"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_QueryWorkflow_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_RespondQueryTaskCompleted_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_QueryWorkflow_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.QueryWorkflow
+// MatchingService_RespondQueryTaskCompleted_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.RespondQueryTaskCompleted
 // function.
-var MatchingService_QueryWorkflow_Helper = struct {
-	// Args accepts the parameters of QueryWorkflow in-order and returns
+var MatchingService_RespondQueryTaskCompleted_Helper = struct {
+	// Args accepts the parameters of RespondQueryTaskCompleted in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		queryRequest *QueryWorkflowRequest,
-	) *MatchingService_QueryWorkflow_Args
+		request *RespondQueryTaskCompletedRequest,
+	) *MatchingService_RespondQueryTaskCompleted_Args
 
 	// IsException returns true if the given error can be thrown
-	// by QueryWorkflow.
+	// by RespondQueryTaskCompleted.
 	//
-	// An error can be thrown by QueryWorkflow only if the
+	// An error can be thrown by RespondQueryTaskCompleted only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for QueryWorkflow
-	// given its return value and error.
+	// WrapResponse returns the result struct for RespondQueryTaskCompleted
+	// given the error returned by it. The provided error may
+	// be nil if RespondQueryTaskCompleted did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// QueryWorkflow into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by QueryWorkflow
+	// This allows mapping errors returned by RespondQueryTaskCompleted into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RespondQueryTaskCompleted
 	//
-	//   value, err := QueryWorkflow(args)
-	//   result, err := MatchingService_QueryWorkflow_Helper.WrapResponse(value, err)
+	//   err := RespondQueryTaskCompleted(args)
+	//   result, err := MatchingService_RespondQueryTaskCompleted_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from QueryWorkflow: %v", err)
+	//     return fmt.Errorf("unexpected error from RespondQueryTaskCompleted: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.QueryWorkflowResponse, error) (*MatchingService_QueryWorkflow_Result, error)
+	WrapResponse func(error) (*MatchingService_RespondQueryTaskCompleted_Result, error)
 
-	// UnwrapResponse takes the result struct for QueryWorkflow
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for RespondQueryTaskCompleted
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if QueryWorkflow threw an
+	// The error is non-nil only if RespondQueryTaskCompleted threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_QueryWorkflow_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_QueryWorkflow_Result) (*shared.QueryWorkflowResponse, error)
+	//   err := MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_RespondQueryTaskCompleted_Result) error
 }{}
 
 func init() {
-	MatchingService_QueryWorkflow_Helper.Args = func(
-		queryRequest *QueryWorkflowRequest,
-	) *MatchingService_QueryWorkflow_Args {
-		return &MatchingService_QueryWorkflow_Args{
-			QueryRequest: queryRequest,
+	MatchingService_RespondQueryTaskCompleted_Helper.Args = func(
+		request *RespondQueryTaskCompletedRequest,
+	) *MatchingService_RespondQueryTaskCompleted_Args {
+		return &MatchingService_RespondQueryTaskCompleted_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_QueryWorkflow_Helper.IsException = func(err error) bool {
+	MatchingService_RespondQueryTaskCompleted_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -7196,8 +9458,6 @@ func init() {
 			return true
 		case *shared.EntityNotExistsError:
 			return true
-		case *shared.QueryFailedError:
-			return true
 		case *shared.LimitExceededError:
 			return true
 		case *shared.ServiceBusyError:
@@ -7207,47 +9467,42 @@ func init() {
 		}
 	}
 
-	MatchingService_QueryWorkflow_Helper.WrapResponse = func(success *shared.QueryWorkflowResponse, err error) (*MatchingService_QueryWorkflow_Result, error) {
+	MatchingService_RespondQueryTaskCompleted_Helper.WrapResponse = func(err error) (*MatchingService_RespondQueryTaskCompleted_Result, error) {
 		if err == nil {
-			return &MatchingService_QueryWorkflow_Result{Success: success}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.BadRequestError")
 			}
-			return &MatchingService_QueryWorkflow_Result{BadRequestError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.InternalServiceError")
 			}
-			return &MatchingService_QueryWorkflow_Result{InternalServiceError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{InternalServiceError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.EntityNotExistError")
-			}
-			return &MatchingService_QueryWorkflow_Result{EntityNotExistError: e}, nil
-		case *shared.QueryFailedError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.QueryFailedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.EntityNotExistError")
 			}
-			return &MatchingService_QueryWorkflow_Result{QueryFailedError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{EntityNotExistError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.LimitExceededError")
 			}
-			return &MatchingService_QueryWorkflow_Result{LimitExceededError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{LimitExceededError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_QueryWorkflow_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.ServiceBusyError")
 			}
-			return &MatchingService_QueryWorkflow_Result{ServiceBusyError: e}, nil
+			return &MatchingService_RespondQueryTaskCompleted_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_QueryWorkflow_Helper.UnwrapResponse = func(result *MatchingService_QueryWorkflow_Result) (success *shared.QueryWorkflowResponse, err error) {
+	MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse = func(result *MatchingService_RespondQueryTaskCompleted_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -7260,10 +9515,6 @@ func init() {
 			err = result.EntityNotExistError
 			return
 		}
-		if result.QueryFailedError != nil {
-			err = result.QueryFailedError
-			return
-		}
 		if result.LimitExceededError != nil {
 			err = result.LimitExceededError
 			return
@@ -7272,35 +9523,23 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_QueryWorkflow_Result represents the result of a MatchingService.QueryWorkflow function call.
-//
-// The result of a QueryWorkflow execution is sent and received over the wire as this struct.
+// MatchingService_RespondQueryTaskCompleted_Result represents the result of a MatchingService.RespondQueryTaskCompleted function call.
 //
-// Success is set only if the function did not throw an exception.
-type MatchingService_QueryWorkflow_Result struct {
-	// Value returned by QueryWorkflow after a successful execution.
-	Success              *shared.QueryWorkflowResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError       `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError  `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError  `json:"entityNotExistError,omitempty"`
-	QueryFailedError     *shared.QueryFailedError      `json:"queryFailedError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError    `json:"limitExceededError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError      `json:"serviceBusyError,omitempty"`
+// The result of a RespondQueryTaskCompleted execution is sent and received over the wire as this struct.
+type MatchingService_RespondQueryTaskCompleted_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_QueryWorkflow_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_RespondQueryTaskCompleted_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7315,22 +9554,14 @@ type MatchingService_QueryWorkflow_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -7355,20 +9586,12 @@ func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.QueryFailedError != nil {
-		w, err = v.QueryFailedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
 	if v.LimitExceededError != nil {
 		w, err = v.LimitExceededError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 	if v.ServiceBusyError != nil {
@@ -7376,34 +9599,22 @@ func (v *MatchingService_QueryWorkflow_Result) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 6, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_RespondQueryTaskCompleted_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryWorkflowResponse_Read(w wire.Value) (*shared.QueryWorkflowResponse, error) {
-	var v shared.QueryWorkflowResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _QueryFailedError_Read(w wire.Value) (*shared.QueryFailedError, error) {
-	var v shared.QueryFailedError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a MatchingService_QueryWorkflow_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_RespondQueryTaskCompleted_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_QueryWorkflow_Result struct
+// An error is returned if we were unable to build a MatchingService_RespondQueryTaskCompleted_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7411,24 +9622,16 @@ func _QueryFailedError_Read(w wire.Value) (*shared.QueryFailedError, error) {
 //     return nil, err
 //   }
 //
-//   var v MatchingService_QueryWorkflow_Result
+//   var v MatchingService_RespondQueryTaskCompleted_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _QueryWorkflowResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -7454,14 +9657,6 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 
 			}
 		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.QueryFailedError, err = _QueryFailedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
 			if field.Value.Type() == wire.TStruct {
 				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
 				if err != nil {
@@ -7469,7 +9664,7 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 				}
 
 			}
-		case 6:
+		case 5:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -7481,9 +9676,6 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -7493,35 +9685,28 @@ func (v *MatchingService_QueryWorkflow_Result) FromWire(w wire.Value) error {
 	if v.EntityNotExistError != nil {
 		count++
 	}
-	if v.QueryFailedError != nil {
-		count++
-	}
 	if v.LimitExceededError != nil {
 		count++
 	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("MatchingService_QueryWorkflow_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("MatchingService_RespondQueryTaskCompleted_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_QueryWorkflow_Result
+// String returns a readable string representation of a MatchingService_RespondQueryTaskCompleted_Result
 // struct.
-func (v *MatchingService_QueryWorkflow_Result) String() string {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [5]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -7534,10 +9719,6 @@ func (v *MatchingService_QueryWorkflow_Result) String() string {
 		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
-	if v.QueryFailedError != nil {
-		fields[i] = fmt.Sprintf("QueryFailedError: %v", v.QueryFailedError)
-		i++
-	}
 	if v.LimitExceededError != nil {
 		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
 		i++
@@ -7547,22 +9728,19 @@ func (v *MatchingService_QueryWorkflow_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_QueryWorkflow_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_RespondQueryTaskCompleted_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_QueryWorkflow_Result match the
-// provided MatchingService_QueryWorkflow_Result.
+// Equals returns true if all the fields of this MatchingService_RespondQueryTaskCompleted_Result match the
+// provided MatchingService_RespondQueryTaskCompleted_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_QueryWorkflow_Result) bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) Equals(rhs *MatchingService_RespondQueryTaskCompleted_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -7572,9 +9750,6 @@ func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_Query
 	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
-	if !((v.QueryFailedError == nil && rhs.QueryFailedError == nil) || (v.QueryFailedError != nil && rhs.QueryFailedError != nil && v.QueryFailedError.Equals(rhs.QueryFailedError))) {
-		return false
-	}
 	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
 		return false
 	}
@@ -7586,14 +9761,11 @@ func (v *MatchingService_QueryWorkflow_Result) Equals(rhs *MatchingService_Query
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_QueryWorkflow_Result.
-func (v *MatchingService_QueryWorkflow_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_RespondQueryTaskCompleted_Result.
+func (v *MatchingService_RespondQueryTaskCompleted_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -7603,36 +9775,18 @@ func (v *MatchingService_QueryWorkflow_Result) MarshalLogObject(enc zapcore.Obje
 	if v.EntityNotExistError != nil {
 		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
 	}
-	if v.QueryFailedError != nil {
-		err = multierr.Append(err, enc.AddObject("queryFailedError", v.QueryFailedError))
-	}
 	if v.LimitExceededError != nil {
 		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
-	}
-	return err
-}
-
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetSuccess() (o *shared.QueryWorkflowResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -7641,13 +9795,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetBadRequestError() (o *shared.B
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -7656,13 +9810,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetInternalServiceError() (o *sha
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -7671,28 +9825,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetEntityNotExistError() (o *shar
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetEntityNotExistError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
-// GetQueryFailedError returns the value of QueryFailedError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetQueryFailedError() (o *shared.QueryFailedError) {
-	if v != nil && v.QueryFailedError != nil {
-		return v.QueryFailedError
-	}
-
-	return
-}
-
-// IsSetQueryFailedError returns true if QueryFailedError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetQueryFailedError() bool {
-	return v != nil && v.QueryFailedError != nil
-}
-
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -7701,13 +9840,13 @@ func (v *MatchingService_QueryWorkflow_Result) GetLimitExceededError() (o *share
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetLimitExceededError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_QueryWorkflow_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -7716,33 +9855,33 @@ func (v *MatchingService_QueryWorkflow_Result) GetServiceBusyError() (o *shared.
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_QueryWorkflow_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "QueryWorkflow" for this struct.
-func (v *MatchingService_QueryWorkflow_Result) MethodName() string {
-	return "QueryWorkflow"
+// This will always be "RespondQueryTaskCompleted" for this struct.
+func (v *MatchingService_RespondQueryTaskCompleted_Result) MethodName() string {
+	return "RespondQueryTaskCompleted"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_QueryWorkflow_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_RespondQueryTaskCompleted_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_RespondQueryTaskCompleted_Args represents the arguments for the MatchingService.RespondQueryTaskCompleted function.
+// MatchingService_UpdateTaskListLimits_Args represents the arguments for the MatchingService.UpdateTaskListLimits function.
 //
-// The arguments for RespondQueryTaskCompleted are sent and received over the wire as this struct.
-type MatchingService_RespondQueryTaskCompleted_Args struct {
-	Request *RespondQueryTaskCompletedRequest `json:"request,omitempty"`
+// The arguments for UpdateTaskListLimits are sent and received over the wire as this struct.
+type MatchingService_UpdateTaskListLimits_Args struct {
+	Request *UpdateTaskListLimitsRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_RespondQueryTaskCompleted_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_UpdateTaskListLimits_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7757,7 +9896,7 @@ type MatchingService_RespondQueryTaskCompleted_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_RespondQueryTaskCompleted_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_UpdateTaskListLimits_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -7777,17 +9916,17 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) ToWire() (wire.Value, e
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondQueryTaskCompletedRequest_1_Read(w wire.Value) (*RespondQueryTaskCompletedRequest, error) {
-	var v RespondQueryTaskCompletedRequest
+func _UpdateTaskListLimitsRequest_1_Read(w wire.Value) (*UpdateTaskListLimitsRequest, error) {
+	var v UpdateTaskListLimitsRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_RespondQueryTaskCompleted_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_UpdateTaskListLimits_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_RespondQueryTaskCompleted_Args struct
+// An error is returned if we were unable to build a MatchingService_UpdateTaskListLimits_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7795,19 +9934,19 @@ func _RespondQueryTaskCompletedRequest_1_Read(w wire.Value) (*RespondQueryTaskCo
 //     return nil, err
 //   }
 //
-//   var v MatchingService_RespondQueryTaskCompleted_Args
+//   var v MatchingService_UpdateTaskListLimits_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_RespondQueryTaskCompleted_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_UpdateTaskListLimits_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _RespondQueryTaskCompletedRequest_1_Read(field.Value)
+				v.Request, err = _UpdateTaskListLimitsRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7819,9 +9958,9 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) FromWire(w wire.Value)
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_RespondQueryTaskCompleted_Args
+// String returns a readable string representation of a MatchingService_UpdateTaskListLimits_Args
 // struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) String() string {
+func (v *MatchingService_UpdateTaskListLimits_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -7833,14 +9972,14 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_RespondQueryTaskCompleted_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_UpdateTaskListLimits_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_RespondQueryTaskCompleted_Args match the
-// provided MatchingService_RespondQueryTaskCompleted_Args.
+// Equals returns true if all the fields of this MatchingService_UpdateTaskListLimits_Args match the
+// provided MatchingService_UpdateTaskListLimits_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) Equals(rhs *MatchingService_RespondQueryTaskCompleted_Args) bool {
+func (v *MatchingService_UpdateTaskListLimits_Args) Equals(rhs *MatchingService_UpdateTaskListLimits_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7854,8 +9993,8 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) Equals(rhs *MatchingSer
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_RespondQueryTaskCompleted_Args.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_UpdateTaskListLimits_Args.
+func (v *MatchingService_UpdateTaskListLimits_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -7867,7 +10006,7 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) MarshalLogObject(enc za
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) GetRequest() (o *RespondQueryTaskCompletedRequest) {
+func (v *MatchingService_UpdateTaskListLimits_Args) GetRequest() (o *UpdateTaskListLimitsRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -7876,90 +10015,86 @@ func (v *MatchingService_RespondQueryTaskCompleted_Args) GetRequest() (o *Respon
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) IsSetRequest() bool {
+func (v *MatchingService_UpdateTaskListLimits_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "RespondQueryTaskCompleted" for this struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) MethodName() string {
-	return "RespondQueryTaskCompleted"
+// This will always be "UpdateTaskListLimits" for this struct.
+func (v *MatchingService_UpdateTaskListLimits_Args) MethodName() string {
+	return "UpdateTaskListLimits"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_UpdateTaskListLimits_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_RespondQueryTaskCompleted_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.RespondQueryTaskCompleted
+// MatchingService_UpdateTaskListLimits_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.UpdateTaskListLimits
 // function.
-var MatchingService_RespondQueryTaskCompleted_Helper = struct {
-	// Args accepts the parameters of RespondQueryTaskCompleted in-order and returns
+var MatchingService_UpdateTaskListLimits_Helper = struct {
+	// Args accepts the parameters of UpdateTaskListLimits in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *RespondQueryTaskCompletedRequest,
-	) *MatchingService_RespondQueryTaskCompleted_Args
+		request *UpdateTaskListLimitsRequest,
+	) *MatchingService_UpdateTaskListLimits_Args
 
 	// IsException returns true if the given error can be thrown
-	// by RespondQueryTaskCompleted.
+	// by UpdateTaskListLimits.
 	//
-	// An error can be thrown by RespondQueryTaskCompleted only if the
+	// An error can be thrown by UpdateTaskListLimits only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for RespondQueryTaskCompleted
+	// WrapResponse returns the result struct for UpdateTaskListLimits
 	// given the error returned by it. The provided error may
-	// be nil if RespondQueryTaskCompleted did not fail.
+	// be nil if UpdateTaskListLimits did not fail.
 	//
-	// This allows mapping errors returned by RespondQueryTaskCompleted into a
+	// This allows mapping errors returned by UpdateTaskListLimits into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// RespondQueryTaskCompleted
+	// UpdateTaskListLimits
 	//
-	//   err := RespondQueryTaskCompleted(args)
-	//   result, err := MatchingService_RespondQueryTaskCompleted_Helper.WrapResponse(err)
+	//   err := UpdateTaskListLimits(args)
+	//   result, err := MatchingService_UpdateTaskListLimits_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from RespondQueryTaskCompleted: %v", err)
+	//     return fmt.Errorf("unexpected error from UpdateTaskListLimits: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_RespondQueryTaskCompleted_Result, error)
+	WrapResponse func(error) (*MatchingService_UpdateTaskListLimits_Result, error)
 
-	// UnwrapResponse takes the result struct for RespondQueryTaskCompleted
+	// UnwrapResponse takes the result struct for UpdateTaskListLimits
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if RespondQueryTaskCompleted threw an
+	// The error is non-nil only if UpdateTaskListLimits threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_RespondQueryTaskCompleted_Result) error
+	//   err := MatchingService_UpdateTaskListLimits_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_UpdateTaskListLimits_Result) error
 }{}
 
 func init() {
-	MatchingService_RespondQueryTaskCompleted_Helper.Args = func(
-		request *RespondQueryTaskCompletedRequest,
-	) *MatchingService_RespondQueryTaskCompleted_Args {
-		return &MatchingService_RespondQueryTaskCompleted_Args{
+	MatchingService_UpdateTaskListLimits_Helper.Args = func(
+		request *UpdateTaskListLimitsRequest,
+	) *MatchingService_UpdateTaskListLimits_Args {
+		return &MatchingService_UpdateTaskListLimits_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_RespondQueryTaskCompleted_Helper.IsException = func(err error) bool {
+	MatchingService_UpdateTaskListLimits_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
-		case *shared.LimitExceededError:
-			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -7967,42 +10102,32 @@ func init() {
 		}
 	}
 
-	MatchingService_RespondQueryTaskCompleted_Helper.WrapResponse = func(err error) (*MatchingService_RespondQueryTaskCompleted_Result, error) {
+	MatchingService_UpdateTaskListLimits_Helper.WrapResponse = func(err error) (*MatchingService_UpdateTaskListLimits_Result, error) {
 		if err == nil {
-			return &MatchingService_RespondQueryTaskCompleted_Result{}, nil
+			return &MatchingService_UpdateTaskListLimits_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_UpdateTaskListLimits_Result.BadRequestError")
 			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{BadRequestError: e}, nil
+			return &MatchingService_UpdateTaskListLimits_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.InternalServiceError")
-			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.EntityNotExistError")
-			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{EntityNotExistError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_UpdateTaskListLimits_Result.InternalServiceError")
 			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{LimitExceededError: e}, nil
+			return &MatchingService_UpdateTaskListLimits_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_RespondQueryTaskCompleted_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_UpdateTaskListLimits_Result.ServiceBusyError")
 			}
-			return &MatchingService_RespondQueryTaskCompleted_Result{ServiceBusyError: e}, nil
+			return &MatchingService_UpdateTaskListLimits_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse = func(result *MatchingService_RespondQueryTaskCompleted_Result) (err error) {
+	MatchingService_UpdateTaskListLimits_Helper.UnwrapResponse = func(result *MatchingService_UpdateTaskListLimits_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -8011,14 +10136,6 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
-			return
-		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
@@ -8028,18 +10145,16 @@ func init() {
 
 }
 
-// MatchingService_RespondQueryTaskCompleted_Result represents the result of a MatchingService.RespondQueryTaskCompleted function call.
+// MatchingService_UpdateTaskListLimits_Result represents the result of a MatchingService.UpdateTaskListLimits function call.
 //
-// The result of a RespondQueryTaskCompleted execution is sent and received over the wire as this struct.
-type MatchingService_RespondQueryTaskCompleted_Result struct {
+// The result of a UpdateTaskListLimits execution is sent and received over the wire as this struct.
+type MatchingService_UpdateTaskListLimits_Result struct {
 	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
 	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
-	LimitExceededError   *shared.LimitExceededError   `json:"limitExceededError,omitempty"`
 	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_RespondQueryTaskCompleted_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_UpdateTaskListLimits_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8054,9 +10169,9 @@ type MatchingService_RespondQueryTaskCompleted_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_RespondQueryTaskCompleted_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_UpdateTaskListLimits_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8078,43 +10193,27 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 3, Value: w}
-		i++
-	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 5, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_RespondQueryTaskCompleted_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_UpdateTaskListLimits_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MatchingService_RespondQueryTaskCompleted_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_UpdateTaskListLimits_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_RespondQueryTaskCompleted_Result struct
+// An error is returned if we were unable to build a MatchingService_UpdateTaskListLimits_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8122,12 +10221,12 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) ToWire() (wire.Value,
 //     return nil, err
 //   }
 //
-//   var v MatchingService_RespondQueryTaskCompleted_Result
+//   var v MatchingService_UpdateTaskListLimits_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_RespondQueryTaskCompleted_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_UpdateTaskListLimits_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -8149,22 +10248,6 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) FromWire(w wire.Value
 
 			}
 		case 3:
-			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -8182,30 +10265,24 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) FromWire(w wire.Value
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_RespondQueryTaskCompleted_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_UpdateTaskListLimits_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_RespondQueryTaskCompleted_Result
+// String returns a readable string representation of a MatchingService_UpdateTaskListLimits_Result
 // struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) String() string {
+func (v *MatchingService_UpdateTaskListLimits_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -8215,27 +10292,19 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_RespondQueryTaskCompleted_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_UpdateTaskListLimits_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_RespondQueryTaskCompleted_Result match the
-// provided MatchingService_RespondQueryTaskCompleted_Result.
+// Equals returns true if all the fields of this MatchingService_UpdateTaskListLimits_Result match the
+// provided MatchingService_UpdateTaskListLimits_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) Equals(rhs *MatchingService_RespondQueryTaskCompleted_Result) bool {
+func (v *MatchingService_UpdateTaskListLimits_Result) Equals(rhs *MatchingService_UpdateTaskListLimits_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -8247,12 +10316,6 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) Equals(rhs *MatchingS
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
-		return false
-	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -8261,8 +10324,8 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) Equals(rhs *MatchingS
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_RespondQueryTaskCompleted_Result.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_UpdateTaskListLimits_Result.
+func (v *MatchingService_UpdateTaskListLimits_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -8272,12 +10335,6 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) MarshalLogObject(enc
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
@@ -8286,7 +10343,7 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) MarshalLogObject(enc
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_UpdateTaskListLimits_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -8295,13 +10352,13 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) GetBadRequestError()
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_UpdateTaskListLimits_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_UpdateTaskListLimits_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -8310,43 +10367,13 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) GetInternalServiceErr
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_UpdateTaskListLimits_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
-	}
-
-	return
-}
-
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
-}
-
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_UpdateTaskListLimits_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -8355,21 +10382,21 @@ func (v *MatchingService_RespondQueryTaskCompleted_Result) GetServiceBusyError()
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_UpdateTaskListLimits_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "RespondQueryTaskCompleted" for this struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) MethodName() string {
-	return "RespondQueryTaskCompleted"
+// This will always be "UpdateTaskListLimits" for this struct.
+func (v *MatchingService_UpdateTaskListLimits_Result) MethodName() string {
+	return "UpdateTaskListLimits"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_RespondQueryTaskCompleted_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_UpdateTaskListLimits_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
@@ -80,11 +80,23 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.QueryWorkflowResponse, error)
 
+	ReleaseTask(
+		ctx context.Context,
+		Request *matching.ReleaseTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RespondQueryTaskCompleted(
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateTaskListLimits(
+		ctx context.Context,
+		Request *matching.UpdateTaskListLimitsRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the MatchingService service.
@@ -272,6 +284,29 @@ func (c client) QueryWorkflow(
 	return
 }
 
+func (c client) ReleaseTask(
+	ctx context.Context,
+	_Request *matching.ReleaseTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_ReleaseTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_ReleaseTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_ReleaseTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondQueryTaskCompleted(
 	ctx context.Context,
 	_Request *matching.RespondQueryTaskCompletedRequest,
@@ -294,3 +329,26 @@ func (c client) RespondQueryTaskCompleted(
 	err = matching.MatchingService_RespondQueryTaskCompleted_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateTaskListLimits(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListLimitsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_UpdateTaskListLimits_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_UpdateTaskListLimits_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_UpdateTaskListLimits_Helper.UnwrapResponse(&result)
+	return
+}
@@ -71,10 +71,20 @@ type Interface interface {
 		QueryRequest *matching.QueryWorkflowRequest,
 	) (*shared.QueryWorkflowResponse, error)
 
+	ReleaseTask(
+		ctx context.Context,
+		Request *matching.ReleaseTaskRequest,
+	) error
+
 	RespondQueryTaskCompleted(
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
 	) error
+
+	UpdateTaskListLimits(
+		ctx context.Context,
+		Request *matching.UpdateTaskListLimitsRequest,
+	) error
 }
 
 // New prepares an implementation of the MatchingService service for
@@ -165,6 +175,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReleaseTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReleaseTask),
+				},
+				Signature:    "ReleaseTask(Request *matching.ReleaseTaskRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RespondQueryTaskCompleted",
 				HandlerSpec: thrift.HandlerSpec{
@@ -175,10 +196,21 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "RespondQueryTaskCompleted(Request *matching.RespondQueryTaskCompletedRequest)",
 				ThriftModule: matching.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateTaskListLimits",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.UpdateTaskListLimits),
+				},
+				Signature:    "UpdateTaskListLimits(Request *matching.UpdateTaskListLimitsRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 8)
+	procedures := make([]transport.Procedure, 0, 10)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -318,6 +350,25 @@ func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) ReleaseTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_ReleaseTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ReleaseTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_ReleaseTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_RespondQueryTaskCompleted_Args
 	if err := args.FromWire(body); err != nil {
@@ -336,3 +387,22 @@ func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value)
 	}
 	return response, err
 }
+
+func (h handler) UpdateTaskListLimits(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_UpdateTaskListLimits_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.UpdateTaskListLimits(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_UpdateTaskListLimits_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
@@ -290,6 +290,37 @@ func (mr *_MockClientRecorder) QueryWorkflow(
 	return mr.mock.ctrl.RecordCall(mr.mock, "QueryWorkflow", args...)
 }
 
+// ReleaseTask responds to a ReleaseTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ReleaseTask(gomock.Any(), ...).Return(...)
+// 	... := client.ReleaseTask(...)
+func (m *MockClient) ReleaseTask(
+	ctx context.Context,
+	_Request *matching.ReleaseTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReleaseTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReleaseTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReleaseTask", args...)
+}
+
 // RespondQueryTaskCompleted responds to a RespondQueryTaskCompleted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -320,3 +351,34 @@ func (mr *_MockClientRecorder) RespondQueryTaskCompleted(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "RespondQueryTaskCompleted", args...)
 }
+
+// UpdateTaskListLimits responds to a UpdateTaskListLimits call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().UpdateTaskListLimits(gomock.Any(), ...).Return(...)
+// 	... := client.UpdateTaskListLimits(...)
+func (m *MockClient) UpdateTaskListLimits(
+	ctx context.Context,
+	_Request *matching.UpdateTaskListLimitsRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListLimits", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListLimits(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListLimits", args...)
+}
@@ -69,6 +69,9 @@ type ActivityInfo struct {
 	RetryLastFailureReason        *string  `json:"retryLastFailureReason,omitempty"`
 	RetryLastWorkerIdentity       *string  `json:"retryLastWorkerIdentity,omitempty"`
 	RetryLastFailureDetails       []byte   `json:"retryLastFailureDetails,omitempty"`
+	Paused                        *bool    `json:"paused,omitempty"`
+	Priority                      *int32   `json:"priority,omitempty"`
+	FairnessKey                   *string  `json:"fairnessKey,omitempty"`
 }
 
 type _List_String_ValueList []string
@@ -114,7 +117,7 @@ func (_List_String_ValueList) Close() {}
 //   }
 func (v *ActivityInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [31]wire.Field
+		fields [34]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -368,6 +371,30 @@ func (v *ActivityInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.Paused != nil {
+		w, err = wire.NewValueBool(*(v.Paused)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 72, Value: w}
+		i++
+	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 74, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 76, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -713,6 +740,36 @@ func (v *ActivityInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 72:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Paused = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 74:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 76:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -727,7 +784,7 @@ func (v *ActivityInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [31]string
+	var fields [34]string
 	i := 0
 	if v.Version != nil {
 		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
@@ -853,6 +910,18 @@ func (v *ActivityInfo) String() string {
 		fields[i] = fmt.Sprintf("RetryLastFailureDetails: %v", v.RetryLastFailureDetails)
 		i++
 	}
+	if v.Paused != nil {
+		fields[i] = fmt.Sprintf("Paused: %v", *(v.Paused))
+		i++
+	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("ActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1025,6 +1094,15 @@ func (v *ActivityInfo) Equals(rhs *ActivityInfo) bool {
 	if !((v.RetryLastFailureDetails == nil && rhs.RetryLastFailureDetails == nil) || (v.RetryLastFailureDetails != nil && rhs.RetryLastFailureDetails != nil && bytes.Equal(v.RetryLastFailureDetails, rhs.RetryLastFailureDetails))) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Paused, rhs.Paused) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -1139,6 +1217,15 @@ func (v *ActivityInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.RetryLastFailureDetails != nil {
 		enc.AddString("retryLastFailureDetails", base64.StdEncoding.EncodeToString(v.RetryLastFailureDetails))
 	}
+	if v.Paused != nil {
+		enc.AddBool("paused", *v.Paused)
+	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -1607,6 +1694,51 @@ func (v *ActivityInfo) IsSetRetryLastFailureDetails() bool {
 	return v != nil && v.RetryLastFailureDetails != nil
 }
 
+// GetPaused returns the value of Paused if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetPaused() (o bool) {
+	if v != nil && v.Paused != nil {
+		return *v.Paused
+	}
+
+	return
+}
+
+// IsSetPaused returns true if Paused is not nil.
+func (v *ActivityInfo) IsSetPaused() bool {
+	return v != nil && v.Paused != nil
+}
+
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *ActivityInfo) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *ActivityInfo) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *ActivityInfo) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type ChildExecutionInfo struct {
 	Version                *int64  `json:"version,omitempty"`
 	InitiatedEventBatchID  *int64  `json:"initiatedEventBatchID,omitempty"`
@@ -2268,27 +2400,28 @@ func (v *ChildExecutionInfo) IsSetParentClosePolicy() bool {
 }
 
 type DomainInfo struct {
-	Name                        *string           `json:"name,omitempty"`
-	Description                 *string           `json:"description,omitempty"`
-	Owner                       *string           `json:"owner,omitempty"`
-	Status                      *int32            `json:"status,omitempty"`
-	RetentionDays               *int16            `json:"retentionDays,omitempty"`
-	EmitMetric                  *bool             `json:"emitMetric,omitempty"`
-	ArchivalBucket              *string           `json:"archivalBucket,omitempty"`
-	ArchivalStatus              *int16            `json:"archivalStatus,omitempty"`
-	ConfigVersion               *int64            `json:"configVersion,omitempty"`
-	NotificationVersion         *int64            `json:"notificationVersion,omitempty"`
-	FailoverNotificationVersion *int64            `json:"failoverNotificationVersion,omitempty"`
-	FailoverVersion             *int64            `json:"failoverVersion,omitempty"`
-	ActiveClusterName           *string           `json:"activeClusterName,omitempty"`
-	Clusters                    []string          `json:"clusters,omitempty"`
-	Data                        map[string]string `json:"data,omitempty"`
-	BadBinaries                 []byte            `json:"badBinaries,omitempty"`
-	BadBinariesEncoding         *string           `json:"badBinariesEncoding,omitempty"`
-	HistoryArchivalStatus       *int16            `json:"historyArchivalStatus,omitempty"`
-	HistoryArchivalURI          *string           `json:"historyArchivalURI,omitempty"`
-	VisibilityArchivalStatus    *int16            `json:"visibilityArchivalStatus,omitempty"`
-	VisibilityArchivalURI       *string           `json:"visibilityArchivalURI,omitempty"`
+	Name                        *string                            `json:"name,omitempty"`
+	Description                 *string                            `json:"description,omitempty"`
+	Owner                       *string                            `json:"owner,omitempty"`
+	Status                      *int32                             `json:"status,omitempty"`
+	RetentionDays               *int16                             `json:"retentionDays,omitempty"`
+	EmitMetric                  *bool                              `json:"emitMetric,omitempty"`
+	ArchivalBucket              *string                            `json:"archivalBucket,omitempty"`
+	ArchivalStatus              *int16                             `json:"archivalStatus,omitempty"`
+	ConfigVersion               *int64                             `json:"configVersion,omitempty"`
+	NotificationVersion         *int64                             `json:"notificationVersion,omitempty"`
+	FailoverNotificationVersion *int64                             `json:"failoverNotificationVersion,omitempty"`
+	FailoverVersion             *int64                             `json:"failoverVersion,omitempty"`
+	ActiveClusterName           *string                            `json:"activeClusterName,omitempty"`
+	Clusters                    []string                           `json:"clusters,omitempty"`
+	Data                        map[string]string                  `json:"data,omitempty"`
+	BadBinaries                 []byte                             `json:"badBinaries,omitempty"`
+	BadBinariesEncoding         *string                            `json:"badBinariesEncoding,omitempty"`
+	HistoryArchivalStatus       *int16                             `json:"historyArchivalStatus,omitempty"`
+	HistoryArchivalURI          *string                            `json:"historyArchivalURI,omitempty"`
+	VisibilityArchivalStatus    *int16                             `json:"visibilityArchivalStatus,omitempty"`
+	VisibilityArchivalURI       *string                            `json:"visibilityArchivalURI,omitempty"`
+	SearchAttributes            map[string]shared.IndexedValueType `json:"searchAttributes,omitempty"`
 }
 
 type _Map_String_String_MapItemList map[string]string
@@ -2326,6 +2459,41 @@ func (_Map_String_String_MapItemList) ValueType() wire.Type {
 
 func (_Map_String_String_MapItemList) Close() {}
 
+type _Map_String_IndexedValueType_MapItemList map[string]shared.IndexedValueType
+
+func (m _Map_String_IndexedValueType_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_IndexedValueType_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_IndexedValueType_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_IndexedValueType_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_IndexedValueType_MapItemList) Close() {}
+
 // ToWire translates a DomainInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -2343,7 +2511,7 @@ func (_Map_String_String_MapItemList) Close() {}
 //   }
 func (v *DomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [21]wire.Field
+		fields [22]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2517,6 +2685,14 @@ func (v *DomainInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 48, Value: w}
 		i++
 	}
+	if v.SearchAttributes != nil {
+		w, err = wire.NewValueMap(_Map_String_IndexedValueType_MapItemList(v.SearchAttributes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2549,6 +2725,40 @@ func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
 	return o, err
 }
 
+func _IndexedValueType_Read(w wire.Value) (shared.IndexedValueType, error) {
+	var v shared.IndexedValueType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Map_String_IndexedValueType_Read(m wire.MapItemList) (map[string]shared.IndexedValueType, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[string]shared.IndexedValueType, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _IndexedValueType_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a DomainInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -2774,6 +2984,14 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TMap {
+				v.SearchAttributes, err = _Map_String_IndexedValueType_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2788,7 +3006,7 @@ func (v *DomainInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [21]string
+	var fields [22]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -2874,6 +3092,10 @@ func (v *DomainInfo) String() string {
 		fields[i] = fmt.Sprintf("VisibilityArchivalURI: %v", *(v.VisibilityArchivalURI))
 		i++
 	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2905,6 +3127,23 @@ func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
 	return true
 }
 
+func _Map_String_IndexedValueType_Equals(lhs, rhs map[string]shared.IndexedValueType) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this DomainInfo match the
 // provided DomainInfo.
 //
@@ -2978,6 +3217,9 @@ func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
 	if !_String_EqualsPtr(v.VisibilityArchivalURI, rhs.VisibilityArchivalURI) {
 		return false
 	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && _Map_String_IndexedValueType_Equals(v.SearchAttributes, rhs.SearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -2993,6 +3235,17 @@ func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (
 	return err
 }
 
+type _Map_String_IndexedValueType_Zapper map[string]shared.IndexedValueType
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_IndexedValueType_Zapper.
+func (m _Map_String_IndexedValueType_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DomainInfo.
 func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -3062,6 +3315,9 @@ func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.VisibilityArchivalURI != nil {
 		enc.AddString("visibilityArchivalURI", *v.VisibilityArchivalURI)
 	}
+	if v.SearchAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("searchAttributes", (_Map_String_IndexedValueType_Zapper)(v.SearchAttributes)))
+	}
 	return err
 }
 
@@ -3380,6 +3636,21 @@ func (v *DomainInfo) IsSetVisibilityArchivalURI() bool {
 	return v != nil && v.VisibilityArchivalURI != nil
 }
 
+// GetSearchAttributes returns the value of SearchAttributes if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetSearchAttributes() (o map[string]shared.IndexedValueType) {
+	if v != nil && v.SearchAttributes != nil {
+		return v.SearchAttributes
+	}
+
+	return
+}
+
+// IsSetSearchAttributes returns true if SearchAttributes is not nil.
+func (v *DomainInfo) IsSetSearchAttributes() bool {
+	return v != nil && v.SearchAttributes != nil
+}
+
 type HistoryTreeInfo struct {
 	CreatedTimeNanos *int64                       `json:"createdTimeNanos,omitempty"`
 	Ancestors        []*shared.HistoryBranchRange `json:"ancestors,omitempty"`
@@ -5870,6 +6141,8 @@ type TaskInfo struct {
 	ScheduleID       *int64  `json:"scheduleID,omitempty"`
 	ExpiryTimeNanos  *int64  `json:"expiryTimeNanos,omitempty"`
 	CreatedTimeNanos *int64  `json:"createdTimeNanos,omitempty"`
+	Priority         *int32  `json:"priority,omitempty"`
+	FairnessKey      *string `json:"fairnessKey,omitempty"`
 }
 
 // ToWire translates a TaskInfo struct into a Thrift-level intermediate
@@ -5889,7 +6162,7 @@ type TaskInfo struct {
 //   }
 func (v *TaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5935,6 +6208,22 @@ func (v *TaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 15, Value: w}
 		i++
 	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 17, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -6008,6 +6297,26 @@ func (v *TaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 16:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 17:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6022,7 +6331,7 @@ func (v *TaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.WorkflowID != nil {
 		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
@@ -6044,6 +6353,14 @@ func (v *TaskInfo) String() string {
 		fields[i] = fmt.Sprintf("CreatedTimeNanos: %v", *(v.CreatedTimeNanos))
 		i++
 	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("TaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -6073,6 +6390,12 @@ func (v *TaskInfo) Equals(rhs *TaskInfo) bool {
 	if !_I64_EqualsPtr(v.CreatedTimeNanos, rhs.CreatedTimeNanos) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -6098,6 +6421,12 @@ func (v *TaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.CreatedTimeNanos != nil {
 		enc.AddInt64("createdTimeNanos", *v.CreatedTimeNanos)
 	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -6176,11 +6505,45 @@ func (v *TaskInfo) IsSetCreatedTimeNanos() bool {
 	return v != nil && v.CreatedTimeNanos != nil
 }
 
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *TaskInfo) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *TaskInfo) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 type TaskListInfo struct {
-	Kind             *int16 `json:"kind,omitempty"`
-	AckLevel         *int64 `json:"ackLevel,omitempty"`
-	ExpiryTimeNanos  *int64 `json:"expiryTimeNanos,omitempty"`
-	LastUpdatedNanos *int64 `json:"lastUpdatedNanos,omitempty"`
+	Kind                *int16   `json:"kind,omitempty"`
+	AckLevel            *int64   `json:"ackLevel,omitempty"`
+	ExpiryTimeNanos     *int64   `json:"expiryTimeNanos,omitempty"`
+	LastUpdatedNanos    *int64   `json:"lastUpdatedNanos,omitempty"`
+	NumReadPartitions   *int32   `json:"numReadPartitions,omitempty"`
+	NumWritePartitions  *int32   `json:"numWritePartitions,omitempty"`
+	MaxTasksPerSecond   *float64 `json:"maxTasksPerSecond,omitempty"`
+	MaxOutstandingTasks *int32   `json:"maxOutstandingTasks,omitempty"`
 }
 
 // ToWire translates a TaskListInfo struct into a Thrift-level intermediate
@@ -6200,7 +6563,7 @@ type TaskListInfo struct {
 //   }
 func (v *TaskListInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -6238,6 +6601,38 @@ func (v *TaskListInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 16, Value: w}
 		i++
 	}
+	if v.NumReadPartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumReadPartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
+	if v.NumWritePartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumWritePartitions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MaxTasksPerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.MaxTasksPerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 22, Value: w}
+		i++
+	}
+	if v.MaxOutstandingTasks != nil {
+		w, err = wire.NewValueI32(*(v.MaxOutstandingTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 24, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -6303,6 +6698,46 @@ func (v *TaskListInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 18:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumReadPartitions = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumWritePartitions = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 22:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.MaxTasksPerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 24:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaxOutstandingTasks = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6317,7 +6752,7 @@ func (v *TaskListInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [8]string
 	i := 0
 	if v.Kind != nil {
 		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
@@ -6335,6 +6770,22 @@ func (v *TaskListInfo) String() string {
 		fields[i] = fmt.Sprintf("LastUpdatedNanos: %v", *(v.LastUpdatedNanos))
 		i++
 	}
+	if v.NumReadPartitions != nil {
+		fields[i] = fmt.Sprintf("NumReadPartitions: %v", *(v.NumReadPartitions))
+		i++
+	}
+	if v.NumWritePartitions != nil {
+		fields[i] = fmt.Sprintf("NumWritePartitions: %v", *(v.NumWritePartitions))
+		i++
+	}
+	if v.MaxTasksPerSecond != nil {
+		fields[i] = fmt.Sprintf("MaxTasksPerSecond: %v", *(v.MaxTasksPerSecond))
+		i++
+	}
+	if v.MaxOutstandingTasks != nil {
+		fields[i] = fmt.Sprintf("MaxOutstandingTasks: %v", *(v.MaxOutstandingTasks))
+		i++
+	}
 
 	return fmt.Sprintf("TaskListInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -6361,6 +6812,18 @@ func (v *TaskListInfo) Equals(rhs *TaskListInfo) bool {
 	if !_I64_EqualsPtr(v.LastUpdatedNanos, rhs.LastUpdatedNanos) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.NumReadPartitions, rhs.NumReadPartitions) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NumWritePartitions, rhs.NumWritePartitions) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.MaxTasksPerSecond, rhs.MaxTasksPerSecond) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaxOutstandingTasks, rhs.MaxOutstandingTasks) {
+		return false
+	}
 
 	return true
 }
@@ -6383,6 +6846,18 @@ func (v *TaskListInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.LastUpdatedNanos != nil {
 		enc.AddInt64("lastUpdatedNanos", *v.LastUpdatedNanos)
 	}
+	if v.NumReadPartitions != nil {
+		enc.AddInt32("numReadPartitions", *v.NumReadPartitions)
+	}
+	if v.NumWritePartitions != nil {
+		enc.AddInt32("numWritePartitions", *v.NumWritePartitions)
+	}
+	if v.MaxTasksPerSecond != nil {
+		enc.AddFloat64("maxTasksPerSecond", *v.MaxTasksPerSecond)
+	}
+	if v.MaxOutstandingTasks != nil {
+		enc.AddInt32("maxOutstandingTasks", *v.MaxOutstandingTasks)
+	}
 	return err
 }
 
@@ -6446,6 +6921,66 @@ func (v *TaskListInfo) IsSetLastUpdatedNanos() bool {
 	return v != nil && v.LastUpdatedNanos != nil
 }
 
+// GetNumReadPartitions returns the value of NumReadPartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetNumReadPartitions() (o int32) {
+	if v != nil && v.NumReadPartitions != nil {
+		return *v.NumReadPartitions
+	}
+
+	return
+}
+
+// IsSetNumReadPartitions returns true if NumReadPartitions is not nil.
+func (v *TaskListInfo) IsSetNumReadPartitions() bool {
+	return v != nil && v.NumReadPartitions != nil
+}
+
+// GetNumWritePartitions returns the value of NumWritePartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetNumWritePartitions() (o int32) {
+	if v != nil && v.NumWritePartitions != nil {
+		return *v.NumWritePartitions
+	}
+
+	return
+}
+
+// IsSetNumWritePartitions returns true if NumWritePartitions is not nil.
+func (v *TaskListInfo) IsSetNumWritePartitions() bool {
+	return v != nil && v.NumWritePartitions != nil
+}
+
+// GetMaxTasksPerSecond returns the value of MaxTasksPerSecond if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetMaxTasksPerSecond() (o float64) {
+	if v != nil && v.MaxTasksPerSecond != nil {
+		return *v.MaxTasksPerSecond
+	}
+
+	return
+}
+
+// IsSetMaxTasksPerSecond returns true if MaxTasksPerSecond is not nil.
+func (v *TaskListInfo) IsSetMaxTasksPerSecond() bool {
+	return v != nil && v.MaxTasksPerSecond != nil
+}
+
+// GetMaxOutstandingTasks returns the value of MaxOutstandingTasks if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetMaxOutstandingTasks() (o int32) {
+	if v != nil && v.MaxOutstandingTasks != nil {
+		return *v.MaxOutstandingTasks
+	}
+
+	return
+}
+
+// IsSetMaxOutstandingTasks returns true if MaxOutstandingTasks is not nil.
+func (v *TaskListInfo) IsSetMaxOutstandingTasks() bool {
+	return v != nil && v.MaxOutstandingTasks != nil
+}
+
 type TimerInfo struct {
 	Version         *int64 `json:"version,omitempty"`
 	StartedID       *int64 `json:"startedID,omitempty"`
@@ -7833,6 +8368,9 @@ type WorkflowExecutionInfo struct {
 	Memo                                    map[string][]byte           `json:"memo,omitempty"`
 	VersionHistories                        []byte                      `json:"versionHistories,omitempty"`
 	VersionHistoriesEncoding                *string                     `json:"versionHistoriesEncoding,omitempty"`
+	DelayStartSeconds                       *int32                      `json:"delayStartSeconds,omitempty"`
+	Priority                                *int32                      `json:"priority,omitempty"`
+	FairnessKey                             *string                     `json:"fairnessKey,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -7890,7 +8428,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //   }
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [60]wire.Field
+		fields [63]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8376,6 +8914,30 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 124, Value: w}
 		i++
 	}
+	if v.DelayStartSeconds != nil {
+		w, err = wire.NewValueI32(*(v.DelayStartSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 126, Value: w}
+		i++
+	}
+	if v.Priority != nil {
+		w, err = wire.NewValueI32(*(v.Priority)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 128, Value: w}
+		i++
+	}
+	if v.FairnessKey != nil {
+		w, err = wire.NewValueString(*(v.FairnessKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -9007,6 +9569,36 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 126:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.DelayStartSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 128:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Priority = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FairnessKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -9021,7 +9613,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [60]string
+	var fields [63]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -9263,6 +9855,18 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("VersionHistoriesEncoding: %v", *(v.VersionHistoriesEncoding))
 		i++
 	}
+	if v.DelayStartSeconds != nil {
+		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
+		i++
+	}
+	if v.Priority != nil {
+		fields[i] = fmt.Sprintf("Priority: %v", *(v.Priority))
+		i++
+	}
+	if v.FairnessKey != nil {
+		fields[i] = fmt.Sprintf("FairnessKey: %v", *(v.FairnessKey))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -9474,6 +10078,15 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.VersionHistoriesEncoding, rhs.VersionHistoriesEncoding) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Priority, rhs.Priority) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FairnessKey, rhs.FairnessKey) {
+		return false
+	}
 
 	return true
 }
@@ -9675,6 +10288,15 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.VersionHistoriesEncoding != nil {
 		enc.AddString("versionHistoriesEncoding", *v.VersionHistoriesEncoding)
 	}
+	if v.DelayStartSeconds != nil {
+		enc.AddInt32("delayStartSeconds", *v.DelayStartSeconds)
+	}
+	if v.Priority != nil {
+		enc.AddInt32("priority", *v.Priority)
+	}
+	if v.FairnessKey != nil {
+		enc.AddString("fairnessKey", *v.FairnessKey)
+	}
 	return err
 }
 
@@ -10578,16 +11200,61 @@ func (v *WorkflowExecutionInfo) IsSetVersionHistoriesEncoding() bool {
 	return v != nil && v.VersionHistoriesEncoding != nil
 }
 
+// GetDelayStartSeconds returns the value of DelayStartSeconds if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetDelayStartSeconds() (o int32) {
+	if v != nil && v.DelayStartSeconds != nil {
+		return *v.DelayStartSeconds
+	}
+
+	return
+}
+
+// IsSetDelayStartSeconds returns true if DelayStartSeconds is not nil.
+func (v *WorkflowExecutionInfo) IsSetDelayStartSeconds() bool {
+	return v != nil && v.DelayStartSeconds != nil
+}
+
+// GetPriority returns the value of Priority if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetPriority() (o int32) {
+	if v != nil && v.Priority != nil {
+		return *v.Priority
+	}
+
+	return
+}
+
+// IsSetPriority returns true if Priority is not nil.
+func (v *WorkflowExecutionInfo) IsSetPriority() bool {
+	return v != nil && v.Priority != nil
+}
+
+// GetFairnessKey returns the value of FairnessKey if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetFairnessKey() (o string) {
+	if v != nil && v.FairnessKey != nil {
+		return *v.FairnessKey
+	}
+
+	return
+}
+
+// IsSetFairnessKey returns true if FairnessKey is not nil.
+func (v *WorkflowExecutionInfo) IsSetFairnessKey() bool {
+	return v != nil && v.FairnessKey != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "cf103e145116be6a247e2fc8cc529c698b4ed3a0",
+	SHA1:     "362328b67aa22e7861a65a122c52fd731a9746fc",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct ReplicationInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") lastEventID\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  40: optional i64 (js.type = \"Long\") currentVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  46: optional map<string, ReplicationInfo> lastReplicationInfo\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  30: optional string domainName\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  32: optional map<string, ReplicationInfo> lastReplicationInfo\n  34: optional binary newRunBranchToken\n  36: optional bool resetWorkflow\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional map<string, shared.IndexedValueType> searchAttributes\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct ReplicationInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") lastEventID\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  40: optional i64 (js.type = \"Long\") currentVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  46: optional map<string, ReplicationInfo> lastReplicationInfo\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional i32 delayStartSeconds\n  128: optional i32 priority\n  130: optional string fairnessKey\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n  72: optional bool paused\n  74: optional i32 priority\n  76: optional string fairnessKey\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  30: optional string domainName\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  16: optional i32 priority\n  17: optional string fairnessKey\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional i32 numReadPartitions\n  20: optional i32 numWritePartitions\n  22: optional double maxTasksPerSecond\n  24: optional i32 maxOutstandingTasks\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  32: optional map<string, ReplicationInfo> lastReplicationInfo\n  34: optional binary newRunBranchToken\n  36: optional bool resetWorkflow\n}"
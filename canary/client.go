@@ -30,10 +30,14 @@ import (
 	"go.uber.org/cadence/activity"
 	"go.uber.org/cadence/client"
 	"go.uber.org/cadence/workflow"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/tchannel"
 )
 
 const (
-	cadenceConfig = "cadence"
+	cadenceConfig   = "cadence"
+	canaryCaller    = "cadence-canary"
+	frontendService = "cadence-frontend"
 )
 
 // cadenceClient is an abstraction on top of
@@ -73,6 +77,29 @@ func (client *cadenceClient) createDomain(name string, desc string, owner string
 	return nil
 }
 
+// newCadenceService builds a client of the cadence frontend, the frontend is
+// reached over TLS when it is enabled in the config
+func newCadenceService(cfg *Cadence) (workflowserviceclient.Interface, error) {
+	ch, err := cfg.TLS.NewChannel(canaryCaller)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := tchannel.NewChannelTransport(tchannel.WithChannel(ch), tchannel.ListenAddr("127.0.0.1:0"))
+	if err != nil {
+		return nil, err
+	}
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: canaryCaller,
+		Outbounds: yarpc.Outbounds{
+			frontendService: {Unary: transport.NewSingleOutbound(cfg.HostPort)},
+		},
+	})
+	if err := dispatcher.Start(); err != nil {
+		return nil, err
+	}
+	return workflowserviceclient.New(dispatcher.ClientConfig(frontendService)), nil
+}
+
 // newCadenceClient builds a cadenceClient from the runtimeContext
 func newCadenceClient(domain string, runtime *RuntimeContext) cadenceClient {
 	tracer := opentracing.GlobalTracer()
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/uber-go/tally"
+	serviceconfig "github.com/uber/cadence/common/service/config"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/config"
 	"go.uber.org/zap"
@@ -36,6 +37,15 @@ type (
 		Domains  []string `yaml:"domains"`
 		Excludes []string `yaml:"excludes"`
 	}
+
+	// Cadence contains the configurable yaml
+	// properties for connecting to the cadence frontend
+	Cadence struct {
+		// HostPort is the host:port of the cadence frontend
+		HostPort string `yaml:"host"`
+		// TLS is the config for connecting to a TLS enabled frontend
+		TLS serviceconfig.TLS `yaml:"tls"`
+	}
 )
 
 const (
@@ -56,6 +66,19 @@ func newCanaryConfig(provider config.Provider) (*Config, error) {
 	return &cfg, nil
 }
 
+// newCadenceConfig loads the config for connecting to the cadence frontend
+func newCadenceConfig(provider config.Provider) (*Cadence, error) {
+	raw := provider.Get(cadenceConfig)
+	var cfg Cadence
+	if err := raw.Populate(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to load cadence configuration with error: %v", err)
+	}
+	if len(cfg.HostPort) == 0 {
+		return nil, fmt.Errorf("missing value for cadence host property")
+	}
+	return &cfg, nil
+}
+
 func (c *Config) validate() error {
 	if len(c.Domains) == 0 {
 		return fmt.Errorf("missing value for domains property")
@@ -71,6 +71,38 @@ func (c *clientImpl) AddSearchAttribute(
 	return client.AddSearchAttribute(ctx, request, opts...)
 }
 
+func (c *clientImpl) RemoveSearchAttribute(
+	ctx context.Context,
+	request *admin.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RemoveSearchAttribute(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListSearchAttributes(
+	ctx context.Context,
+	request *admin.ListSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetSearchAttributesResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListSearchAttributes(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -119,6 +151,86 @@ func (c *clientImpl) CloseShard(
 	return client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResetActivity(
+	ctx context.Context,
+	request *shared.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResetActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateActivityTimeouts(
+	ctx context.Context,
+	request *shared.UpdateActivityTimeoutsRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateActivityTimeouts(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskListLimits(
+	ctx context.Context,
+	request *shared.UpdateTaskListLimitsRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskListLimits(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -151,6 +263,54 @@ func (c *clientImpl) GetWorkflowExecutionRawHistory(
 	return client.GetWorkflowExecutionRawHistory(ctx, request, opts...)
 }
 
+func (c *clientImpl) VerifyArchivedWorkflowExecution(
+	ctx context.Context,
+	request *admin.VerifyArchivedWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.VerifyArchivedWorkflowExecutionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.VerifyArchivedWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *admin.RearchiveWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RearchiveWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *admin.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ImportWorkflowExecutionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -61,6 +61,42 @@ func (c *metricClient) AddSearchAttribute(
 	return err
 }
 
+func (c *metricClient) RemoveSearchAttribute(
+	ctx context.Context,
+	request *admin.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRemoveSearchAttributeScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRemoveSearchAttributeScope, metrics.CadenceClientLatency)
+	err := c.client.RemoveSearchAttribute(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRemoveSearchAttributeScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListSearchAttributes(
+	ctx context.Context,
+	request *admin.ListSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetSearchAttributesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListSearchAttributesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListSearchAttributesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListSearchAttributes(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListSearchAttributesScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -115,6 +151,91 @@ func (c *metricClient) CloseShard(
 	return err
 }
 
+func (c *metricClient) PauseActivity(
+	ctx context.Context,
+	request *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseActivityScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseActivityScope, metrics.CadenceClientLatency)
+	err := c.client.PauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UnpauseActivity(
+	ctx context.Context,
+	request *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUnpauseActivityScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpauseActivityScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpauseActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ResetActivity(
+	ctx context.Context,
+	request *shared.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResetActivityScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResetActivityScope, metrics.CadenceClientLatency)
+	err := c.client.ResetActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResetActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UpdateActivityTimeouts(
+	ctx context.Context,
+	request *shared.UpdateActivityTimeoutsRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityTimeoutsScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateActivityTimeoutsScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateActivityTimeouts(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityTimeoutsScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UpdateTaskListLimits(
+	ctx context.Context,
+	request *shared.UpdateTaskListLimitsRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateTaskListLimitsScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateTaskListLimitsScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateTaskListLimits(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateTaskListLimitsScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -150,3 +271,57 @@ func (c *metricClient) GetWorkflowExecutionRawHistory(
 	}
 	return resp, err
 }
+
+func (c *metricClient) VerifyArchivedWorkflowExecution(
+	ctx context.Context,
+	request *admin.VerifyArchivedWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.VerifyArchivedWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientVerifyArchivedWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientVerifyArchivedWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.VerifyArchivedWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientVerifyArchivedWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *admin.RearchiveWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRearchiveWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRearchiveWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.RearchiveWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRearchiveWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *admin.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ImportWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientImportWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientImportWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ImportWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientImportWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
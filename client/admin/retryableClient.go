@@ -58,6 +58,34 @@ func (c *retryableClient) AddSearchAttribute(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) RemoveSearchAttribute(
+	ctx context.Context,
+	request *admin.RemoveSearchAttributeRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RemoveSearchAttribute(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListSearchAttributes(
+	ctx context.Context,
+	request *admin.ListSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetSearchAttributesResponse, error) {
+
+	var resp *shared.GetSearchAttributesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListSearchAttributes(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
@@ -104,6 +132,71 @@ func (c *retryableClient) CloseShard(
 	return err
 }
 
+func (c *retryableClient) PauseActivity(
+	ctx context.Context,
+	request *shared.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseActivity(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UnpauseActivity(
+	ctx context.Context,
+	request *shared.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpauseActivity(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResetActivity(
+	ctx context.Context,
+	request *shared.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResetActivity(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UpdateActivityTimeouts(
+	ctx context.Context,
+	request *shared.UpdateActivityTimeoutsRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateActivityTimeouts(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UpdateTaskListLimits(
+	ctx context.Context,
+	request *shared.UpdateTaskListLimitsRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateTaskListLimits(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *admin.DescribeWorkflowExecutionRequest,
@@ -135,3 +228,48 @@ func (c *retryableClient) GetWorkflowExecutionRawHistory(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) VerifyArchivedWorkflowExecution(
+	ctx context.Context,
+	request *admin.VerifyArchivedWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.VerifyArchivedWorkflowExecutionResponse, error) {
+
+	var resp *admin.VerifyArchivedWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.VerifyArchivedWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *admin.RearchiveWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RearchiveWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *admin.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*admin.ImportWorkflowExecutionResponse, error) {
+
+	var resp *admin.ImportWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ImportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
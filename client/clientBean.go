@@ -36,7 +36,10 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+	tcg "github.com/uber/tchannel-go"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/middleware"
 	"go.uber.org/yarpc/api/peer"
 	"go.uber.org/yarpc/api/transport"
 	"go.uber.org/yarpc/peer/roundrobin"
@@ -78,8 +81,10 @@ type (
 	}
 
 	dnsDispatcherProvider struct {
-		interval time.Duration
-		logger   log.Logger
+		interval           time.Duration
+		logger             log.Logger
+		tls                config.TLS
+		outboundMiddleware middleware.UnaryOutbound
 	}
 	dnsUpdater struct {
 		interval     time.Duration
@@ -97,6 +102,10 @@ type (
 	aPeer struct {
 		addrPort string
 	}
+	// channelPeerList applies peer list updates to the peers of a tchannel channel
+	channelPeerList struct {
+		peers *tcg.PeerList
+	}
 )
 
 // NewClientBean provides a collection of clients
@@ -237,34 +246,64 @@ func (h *clientBeanImpl) lazyInitMatchingClient(domainIDToName DomainIDToNameFun
 	return client, nil
 }
 
-// NewDNSYarpcDispatcherProvider create a dispatcher provider which handles with IP address
-func NewDNSYarpcDispatcherProvider(logger log.Logger, interval time.Duration) DispatcherProvider {
+// NewDNSYarpcDispatcherProvider create a dispatcher provider which handles with IP address,
+// connections are made over TLS when it is enabled in the config and every request
+// passes through the outbound middleware when it is not nil
+func NewDNSYarpcDispatcherProvider(
+	logger log.Logger,
+	interval time.Duration,
+	tls config.TLS,
+	outboundMiddleware middleware.UnaryOutbound,
+) DispatcherProvider {
 	if interval <= 0 {
 		interval = defaultRefreshInterval
 	}
 	return &dnsDispatcherProvider{
-		interval: interval,
-		logger:   logger,
+		interval:           interval,
+		logger:             logger,
+		tls:                tls,
+		outboundMiddleware: outboundMiddleware,
 	}
 }
 
 func (p *dnsDispatcherProvider) Get(serviceName string, address string) (*yarpc.Dispatcher, error) {
-	tchanTransport, err := tchannel.NewTransport(
-		tchannel.ServiceName(serviceName),
-		// this aim to get rid of the annoying popup about accepting incoming network connections
-		tchannel.ListenAddr("127.0.0.1:0"),
-	)
-	if err != nil {
-		return nil, err
+	var peerList peer.List
+	var outbound transport.UnaryOutbound
+	if p.tls.Enabled {
+		// the peer based tchannel transport cannot dial TLS connections,
+		// so the channel transport is used with the peers of its channel
+		ch, err := p.tls.NewChannel(serviceName)
+		if err != nil {
+			return nil, err
+		}
+		tchanTransport, err := tchannel.NewChannelTransport(
+			tchannel.WithChannel(ch),
+			tchannel.ListenAddr("127.0.0.1:0"),
+		)
+		if err != nil {
+			return nil, err
+		}
+		peerList = &channelPeerList{peers: ch.Peers()}
+		outbound = tchanTransport.NewOutbound()
+	} else {
+		tchanTransport, err := tchannel.NewTransport(
+			tchannel.ServiceName(serviceName),
+			// this aim to get rid of the annoying popup about accepting incoming network connections
+			tchannel.ListenAddr("127.0.0.1:0"),
+		)
+		if err != nil {
+			return nil, err
+		}
+		roundRobinList := roundrobin.New(tchanTransport)
+		peerList = roundRobinList
+		outbound = tchanTransport.NewOutbound(roundRobinList)
 	}
 
-	peerList := roundrobin.New(tchanTransport)
 	peerListUpdater, err := newDNSUpdater(peerList, address, p.interval, p.logger)
 	if err != nil {
 		return nil, err
 	}
 	peerListUpdater.Start()
-	outbound := tchanTransport.NewOutbound(peerList)
 
 	p.logger.Info("Creating RPC dispatcher outbound", tag.Service(serviceName), tag.Address(address))
 
@@ -277,6 +316,9 @@ func (p *dnsDispatcherProvider) Get(serviceName string, address string) (*yarpc.
 				ServiceName: serviceName,
 			},
 		},
+		OutboundMiddleware: yarpc.OutboundMiddleware{
+			Unary: p.outboundMiddleware,
+		},
 	})
 
 	if err := dispatcher.Start(); err != nil {
@@ -374,6 +416,18 @@ func (d *dnsUpdater) refresh() (*dnsRefreshResult, error) {
 	}, nil
 }
 
+func (l *channelPeerList) Update(updates peer.ListUpdates) error {
+	for _, id := range updates.Additions {
+		l.peers.Add(id.Identifier())
+	}
+	for _, id := range updates.Removals {
+		if err := l.peers.Remove(id.Identifier()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a aPeer) Identifier() string {
 	return a.addrPort
 }
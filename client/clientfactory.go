@@ -162,7 +162,8 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 		timeout,
 		longPollTimeout,
 		common.NewClientCache(keyResolver, clientProvider),
-		matching.NewLoadBalancer(domainIDToName, cf.dynConfig),
+		domainIDToName,
+		cf.dynConfig,
 	)
 
 	if cf.metricsClient != nil {
@@ -254,6 +254,7 @@ func (c *clientImpl) CountWorkflowExecutions(
 
 func (c *clientImpl) GetSearchAttributes(
 	ctx context.Context,
+	request *shared.GetSearchAttributesRequest,
 	opts ...yarpc.CallOption,
 ) (*shared.GetSearchAttributesResponse, error) {
 
@@ -264,7 +265,7 @@ func (c *clientImpl) GetSearchAttributes(
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.GetSearchAttributes(ctx, opts...)
+	return client.GetSearchAttributes(ctx, request, opts...)
 }
 
 func (c *clientImpl) PollForActivityTask(
@@ -315,6 +316,22 @@ func (c *clientImpl) QueryWorkflow(
 	return client.QueryWorkflow(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *shared.RecordActivityTaskHeartbeatRequest,
@@ -705,3 +722,131 @@ func (c *clientImpl) ReapplyEvents(
 	defer cancel()
 	return client.ReapplyEvents(ctx, request, opts...)
 }
+
+func (c *clientImpl) CreateSchedule(
+	ctx context.Context,
+	request *shared.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CreateSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeSchedule(
+	ctx context.Context,
+	request *shared.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeScheduleResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateSchedule(
+	ctx context.Context,
+	request *shared.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseSchedule(
+	ctx context.Context,
+	request *shared.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseSchedule(
+	ctx context.Context,
+	request *shared.UnpauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteSchedule(
+	ctx context.Context,
+	request *shared.DeleteScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) BackfillSchedule(
+	ctx context.Context,
+	request *shared.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.BackfillSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListSchedules(
+	ctx context.Context,
+	request *shared.ListSchedulesRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListSchedulesResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListSchedules(ctx, request, opts...)
+}
@@ -262,13 +262,14 @@ func (c *metricClient) CountWorkflowExecutions(
 
 func (c *metricClient) GetSearchAttributes(
 	ctx context.Context,
+	request *shared.GetSearchAttributesRequest,
 	opts ...yarpc.CallOption,
 ) (*shared.GetSearchAttributesResponse, error) {
 
 	c.metricsClient.IncCounter(metrics.FrontendClientGetSearchAttributesScope, metrics.CadenceClientRequests)
 
 	sw := c.metricsClient.StartTimer(metrics.FrontendClientGetSearchAttributesScope, metrics.CadenceClientLatency)
-	resp, err := c.client.GetSearchAttributes(ctx, opts...)
+	resp, err := c.client.GetSearchAttributes(ctx, request, opts...)
 	sw.Stop()
 
 	if err != nil {
@@ -331,6 +332,24 @@ func (c *metricClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *shared.RecordActivityTaskHeartbeatRequest,
@@ -741,3 +760,139 @@ func (c *metricClient) ReapplyEvents(
 	}
 	return err
 }
+
+func (c *metricClient) CreateSchedule(
+	ctx context.Context,
+	request *shared.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientCreateScheduleScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientCreateScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.CreateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientCreateScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DescribeSchedule(
+	ctx context.Context,
+	request *shared.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientDescribeScheduleScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientDescribeScheduleScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientDescribeScheduleScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateSchedule(
+	ctx context.Context,
+	request *shared.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUpdateScheduleScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUpdateScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) PauseSchedule(
+	ctx context.Context,
+	request *shared.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientPauseScheduleScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientPauseScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.PauseSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientPauseScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UnpauseSchedule(
+	ctx context.Context,
+	request *shared.UnpauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUnpauseScheduleScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUnpauseScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUnpauseScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DeleteSchedule(
+	ctx context.Context,
+	request *shared.DeleteScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientDeleteScheduleScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientDeleteScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientDeleteScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) BackfillSchedule(
+	ctx context.Context,
+	request *shared.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientBackfillScheduleScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientBackfillScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.BackfillSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientBackfillScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ListSchedules(
+	ctx context.Context,
+	request *shared.ListSchedulesRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListSchedulesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientListSchedulesScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientListSchedulesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListSchedules(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientListSchedulesScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
@@ -236,13 +236,14 @@ func (c *retryableClient) CountWorkflowExecutions(
 
 func (c *retryableClient) GetSearchAttributes(
 	ctx context.Context,
+	request *shared.GetSearchAttributesRequest,
 	opts ...yarpc.CallOption,
 ) (*shared.GetSearchAttributesResponse, error) {
 
 	var resp *shared.GetSearchAttributesResponse
 	op := func() error {
 		var err error
-		resp, err = c.client.GetSearchAttributes(ctx, opts...)
+		resp, err = c.client.GetSearchAttributes(ctx, request, opts...)
 		return err
 	}
 	err := backoff.Retry(op, c.policy, c.isRetryable)
@@ -297,6 +298,22 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	var resp *shared.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *shared.RecordActivityTaskHeartbeatRequest,
@@ -610,3 +627,107 @@ func (c *retryableClient) ReapplyEvents(
 	}
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
+
+func (c *retryableClient) CreateSchedule(
+	ctx context.Context,
+	request *shared.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.CreateSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DescribeSchedule(
+	ctx context.Context,
+	request *shared.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeScheduleResponse, error) {
+
+	var resp *shared.DescribeScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateSchedule(
+	ctx context.Context,
+	request *shared.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) PauseSchedule(
+	ctx context.Context,
+	request *shared.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UnpauseSchedule(
+	ctx context.Context,
+	request *shared.UnpauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpauseSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DeleteSchedule(
+	ctx context.Context,
+	request *shared.DeleteScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) BackfillSchedule(
+	ctx context.Context,
+	request *shared.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.BackfillSchedule(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ListSchedules(
+	ctx context.Context,
+	request *shared.ListSchedulesRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListSchedulesResponse, error) {
+
+	var resp *shared.ListSchedulesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListSchedules(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
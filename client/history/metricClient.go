@@ -559,6 +559,24 @@ func (c *metricClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) ReapplyEvents(
 	ctx context.Context,
 	request *h.ReapplyEventsRequest,
@@ -575,3 +593,122 @@ func (c *metricClient) ReapplyEvents(
 	}
 	return err
 }
+
+func (c *metricClient) PauseActivity(
+	ctx context.Context,
+	request *h.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseActivityScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseActivityScope, metrics.CadenceClientLatency)
+	err := c.client.PauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UnpauseActivity(
+	ctx context.Context,
+	request *h.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUnpauseActivityScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUnpauseActivityScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUnpauseActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ResetActivity(
+	ctx context.Context,
+	request *h.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientResetActivityScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetActivityScope, metrics.CadenceClientLatency)
+	err := c.client.ResetActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResetActivityScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UpdateActivityTimeouts(
+	ctx context.Context,
+	request *h.UpdateActivityTimeoutsRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateActivityTimeoutsScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateActivityTimeoutsScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateActivityTimeouts(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateActivityTimeoutsScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UpdateWorkflowSearchAttributes(
+	ctx context.Context,
+	request *h.UpdateWorkflowSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowSearchAttributesScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateWorkflowSearchAttributesScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateWorkflowSearchAttributes(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowSearchAttributesScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *h.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientImportWorkflowExecutionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientImportWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.ImportWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientImportWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
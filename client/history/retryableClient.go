@@ -496,6 +496,22 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+	var resp *shared.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *h.ReapplyEventsRequest,
@@ -508,3 +524,94 @@ func (c *retryableClient) ReapplyEvents(
 
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
+
+func (c *retryableClient) PauseActivity(
+	ctx context.Context,
+	request *h.PauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PauseActivity(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UnpauseActivity(
+	ctx context.Context,
+	request *h.UnpauseActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UnpauseActivity(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ResetActivity(
+	ctx context.Context,
+	request *h.ResetActivityRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResetActivity(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UpdateActivityTimeouts(
+	ctx context.Context,
+	request *h.UpdateActivityTimeoutsRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateActivityTimeouts(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) UpdateWorkflowSearchAttributes(
+	ctx context.Context,
+	request *h.UpdateWorkflowSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateWorkflowSearchAttributes(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *h.ImportWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ImportWorkflowExecution(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
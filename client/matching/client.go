@@ -29,6 +29,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"go.uber.org/yarpc"
 )
 
@@ -53,14 +54,25 @@ func NewClient(
 	timeout time.Duration,
 	longPollTimeout time.Duration,
 	clients common.ClientCache,
-	lb LoadBalancer,
+	domainIDToName func(string) (string, error),
+	dc *dynamicconfig.Collection,
 ) Client {
-	return &clientImpl{
+	client := &clientImpl{
 		timeout:         timeout,
 		longPollTimeout: longPollTimeout,
 		clients:         clients,
-		loadBalancer:    lb,
 	}
+	// partition config is looked up directly from the root partition,
+	// so the provider uses this client without any load balancing
+	client.loadBalancer = NewLoadBalancer(NewPartitionConfigProvider(
+		client,
+		domainIDToName,
+		dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnablePartitionAutoScale, false),
+		dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval, 10*time.Second),
+		dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
+		dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
+	))
+	return client
 }
 
 func (c *clientImpl) AddActivityTask(
@@ -198,6 +210,28 @@ func (c *clientImpl) DescribeTaskList(ctx context.Context, request *m.DescribeTa
 	return client.DescribeTaskList(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateTaskListLimits(ctx context.Context, request *m.UpdateTaskListLimitsRequest, opts ...yarpc.CallOption) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTasklist(request.UpdateRequest.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskListLimits(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReleaseTask(ctx context.Context, request *m.ReleaseTaskRequest, opts ...yarpc.CallOption) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTasklist(request.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReleaseTask(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -26,7 +26,6 @@ import (
 	"strings"
 
 	"github.com/uber/cadence/.gen/go/shared"
-	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -59,9 +58,7 @@ type (
 	}
 
 	defaultLoadBalancer struct {
-		nReadPartitions  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
-		nWritePartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
-		domainIDToName   func(string) (string, error)
+		provider PartitionConfigProvider
 	}
 )
 
@@ -72,13 +69,10 @@ const (
 // NewLoadBalancer returns an instance of matching load balancer that
 // can help distribute api calls across task list partitions
 func NewLoadBalancer(
-	domainIDToName func(string) (string, error),
-	dc *dynamicconfig.Collection,
+	provider PartitionConfigProvider,
 ) LoadBalancer {
 	return &defaultLoadBalancer{
-		domainIDToName:   domainIDToName,
-		nReadPartitions:  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
-		nWritePartitions: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
+		provider: provider,
 	}
 }
 
@@ -88,7 +82,7 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	taskListType int,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom, lb.nWritePartitions)
+	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom, lb.provider.GetNumberOfWritePartitions)
 }
 
 func (lb *defaultLoadBalancer) PickReadPartition(
@@ -97,7 +91,7 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	taskListType int,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom, lb.nReadPartitions)
+	return lb.pickPartition(domainID, taskList, taskListType, forwardedFrom, lb.provider.GetNumberOfReadPartitions)
 }
 
 func (lb *defaultLoadBalancer) pickPartition(
@@ -105,7 +99,7 @@ func (lb *defaultLoadBalancer) pickPartition(
 	taskList shared.TaskList,
 	taskListType int,
 	forwardedFrom string,
-	nPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters,
+	nPartitions func(domainID string, taskList shared.TaskList, taskListType int) int,
 ) string {
 
 	if forwardedFrom != "" || taskList.GetKind() == shared.TaskListKindSticky {
@@ -117,12 +111,7 @@ func (lb *defaultLoadBalancer) pickPartition(
 		return taskList.GetName()
 	}
 
-	domainName, err := lb.domainIDToName(domainID)
-	if err != nil {
-		return taskList.GetName()
-	}
-
-	n := nPartitions(domainName, taskList.GetName(), taskListType)
+	n := nPartitions(domainID, taskList, taskListType)
 	if n <= 0 {
 		return taskList.GetName()
 	}
@@ -215,6 +215,40 @@ func (c *metricClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *metricClient) UpdateTaskListLimits(
+	ctx context.Context,
+	request *m.UpdateTaskListLimitsRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListLimitsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientUpdateTaskListLimitsScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateTaskListLimits(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListLimitsScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ReleaseTask(
+	ctx context.Context,
+	request *m.ReleaseTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientReleaseTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientReleaseTaskScope, metrics.CadenceClientLatency)
+	err := c.client.ReleaseTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientReleaseTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) emitForwardedFromStats(scope int, forwardedFrom string, taskList *workflow.TaskList) {
 	if taskList == nil {
 		return
@@ -0,0 +1,191 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	m "github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// PartitionConfigProvider is the interface for implementers of
+	// component that provides the number of partitions of a task list.
+	// When partition auto scale is enabled, the partition count is owned
+	// by the root partition of the task list and can change at runtime,
+	// otherwise it comes from dynamic config
+	PartitionConfigProvider interface {
+		// GetNumberOfReadPartitions returns the number of partitions pollers can be sent to
+		GetNumberOfReadPartitions(domainID string, taskList shared.TaskList, taskListType int) int
+		// GetNumberOfWritePartitions returns the number of partitions tasks can be added to
+		GetNumberOfWritePartitions(domainID string, taskList shared.TaskList, taskListType int) int
+	}
+
+	partitionConfigProviderImpl struct {
+		client           Client
+		domainIDToName   func(string) (string, error)
+		enableAutoScale  dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		refreshInterval  dynamicconfig.DurationPropertyFn
+		nReadPartitions  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		nWritePartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		configCache      cache.Cache
+	}
+
+	partitionConfigKey struct {
+		domainID     string
+		name         string
+		taskListType int
+	}
+
+	partitionConfigEntry struct {
+		numReadPartitions  int32
+		numWritePartitions int32
+		lastRefreshTime    int64 // unix nanos
+		refreshing         int32
+	}
+)
+
+const (
+	partitionConfigCacheInitialSize = 128
+	partitionConfigCacheMaxSize     = 10000
+	partitionConfigRefreshTimeout   = 5 * time.Second
+)
+
+// NewPartitionConfigProvider returns an instance of partition config provider that
+// looks up the partition config of task lists from their root partition through
+// the given client
+func NewPartitionConfigProvider(
+	client Client,
+	domainIDToName func(string) (string, error),
+	enableAutoScale dynamicconfig.BoolPropertyFnWithTaskListInfoFilters,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	nReadPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters,
+	nWritePartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters,
+) PartitionConfigProvider {
+	return &partitionConfigProviderImpl{
+		client:           client,
+		domainIDToName:   domainIDToName,
+		enableAutoScale:  enableAutoScale,
+		refreshInterval:  refreshInterval,
+		nReadPartitions:  nReadPartitions,
+		nWritePartitions: nWritePartitions,
+		configCache: cache.New(partitionConfigCacheMaxSize, &cache.Options{
+			InitialCapacity: partitionConfigCacheInitialSize,
+		}),
+	}
+}
+
+func (p *partitionConfigProviderImpl) GetNumberOfReadPartitions(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+) int {
+	return p.getNumberOfPartitions(domainID, taskList, taskListType, p.nReadPartitions, func(entry *partitionConfigEntry) int {
+		return int(atomic.LoadInt32(&entry.numReadPartitions))
+	})
+}
+
+func (p *partitionConfigProviderImpl) GetNumberOfWritePartitions(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+) int {
+	return p.getNumberOfPartitions(domainID, taskList, taskListType, p.nWritePartitions, func(entry *partitionConfigEntry) int {
+		return int(atomic.LoadInt32(&entry.numWritePartitions))
+	})
+}
+
+func (p *partitionConfigProviderImpl) getNumberOfPartitions(
+	domainID string,
+	taskList shared.TaskList,
+	taskListType int,
+	nPartitions dynamicconfig.IntPropertyFnWithTaskListInfoFilters,
+	getter func(entry *partitionConfigEntry) int,
+) int {
+
+	domainName, err := p.domainIDToName(domainID)
+	if err != nil {
+		return 1
+	}
+	if !p.enableAutoScale(domainName, taskList.GetName(), taskListType) {
+		return nPartitions(domainName, taskList.GetName(), taskListType)
+	}
+
+	key := partitionConfigKey{domainID: domainID, name: taskList.GetName(), taskListType: taskListType}
+	entry, ok := p.configCache.Get(key).(*partitionConfigEntry)
+	if !ok {
+		// until the root partition answers, fall back to the statically configured value
+		entry = &partitionConfigEntry{
+			numReadPartitions:  int32(p.nReadPartitions(domainName, taskList.GetName(), taskListType)),
+			numWritePartitions: int32(p.nWritePartitions(domainName, taskList.GetName(), taskListType)),
+		}
+		existing, err := p.configCache.PutIfNotExist(key, entry)
+		if err != nil {
+			return nPartitions(domainName, taskList.GetName(), taskListType)
+		}
+		entry = existing.(*partitionConfigEntry)
+	}
+
+	lastRefreshTime := time.Unix(0, atomic.LoadInt64(&entry.lastRefreshTime))
+	if time.Since(lastRefreshTime) >= p.refreshInterval() && atomic.CompareAndSwapInt32(&entry.refreshing, 0, 1) {
+		go p.refresh(key, entry)
+	}
+	return getter(entry)
+}
+
+// refresh looks up the partition config from the root partition of the task list.
+// On failure the previous value is kept until the next refresh interval
+func (p *partitionConfigProviderImpl) refresh(key partitionConfigKey, entry *partitionConfigEntry) {
+	defer atomic.StoreInt32(&entry.refreshing, 0)
+	atomic.StoreInt64(&entry.lastRefreshTime, time.Now().UnixNano())
+
+	taskListType := shared.TaskListTypeDecision
+	if key.taskListType == persistence.TaskListTypeActivity {
+		taskListType = shared.TaskListTypeActivity
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), partitionConfigRefreshTimeout)
+	defer cancel()
+	resp, err := p.client.DescribeTaskList(ctx, &m.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(key.domainID),
+		DescRequest: &shared.DescribeTaskListRequest{
+			TaskList: &shared.TaskList{
+				Name: common.StringPtr(key.name),
+				Kind: common.TaskListKindPtr(shared.TaskListKindNormal),
+			},
+			TaskListType: &taskListType,
+		},
+	})
+	if err != nil || resp.PartitionConfig == nil {
+		return
+	}
+
+	numWritePartitions := common.MaxInt(1, int(resp.PartitionConfig.GetNumWritePartitions()))
+	numReadPartitions := common.MaxInt(numWritePartitions, int(resp.PartitionConfig.GetNumReadPartitions()))
+	atomic.StoreInt32(&entry.numReadPartitions, int32(numReadPartitions))
+	atomic.StoreInt32(&entry.numWritePartitions, int32(numWritePartitions))
+}
@@ -156,3 +156,27 @@ func (c *retryableClient) DescribeTaskList(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateTaskListLimits(
+	ctx context.Context,
+	request *m.UpdateTaskListLimitsRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.UpdateTaskListLimits(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ReleaseTask(
+	ctx context.Context,
+	request *m.ReleaseTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.ReleaseTask(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
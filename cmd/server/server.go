@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -126,6 +127,7 @@ func (s *server) startService() common.Daemon {
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
 
 	params.DCRedirectionPolicy = s.cfg.DCRedirectionPolicy
+	params.Authorization = s.cfg.Authorization
 
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
 
@@ -141,7 +143,17 @@ func (s *server) startService() common.Daemon {
 	)
 
 	if s.cfg.PublicClient.HostPort != "" {
-		params.DispatcherProvider = client.NewDNSYarpcDispatcherProvider(params.Logger, s.cfg.PublicClient.RefreshInterval)
+		// requests to the frontends are authenticated as the service when a token key is configured
+		authMiddleware, err := authorization.NewTokenOutboundMiddleware(s.cfg.Authorization, params.Name)
+		if err != nil {
+			log.Fatalf("error creating authorization middleware: %v", err)
+		}
+		params.DispatcherProvider = client.NewDNSYarpcDispatcherProvider(
+			params.Logger,
+			s.cfg.PublicClient.RefreshInterval,
+			svcCfg.RPC.TLS,
+			authMiddleware,
+		)
 	} else {
 		log.Fatalf("need to provide an endpoint config for PublicClient")
 	}
@@ -34,6 +34,7 @@ type (
 		Port() string
 		Username() string
 		Password() string
+		Query() url.Values
 		String() string
 	}
 
@@ -88,6 +89,10 @@ func (u *uri) Password() string {
 	return password
 }
 
+func (u *uri) Query() url.Values {
+	return u.url.Query()
+}
+
 func (u *uri) String() string {
 	return u.url.String()
 }
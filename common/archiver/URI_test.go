@@ -52,6 +52,7 @@ func (s *URISuite) TestURI() {
 		port      string
 		username  string
 		password  string
+		query     map[string]string
 	}{
 		{
 			URIString: "",
@@ -106,6 +107,13 @@ func (s *URISuite) TestURI() {
 			username:  "person",
 			password:  "password",
 		},
+		{
+			URIString: "file:///absolute/path/to/dir?codec=gzip",
+			valid:     true,
+			scheme:    "file",
+			path:      "/absolute/path/to/dir",
+			query:     map[string]string{"codec": "gzip"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -122,5 +130,8 @@ func (s *URISuite) TestURI() {
 		s.Equal(tc.port, URI.Port())
 		s.Equal(tc.username, URI.Username())
 		s.Equal(tc.password, URI.Password())
+		for key, value := range tc.query {
+			s.Equal(value, URI.Query().Get(key))
+		}
 	}
 }
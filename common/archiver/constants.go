@@ -53,10 +53,18 @@ var (
 	ErrContextTimeout = errors.New("archive aborted because context timed out")
 	// ErrInvalidGetHistoryRequest is the error for invalid GetHistory request
 	ErrInvalidGetHistoryRequest = errors.New("get archived history request is invalid")
+	// ErrInvalidVerifyHistoryRequest is the error for invalid VerifyHistory request
+	ErrInvalidVerifyHistoryRequest = errors.New("verify archived history request is invalid")
+	// ErrInvalidGetMutableStateSummaryRequest is the error for invalid GetMutableStateSummary request
+	ErrInvalidGetMutableStateSummaryRequest = errors.New("get archived mutable state summary request is invalid")
 	// ErrInvalidQueryVisibilityRequest is the error for invalid Query Visibility request
 	ErrInvalidQueryVisibilityRequest = errors.New("query visiblity request is invalid")
 	// ErrNextPageTokenCorrupted is the error for corrupted GetHistory token
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
 	ErrHistoryNotExist = errors.New("requested workflow history does not exist")
+	// ErrMutableStateSummaryNotExist is the error for non-exist mutable state summary
+	ErrMutableStateSummaryNotExist = errors.New("requested workflow mutable state summary does not exist")
+	// ErrHistoryCorrupted is the error for archived history which does not match its checksum or cannot be decoded
+	ErrHistoryCorrupted = errors.New("archived workflow history is corrupted")
 )
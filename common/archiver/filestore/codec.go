@@ -0,0 +1,185 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/ioutil"
+	"sync"
+
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
+)
+
+const (
+	// CodecNone stores history batches as plain encoded json
+	CodecNone = "none"
+	// CodecGzip compresses history batches with gzip
+	CodecGzip = "gzip"
+	// CodecZstd compresses history batches with zstd
+	CodecZstd = "zstd"
+	// CodecSnappy compresses history batches with snappy
+	CodecSnappy = "snappy"
+
+	// codecQueryKey is the URI query parameter used to select a codec for a domain,
+	// e.g. file:///tmp/cadence_archival/development?codec=gzip
+	codecQueryKey = "codec"
+)
+
+var (
+	// historyFileHeader prefixes every compressed history file and is followed by the
+	// codec name and a newline. Files without it are legacy uncompressed json.
+	historyFileHeader = []byte("cadence-history-codec:")
+
+	errUnknownHistoryCodec    = errors.New("unknown history codec")
+	errMalformedHistoryHeader = errors.New("malformed history file header")
+
+	codecsLock sync.RWMutex
+	codecs     = map[string]HistoryCodec{}
+)
+
+type (
+	// HistoryCodec compresses and decompresses archived history files
+	HistoryCodec interface {
+		Name() string
+		Encode(data []byte) ([]byte, error)
+		Decode(data []byte) ([]byte, error)
+	}
+
+	gzipCodec   struct{}
+	zstdCodec   struct{}
+	snappyCodec struct{}
+)
+
+func init() {
+	RegisterHistoryCodec(&gzipCodec{})
+	RegisterHistoryCodec(&zstdCodec{})
+	RegisterHistoryCodec(&snappyCodec{})
+}
+
+// RegisterHistoryCodec makes a codec available to the filestore history archiver under its name.
+// Registering a codec with an existing name replaces the previous one.
+func RegisterHistoryCodec(codec HistoryCodec) {
+	codecsLock.Lock()
+	defer codecsLock.Unlock()
+	codecs[codec.Name()] = codec
+}
+
+// getHistoryCodec returns the codec registered under name, nil is returned for CodecNone
+func getHistoryCodec(name string) (HistoryCodec, error) {
+	if name == "" || name == CodecNone {
+		return nil, nil
+	}
+	codecsLock.RLock()
+	defer codecsLock.RUnlock()
+	codec, ok := codecs[name]
+	if !ok {
+		return nil, errUnknownHistoryCodec
+	}
+	return codec, nil
+}
+
+// compressHistory encodes data with codec and prepends the header recording the codec name.
+// Data is returned unchanged when codec is nil so that it stays readable by older versions.
+func compressHistory(codec HistoryCodec, data []byte) ([]byte, error) {
+	if codec == nil {
+		return data, nil
+	}
+	encoded, err := codec.Encode(data)
+	if err != nil {
+		return nil, err
+	}
+	header := append(append([]byte{}, historyFileHeader...), codec.Name()...)
+	header = append(header, '\n')
+	return append(header, encoded...), nil
+}
+
+// decompressHistory decodes data using the codec recorded in its header,
+// data without a header is returned unchanged.
+func decompressHistory(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, historyFileHeader) {
+		return data, nil
+	}
+	data = data[len(historyFileHeader):]
+	newline := bytes.IndexByte(data, '\n')
+	if newline <= 0 {
+		return nil, errMalformedHistoryHeader
+	}
+	codec, err := getHistoryCodec(string(data[:newline]))
+	if err != nil {
+		return nil, err
+	}
+	if codec == nil {
+		return data[newline+1:], nil
+	}
+	return codec.Decode(data[newline+1:])
+}
+
+func (c *gzipCodec) Name() string {
+	return CodecGzip
+}
+
+func (c *gzipCodec) Encode(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *gzipCodec) Decode(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+func (c *zstdCodec) Name() string {
+	return CodecZstd
+}
+
+func (c *zstdCodec) Encode(data []byte) ([]byte, error) {
+	return zstd.Compress(nil, data)
+}
+
+func (c *zstdCodec) Decode(data []byte) ([]byte, error) {
+	return zstd.Decompress(nil, data)
+}
+
+func (c *snappyCodec) Name() string {
+	return CodecSnappy
+}
+
+func (c *snappyCodec) Encode(data []byte) ([]byte, error) {
+	return snappy.Encode(nil, data), nil
+}
+
+func (c *snappyCodec) Decode(data []byte) ([]byte, error) {
+	return snappy.Decode(nil, data)
+}
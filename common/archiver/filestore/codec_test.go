@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CodecSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestCodecSuite(t *testing.T) {
+	suite.Run(t, new(CodecSuite))
+}
+
+func (s *CodecSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *CodecSuite) TestGetHistoryCodec() {
+	codec, err := getHistoryCodec("")
+	s.NoError(err)
+	s.Nil(codec)
+
+	codec, err = getHistoryCodec(CodecNone)
+	s.NoError(err)
+	s.Nil(codec)
+
+	for _, name := range []string{CodecGzip, CodecZstd, CodecSnappy} {
+		codec, err = getHistoryCodec(name)
+		s.NoError(err)
+		s.Equal(name, codec.Name())
+	}
+
+	_, err = getHistoryCodec("unknown")
+	s.Equal(errUnknownHistoryCodec, err)
+}
+
+func (s *CodecSuite) TestCompressAndDecompress() {
+	data := bytes.Repeat([]byte(`[{"events":[{"eventId":1}]}]`), 100)
+	for _, name := range []string{CodecGzip, CodecZstd, CodecSnappy} {
+		codec, err := getHistoryCodec(name)
+		s.NoError(err)
+		compressed, err := compressHistory(codec, data)
+		s.NoError(err)
+		s.True(bytes.HasPrefix(compressed, historyFileHeader))
+		s.True(len(compressed) < len(data))
+
+		decompressed, err := decompressHistory(compressed)
+		s.NoError(err)
+		s.Equal(data, decompressed)
+	}
+}
+
+func (s *CodecSuite) TestCompressAndDecompress_NoCodec() {
+	data := []byte(`[{"events":[{"eventId":1}]}]`)
+	compressed, err := compressHistory(nil, data)
+	s.NoError(err)
+	s.Equal(data, compressed)
+
+	decompressed, err := decompressHistory(data)
+	s.NoError(err)
+	s.Equal(data, decompressed)
+}
+
+func (s *CodecSuite) TestDecompress_Fail() {
+	_, err := decompressHistory(historyFileHeader)
+	s.Equal(errMalformedHistoryHeader, err)
+
+	_, err = decompressHistory(append(append([]byte{}, historyFileHeader...), []byte("unknown\ndata")...))
+	s.Equal(errUnknownHistoryCodec, err)
+
+	_, err = decompressHistory(append(append([]byte{}, historyFileHeader...), []byte("gzip\nnot gzip")...))
+	s.Error(err)
+}
+
+type testCodec struct{}
+
+func (c *testCodec) Name() string                       { return "test" }
+func (c *testCodec) Encode(data []byte) ([]byte, error) { return append([]byte("x"), data...), nil }
+func (c *testCodec) Decode(data []byte) ([]byte, error) { return data[1:], nil }
+
+func (s *CodecSuite) TestRegisterHistoryCodec() {
+	RegisterHistoryCodec(&testCodec{})
+	codec, err := getHistoryCodec("test")
+	s.NoError(err)
+	compressed, err := compressHistory(codec, []byte("data"))
+	s.NoError(err)
+	decompressed, err := decompressHistory(compressed)
+	s.NoError(err)
+	s.Equal([]byte("data"), decompressed)
+}
@@ -22,7 +22,10 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(domainID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories stored in that file are encoded in JSON format and optionally
+// compressed by the codec selected with the "codec" query parameter of the URI, falling back to
+// the codec configured for the provider. The codec name is recorded in the file header so files
+// written with any codec, as well as uncompressed files, can always be read back.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -32,6 +35,14 @@
 // of NextPageToken or close failover version is specified, the highest close failover version
 // will be picked.
 
+// Along with each history file, Archive() writes a file with the same name and a .checksum suffix
+// holding the checksum of the history file. The Verify() method uses it to detect archives which
+// have been modified or truncated since they were written.
+
+// If the request carries the final mutable state summary of the workflow run, Archive() also writes
+// it to a file with the same name as the history file and a .summary suffix, which is returned by
+// GetMutableStateSummary() so that archived runs can still be described.
+
 package filestore
 
 import (
@@ -53,9 +64,13 @@ const (
 	// URIScheme is the scheme for the filestore implementation
 	URIScheme = "file"
 
-	errEncodeHistory = "failed to encode history batches"
-	errMakeDirectory = "failed to make directory"
-	errWriteFile     = "failed to write history to file"
+	errEncodeHistory   = "failed to encode history batches"
+	errCompressHistory = "failed to compress history batches"
+	errMakeDirectory   = "failed to make directory"
+	errWriteFile       = "failed to write history to file"
+	errWriteChecksum   = "failed to write history checksum to file"
+	errEncodeSummary   = "failed to encode mutable state summary"
+	errWriteSummary    = "failed to write mutable state summary to file"
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
@@ -70,6 +85,7 @@ type (
 		container *archiver.HistoryBootstrapContainer
 		fileMode  os.FileMode
 		dirMode   os.FileMode
+		codec     HistoryCodec
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -79,6 +95,10 @@ type (
 		CloseFailoverVersion int64
 		NextBatchIdx         int
 	}
+
+	historyChecksum struct {
+		Checksum string
+	}
 )
 
 // NewHistoryArchiver creates a new archiver.HistoryArchiver based on filestore
@@ -102,10 +122,15 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	codec, err := getHistoryCodec(config.Compression)
+	if err != nil {
+		return nil, err
+	}
 	return &historyArchiver{
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		codec:           codec,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -167,6 +192,17 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	codec, err := h.getCodec(URI)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+	encodedHistoryBatches, err = compressHistory(codec, encodedHistoryBatches)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errCompressHistory), tag.Error(err))
+		return err
+	}
+
 	dirPath := URI.Path()
 	if err = mkdirAll(dirPath, h.dirMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
@@ -179,6 +215,30 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	encodedChecksum, err := encode(&historyChecksum{Checksum: archiver.ComputeChecksum(encodedHistoryBatches)})
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+	checksumFilename := constructHistoryChecksumFilename(request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := writeFile(path.Join(dirPath, checksumFilename), encodedChecksum, h.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errWriteChecksum), tag.Error(err))
+		return err
+	}
+
+	if request.MutableStateSummary != nil {
+		encodedSummary, err := encode(request.MutableStateSummary)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeSummary), tag.Error(err))
+			return err
+		}
+		summaryFilename := constructMutableStateSummaryFilename(request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+		if err := writeFile(path.Join(dirPath, summaryFilename), encodedSummary, h.fileMode); err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errWriteSummary), tag.Error(err))
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -216,7 +276,7 @@ func (h *historyArchiver) Get(
 			NextBatchIdx:         0,
 		}
 	} else {
-		highestVersion, err := getHighestVersion(dirPath, request)
+		highestVersion, err := getHighestVersion(dirPath, request.DomainID, request.WorkflowID, request.RunID)
 		if err != nil {
 			return nil, &shared.InternalServiceError{Message: err.Error()}
 		}
@@ -241,6 +301,11 @@ func (h *historyArchiver) Get(
 		return nil, &shared.InternalServiceError{Message: err.Error()}
 	}
 
+	encodedHistoryBatches, err = decompressHistory(encodedHistoryBatches)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+
 	historyBatches, err := decodeHistoryBatches(encodedHistoryBatches)
 	if err != nil {
 		return nil, &shared.InternalServiceError{Message: err.Error()}
@@ -271,14 +336,160 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) Verify(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.VerifyHistoryRequest,
+) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return &shared.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateVerifyRequest(request); err != nil {
+		return &shared.BadRequestError{Message: archiver.ErrInvalidVerifyHistoryRequest.Error()}
+	}
+
+	dirPath := URI.Path()
+	exists, err := directoryExists(dirPath)
+	if err != nil {
+		return &shared.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return archiver.ErrHistoryNotExist
+	}
+
+	var version int64
+	if request.CloseFailoverVersion != nil {
+		version = *request.CloseFailoverVersion
+	} else {
+		highestVersion, err := getHighestVersion(dirPath, request.DomainID, request.WorkflowID, request.RunID)
+		if err == archiver.ErrHistoryNotExist {
+			return err
+		}
+		if err != nil {
+			return &shared.InternalServiceError{Message: err.Error()}
+		}
+		version = *highestVersion
+	}
+
+	filepath := path.Join(dirPath, constructHistoryFilename(request.DomainID, request.WorkflowID, request.RunID, version))
+	exists, err = fileExists(filepath)
+	if err != nil {
+		return &shared.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return archiver.ErrHistoryNotExist
+	}
+
+	encodedHistoryBatches, err := readFile(filepath)
+	if err != nil {
+		return &shared.InternalServiceError{Message: err.Error()}
+	}
+
+	// histories archived before checksums were introduced are only checked for being decodable
+	checksumFilepath := path.Join(dirPath, constructHistoryChecksumFilename(request.DomainID, request.WorkflowID, request.RunID, version))
+	exists, err = fileExists(checksumFilepath)
+	if err != nil {
+		return &shared.InternalServiceError{Message: err.Error()}
+	}
+	if exists {
+		encodedChecksum, err := readFile(checksumFilepath)
+		if err != nil {
+			return &shared.InternalServiceError{Message: err.Error()}
+		}
+		checksum, err := decodeHistoryChecksum(encodedChecksum)
+		if err != nil || checksum.Checksum != archiver.ComputeChecksum(encodedHistoryBatches) {
+			return archiver.ErrHistoryCorrupted
+		}
+	}
+
+	encodedHistoryBatches, err = decompressHistory(encodedHistoryBatches)
+	if err != nil {
+		return archiver.ErrHistoryCorrupted
+	}
+	if _, err := decodeHistoryBatches(encodedHistoryBatches); err != nil {
+		return archiver.ErrHistoryCorrupted
+	}
+	return nil
+}
+
+func (h *historyArchiver) GetMutableStateSummary(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetMutableStateSummaryRequest,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateGetMutableStateSummaryRequest(request); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidGetMutableStateSummaryRequest.Error()}
+	}
+
+	dirPath := URI.Path()
+	exists, err := directoryExists(dirPath)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil, &shared.EntityNotExistsError{Message: archiver.ErrMutableStateSummaryNotExist.Error()}
+	}
+
+	var version int64
+	if request.CloseFailoverVersion != nil {
+		version = *request.CloseFailoverVersion
+	} else {
+		highestVersion, err := getHighestVersion(dirPath, request.DomainID, request.WorkflowID, request.RunID)
+		if err == archiver.ErrHistoryNotExist {
+			return nil, &shared.EntityNotExistsError{Message: archiver.ErrMutableStateSummaryNotExist.Error()}
+		}
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+		version = *highestVersion
+	}
+
+	filepath := path.Join(dirPath, constructMutableStateSummaryFilename(request.DomainID, request.WorkflowID, request.RunID, version))
+	exists, err = fileExists(filepath)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil, &shared.EntityNotExistsError{Message: archiver.ErrMutableStateSummaryNotExist.Error()}
+	}
+
+	encodedSummary, err := readFile(filepath)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	summary, err := decodeMutableStateSummary(encodedSummary)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	return summary, nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
 	}
 
+	if _, err := getHistoryCodec(URI.Query().Get(codecQueryKey)); err != nil {
+		return err
+	}
+
 	return validateDirPath(URI.Path())
 }
 
+// getCodec returns the codec selected by the URI, or the codec configured for the archiver if the URI selects none
+func (h *historyArchiver) getCodec(URI archiver.URI) (HistoryCodec, error) {
+	name := URI.Query().Get(codecQueryKey)
+	if name == "" {
+		return h.codec, nil
+	}
+	return getHistoryCodec(name)
+}
+
 func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiver.HistoryBlob, error) {
 	historyBlob, err := historyIterator.Next()
 	op := func() error {
@@ -297,8 +508,8 @@ func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIte
 	return historyBlob, nil
 }
 
-func getHighestVersion(dirPath string, request *archiver.GetHistoryRequest) (*int64, error) {
-	filenames, err := listFilesByPrefix(dirPath, constructHistoryFilenamePrefix(request.DomainID, request.WorkflowID, request.RunID))
+func getHighestVersion(dirPath string, domainID, workflowID, runID string) (*int64, error) {
+	filenames, err := listFilesByPrefix(dirPath, constructHistoryFilenamePrefix(domainID, workflowID, runID))
 	if err != nil {
 		return nil, err
 	}
@@ -21,6 +21,7 @@
 package filestore
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io/ioutil"
@@ -112,6 +113,14 @@ func (s *historyArchiverSuite) TestValidateURI() {
 			URI:         "file:///a/b/c",
 			expectedErr: nil,
 		},
+		{
+			URI:         "file:///a/b/c?codec=gzip",
+			expectedErr: nil,
+		},
+		{
+			URI:         "file:///a/b/c?codec=unknown",
+			expectedErr: errUnknownHistoryCodec,
+		},
 	}
 
 	historyArchiver := s.newTestHistoryArchiver(nil)
@@ -522,6 +531,229 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestVerify_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	err = historyArchiver.Verify(context.Background(), URI, &archiver.VerifyHistoryRequest{})
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestVerify_Fail_HistoryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	request := s.newVerifyRequest()
+	request.CloseFailoverVersion = common.Int64Ptr(20)
+	s.Equal(archiver.ErrHistoryNotExist, historyArchiver.Verify(context.Background(), URI, request))
+
+	URI, err = archiver.NewURI("file:///some/dir/not/exist")
+	s.NoError(err)
+	s.Equal(archiver.ErrHistoryNotExist, historyArchiver.Verify(context.Background(), URI, s.newVerifyRequest()))
+}
+
+func (s *historyArchiverSuite) TestVerify_Success_NoChecksum() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	s.NoError(historyArchiver.Verify(context.Background(), URI, s.newVerifyRequest()))
+}
+
+func (s *historyArchiverSuite) TestArchiveAndVerify() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestArchiveAndVerify")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir + "?codec=gzip")
+	s.NoError(err)
+	s.NoError(historyArchiver.Archive(context.Background(), URI, archiveRequest))
+	s.assertFileExists(path.Join(dir, constructHistoryChecksumFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)))
+	s.NoError(historyArchiver.Verify(context.Background(), URI, s.newVerifyRequest()))
+
+	filepath := path.Join(dir, constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion))
+	data, err := readFile(filepath)
+	s.NoError(err)
+	s.NoError(writeFile(filepath, data[:len(data)-1], testFileMode))
+	s.Equal(archiver.ErrHistoryCorrupted, historyArchiver.Verify(context.Background(), URI, s.newVerifyRequest()))
+
+	s.NoError(os.Remove(filepath))
+	s.Equal(archiver.ErrHistoryNotExist, historyArchiver.Verify(context.Background(), URI, s.newVerifyRequest()))
+}
+
+func (s *historyArchiverSuite) TestGetMutableStateSummary_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	summary, err := historyArchiver.GetMutableStateSummary(context.Background(), URI, &archiver.GetMutableStateSummaryRequest{})
+	s.Nil(summary)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGetMutableStateSummary_Fail_SummaryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	// histories in the test directory are archived without a summary
+	summary, err := historyArchiver.GetMutableStateSummary(context.Background(), URI, s.newGetMutableStateSummaryRequest())
+	s.Nil(summary)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+
+	URI, err = archiver.NewURI("file:///some/dir/not/exist")
+	s.NoError(err)
+	summary, err = historyArchiver.GetMutableStateSummary(context.Background(), URI, s.newGetMutableStateSummaryRequest())
+	s.Nil(summary)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGetMutableStateSummary() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestArchiveAndGetMutableStateSummary")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		MutableStateSummary:  newTestMutableStateSummary(),
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	s.NoError(historyArchiver.Archive(context.Background(), URI, archiveRequest))
+	s.assertFileExists(path.Join(dir, constructMutableStateSummaryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)))
+
+	// the summary file must not be picked up as a history version
+	highestVersion, err := getHighestVersion(dir, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(int64(testCloseFailoverVersion), *highestVersion)
+
+	summary, err := historyArchiver.GetMutableStateSummary(context.Background(), URI, s.newGetMutableStateSummaryRequest())
+	s.NoError(err)
+	s.Equal(newTestMutableStateSummary(), summary)
+
+	request := s.newGetMutableStateSummaryRequest()
+	request.CloseFailoverVersion = common.Int64Ptr(testCloseFailoverVersion + 1)
+	summary, err = historyArchiver.GetMutableStateSummary(context.Background(), URI, request)
+	s.Nil(summary)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_Fail_UnknownCompression() {
+	config := &config.FilestoreArchiver{
+		FileMode:    testFileModeStr,
+		DirMode:     testDirModeStr,
+		Compression: "unknown",
+	}
+	_, err := newHistoryArchiver(s.container, config, nil)
+	s.Equal(errUnknownHistoryCodec, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet_Compressed() {
+	for _, codec := range []string{CodecNone, CodecGzip, CodecZstd, CodecSnappy} {
+		mockCtrl := gomock.NewController(s.T())
+		historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+		historyBlob := &archiver.HistoryBlob{
+			Header: &archiver.HistoryBlobHeader{
+				IsLast: common.BoolPtr(true),
+			},
+			Body: s.historyBatchesV100,
+		}
+		gomock.InOrder(
+			historyIterator.EXPECT().HasNext().Return(true),
+			historyIterator.EXPECT().Next().Return(historyBlob, nil),
+			historyIterator.EXPECT().HasNext().Return(false),
+		)
+
+		dir, err := ioutil.TempDir("", "TestArchiveAndGet_Compressed")
+		s.NoError(err)
+
+		historyArchiver := s.newTestHistoryArchiver(historyIterator)
+		archiveRequest := &archiver.ArchiveHistoryRequest{
+			DomainID:             testDomainID,
+			DomainName:           testDomainName,
+			WorkflowID:           testWorkflowID,
+			RunID:                testRunID,
+			BranchToken:          testBranchToken,
+			NextEventID:          testNextEventID,
+			CloseFailoverVersion: testCloseFailoverVersion,
+		}
+		URI, err := archiver.NewURI("file://" + dir + "?codec=" + codec)
+		s.NoError(err)
+		err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+		s.NoError(err)
+
+		expectedFilename := constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
+		data, err := readFile(path.Join(dir, expectedFilename))
+		s.NoError(err)
+		s.Equal(codec != CodecNone, bytes.HasPrefix(data, historyFileHeader))
+
+		// archiver configured with a different default codec can still read the file
+		readArchiver := s.newTestHistoryArchiver(nil)
+		readArchiver.codec, err = getHistoryCodec(CodecGzip)
+		s.NoError(err)
+		plainURI, err := archiver.NewURI("file://" + dir)
+		s.NoError(err)
+		getRequest := &archiver.GetHistoryRequest{
+			DomainID:   testDomainID,
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+			PageSize:   testPageSize,
+		}
+		response, err := readArchiver.Get(context.Background(), plainURI, getRequest)
+		s.NoError(err)
+		s.NotNil(response)
+		s.Nil(response.NextPageToken)
+		s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+		os.RemoveAll(dir)
+		mockCtrl.Finish()
+	}
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -532,6 +764,22 @@ func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.H
 	return archiver
 }
 
+func (s *historyArchiverSuite) newVerifyRequest() *archiver.VerifyHistoryRequest {
+	return &archiver.VerifyHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+}
+
+func (s *historyArchiverSuite) newGetMutableStateSummaryRequest() *archiver.GetMutableStateSummaryRequest {
+	return &archiver.GetMutableStateSummaryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+}
+
 func (s *historyArchiverSuite) setupHistoryDirectory() {
 	s.historyBatchesV1 = []*shared.History{
 		&shared.History{
@@ -594,3 +842,25 @@ func getCanceledContext() context.Context {
 	cancel()
 	return ctx
 }
+
+func newTestMutableStateSummary() *shared.DescribeWorkflowExecutionResponse {
+	return &shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(testWorkflowID),
+				RunId:      common.StringPtr(testRunID),
+			},
+			CloseStatus: shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+			Memo: &shared.Memo{
+				Fields: map[string][]byte{"testField": []byte("testValue")},
+			},
+		},
+		PendingChildren: []*shared.PendingChildExecutionInfo{
+			{
+				WorkflowID:  common.StringPtr("child-workflow-id"),
+				RunID:       common.StringPtr("child-run-id"),
+				InitiatedID: common.Int64Ptr(5),
+			},
+		},
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2019 Uber Technologies, Inc.
+// Copyright (c) 2017 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -23,6 +23,8 @@
 package filestore
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -43,6 +45,9 @@ type (
 	queryParser struct{}
 
 	parsedQuery struct {
+		// earliestCloseTime, latestCloseTime and the fields below are only derived from
+		// conditions that every matching record must satisfy (i.e. top level "and" conditions),
+		// so they can be used to narrow down the set of records that need to be examined.
 		earliestCloseTime int64
 		latestCloseTime   int64
 		workflowID        *string
@@ -50,16 +55,27 @@ type (
 		workflowTypeName  *string
 		closeStatus       *shared.WorkflowExecutionCloseStatus
 		emptyResult       bool
+
+		// filter evaluates the complete where clause against a record, nil matches all records
+		filter recordFilter
+		// ascending is true when results should be ordered by close time in ascending order
+		ascending bool
 	}
+
+	// recordFilter returns true if the visibility record matches a (sub) expression of the query
+	recordFilter func(record *visibilityRecord) bool
 )
 
-// All allowed fields for filtering
+// All allowed fields for filtering, any other field name is treated as a custom search attribute
 const (
-	WorkflowID   = "WorkflowID"
-	RunID        = "RunID"
-	WorkflowType = "WorkflowType"
-	CloseTime    = "CloseTime"
-	CloseStatus  = "CloseStatus"
+	WorkflowID    = "WorkflowID"
+	RunID         = "RunID"
+	WorkflowType  = "WorkflowType"
+	CloseTime     = "CloseTime"
+	CloseStatus   = "CloseStatus"
+	StartTime     = "StartTime"
+	ExecutionTime = "ExecutionTime"
+	HistoryLength = "HistoryLength"
 )
 
 const (
@@ -68,6 +84,37 @@ const (
 	defaultDateTimeFormat = time.RFC3339
 )
 
+var (
+	systemFields = []string{
+		WorkflowID,
+		RunID,
+		WorkflowType,
+		CloseTime,
+		CloseStatus,
+		StartTime,
+		ExecutionTime,
+		HistoryLength,
+	}
+
+	equalityOperators = map[string]bool{
+		sqlparser.EqualStr:    true,
+		sqlparser.NotEqualStr: true,
+		sqlparser.InStr:       true,
+		sqlparser.NotInStr:    true,
+	}
+
+	rangeOperators = map[string]bool{
+		sqlparser.EqualStr:        true,
+		sqlparser.NotEqualStr:     true,
+		sqlparser.LessThanStr:     true,
+		sqlparser.LessEqualStr:    true,
+		sqlparser.GreaterThanStr:  true,
+		sqlparser.GreaterEqualStr: true,
+		sqlparser.InStr:           true,
+		sqlparser.NotInStr:        true,
+	}
+)
+
 // NewQueryParser creates a new query parser for filestore
 func NewQueryParser() QueryParser {
 	return &queryParser{}
@@ -78,151 +125,455 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
+	selectStmt, ok := stmt.(*sqlparser.Select)
+	if !ok || selectStmt.Where == nil || selectStmt.Limit != nil || len(selectStmt.GroupBy) != 0 || selectStmt.Having != nil {
+		return nil, errors.New("only where clause and order by are supported")
+	}
 	parsedQuery := &parsedQuery{
 		earliestCloseTime: 0,
 		latestCloseTime:   time.Now().UnixNano(),
 	}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
+	filter, err := p.convertWhereExpr(selectStmt.Where.Expr, parsedQuery, true)
+	if err != nil {
 		return nil, err
 	}
+	parsedQuery.filter = filter
+	if err := p.convertOrderBy(selectStmt.OrderBy, parsedQuery); err != nil {
+		return nil, err
+	}
+	if parsedQuery.earliestCloseTime > parsedQuery.latestCloseTime {
+		parsedQuery.emptyResult = true
+	}
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
+// convertWhereExpr converts expr into a recordFilter. topLevel indicates that every record in
+// the result must satisfy expr, which allows the condition to be recorded in parsedQuery as well.
+func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery, topLevel bool) (recordFilter, error) {
 	if expr == nil {
-		return errors.New("where expression is nil")
+		return nil, errors.New("where expression is nil")
 	}
 
 	switch expr.(type) {
 	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr.(*sqlparser.ComparisonExpr), parsedQuery)
+		return p.convertComparisonExpr(expr.(*sqlparser.ComparisonExpr), parsedQuery, topLevel)
 	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr.(*sqlparser.AndExpr), parsedQuery)
+		return p.convertAndExpr(expr.(*sqlparser.AndExpr), parsedQuery, topLevel)
+	case *sqlparser.OrExpr:
+		return p.convertOrExpr(expr.(*sqlparser.OrExpr), parsedQuery)
+	case *sqlparser.NotExpr:
+		return p.convertNotExpr(expr.(*sqlparser.NotExpr), parsedQuery)
 	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr.(*sqlparser.ParenExpr), parsedQuery)
+		return p.convertWhereExpr(expr.(*sqlparser.ParenExpr).Expr, parsedQuery, topLevel)
 	default:
-		return errors.New("only comparsion and \"and\" expression is supported")
+		return nil, errors.New("only comparison, \"and\", \"or\" and \"not\" expressions are supported")
+	}
+}
+
+func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery, topLevel bool) (recordFilter, error) {
+	left, err := p.convertWhereExpr(andExpr.Left, parsedQuery, topLevel)
+	if err != nil {
+		return nil, err
 	}
+	right, err := p.convertWhereExpr(andExpr.Right, parsedQuery, topLevel)
+	if err != nil {
+		return nil, err
+	}
+	return func(record *visibilityRecord) bool {
+		return left(record) && right(record)
+	}, nil
 }
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
+func (p *queryParser) convertOrExpr(orExpr *sqlparser.OrExpr, parsedQuery *parsedQuery) (recordFilter, error) {
+	left, err := p.convertWhereExpr(orExpr.Left, parsedQuery, false)
+	if err != nil {
+		return nil, err
+	}
+	right, err := p.convertWhereExpr(orExpr.Right, parsedQuery, false)
+	if err != nil {
+		return nil, err
+	}
+	return func(record *visibilityRecord) bool {
+		return left(record) || right(record)
+	}, nil
 }
 
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
+func (p *queryParser) convertNotExpr(notExpr *sqlparser.NotExpr, parsedQuery *parsedQuery) (recordFilter, error) {
+	inner, err := p.convertWhereExpr(notExpr.Expr, parsedQuery, false)
+	if err != nil {
+		return nil, err
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
+	return func(record *visibilityRecord) bool {
+		return !inner(record)
+	}, nil
 }
 
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery, topLevel bool) (recordFilter, error) {
 	colName, ok := compExpr.Left.(*sqlparser.ColName)
 	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
+		return nil, fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
 	}
 	colNameStr := sqlparser.String(colName)
 	op := compExpr.Operator
-	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
-	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+	valStrs, err := extractValueStrings(compExpr)
+	if err != nil {
+		return nil, err
 	}
-	valStr := sqlparser.String(valExpr)
 
 	switch colNameStr {
-	case WorkflowID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
+	case WorkflowID, RunID, WorkflowType:
+		if !equalityOperators[op] {
+			return nil, fmt.Errorf("operator %s is not supported for %s", op, colNameStr)
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowID)
+		var values []interface{}
+		for _, valStr := range valStrs {
+			val, err := extractStringValue(valStr)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, val)
 		}
-		if parsedQuery.workflowID != nil && *parsedQuery.workflowID != val {
-			parsedQuery.emptyResult = true
-			return nil
+		if topLevel && op == sqlparser.EqualStr {
+			p.convertStringField(colNameStr, values[0].(string), parsedQuery)
 		}
-		parsedQuery.workflowID = common.StringPtr(val)
-	case RunID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
+		return newFieldFilter(op, values, func(record *visibilityRecord) interface{} {
+			switch colNameStr {
+			case WorkflowID:
+				return record.WorkflowID
+			case RunID:
+				return record.RunID
+			default:
+				return record.WorkflowTypeName
+			}
+		}), nil
+	case CloseStatus:
+		if !equalityOperators[op] {
+			return nil, fmt.Errorf("operator %s is not supported for %s", op, CloseStatus)
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", RunID)
+		var values []interface{}
+		for _, valStr := range valStrs {
+			val, err := extractStringValue(valStr)
+			if err != nil {
+				return nil, err
+			}
+			status, err := convertStatusStr(val)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, int64(status))
 		}
-		if parsedQuery.runID != nil && *parsedQuery.runID != val {
-			parsedQuery.emptyResult = true
-			return nil
+		if topLevel && op == sqlparser.EqualStr {
+			status := shared.WorkflowExecutionCloseStatus(values[0].(int64))
+			if parsedQuery.closeStatus != nil && *parsedQuery.closeStatus != status {
+				parsedQuery.emptyResult = true
+			}
+			parsedQuery.closeStatus = status.Ptr()
 		}
-		parsedQuery.runID = common.StringPtr(val)
-	case WorkflowType:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
+		return newFieldFilter(op, values, func(record *visibilityRecord) interface{} {
+			return int64(record.CloseStatus)
+		}), nil
+	case CloseTime, StartTime, ExecutionTime:
+		if !rangeOperators[op] {
+			return nil, fmt.Errorf("operator %s is not supported for %s", op, colNameStr)
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowType)
+		var values []interface{}
+		for _, valStr := range valStrs {
+			timestamp, err := convertToTimestamp(valStr)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, timestamp)
 		}
-		if parsedQuery.workflowTypeName != nil && *parsedQuery.workflowTypeName != val {
-			parsedQuery.emptyResult = true
-			return nil
+		if topLevel && colNameStr == CloseTime && len(values) == 1 {
+			p.convertCloseTime(values[0].(int64), op, parsedQuery)
 		}
-		parsedQuery.workflowTypeName = common.StringPtr(val)
-	case CloseStatus:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
+		return newFieldFilter(op, values, func(record *visibilityRecord) interface{} {
+			switch colNameStr {
+			case CloseTime:
+				return record.CloseTimestamp
+			case StartTime:
+				return record.StartTimestamp
+			default:
+				return record.ExecutionTimestamp
+			}
+		}), nil
+	case HistoryLength:
+		if !rangeOperators[op] {
+			return nil, fmt.Errorf("operator %s is not supported for %s", op, HistoryLength)
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseStatus)
+		var values []interface{}
+		for _, valStr := range valStrs {
+			val, err := strconv.ParseInt(valStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("value %s is not an integer value", valStr)
+			}
+			values = append(values, val)
 		}
-		status, err := convertStatusStr(val)
-		if err != nil {
-			return err
+		return newFieldFilter(op, values, func(record *visibilityRecord) interface{} {
+			return record.HistoryLength
+		}), nil
+	default:
+		return p.convertSearchAttribute(colNameStr, op, valStrs)
+	}
+}
+
+func (p *queryParser) convertStringField(field string, val string, parsedQuery *parsedQuery) {
+	var target **string
+	switch field {
+	case WorkflowID:
+		target = &parsedQuery.workflowID
+	case RunID:
+		target = &parsedQuery.runID
+	default:
+		target = &parsedQuery.workflowTypeName
+	}
+	if *target != nil && **target != val {
+		parsedQuery.emptyResult = true
+		return
+	}
+	*target = common.StringPtr(val)
+}
+
+func (p *queryParser) convertSearchAttribute(key string, op string, valStrs []string) (recordFilter, error) {
+	for _, field := range systemFields {
+		if strings.EqualFold(key, field) {
+			return nil, fmt.Errorf("unknown filter name: %s, did you mean %s", key, field)
 		}
-		if parsedQuery.closeStatus != nil && *parsedQuery.closeStatus != status {
-			parsedQuery.emptyResult = true
-			return nil
+	}
+	if !rangeOperators[op] {
+		return nil, fmt.Errorf("operator %s is not supported for search attribute %s", op, key)
+	}
+
+	var values []interface{}
+	for _, valStr := range valStrs {
+		if val, err := extractStringValue(valStr); err == nil {
+			values = append(values, val)
+			continue
 		}
-		parsedQuery.closeStatus = status.Ptr()
-	case CloseTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
+		if val, err := strconv.ParseInt(valStr, 10, 64); err == nil {
+			values = append(values, val)
+			continue
 		}
-		return p.convertCloseTime(timestamp, op, parsedQuery)
-	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		if val, err := strconv.ParseFloat(valStr, 64); err == nil {
+			values = append(values, val)
+			continue
+		}
+		if val, err := strconv.ParseBool(valStr); err == nil {
+			values = append(values, val)
+			continue
+		}
+		return nil, fmt.Errorf("invalid value for search attribute %s: %s", key, valStr)
 	}
 
-	return nil
+	return func(record *visibilityRecord) bool {
+		encoded, ok := record.SearchAttributes[key]
+		if !ok {
+			return false
+		}
+		actual, err := decodeSearchAttributeValue(encoded)
+		if err != nil {
+			return false
+		}
+		return matchOperator(op, actual, values)
+	}, nil
 }
 
-func (p *queryParser) convertCloseTime(timestamp int64, op string, parsedQuery *parsedQuery) error {
+func (p *queryParser) convertCloseTime(timestamp int64, op string, parsedQuery *parsedQuery) {
 	switch op {
-	case "=":
-		if err := p.convertCloseTime(timestamp, ">=", parsedQuery); err != nil {
-			return err
-		}
-		if err := p.convertCloseTime(timestamp, "<=", parsedQuery); err != nil {
-			return err
-		}
-	case "<":
+	case sqlparser.EqualStr:
+		p.convertCloseTime(timestamp, sqlparser.GreaterEqualStr, parsedQuery)
+		p.convertCloseTime(timestamp, sqlparser.LessEqualStr, parsedQuery)
+	case sqlparser.LessThanStr:
 		parsedQuery.latestCloseTime = common.MinInt64(parsedQuery.latestCloseTime, timestamp-1)
-	case "<=":
+	case sqlparser.LessEqualStr:
 		parsedQuery.latestCloseTime = common.MinInt64(parsedQuery.latestCloseTime, timestamp)
-	case ">":
+	case sqlparser.GreaterThanStr:
 		parsedQuery.earliestCloseTime = common.MaxInt64(parsedQuery.earliestCloseTime, timestamp+1)
-	case ">=":
+	case sqlparser.GreaterEqualStr:
 		parsedQuery.earliestCloseTime = common.MaxInt64(parsedQuery.earliestCloseTime, timestamp)
-	default:
-		return fmt.Errorf("operator %s is not supported for close time", op)
 	}
+}
+
+func (p *queryParser) convertOrderBy(orderBy sqlparser.OrderBy, parsedQuery *parsedQuery) error {
+	if len(orderBy) == 0 {
+		return nil
+	}
+	if len(orderBy) > 1 {
+		return errors.New("only one order by field is supported")
+	}
+	colName, ok := orderBy[0].Expr.(*sqlparser.ColName)
+	if !ok || sqlparser.String(colName) != CloseTime {
+		return fmt.Errorf("only order by %s is supported", CloseTime)
+	}
+	parsedQuery.ascending = orderBy[0].Direction == sqlparser.AscScr
 	return nil
 }
 
+func newFieldFilter(op string, values []interface{}, getter func(*visibilityRecord) interface{}) recordFilter {
+	return func(record *visibilityRecord) bool {
+		return matchOperator(op, getter(record), values)
+	}
+}
+
+// matchOperator checks if actual satisfies the comparison with values. values contains
+// exactly one element unless op is "in" or "not in". If actual is a list (e.g. a search attribute
+// of array type), the comparison is satisfied if any of the elements satisfies it.
+func matchOperator(op string, actual interface{}, values []interface{}) bool {
+	switch op {
+	case sqlparser.NotEqualStr:
+		return !matchOperator(sqlparser.EqualStr, actual, values)
+	case sqlparser.NotInStr:
+		return !matchOperator(sqlparser.InStr, actual, values)
+	}
+
+	if elements, ok := actual.([]interface{}); ok {
+		for _, element := range elements {
+			if matchOperator(op, element, values) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, value := range values {
+		result, ok := compareValues(actual, value)
+		if !ok {
+			continue
+		}
+		switch op {
+		case sqlparser.EqualStr, sqlparser.InStr:
+			if result == 0 {
+				return true
+			}
+		case sqlparser.LessThanStr:
+			return result < 0
+		case sqlparser.LessEqualStr:
+			return result <= 0
+		case sqlparser.GreaterThanStr:
+			return result > 0
+		case sqlparser.GreaterEqualStr:
+			return result >= 0
+		}
+	}
+	return false
+}
+
+// compareValues returns -1, 0 or 1 if a is less than, equal to or greater than b,
+// the second return value is false if a and b are not comparable
+func compareValues(a, b interface{}) (int, bool) {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			if a == b {
+				return 0, true
+			}
+			if !a {
+				return -1, true
+			}
+			return 1, true
+		}
+	case int64:
+		switch b := b.(type) {
+		case int64:
+			return compareInts(a, b), true
+		case float64:
+			return compareFloats(float64(a), b), true
+		}
+	case float64:
+		switch b := b.(type) {
+		case int64:
+			return compareFloats(a, float64(b)), true
+		case float64:
+			return compareFloats(a, b), true
+		}
+	}
+	return 0, false
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// decodeSearchAttributeValue decodes a json encoded search attribute value, numbers are
+// returned as int64 when possible and float64 otherwise
+func decodeSearchAttributeValue(encoded string) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(encoded)))
+	decoder.UseNumber()
+	var val interface{}
+	if err := decoder.Decode(&val); err != nil {
+		return nil, err
+	}
+	return convertJSONNumbers(val), nil
+}
+
+func convertJSONNumbers(val interface{}) interface{} {
+	switch val := val.(type) {
+	case json.Number:
+		if intVal, err := val.Int64(); err == nil {
+			return intVal
+		}
+		floatVal, _ := val.Float64()
+		return floatVal
+	case []interface{}:
+		for i := range val {
+			val[i] = convertJSONNumbers(val[i])
+		}
+		return val
+	default:
+		return val
+	}
+}
+
+func extractValueStrings(compExpr *sqlparser.ComparisonExpr) ([]string, error) {
+	var valExprs sqlparser.Exprs
+	switch compExpr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := compExpr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+		}
+		valExprs = sqlparser.Exprs(tuple)
+	default:
+		valExprs = sqlparser.Exprs{compExpr.Right}
+	}
+
+	var valStrs []string
+	for _, valExpr := range valExprs {
+		switch valExpr.(type) {
+		case *sqlparser.SQLVal, sqlparser.BoolVal:
+			valStrs = append(valStrs, sqlparser.String(valExpr))
+		default:
+			return nil, fmt.Errorf("invalid value: %s", sqlparser.String(valExpr))
+		}
+	}
+	if len(valStrs) == 0 {
+		return nil, fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+	}
+	return valStrs, nil
+}
+
 func convertToTimestamp(timeStr string) (int64, error) {
 	timestamp, err := strconv.ParseInt(timeStr, 10, 64)
 	if err == nil {
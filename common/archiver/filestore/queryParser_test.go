@@ -107,8 +107,14 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 			expectErr: true,
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
-			expectErr: true,
+			query:       "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
+		},
+		{
+			query:       "WorkflowID in (\"random workflowID\", \"another workflowID\") and RunID != \"random runID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "WorkflowID = \"random workflowID\" or runID = \"random runID\"",
@@ -179,8 +185,14 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 			expectErr: true,
 		},
 		{
-			query:     "CloseStatus = \"Failed\" or CloseStatus = \"Failed\"",
-			expectErr: true,
+			query:       "CloseStatus = \"Failed\" or CloseStatus = \"Completed\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
+		},
+		{
+			query:       "CloseStatus not in (\"Failed\", \"TimedOut\")",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "CloseStatus = \"unknown\"",
@@ -316,7 +328,132 @@ func (s *queryParserSuite) TestParse() {
 		s.NoError(err)
 		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
 		if !tc.parsedQuery.emptyResult {
-			s.Equal(tc.parsedQuery, parsedQuery)
+			s.Equal(tc.parsedQuery.earliestCloseTime, parsedQuery.earliestCloseTime)
+			s.Equal(tc.parsedQuery.latestCloseTime, parsedQuery.latestCloseTime)
+			s.Equal(tc.parsedQuery.workflowID, parsedQuery.workflowID)
+			s.Equal(tc.parsedQuery.runID, parsedQuery.runID)
+			s.Equal(tc.parsedQuery.workflowTypeName, parsedQuery.workflowTypeName)
+			s.Equal(tc.parsedQuery.closeStatus, parsedQuery.closeStatus)
+			s.Equal(tc.parsedQuery.ascending, parsedQuery.ascending)
+			s.NotNil(parsedQuery.filter)
+		}
+	}
+}
+
+func (s *queryParserSuite) TestParse_CloseTimeInDisjunction() {
+	parsedQuery, err := s.parser.Parse("CloseTime > 2000 or WorkflowID = 'random workflowID'")
+	s.NoError(err)
+	s.Equal(int64(0), parsedQuery.earliestCloseTime)
+	s.Nil(parsedQuery.workflowID)
+
+	parsedQuery, err = s.parser.Parse("CloseTime > 2000 and not (CloseTime > 3000)")
+	s.NoError(err)
+	s.Equal(int64(2001), parsedQuery.earliestCloseTime)
+}
+
+func (s *queryParserSuite) TestParseOrderBy() {
+	testCases := []struct {
+		query     string
+		expectErr bool
+		ascending bool
+	}{
+		{
+			query:     "WorkflowID = 'random workflowID' order by CloseTime",
+			expectErr: false,
+			ascending: true,
+		},
+		{
+			query:     "WorkflowID = 'random workflowID' order by CloseTime asc",
+			expectErr: false,
+			ascending: true,
+		},
+		{
+			query:     "WorkflowID = 'random workflowID' order by CloseTime desc",
+			expectErr: false,
+			ascending: false,
+		},
+		{
+			query:     "WorkflowID = 'random workflowID' order by StartTime desc",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowID = 'random workflowID' order by CloseTime desc, RunID",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowID = 'random workflowID' limit 10",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err)
+			continue
+		}
+		s.NoError(err)
+		s.Equal(tc.ascending, parsedQuery.ascending)
+	}
+}
+
+func (s *queryParserSuite) TestFilter() {
+	record := &visibilityRecord{
+		WorkflowID:         "random workflowID",
+		RunID:              "random runID",
+		WorkflowTypeName:   "random typeName",
+		StartTimestamp:     1000,
+		ExecutionTimestamp: 1500,
+		CloseTimestamp:     2000,
+		CloseStatus:        shared.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:      20,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": `"keyword"`,
+			"CustomIntField":     "10",
+			"CustomDoubleField":  "2.5",
+			"CustomBoolField":    "true",
+			"CustomArrayField":   `["a","b"]`,
+		},
+	}
+
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		shouldMatch bool
+	}{
+		{query: "WorkflowID = 'random workflowID' or WorkflowID = 'another workflowID'", shouldMatch: true},
+		{query: "WorkflowID = 'another workflowID' or RunID = 'another runID'", shouldMatch: false},
+		{query: "not (WorkflowType = 'random typeName')", shouldMatch: false},
+		{query: "WorkflowType != 'another typeName'", shouldMatch: true},
+		{query: "RunID in ('another runID', 'random runID')", shouldMatch: true},
+		{query: "RunID not in ('another runID', 'random runID')", shouldMatch: false},
+		{query: "CloseStatus in ('Completed', 'Failed')", shouldMatch: true},
+		{query: "CloseStatus != 'Failed'", shouldMatch: false},
+		{query: "StartTime >= 1000 and StartTime < 1001", shouldMatch: true},
+		{query: "ExecutionTime > 1500", shouldMatch: false},
+		{query: "CloseTime = 2000 and ExecutionTime <= 1500", shouldMatch: true},
+		{query: "HistoryLength > 10 and HistoryLength <= 20", shouldMatch: true},
+		{query: "HistoryLength in (1, 2, 3)", shouldMatch: false},
+		{query: "HistoryLength > 'abc'", expectErr: true},
+		{query: "CustomKeywordField = 'keyword'", shouldMatch: true},
+		{query: "CustomKeywordField = 10", shouldMatch: false},
+		{query: "CustomIntField >= 10 and CustomIntField < 10.5", shouldMatch: true},
+		{query: "CustomDoubleField > 2", shouldMatch: true},
+		{query: "CustomBoolField = true", shouldMatch: true},
+		{query: "CustomArrayField = 'b'", shouldMatch: true},
+		{query: "CustomArrayField not in ('a', 'c')", shouldMatch: false},
+		{query: "UnknownField = 'value'", shouldMatch: false},
+		{query: "historyLength > 10", expectErr: true},
+		{query: "(WorkflowID = 'random workflowID' and CustomIntField > 100) or (HistoryLength = 20 and not CustomBoolField = false)", shouldMatch: true},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
 		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.shouldMatch, parsedQuery.filter(record), tc.query)
 	}
 }
@@ -89,6 +89,16 @@ func writeFile(filepath string, data []byte, fileMode os.FileMode) error {
 	return nil
 }
 
+func appendFile(filepath string, data []byte, fileMode os.FileMode) error {
+	f, err := os.OpenFile(filepath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, fileMode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(data)
+	return err
+}
+
 // readFile reads the contents of a file specified by filepath
 // WARNING: callers of this method should be extremely careful not to use it in a context where filepath is supplied by
 // the user.
@@ -146,6 +156,24 @@ func decodeHistoryBatches(data []byte) ([]*shared.History, error) {
 	return historyBatches, nil
 }
 
+func decodeHistoryChecksum(data []byte) (*historyChecksum, error) {
+	checksum := &historyChecksum{}
+	err := json.Unmarshal(data, checksum)
+	if err != nil {
+		return nil, err
+	}
+	return checksum, nil
+}
+
+func decodeMutableStateSummary(data []byte) (*shared.DescribeWorkflowExecutionResponse, error) {
+	summary := &shared.DescribeWorkflowExecutionResponse{}
+	err := json.Unmarshal(data, summary)
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
 func decodeVisibilityRecord(data []byte) (*visibilityRecord, error) {
 	record := &visibilityRecord{}
 	err := json.Unmarshal(data, record)
@@ -181,12 +209,20 @@ func constructHistoryFilename(domainID, workflowID, runID string, version int64)
 	return fmt.Sprintf("%s_%v.history", combinedHash, version)
 }
 
+func constructHistoryChecksumFilename(domainID, workflowID, runID string, version int64) string {
+	return constructHistoryFilename(domainID, workflowID, runID, version) + ".checksum"
+}
+
+func constructMutableStateSummaryFilename(domainID, workflowID, runID string, version int64) string {
+	return constructHistoryFilename(domainID, workflowID, runID, version) + ".summary"
+}
+
 func constructHistoryFilenamePrefix(domainID, workflowID, runID string) string {
 	return strings.Join([]string{hash(domainID), hash(workflowID), hash(runID)}, "")
 }
 
 func constructVisibilityFilename(closeTimestamp int64, runID string) string {
-	return fmt.Sprintf("%v_%s.visibility", closeTimestamp, hash(runID))
+	return fmt.Sprintf("%v_%s%s", closeTimestamp, hash(runID), visibilityFileSuffix)
 }
 
 func hash(s string) string {
@@ -39,6 +39,7 @@ import (
 const (
 	errEncodeVisibilityRecord    = "failed to encode visibility record"
 	errInvalidVisibilityFilename = "failed to parse visibility file name"
+	errUpdateVisibilityIndex     = "failed to update visibility index"
 )
 
 type (
@@ -130,6 +131,11 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
+	if err := v.addToVisibilityIndex(dirPath, (*visibilityRecord)(request)); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errUpdateVisibilityIndex), tag.Error(err))
+		return err
+	}
+
 	return nil
 }
 
@@ -186,12 +192,28 @@ func (v *visibilityArchiver) query(
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
-	files, err := listFiles(dirPath)
+	indexed, err := fileExists(path.Join(dirPath, visibilityIndexDirName, visibilityIndexCompleteMarker))
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	if indexed {
+		return v.queryIndex(dirPath, request, token)
+	}
+	return v.queryFiles(dirPath, request, token)
+}
+
+// queryFiles scans all visibility records in the directory, it's used when the index is not built yet
+func (v *visibilityArchiver) queryFiles(
+	dirPath string,
+	request *queryVisibilityRequest,
+	token *queryVisibilityToken,
+) (*archiver.QueryVisibilityResponse, error) {
+	files, err := listVisibilityFiles(dirPath)
 	if err != nil {
 		return nil, &shared.InternalServiceError{Message: err.Error()}
 	}
 
-	files, err = sortAndFilterFiles(files, token)
+	files, err = sortAndFilterFiles(files, token, request.parsedQuery.ascending)
 	if err != nil {
 		return nil, &shared.InternalServiceError{Message: err.Error()}
 	}
@@ -200,7 +222,7 @@ func (v *visibilityArchiver) query(
 	}
 
 	response := &archiver.QueryVisibilityResponse{}
-	for idx, file := range files {
+	for _, file := range files {
 		encodedRecord, err := readFile(path.Join(dirPath, file))
 		if err != nil {
 			return nil, &shared.InternalServiceError{Message: err.Error()}
@@ -211,24 +233,19 @@ func (v *visibilityArchiver) query(
 			return nil, &shared.InternalServiceError{Message: err.Error()}
 		}
 
-		if record.CloseTimestamp < request.parsedQuery.earliestCloseTime {
+		if request.parsedQuery.ascending && record.CloseTimestamp > request.parsedQuery.latestCloseTime {
+			break
+		}
+		if !request.parsedQuery.ascending && record.CloseTimestamp < request.parsedQuery.earliestCloseTime {
 			break
 		}
 
 		if matchQuery(record, request.parsedQuery) {
-			response.Executions = append(response.Executions, convertToExecutionInfo(record))
-			if len(response.Executions) == request.pageSize {
-				if idx != len(files) {
-					newToken := &queryVisibilityToken{
-						LastCloseTime: record.CloseTimestamp,
-						LastRunID:     record.RunID,
-					}
-					encodedToken, err := serializeToken(newToken)
-					if err != nil {
-						return nil, &shared.InternalServiceError{Message: err.Error()}
-					}
-					response.NextPageToken = encodedToken
-				}
+			pageFull, err := addToQueryResponse(response, record, request.pageSize)
+			if err != nil {
+				return nil, &shared.InternalServiceError{Message: err.Error()}
+			}
+			if pageFull {
 				break
 			}
 		}
@@ -237,6 +254,78 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+// queryIndex only reads index buckets within the close time range of the query
+// and the visibility record files of matching records
+func (v *visibilityArchiver) queryIndex(
+	dirPath string,
+	request *queryVisibilityRequest,
+	token *queryVisibilityToken,
+) (*archiver.QueryVisibilityResponse, error) {
+	indexDirPath := path.Join(dirPath, visibilityIndexDirName)
+	buckets, err := listVisibilityIndexBuckets(indexDirPath, request.parsedQuery, token)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, bucket := range buckets {
+		records, err := readVisibilityIndexBucket(indexDirPath, bucket)
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+		sortVisibilityRecords(records, request.parsedQuery.ascending)
+
+		for _, indexedRecord := range records {
+			if token != nil && !afterToken(indexedRecord.CloseTimestamp, hash(indexedRecord.RunID), token, request.parsedQuery.ascending) {
+				continue
+			}
+			if !matchQuery(indexedRecord, request.parsedQuery) {
+				continue
+			}
+
+			encodedRecord, err := readFile(path.Join(dirPath, constructVisibilityFilename(indexedRecord.CloseTimestamp, indexedRecord.RunID)))
+			if err != nil {
+				return nil, &shared.InternalServiceError{Message: err.Error()}
+			}
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, &shared.InternalServiceError{Message: err.Error()}
+			}
+
+			pageFull, err := addToQueryResponse(response, record, request.pageSize)
+			if err != nil {
+				return nil, &shared.InternalServiceError{Message: err.Error()}
+			}
+			if pageFull {
+				return response, nil
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func addToQueryResponse(
+	response *archiver.QueryVisibilityResponse,
+	record *visibilityRecord,
+	pageSize int,
+) (bool, error) {
+	response.Executions = append(response.Executions, convertToExecutionInfo(record))
+	if len(response.Executions) < pageSize {
+		return false, nil
+	}
+	newToken := &queryVisibilityToken{
+		LastCloseTime: record.CloseTimestamp,
+		LastRunID:     record.RunID,
+	}
+	encodedToken, err := serializeToken(newToken)
+	if err != nil {
+		return false, err
+	}
+	response.NextPageToken = encodedToken
+	return true, nil
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -251,9 +340,9 @@ type parsedVisFilename struct {
 	hashedRunID string
 }
 
-// sortAndFilterFiles sort visibility record file names based on close timestamp (desc) and use hashed runID to break ties.
-// if a nextPageToken is give, it only returns filenames that have a smaller close timestamp
-func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]string, error) {
+// sortAndFilterFiles sort visibility record file names based on close timestamp (desc unless ascending is true)
+// and use hashed runID to break ties. if a nextPageToken is give, it only returns filenames that come after the token
+func sortAndFilterFiles(filenames []string, token *queryVisibilityToken, ascending bool) ([]string, error) {
 	var parsedFilenames []*parsedVisFilename
 	for _, name := range filenames {
 		pieces := strings.FieldsFunc(name, func(r rune) bool {
@@ -275,20 +364,17 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 	}
 
 	sort.Slice(parsedFilenames, func(i, j int) bool {
-		if parsedFilenames[i].closeTime == parsedFilenames[j].closeTime {
-			return parsedFilenames[i].hashedRunID > parsedFilenames[j].hashedRunID
-		}
-		return parsedFilenames[i].closeTime > parsedFilenames[j].closeTime
+		return lessVisibilityRecord(
+			parsedFilenames[i].closeTime, parsedFilenames[i].hashedRunID,
+			parsedFilenames[j].closeTime, parsedFilenames[j].hashedRunID,
+			ascending,
+		)
 	})
 
 	startIdx := 0
 	if token != nil {
-		LastHashedRunID := hash(token.LastRunID)
 		startIdx = sort.Search(len(parsedFilenames), func(i int) bool {
-			if parsedFilenames[i].closeTime == token.LastCloseTime {
-				return parsedFilenames[i].hashedRunID < LastHashedRunID
-			}
-			return parsedFilenames[i].closeTime < token.LastCloseTime
+			return afterToken(parsedFilenames[i].closeTime, parsedFilenames[i].hashedRunID, token, ascending)
 		})
 	}
 
@@ -303,6 +389,42 @@ func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]stri
 	return filteredFilenames, nil
 }
 
+func sortVisibilityRecords(records []*visibilityRecord, ascending bool) {
+	hashedRunIDs := make(map[*visibilityRecord]string, len(records))
+	for _, record := range records {
+		hashedRunIDs[record] = hash(record.RunID)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return lessVisibilityRecord(
+			records[i].CloseTimestamp, hashedRunIDs[records[i]],
+			records[j].CloseTimestamp, hashedRunIDs[records[j]],
+			ascending,
+		)
+	})
+}
+
+// lessVisibilityRecord returns true if record i should be returned before record j
+func lessVisibilityRecord(closeTimeI int64, hashedRunIDI string, closeTimeJ int64, hashedRunIDJ string, ascending bool) bool {
+	if closeTimeI == closeTimeJ {
+		if ascending {
+			return hashedRunIDI < hashedRunIDJ
+		}
+		return hashedRunIDI > hashedRunIDJ
+	}
+	if ascending {
+		return closeTimeI < closeTimeJ
+	}
+	return closeTimeI > closeTimeJ
+}
+
+func afterToken(closeTime int64, hashedRunID string, token *queryVisibilityToken, ascending bool) bool {
+	lastHashedRunID := hash(token.LastRunID)
+	if closeTime == token.LastCloseTime && hashedRunID == lastHashedRunID {
+		return false
+	}
+	return !lessVisibilityRecord(closeTime, hashedRunID, token.LastCloseTime, lastHashedRunID, ascending)
+}
+
 func matchQuery(record *visibilityRecord, query *parsedQuery) bool {
 	if record.CloseTimestamp < query.earliestCloseTime || record.CloseTimestamp > query.latestCloseTime {
 		return false
@@ -319,6 +441,9 @@ func matchQuery(record *visibilityRecord, query *parsedQuery) bool {
 	if query.closeStatus != nil && record.CloseStatus != *query.closeStatus {
 		return false
 	}
+	if query.filter != nil && !query.filter(record) {
+		return false
+	}
 	return true
 }
 
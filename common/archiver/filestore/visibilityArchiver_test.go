@@ -21,12 +21,15 @@
 package filestore
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 	"testing"
 	"time"
 
@@ -195,6 +198,14 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	err = json.Unmarshal(data, archivedRecord)
 	s.NoError(err)
 	s.Equal(request, archivedRecord)
+
+	indexDirPath := path.Join(dir, testDomainID, visibilityIndexDirName)
+	s.assertFileExists(path.Join(indexDirPath, visibilityIndexCompleteMarker))
+	records, err := readVisibilityIndexBucket(indexDirPath, visibilityIndexBucket(closeTimestamp.UnixNano()))
+	s.NoError(err)
+	s.Len(records, 1)
+	s.Nil(records[0].Memo)
+	s.Equal(request.SearchAttributes, records[0].SearchAttributes)
 }
 
 func (s *visibilityArchiverSuite) TestMatchQuery() {
@@ -315,7 +326,7 @@ func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
 	}
 
 	for _, tc := range testCases {
-		result, err := sortAndFilterFiles(tc.filenames, tc.token)
+		result, err := sortAndFilterFiles(tc.filenames, tc.token, false)
 		s.NoError(err)
 		s.Equal(tc.expectedResult, result)
 	}
@@ -489,6 +500,170 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_RebuildIndex() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndQuery_RebuildIndex")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// records archived before the index was introduced
+	dirPath := path.Join(dir, testDomainID)
+	s.NoError(mkdirAll(dirPath, testDirMode))
+	for _, record := range s.visibilityRecords[1:4] {
+		data, err := encode(record)
+		s.NoError(err)
+		s.NoError(writeFile(path.Join(dirPath, constructVisibilityFilename(record.CloseTimestamp, record.RunID)), data, testFileMode))
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "CloseStatus = 'Failed' order by CloseTime asc",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), URI, request)
+	s.NoError(err)
+	s.Len(response.Executions, 2)
+
+	record := *s.visibilityRecords[0]
+	record.Memo = &shared.Memo{
+		Fields: map[string][]byte{
+			"testFields": []byte{1, 2, 3},
+		},
+	}
+	err = visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(&record))
+	s.NoError(err)
+	s.assertFileExists(path.Join(dirPath, visibilityIndexDirName, visibilityIndexCompleteMarker))
+
+	request.PageSize = 1
+	executions := []*shared.WorkflowExecutionInfo{}
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request)
+		s.NoError(err)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 3)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[3]), executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
+	s.Equal(convertToExecutionInfo(&record), executions[2])
+}
+
+func (s *visibilityArchiverSuite) TestArchive_RebuildIndexIncrementally() {
+	dir, err := ioutil.TempDir("", "TestArchive_RebuildIndexIncrementally")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	defer func(batchSize int) { visibilityIndexRebuildBatchSize = batchSize }(visibilityIndexRebuildBatchSize)
+	visibilityIndexRebuildBatchSize = 2
+
+	dirPath := path.Join(dir, testDomainID)
+	indexDirPath := path.Join(dirPath, visibilityIndexDirName)
+	s.NoError(mkdirAll(dirPath, testDirMode))
+	for _, record := range s.visibilityRecords[1:4] {
+		data, err := encode(record)
+		s.NoError(err)
+		s.NoError(writeFile(path.Join(dirPath, constructVisibilityFilename(record.CloseTimestamp, record.RunID)), data, testFileMode))
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	request := (*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[0])
+
+	// the first archive request only indexes one batch of the existing records
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, request))
+	s.assertFileExists(path.Join(indexDirPath, visibilityIndexProgressFile))
+	exists, err := fileExists(path.Join(indexDirPath, visibilityIndexCompleteMarker))
+	s.NoError(err)
+	s.False(exists)
+
+	// a retried archive request completes the index without adding duplicate entries
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, request))
+	s.assertFileExists(path.Join(indexDirPath, visibilityIndexCompleteMarker))
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, request))
+
+	files, err := listFiles(indexDirPath)
+	s.NoError(err)
+	entries := 0
+	for _, file := range files {
+		if !strings.HasSuffix(file, visibilityIndexFileSuffix) {
+			continue
+		}
+		data, err := readFile(path.Join(indexDirPath, file))
+		s.NoError(err)
+		entries += bytes.Count(data, []byte{'\n'})
+	}
+	s.Equal(4, entries)
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_RichQuery() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndQuery_RichQuery")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	var records []*visibilityRecord
+	for i, record := range s.visibilityRecords {
+		recordWithAttributes := *record
+		recordWithAttributes.SearchAttributes = map[string]string{
+			"CustomIntField": fmt.Sprintf("%v", i),
+		}
+		records = append(records, &recordWithAttributes)
+		err := visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(&recordWithAttributes))
+		s.NoError(err)
+	}
+	// archiving the same record again should not result in duplicates
+	err = visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(records[1]))
+	s.NoError(err)
+
+	testCases := []struct {
+		query    string
+		expected []*visibilityRecord
+	}{
+		{
+			query:    "CloseStatus = 'Failed' and (HistoryLength > 400 or CustomIntField = 0)",
+			expected: []*visibilityRecord{records[0], records[3]},
+		},
+		{
+			query:    "not (CloseStatus = 'Failed') or CustomIntField in (1, 3) order by CloseTime asc",
+			expected: []*visibilityRecord{records[3], records[2], records[1]},
+		},
+		{
+			query:    "StartTime >= 2 and CloseTime < 1000",
+			expected: []*visibilityRecord{records[2], records[3]},
+		},
+		{
+			query:    "WorkflowID != 'another workflow ID' and CloseTime > 5 order by CloseTime desc",
+			expected: []*visibilityRecord{records[0], records[1]},
+		},
+	}
+
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			DomainID: testDomainID,
+			PageSize: 1,
+			Query:    tc.query,
+		}
+		executions := []*shared.WorkflowExecutionInfo{}
+		for len(executions) == 0 || request.NextPageToken != nil {
+			response, err := visibilityArchiver.Query(context.Background(), URI, request)
+			s.NoError(err)
+			if len(response.Executions) == 0 {
+				break
+			}
+			executions = append(executions, response.Executions...)
+			request.NextPageToken = response.NextPageToken
+		}
+		s.Len(executions, len(tc.expected), tc.query)
+		for i, record := range tc.expected {
+			s.Equal(convertToExecutionInfo(record), executions[i], tc.query)
+		}
+	}
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -0,0 +1,263 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The visibility index of a domain is stored in the index sub-directory of the domain's visibility directory.
+// Records are grouped into buckets by close time. Each bucket is a file named bucketStartTime.index which
+// contains one json encoded record (without memo) per line. A query only reads buckets that overlap with
+// the close time range of the query and only reads the full visibility record file for matching records.
+// Records archived before the index was introduced are indexed incrementally, each archive request indexes
+// one batch of them in filename order and records the last indexed filename in the progress file.
+// The index is only used by queries once the complete marker file is written.
+const (
+	visibilityIndexDirName        = "index"
+	visibilityIndexFileSuffix     = ".index"
+	visibilityIndexCompleteMarker = "complete"
+	visibilityIndexProgressFile   = "progress"
+	visibilityIndexBucketSize     = int64(time.Hour)
+
+	visibilityFileSuffix = ".visibility"
+)
+
+// visibilityIndexRebuildBatchSize is the number of existing records indexed by each archive request
+var visibilityIndexRebuildBatchSize = 1000
+
+func (v *visibilityArchiver) addToVisibilityIndex(dirPath string, record *visibilityRecord) error {
+	indexDirPath := path.Join(dirPath, visibilityIndexDirName)
+	if err := mkdirAll(indexDirPath, v.dirMode); err != nil {
+		return err
+	}
+	if err := v.appendToVisibilityIndex(indexDirPath, []*visibilityRecord{record}); err != nil {
+		return err
+	}
+	complete, err := fileExists(path.Join(indexDirPath, visibilityIndexCompleteMarker))
+	if err != nil {
+		return err
+	}
+	if !complete {
+		return v.rebuildVisibilityIndex(dirPath)
+	}
+	return nil
+}
+
+// rebuildVisibilityIndex indexes the next batch of existing records. It's safe to retry, records already
+// in the index are not added again
+func (v *visibilityArchiver) rebuildVisibilityIndex(dirPath string) error {
+	indexDirPath := path.Join(dirPath, visibilityIndexDirName)
+	progressFilePath := path.Join(indexDirPath, visibilityIndexProgressFile)
+	lastIndexedFile := ""
+	inProgress, err := fileExists(progressFilePath)
+	if err != nil {
+		return err
+	}
+	if inProgress {
+		data, err := readFile(progressFilePath)
+		if err != nil {
+			return err
+		}
+		lastIndexedFile = string(data)
+	}
+
+	files, err := listVisibilityFiles(dirPath)
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+	start := sort.SearchStrings(files, lastIndexedFile)
+	if start < len(files) && files[start] == lastIndexedFile {
+		start++
+	}
+	end := start + visibilityIndexRebuildBatchSize
+	if end > len(files) {
+		end = len(files)
+	}
+
+	var records []*visibilityRecord
+	for _, file := range files[start:end] {
+		encodedRecord, err := readFile(path.Join(dirPath, file))
+		if err != nil {
+			return err
+		}
+		record, err := decodeVisibilityRecord(encodedRecord)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+	if err := v.appendToVisibilityIndex(indexDirPath, records); err != nil {
+		return err
+	}
+	if end == len(files) {
+		return writeFile(path.Join(indexDirPath, visibilityIndexCompleteMarker), nil, v.fileMode)
+	}
+	return writeFile(progressFilePath, []byte(files[end-1]), v.fileMode)
+}
+
+// appendToVisibilityIndex adds the records to their index buckets, skipping runs already in the bucket
+func (v *visibilityArchiver) appendToVisibilityIndex(indexDirPath string, records []*visibilityRecord) error {
+	indexedRunIDs := make(map[int64]map[string]struct{})
+	entries := make(map[int64][]byte)
+	for _, record := range records {
+		bucket := visibilityIndexBucket(record.CloseTimestamp)
+		if _, ok := indexedRunIDs[bucket]; !ok {
+			runIDs, err := readVisibilityIndexBucketRunIDs(indexDirPath, bucket)
+			if err != nil {
+				return err
+			}
+			indexedRunIDs[bucket] = runIDs
+		}
+		if _, ok := indexedRunIDs[bucket][record.RunID]; ok {
+			continue
+		}
+		indexedRunIDs[bucket][record.RunID] = struct{}{}
+
+		entry := *record
+		entry.Memo = nil
+		encodedEntry, err := encode(&entry)
+		if err != nil {
+			return err
+		}
+		entries[bucket] = append(append(entries[bucket], encodedEntry...), '\n')
+	}
+	for bucket, data := range entries {
+		if err := appendFile(path.Join(indexDirPath, constructVisibilityIndexFilename(bucket)), data, v.fileMode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readVisibilityIndexBucketRunIDs(indexDirPath string, bucket int64) (map[string]struct{}, error) {
+	runIDs := make(map[string]struct{})
+	exists, err := fileExists(path.Join(indexDirPath, constructVisibilityIndexFilename(bucket)))
+	if err != nil || !exists {
+		return runIDs, err
+	}
+	records, err := readVisibilityIndexBucket(indexDirPath, bucket)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		runIDs[record.RunID] = struct{}{}
+	}
+	return runIDs, nil
+}
+
+// readVisibilityIndexBucket returns all records in a bucket. Entries may still be duplicated when concurrent
+// archive requests add the same run, only the last entry for each runID is returned.
+func readVisibilityIndexBucket(indexDirPath string, bucket int64) ([]*visibilityRecord, error) {
+	data, err := readFile(path.Join(indexDirPath, constructVisibilityIndexFilename(bucket)))
+	if err != nil {
+		return nil, err
+	}
+	recordsByRunID := make(map[string]*visibilityRecord)
+	for _, line := range bytes.Split(data, []byte{'\n'}) {
+		if len(line) == 0 {
+			continue
+		}
+		record, err := decodeVisibilityRecord(line)
+		if err != nil {
+			// a partially written entry, the archive request that wrote it has failed and will be retried
+			continue
+		}
+		recordsByRunID[record.RunID] = record
+	}
+	records := make([]*visibilityRecord, 0, len(recordsByRunID))
+	for _, record := range recordsByRunID {
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// listVisibilityIndexBuckets returns the start time of all index buckets that may contain records
+// matching the query and come after the token, sorted in the order requested by the query
+func listVisibilityIndexBuckets(indexDirPath string, query *parsedQuery, token *queryVisibilityToken) ([]int64, error) {
+	files, err := listFiles(indexDirPath)
+	if err != nil {
+		return nil, err
+	}
+	var buckets []int64
+	for _, file := range files {
+		if !strings.HasSuffix(file, visibilityIndexFileSuffix) {
+			continue
+		}
+		bucket, err := strconv.ParseInt(strings.TrimSuffix(file, visibilityIndexFileSuffix), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse visibility index filename %s", file)
+		}
+		bucketEnd := bucket + visibilityIndexBucketSize - 1
+		if bucketEnd < query.earliestCloseTime || bucket > query.latestCloseTime {
+			continue
+		}
+		if token != nil {
+			if query.ascending && bucketEnd < token.LastCloseTime {
+				continue
+			}
+			if !query.ascending && bucket > token.LastCloseTime {
+				continue
+			}
+		}
+		buckets = append(buckets, bucket)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if query.ascending {
+			return buckets[i] < buckets[j]
+		}
+		return buckets[i] > buckets[j]
+	})
+	return buckets, nil
+}
+
+func listVisibilityFiles(dirPath string) ([]string, error) {
+	files, err := listFiles(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	var visibilityFiles []string
+	for _, file := range files {
+		if strings.HasSuffix(file, visibilityFileSuffix) {
+			visibilityFiles = append(visibilityFiles, file)
+		}
+	}
+	return visibilityFiles, nil
+}
+
+func visibilityIndexBucket(closeTimestamp int64) int64 {
+	bucket := closeTimestamp - closeTimestamp%visibilityIndexBucketSize
+	if closeTimestamp < 0 && bucket != closeTimestamp {
+		bucket -= visibilityIndexBucketSize
+	}
+	return bucket
+}
+
+func constructVisibilityIndexFilename(bucket int64) string {
+	return fmt.Sprintf("%v%s", bucket, visibilityIndexFileSuffix)
+}
@@ -43,6 +43,9 @@ type (
 		BranchToken          []byte
 		NextEventID          int64
 		CloseFailoverVersion int64
+		// MutableStateSummary is the final state of the workflow run, it's archived along with
+		// the history so that archived runs can still be described after retention deletes them
+		MutableStateSummary *shared.DescribeWorkflowExecutionResponse
 	}
 
 	// GetHistoryRequest is the request to Get archived history
@@ -61,6 +64,22 @@ type (
 		NextPageToken  []byte
 	}
 
+	// VerifyHistoryRequest is the request to verify archived history
+	VerifyHistoryRequest struct {
+		DomainID             string
+		WorkflowID           string
+		RunID                string
+		CloseFailoverVersion *int64
+	}
+
+	// GetMutableStateSummaryRequest is the request to get the mutable state summary archived along with history
+	GetMutableStateSummaryRequest struct {
+		DomainID             string
+		WorkflowID           string
+		RunID                string
+		CloseFailoverVersion *int64
+	}
+
 	// HistoryBootstrapContainer contains components needed by all history Archiver implementations
 	HistoryBootstrapContainer struct {
 		HistoryManager   persistence.HistoryManager
@@ -75,6 +94,13 @@ type (
 	HistoryArchiver interface {
 		Archive(context.Context, URI, *ArchiveHistoryRequest, ...ArchiveOption) error
 		Get(context.Context, URI, *GetHistoryRequest) (*GetHistoryResponse, error)
+		// Verify checks archived history against the checksum stored alongside it when it was archived.
+		// It returns ErrHistoryNotExist if the history is missing and ErrHistoryCorrupted if the history
+		// no longer matches its checksum or cannot be decoded.
+		Verify(context.Context, URI, *VerifyHistoryRequest) error
+		// GetMutableStateSummary returns the final mutable state summary archived along with the history.
+		// Histories archived without a summary result in an EntityNotExistsError.
+		GetMutableStateSummary(context.Context, URI, *GetMutableStateSummaryRequest) (*shared.DescribeWorkflowExecutionResponse, error)
 		ValidateURI(URI) error
 	}
 
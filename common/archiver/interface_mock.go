@@ -26,6 +26,7 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+	shared "github.com/uber/cadence/.gen/go/shared"
 )
 
 // HistoryArchiverMock is an autogenerated mock type for the HistoryArchiver type
@@ -77,6 +78,43 @@ func (_m *HistoryArchiverMock) Get(ctx context.Context, uri URI, request *GetHis
 	return r0, r1
 }
 
+// Verify provides a mock function with given fields: ctx, uri, request
+func (_m *HistoryArchiverMock) Verify(ctx context.Context, uri URI, request *VerifyHistoryRequest) error {
+	ret := _m.Called(ctx, uri, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *VerifyHistoryRequest) error); ok {
+		r0 = rf(ctx, uri, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetMutableStateSummary provides a mock function with given fields: ctx, uri, request
+func (_m *HistoryArchiverMock) GetMutableStateSummary(ctx context.Context, uri URI, request *GetMutableStateSummaryRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, uri, request)
+
+	var r0 *shared.DescribeWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *GetMutableStateSummaryRequest) *shared.DescribeWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, uri, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, URI, *GetMutableStateSummaryRequest) error); ok {
+		r1 = rf(ctx, uri, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ValidateURI provides a mock function with given fields: uri
 func (_m *HistoryArchiverMock) ValidateURI(uri URI) error {
 	ret := _m.Called(uri)
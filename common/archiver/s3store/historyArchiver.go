@@ -0,0 +1,444 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+// S3 History Archiver will archive workflow histories to an S3 compatible object store.
+
+// The URI has the format s3://bucket/path. Each Archive() request uploads every history blob
+// read from the history iterator as a separate object with the key
+// path/hash(domainID, workflowID, runID)_version_blobIndex.history. Objects are JSON encoded.
+
+// The Get() method downloads the history blobs one at a time, so a page never requires more than
+// the blobs it returns batches from. It optionally takes in a NextPageToken which specifies the
+// workflow close failover version, the index of the next blob and the index of the first history
+// batch in that blob that should be returned. Instead of NextPageToken, caller can also provide a
+// close failover version, in which case, Get() method will return history batches starting from the
+// beginning of that history version. If neither of NextPageToken or close failover version is
+// specified, the highest close failover version will be picked.
+
+// Once all blobs are uploaded, Archive() uploads the checksums of the blobs to the key
+// path/hash(domainID, workflowID, runID)_version.checksum, which Verify() uses to detect
+// archives with modified or missing blobs.
+
+// If the request carries the final mutable state summary of the workflow run, Archive() also uploads
+// it to the key path/hash(domainID, workflowID, runID)_version.summary, which is returned by
+// GetMutableStateSummary() so that archived runs can still be described.
+
+package s3store
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	errEncodeHistory  = "failed to encode history blob"
+	errUploadBlob     = "failed to upload history blob"
+	errUploadChecksum = "failed to upload history checksum"
+	errEncodeSummary  = "failed to encode mutable state summary"
+	errUploadSummary  = "failed to upload mutable state summary"
+
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+)
+
+type (
+	historyArchiver struct {
+		container *archiver.HistoryBootstrapContainer
+		s3cli     s3iface.S3API
+
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		NextBlobIdx          int
+		NextBatchIdx         int
+	}
+
+	historyChecksum struct {
+		Checksums []string
+	}
+)
+
+// NewHistoryArchiver creates a new archiver.HistoryArchiver based on s3
+func NewHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	config *config.S3Archiver,
+) (archiver.HistoryArchiver, error) {
+	s3cli, err := newS3Client(config)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryArchiver(container, s3cli, nil), nil
+}
+
+func newHistoryArchiver(
+	container *archiver.HistoryBootstrapContainer,
+	s3cli s3iface.S3API,
+	historyIterator archiver.HistoryIterator,
+) *historyArchiver {
+	return &historyArchiver{
+		container:       container,
+		s3cli:           s3cli,
+		historyIterator: historyIterator,
+	}
+}
+
+func (h *historyArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && !isRetryableError(err) && featureCatalog.NonRetriableError != nil {
+			err = featureCatalog.NonRetriableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.container.Logger, request, URI.String())
+
+	if err := softValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	historyIterator := h.historyIterator
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = archiver.NewHistoryIterator(request, h.container.HistoryManager, h.container.HistoryV2Manager, targetHistoryBlobSize)
+	}
+
+	checksum := &historyChecksum{}
+	for blobIdx := 0; historyIterator.HasNext(); blobIdx++ {
+		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
+		if err != nil {
+			logger := logger.WithTags(tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if !isRetryableError(err) {
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			}
+			return err
+		}
+
+		if historyMutated(request, historyBlob.Body, *historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
+			return archiver.ErrHistoryMutated
+		}
+
+		encodedHistoryBlob, err := encode(historyBlob)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
+
+		key := constructHistoryKey(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, blobIdx)
+		if err := upload(ctx, h.s3cli, URI.Hostname(), key, encodedHistoryBlob); err != nil {
+			logger := logger.WithTags(tag.ArchivalArchiveFailReason(errUploadBlob), tag.Error(err))
+			if !isRetryableError(err) {
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			}
+			return err
+		}
+		checksum.Checksums = append(checksum.Checksums, archiver.ComputeChecksum(encodedHistoryBlob))
+	}
+
+	encodedChecksum, err := encode(checksum)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+		return err
+	}
+	key := constructHistoryChecksumKey(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := upload(ctx, h.s3cli, URI.Hostname(), key, encodedChecksum); err != nil {
+		logger := logger.WithTags(tag.ArchivalArchiveFailReason(errUploadChecksum), tag.Error(err))
+		if !isRetryableError(err) {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+		} else {
+			logger.Error(archiver.ArchiveTransientErrorMsg)
+		}
+		return err
+	}
+
+	if request.MutableStateSummary != nil {
+		encodedSummary, err := encode(request.MutableStateSummary)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeSummary), tag.Error(err))
+			return err
+		}
+		key := constructMutableStateSummaryKey(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+		if err := upload(ctx, h.s3cli, URI.Hostname(), key, encodedSummary); err != nil {
+			logger := logger.WithTags(tag.ArchivalArchiveFailReason(errUploadSummary), tag.Error(err))
+			if !isRetryableError(err) {
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+			} else {
+				logger.Error(archiver.ArchiveTransientErrorMsg)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *historyArchiver) Get(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+) (*archiver.GetHistoryResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateGetRequest(request); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidGetHistoryRequest.Error()}
+	}
+
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, &shared.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+	} else if request.CloseFailoverVersion != nil {
+		token = &getHistoryToken{
+			CloseFailoverVersion: *request.CloseFailoverVersion,
+		}
+	} else {
+		highestVersion, err := h.getHighestVersion(ctx, URI, request.DomainID, request.WorkflowID, request.RunID)
+		if err == archiver.ErrHistoryNotExist {
+			return nil, &shared.EntityNotExistsError{Message: err.Error()}
+		}
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+		token = &getHistoryToken{
+			CloseFailoverVersion: *highestVersion,
+		}
+	}
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	for numOfEvents < request.PageSize {
+		key := constructHistoryKey(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, token.CloseFailoverVersion, token.NextBlobIdx)
+		encodedHistoryBlob, err := download(ctx, h.s3cli, URI.Hostname(), key)
+		if isNotFoundError(err) {
+			return nil, &shared.EntityNotExistsError{Message: archiver.ErrHistoryNotExist.Error()}
+		}
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+
+		historyBlob, err := decodeHistoryBlob(encodedHistoryBlob)
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+		if token.NextBatchIdx > len(historyBlob.Body) {
+			return nil, &shared.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+
+		for _, batch := range historyBlob.Body[token.NextBatchIdx:] {
+			response.HistoryBatches = append(response.HistoryBatches, batch)
+			token.NextBatchIdx++
+			numOfEvents += len(batch.Events)
+			if numOfEvents >= request.PageSize {
+				break
+			}
+		}
+
+		if token.NextBatchIdx >= len(historyBlob.Body) {
+			if historyBlob.Header.IsLast != nil && *historyBlob.Header.IsLast {
+				return response, nil
+			}
+			token.NextBlobIdx++
+			token.NextBatchIdx = 0
+		}
+	}
+
+	nextToken, err := serializeToken(token)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	response.NextPageToken = nextToken
+	return response, nil
+}
+
+func (h *historyArchiver) Verify(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.VerifyHistoryRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &shared.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateVerifyRequest(request); err != nil {
+		return &shared.BadRequestError{Message: archiver.ErrInvalidVerifyHistoryRequest.Error()}
+	}
+
+	var version int64
+	if request.CloseFailoverVersion != nil {
+		version = *request.CloseFailoverVersion
+	} else {
+		highestVersion, err := h.getHighestVersion(ctx, URI, request.DomainID, request.WorkflowID, request.RunID)
+		if err == archiver.ErrHistoryNotExist || isNotFoundError(err) {
+			return archiver.ErrHistoryNotExist
+		}
+		if err != nil {
+			return &shared.InternalServiceError{Message: err.Error()}
+		}
+		version = *highestVersion
+	}
+
+	// histories archived before checksums were introduced are only checked for being decodable
+	var checksum *historyChecksum
+	key := constructHistoryChecksumKey(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, version)
+	encodedChecksum, err := download(ctx, h.s3cli, URI.Hostname(), key)
+	if err != nil && !isNotFoundError(err) {
+		return &shared.InternalServiceError{Message: err.Error()}
+	}
+	if err == nil {
+		if checksum, err = decodeHistoryChecksum(encodedChecksum); err != nil {
+			return archiver.ErrHistoryCorrupted
+		}
+	}
+
+	for blobIdx := 0; ; blobIdx++ {
+		key := constructHistoryKey(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, version, blobIdx)
+		encodedHistoryBlob, err := download(ctx, h.s3cli, URI.Hostname(), key)
+		if isNotFoundError(err) {
+			if blobIdx == 0 && checksum == nil {
+				return archiver.ErrHistoryNotExist
+			}
+			return archiver.ErrHistoryCorrupted
+		}
+		if err != nil {
+			return &shared.InternalServiceError{Message: err.Error()}
+		}
+
+		if checksum != nil {
+			if blobIdx >= len(checksum.Checksums) || checksum.Checksums[blobIdx] != archiver.ComputeChecksum(encodedHistoryBlob) {
+				return archiver.ErrHistoryCorrupted
+			}
+		}
+		historyBlob, err := decodeHistoryBlob(encodedHistoryBlob)
+		if err != nil || historyBlob.Header == nil {
+			return archiver.ErrHistoryCorrupted
+		}
+		if historyBlob.Header.IsLast != nil && *historyBlob.Header.IsLast {
+			if checksum != nil && blobIdx != len(checksum.Checksums)-1 {
+				return archiver.ErrHistoryCorrupted
+			}
+			return nil
+		}
+	}
+}
+
+func (h *historyArchiver) GetMutableStateSummary(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetMutableStateSummaryRequest,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateGetMutableStateSummaryRequest(request); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidGetMutableStateSummaryRequest.Error()}
+	}
+
+	var version int64
+	if request.CloseFailoverVersion != nil {
+		version = *request.CloseFailoverVersion
+	} else {
+		highestVersion, err := h.getHighestVersion(ctx, URI, request.DomainID, request.WorkflowID, request.RunID)
+		if err == archiver.ErrHistoryNotExist || isNotFoundError(err) {
+			return nil, &shared.EntityNotExistsError{Message: archiver.ErrMutableStateSummaryNotExist.Error()}
+		}
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: err.Error()}
+		}
+		version = *highestVersion
+	}
+
+	key := constructMutableStateSummaryKey(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, version)
+	encodedSummary, err := download(ctx, h.s3cli, URI.Hostname(), key)
+	if isNotFoundError(err) {
+		return nil, &shared.EntityNotExistsError{Message: archiver.ErrMutableStateSummaryNotExist.Error()}
+	}
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	summary, err := decodeMutableStateSummary(encodedSummary)
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+	return summary, nil
+}
+
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	if err := softValidateURI(URI); err != nil {
+		return err
+	}
+
+	return bucketExists(h.s3cli, URI.Hostname())
+}
+
+func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, domainID, workflowID, runID string) (*int64, error) {
+	prefix := constructHistoryKeyPrefix(URI.Path(), domainID, workflowID, runID)
+	var highestVersion *int64
+	err := h.s3cli.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(URI.Hostname()),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			version, err := extractCloseFailoverVersion(strings.TrimPrefix(aws.StringValue(object.Key), prefix))
+			if err != nil {
+				continue
+			}
+			if highestVersion == nil || version > *highestVersion {
+				highestVersion = &version
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if highestVersion == nil {
+		return nil, archiver.ErrHistoryNotExist
+	}
+	return highestVersion, nil
+}
@@ -0,0 +1,557 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package s3store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"go.uber.org/zap"
+)
+
+const (
+	testDomainID             = "test-domain-id"
+	testDomainName           = "test-domain-name"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = 100
+	testPageSize             = 100
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container          *archiver.HistoryBootstrapContainer
+	s3cli              *memoryS3
+	testArchivalURI    archiver.URI
+	historyBatchesV1   []*shared.History
+	historyBatchesV100 []*shared.History
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupSuite() {
+	var err error
+	s.testArchivalURI, err = archiver.NewURI("s3://" + testBucket + "/a/b")
+	s.Require().NoError(err)
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.HistoryBootstrapContainer{
+		Logger: loggerimpl.NewLogger(zap.NewNop()),
+	}
+	s.s3cli = newMemoryS3(testBucket)
+	s.setupHistoryObjects()
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme://" + testBucket,
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "s3://",
+			expectedErr: errNoBucketSpecified,
+		},
+		{
+			URI:         "s3://unknown-bucket/a/b",
+			expectedErr: errBucketNotExists,
+		},
+		{
+			URI:         "s3://" + testBucket + "/a/b",
+			expectedErr: nil,
+		},
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, historyArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newArchiveRequest()
+	request.WorkflowID = "" // an invalid request
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, request)
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_ErrorOnReadHistory() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_HistoryMutated() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: []*shared.History{
+			{
+				Events: []*shared.HistoryEvent{
+					{
+						EventId:   common.Int64Ptr(common.FirstEventID + 1),
+						Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+						Version:   common.Int64Ptr(testCloseFailoverVersion + 1),
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.Equal(archiver.ErrHistoryMutated, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_NonRetriableErrorOption() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest(), archiver.GetNonRetriableErrorOption(nonRetryableErr))
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_BucketNotExist() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.newHistoryBlob(s.historyBatchesV100, true), nil),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI("s3://unknown-bucket/a/b")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest())
+	s.True(isNotFoundError(err))
+}
+
+func (s *historyArchiverSuite) TestArchive_Success() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.newHistoryBlob(s.historyBatchesV100[:1], false), nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.newHistoryBlob(s.historyBatchesV100[1:], true), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI("s3://" + testBucket + "/archive")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest())
+	s.NoError(err)
+
+	for blobIdx := 0; blobIdx < 2; blobIdx++ {
+		key := constructHistoryKey(URI.Path(), testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, blobIdx)
+		s.Contains(s.s3cli.buckets[testBucket], key)
+	}
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, s.newGetRequest())
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.PageSize = 0 // pageSize should be greater than 0
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidToken() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.NextPageToken = []byte{'r', 'a', 'n', 'd', 'o', 'm'}
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_HistoryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.RunID = "other-run-id"
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+
+	request.CloseFailoverVersion = common.Int64Ptr(testCloseFailoverVersion)
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_PickHighestVersion() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, s.newGetRequest())
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_UseProvidedVersion() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.CloseFailoverVersion = common.Int64Ptr(1)
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV1, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newGetRequest()
+	request.PageSize = 1
+	request.CloseFailoverVersion = common.Int64Ptr(testCloseFailoverVersion)
+
+	var combinedHistory []*shared.History
+	for _, hasNext := range []bool{true, true, false} {
+		response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		s.Len(response.HistoryBatches, 1)
+		s.Equal(hasNext, response.NextPageToken != nil)
+		combinedHistory = append(combinedHistory, response.HistoryBatches...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal(s.historyBatchesV100, combinedHistory)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.newHistoryBlob(s.historyBatchesV100, true), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI("s3://" + testBucket + "/archive")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest())
+	s.NoError(err)
+
+	response, err := historyArchiver.Get(context.Background(), URI, s.newGetRequest())
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestVerify_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	err := historyArchiver.Verify(context.Background(), s.testArchivalURI, &archiver.VerifyHistoryRequest{})
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestVerify_Fail_HistoryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newVerifyRequest()
+	request.RunID = "other-run-id"
+	s.Equal(archiver.ErrHistoryNotExist, historyArchiver.Verify(context.Background(), s.testArchivalURI, request))
+}
+
+func (s *historyArchiverSuite) TestVerify_Success_NoChecksum() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	s.NoError(historyArchiver.Verify(context.Background(), s.testArchivalURI, s.newVerifyRequest()))
+}
+
+func (s *historyArchiverSuite) TestVerify_Fail_MissingBlobWithoutChecksum() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	key := constructHistoryKey(s.testArchivalURI.Path(), testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, 1)
+	delete(s.s3cli.buckets[testBucket], key)
+	s.Equal(archiver.ErrHistoryCorrupted, historyArchiver.Verify(context.Background(), s.testArchivalURI, s.newVerifyRequest()))
+}
+
+func (s *historyArchiverSuite) TestArchiveAndVerify() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.newHistoryBlob(s.historyBatchesV100[:2], false), nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.newHistoryBlob(s.historyBatchesV100[2:], true), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI("s3://" + testBucket + "/archive")
+	s.NoError(err)
+	s.NoError(historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest()))
+	s.NoError(historyArchiver.Verify(context.Background(), URI, s.newVerifyRequest()))
+
+	// a modified blob no longer matches its checksum
+	key := constructHistoryKey(URI.Path(), testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, 1)
+	data, err := encode(s.newHistoryBlob(s.historyBatchesV1, true))
+	s.NoError(err)
+	s.NoError(upload(context.Background(), s.s3cli, testBucket, key, data))
+	s.Equal(archiver.ErrHistoryCorrupted, historyArchiver.Verify(context.Background(), URI, s.newVerifyRequest()))
+
+	// a removed blob is reported as corrupted rather than missing since the checksum still exists
+	delete(s.s3cli.buckets[testBucket], key)
+	s.Equal(archiver.ErrHistoryCorrupted, historyArchiver.Verify(context.Background(), URI, s.newVerifyRequest()))
+}
+
+func (s *historyArchiverSuite) TestGetMutableStateSummary_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	summary, err := historyArchiver.GetMutableStateSummary(context.Background(), s.testArchivalURI, &archiver.GetMutableStateSummaryRequest{})
+	s.Nil(summary)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGetMutableStateSummary_Fail_SummaryNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	// histories in the test bucket are archived without a summary
+	summary, err := historyArchiver.GetMutableStateSummary(context.Background(), s.testArchivalURI, s.newGetMutableStateSummaryRequest())
+	s.Nil(summary)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+
+	request := s.newGetMutableStateSummaryRequest()
+	request.RunID = "other-run-id"
+	summary, err = historyArchiver.GetMutableStateSummary(context.Background(), s.testArchivalURI, request)
+	s.Nil(summary)
+	s.IsType(&shared.EntityNotExistsError{}, err)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGetMutableStateSummary() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.newHistoryBlob(s.historyBatchesV100, true), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI("s3://" + testBucket + "/summary")
+	s.NoError(err)
+	request := s.newArchiveRequest()
+	request.MutableStateSummary = newTestMutableStateSummary()
+	s.NoError(historyArchiver.Archive(context.Background(), URI, request))
+
+	summary, err := historyArchiver.GetMutableStateSummary(context.Background(), URI, s.newGetMutableStateSummaryRequest())
+	s.NoError(err)
+	s.Equal(newTestMutableStateSummary(), summary)
+
+	// the summary object must not be picked up as a history version
+	highestVersion, err := historyArchiver.getHighestVersion(context.Background(), URI, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(int64(testCloseFailoverVersion), *highestVersion)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
+	return newHistoryArchiver(s.container, s.s3cli, historyIterator)
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) newGetRequest() *archiver.GetHistoryRequest {
+	return &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+}
+
+func (s *historyArchiverSuite) newVerifyRequest() *archiver.VerifyHistoryRequest {
+	return &archiver.VerifyHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+}
+
+func (s *historyArchiverSuite) newGetMutableStateSummaryRequest() *archiver.GetMutableStateSummaryRequest {
+	return &archiver.GetMutableStateSummaryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+}
+
+func (s *historyArchiverSuite) newHistoryBlob(historyBatches []*shared.History, isLast bool) *archiver.HistoryBlob {
+	return &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(isLast),
+		},
+		Body: historyBatches,
+	}
+}
+
+// setupHistoryObjects archives version 1 as a single blob and version 100 as two blobs,
+// the first one holding two batches
+func (s *historyArchiverSuite) setupHistoryObjects() {
+	s.historyBatchesV1 = []*shared.History{
+		{
+			Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(testNextEventID - 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(1),
+				},
+			},
+		},
+	}
+
+	s.historyBatchesV100 = []*shared.History{
+		{
+			Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(common.FirstEventID + 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+			},
+		},
+		{
+			Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(common.FirstEventID + 2),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+			},
+		},
+		{
+			Events: []*shared.HistoryEvent{
+				{
+					EventId:   common.Int64Ptr(testNextEventID - 1),
+					Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+					Version:   common.Int64Ptr(testCloseFailoverVersion),
+				},
+			},
+		},
+	}
+
+	s.writeHistoryBlobForGetTest(s.newHistoryBlob(s.historyBatchesV1, true), 1, 0)
+	s.writeHistoryBlobForGetTest(s.newHistoryBlob(s.historyBatchesV100[:2], false), testCloseFailoverVersion, 0)
+	s.writeHistoryBlobForGetTest(s.newHistoryBlob(s.historyBatchesV100[2:], true), testCloseFailoverVersion, 1)
+}
+
+func (s *historyArchiverSuite) writeHistoryBlobForGetTest(historyBlob *archiver.HistoryBlob, version int64, blobIdx int) {
+	data, err := encode(historyBlob)
+	s.Require().NoError(err)
+	key := constructHistoryKey(s.testArchivalURI.Path(), testDomainID, testWorkflowID, testRunID, version, blobIdx)
+	s.Require().NoError(upload(context.Background(), s.s3cli, testBucket, key, data))
+}
+
+func newTestMutableStateSummary() *shared.DescribeWorkflowExecutionResponse {
+	return &shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(testWorkflowID),
+				RunId:      common.StringPtr(testRunID),
+			},
+			CloseStatus: shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+			Memo: &shared.Memo{
+				Fields: map[string][]byte{"testField": []byte("testValue")},
+			},
+		},
+		PendingChildren: []*shared.PendingChildExecutionInfo{
+			{
+				WorkflowID:  common.StringPtr("child-workflow-id"),
+				RunID:       common.StringPtr("child-run-id"),
+				InitiatedID: common.Int64Ptr(5),
+			},
+		},
+	}
+}
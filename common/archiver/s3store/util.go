@@ -0,0 +1,335 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/dgryski/go-farm"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// URIScheme is the scheme for the s3 implementation
+	URIScheme = "s3"
+
+	bucketValidationTimeout = 10 * time.Second
+)
+
+var (
+	errNoBucketSpecified = errors.New("no bucket specified in URI")
+	errBucketNotExists   = errors.New("requested bucket does not exist")
+	errUnknownKey        = errors.New("unknown object key structure")
+
+	// s3RetryableCodes are the transient S3 error codes not recognized by the generic aws helpers
+	s3RetryableCodes = map[string]struct{}{
+		"SlowDown":           {},
+		"InternalError":      {},
+		"ServiceUnavailable": {},
+	}
+)
+
+// S3 client util
+
+func newS3Client(cfg *config.S3Archiver) (s3iface.S3API, error) {
+	awsConfig := &aws.Config{
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+	}
+	if cfg.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(cfg.Endpoint)
+	}
+	if cfg.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(cfg.AccessKeyID, cfg.SecretAccessKey, "")
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return s3.New(sess), nil
+}
+
+func upload(ctx context.Context, client s3iface.S3API, bucket, key string, data []byte) error {
+	_, err := client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func download(ctx context.Context, client s3iface.S3API, bucket, key string) ([]byte, error) {
+	result, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer result.Body.Close()
+	return ioutil.ReadAll(result.Body)
+}
+
+func bucketExists(client s3iface.S3API, bucket string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), bucketValidationTimeout)
+	defer cancel()
+	_, err := client.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+	if isNotFoundError(err) {
+		return errBucketNotExists
+	}
+	return err
+}
+
+func isNotFoundError(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case s3.ErrCodeNoSuchKey, s3.ErrCodeNoSuchBucket, "NotFound":
+			return true
+		}
+	}
+	return false
+}
+
+// isRetryableError tells whether an error returned by persistence or by the S3 client is transient
+func isRetryableError(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		if _, ok := s3RetryableCodes[aerr.Code()]; ok {
+			return true
+		}
+		return request.IsErrorRetryable(err) || request.IsErrorThrottle(err)
+	}
+	return common.IsPersistenceTransientError(err)
+}
+
+// encoding & decoding util
+
+func encode(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func decodeHistoryBlob(data []byte) (*archiver.HistoryBlob, error) {
+	historyBlob := &archiver.HistoryBlob{}
+	err := json.Unmarshal(data, historyBlob)
+	if err != nil {
+		return nil, err
+	}
+	return historyBlob, nil
+}
+
+func decodeHistoryChecksum(data []byte) (*historyChecksum, error) {
+	checksum := &historyChecksum{}
+	err := json.Unmarshal(data, checksum)
+	if err != nil {
+		return nil, err
+	}
+	return checksum, nil
+}
+
+func decodeMutableStateSummary(data []byte) (*shared.DescribeWorkflowExecutionResponse, error) {
+	summary := &shared.DescribeWorkflowExecutionResponse{}
+	err := json.Unmarshal(data, summary)
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func decodeVisibilityRecord(data []byte) (*visibilityRecord, error) {
+	record := &visibilityRecord{}
+	err := json.Unmarshal(data, record)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
+	token := &queryVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// Object key construction
+
+// constructHistoryKeyPrefix returns the prefix shared by the objects of all versions of a workflow history
+func constructHistoryKeyPrefix(path, domainID, workflowID, runID string) string {
+	return constructKey(path, strings.Join([]string{hash(domainID), hash(workflowID), hash(runID)}, "")) + "_"
+}
+
+// constructHistoryKey returns the key of the object holding the blob at blobIdx of a workflow history version
+func constructHistoryKey(path, domainID, workflowID, runID string, version int64, blobIdx int) string {
+	return fmt.Sprintf("%s%v_%v.history", constructHistoryKeyPrefix(path, domainID, workflowID, runID), version, blobIdx)
+}
+
+// constructHistoryChecksumKey returns the key of the object holding the blob checksums of a workflow history version
+func constructHistoryChecksumKey(path, domainID, workflowID, runID string, version int64) string {
+	return fmt.Sprintf("%s%v.checksum", constructHistoryKeyPrefix(path, domainID, workflowID, runID), version)
+}
+
+// constructMutableStateSummaryKey returns the key of the object holding the mutable state summary of a workflow history version
+func constructMutableStateSummaryKey(path, domainID, workflowID, runID string, version int64) string {
+	return fmt.Sprintf("%s%v.summary", constructHistoryKeyPrefix(path, domainID, workflowID, runID), version)
+}
+
+func constructVisibilityKeyPrefix(path, domainID string) string {
+	return constructKey(path, domainID) + "/"
+}
+
+// constructVisibilityKey returns the key of a visibility record. The close timestamp is inverted and
+// zero padded so that listing the keys of a domain returns the most recently closed workflows first
+func constructVisibilityKey(path, domainID string, closeTimestamp int64, runID string) string {
+	return fmt.Sprintf("%s%s_%s.visibility", constructVisibilityKeyPrefix(path, domainID), invertTimestamp(closeTimestamp), hash(runID))
+}
+
+// constructVisibilityStartAfter returns the key after which the records closed no later than closeTimestamp are listed
+func constructVisibilityStartAfter(path, domainID string, closeTimestamp int64) string {
+	return constructVisibilityKeyPrefix(path, domainID) + invertTimestamp(closeTimestamp)
+}
+
+func constructKey(path, name string) string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return name
+	}
+	return path + "/" + name
+}
+
+func invertTimestamp(timestamp int64) string {
+	return fmt.Sprintf("%019d", math.MaxInt64-timestamp)
+}
+
+func hash(s string) string {
+	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
+}
+
+// Validation
+
+func softValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if len(URI.Hostname()) == 0 {
+		return errNoBucketSpecified
+	}
+	return nil
+}
+
+// Misc.
+
+func extractCloseFailoverVersion(keySuffix string) (int64, error) {
+	keyParts := strings.FieldsFunc(keySuffix, func(r rune) bool {
+		return r == '_' || r == '.'
+	})
+	if len(keyParts) != 3 {
+		return -1, errUnknownKey
+	}
+	return strconv.ParseInt(keyParts[0], 10, 64)
+}
+
+func extractCloseTimestamp(keySuffix string) (int64, error) {
+	keyParts := strings.FieldsFunc(keySuffix, func(r rune) bool {
+		return r == '_' || r == '.'
+	})
+	if len(keyParts) != 3 {
+		return 0, errUnknownKey
+	}
+	invertedTimestamp, err := strconv.ParseInt(keyParts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return math.MaxInt64 - invertedTimestamp, nil
+}
+
+func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*shared.History, isLast bool) bool {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+	lastFailoverVersion := lastEvent.GetVersion()
+	if lastFailoverVersion > request.CloseFailoverVersion {
+		return true
+	}
+
+	if !isLast {
+		return false
+	}
+	lastEventID := lastEvent.GetEventId()
+	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
+}
+
+func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiver.HistoryBlob, error) {
+	historyBlob, err := historyIterator.Next()
+	op := func() error {
+		historyBlob, err = historyIterator.Next()
+		return err
+	}
+	for err != nil {
+		if !common.IsPersistenceTransientError(err) {
+			return nil, err
+		}
+		if contextExpired(ctx) {
+			return nil, archiver.ErrContextTimeout
+		}
+		err = backoff.Retry(op, common.CreatePersistanceRetryPolicy(), common.IsPersistenceTransientError)
+	}
+	return historyBlob, nil
+}
+
+func contextExpired(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package s3store
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+)
+
+const (
+	testBucket = "test-bucket"
+
+	// keep pages small so that listing goes through several of them
+	testListPageSize = 2
+)
+
+type (
+	UtilSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	// memoryS3 is an in memory stand-in for the subset of the S3 API used by the archivers
+	memoryS3 struct {
+		s3iface.S3API
+		sync.Mutex
+		buckets map[string]map[string][]byte
+	}
+)
+
+func TestUtilSuite(t *testing.T) {
+	suite.Run(t, new(UtilSuite))
+}
+
+func (s *UtilSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UtilSuite) TestConstructKey() {
+	s.Equal("name", constructKey("", "name"))
+	s.Equal("name", constructKey("/", "name"))
+	s.Equal("a/b/name", constructKey("/a/b/", "name"))
+}
+
+func (s *UtilSuite) TestHistoryKey() {
+	prefix := constructHistoryKeyPrefix("/a/b", testDomainID, testWorkflowID, testRunID)
+	s.True(strings.HasPrefix(prefix, "a/b/"))
+	for _, version := range []int64{-1, 0, testCloseFailoverVersion} {
+		key := constructHistoryKey("/a/b", testDomainID, testWorkflowID, testRunID, version, 3)
+		s.True(strings.HasPrefix(key, prefix))
+		extracted, err := extractCloseFailoverVersion(strings.TrimPrefix(key, prefix))
+		s.NoError(err)
+		s.Equal(version, extracted)
+	}
+
+	_, err := extractCloseFailoverVersion("unknown.history")
+	s.Error(err)
+}
+
+func (s *UtilSuite) TestVisibilityKey_DescendingCloseTime() {
+	prefix := constructVisibilityKeyPrefix("/a", testDomainID)
+	closeTimes := []int64{1, 1000, 1000000, 1500000000000000000}
+	var keys []string
+	for _, closeTime := range closeTimes {
+		key := constructVisibilityKey("/a", testDomainID, closeTime, testRunID)
+		s.True(strings.HasPrefix(key, prefix))
+		extracted, err := extractCloseTimestamp(strings.TrimPrefix(key, prefix))
+		s.NoError(err)
+		s.Equal(closeTime, extracted)
+		keys = append(keys, key)
+	}
+	s.True(sort.SliceIsSorted(keys, func(i, j int) bool { return keys[i] > keys[j] }))
+
+	startAfter := constructVisibilityStartAfter("/a", testDomainID, 1000)
+	s.True(keys[0] > startAfter)
+	s.True(keys[1] > startAfter)
+	s.True(keys[2] < startAfter)
+}
+
+func (s *UtilSuite) TestSoftValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme://bucket/a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "s3:///a/b/c",
+			expectedErr: errNoBucketSpecified,
+		},
+		{
+			URI:         "s3://bucket",
+			expectedErr: nil,
+		},
+		{
+			URI:         "s3://bucket/a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, softValidateURI(URI))
+	}
+}
+
+func (s *UtilSuite) TestIsNotFoundError() {
+	s.True(isNotFoundError(awserr.New(s3.ErrCodeNoSuchKey, "", nil)))
+	s.True(isNotFoundError(awserr.New(s3.ErrCodeNoSuchBucket, "", nil)))
+	s.False(isNotFoundError(awserr.New("InternalError", "", nil)))
+	s.False(isNotFoundError(nil))
+}
+
+func (s *UtilSuite) TestIsRetryableError() {
+	s.True(isRetryableError(awserr.New("RequestTimeout", "", nil)))
+	s.True(isRetryableError(awserr.New("SlowDown", "", nil)))
+	s.False(isRetryableError(awserr.New(s3.ErrCodeNoSuchBucket, "", nil)))
+	s.True(isRetryableError(&shared.ServiceBusyError{}))
+	s.False(isRetryableError(errors.New("some random error")))
+}
+
+func (s *UtilSuite) TestHistoryMutated() {
+	testCases := []struct {
+		historyBatches []*shared.History
+		request        *archiver.ArchiveHistoryRequest
+		isLast         bool
+		isMutated      bool
+	}{
+		{
+			historyBatches: []*shared.History{
+				{
+					Events: []*shared.HistoryEvent{
+						{
+							Version: common.Int64Ptr(15),
+						},
+					},
+				},
+			},
+			request: &archiver.ArchiveHistoryRequest{
+				CloseFailoverVersion: 3,
+			},
+			isMutated: true,
+		},
+		{
+			historyBatches: []*shared.History{
+				{
+					Events: []*shared.HistoryEvent{
+						{
+							EventId: common.Int64Ptr(33),
+							Version: common.Int64Ptr(10),
+						},
+					},
+				},
+			},
+			request: &archiver.ArchiveHistoryRequest{
+				CloseFailoverVersion: 10,
+				NextEventID:          34,
+			},
+			isLast: true,
+		},
+		{
+			historyBatches: []*shared.History{
+				{
+					Events: []*shared.HistoryEvent{
+						{
+							EventId: common.Int64Ptr(33),
+							Version: common.Int64Ptr(10),
+						},
+					},
+				},
+			},
+			request: &archiver.ArchiveHistoryRequest{
+				CloseFailoverVersion: 10,
+				NextEventID:          40,
+			},
+			isLast:    true,
+			isMutated: true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Equal(tc.isMutated, historyMutated(tc.request, tc.historyBatches, tc.isLast))
+	}
+}
+
+func newMemoryS3(buckets ...string) *memoryS3 {
+	m := &memoryS3{buckets: make(map[string]map[string][]byte)}
+	for _, bucket := range buckets {
+		m.buckets[bucket] = make(map[string][]byte)
+	}
+	return m
+}
+
+func (m *memoryS3) HeadBucketWithContext(_ aws.Context, input *s3.HeadBucketInput, _ ...request.Option) (*s3.HeadBucketOutput, error) {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.buckets[aws.StringValue(input.Bucket)]; !ok {
+		return nil, awserr.New("NotFound", "Not Found", nil)
+	}
+	return &s3.HeadBucketOutput{}, nil
+}
+
+func (m *memoryS3) PutObjectWithContext(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+	m.Lock()
+	defer m.Unlock()
+	objects, ok := m.buckets[aws.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist", nil)
+	}
+	data, err := ioutil.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	objects[aws.StringValue(input.Key)] = data
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (m *memoryS3) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	m.Lock()
+	defer m.Unlock()
+	objects, ok := m.buckets[aws.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist", nil)
+	}
+	data, ok := objects[aws.StringValue(input.Key)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "The specified key does not exist", nil)
+	}
+	return &s3.GetObjectOutput{Body: ioutil.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func (m *memoryS3) ListObjectsV2PagesWithContext(
+	_ aws.Context,
+	input *s3.ListObjectsV2Input,
+	fn func(*s3.ListObjectsV2Output, bool) bool,
+	_ ...request.Option,
+) error {
+	m.Lock()
+	objects, ok := m.buckets[aws.StringValue(input.Bucket)]
+	if !ok {
+		m.Unlock()
+		return awserr.New(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist", nil)
+	}
+	var keys []string
+	for key := range objects {
+		if strings.HasPrefix(key, aws.StringValue(input.Prefix)) && key > aws.StringValue(input.StartAfter) {
+			keys = append(keys, key)
+		}
+	}
+	m.Unlock()
+
+	sort.Strings(keys)
+	for start := 0; start == 0 || start < len(keys); start += testListPageSize {
+		end := start + testListPageSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		page := &s3.ListObjectsV2Output{}
+		for _, key := range keys[start:end] {
+			page.Contents = append(page.Contents, &s3.Object{Key: aws.String(key)})
+		}
+		if !fn(page, end == len(keys)) {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package s3store
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errUploadRecord           = "failed to upload visibility record"
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		s3cli       s3iface.S3API
+		queryParser QueryParser
+	}
+
+	queryVisibilityToken struct {
+		LastCloseTime int64
+		LastRunID     string
+	}
+
+	visibilityRecord archiver.ArchiveVisibilityRequest
+
+	queryVisibilityRequest struct {
+		domainID      string
+		pageSize      int
+		nextPageToken []byte
+		parsedQuery   *parsedQuery
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on s3
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.S3Archiver,
+) (archiver.VisibilityArchiver, error) {
+	s3cli, err := newS3Client(config)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityArchiver(container, s3cli), nil
+}
+
+func newVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	s3cli s3iface.S3API,
+) *visibilityArchiver {
+	return &visibilityArchiver{
+		container:   container,
+		s3cli:       s3cli,
+		queryParser: NewQueryParser(),
+	}
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveVisibilityRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	defer func() {
+		if err != nil && !isRetryableError(err) && featureCatalog.NonRetriableError != nil {
+			err = featureCatalog.NonRetriableError()
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+
+	if err := softValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return err
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return err
+	}
+
+	// The key has the format: path/domainID/invertedCloseTimestamp_hash(runID).visibility
+	// This format allows the archiver to list the records of a domain by descending close time
+	// without reading the objects
+	key := constructVisibilityKey(URI.Path(), request.DomainID, request.CloseTimestamp, request.RunID)
+	if err := upload(ctx, v.s3cli, URI.Hostname(), key, encodedVisibilityRecord); err != nil {
+		logger := logger.WithTags(tag.ArchivalArchiveFailReason(errUploadRecord), tag.Error(err))
+		if !isRetryableError(err) {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+		} else {
+			logger.Error(archiver.ArchiveTransientErrorMsg)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, &shared.BadRequestError{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, &shared.BadRequestError{Message: err.Error()}
+	}
+
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	return v.query(ctx, URI, &queryVisibilityRequest{
+		domainID:      request.DomainID,
+		pageSize:      request.PageSize,
+		nextPageToken: request.NextPageToken,
+		parsedQuery:   parsedQuery,
+	})
+}
+
+func (v *visibilityArchiver) query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	// records are listed by descending close time, so listing starts at the latest close time
+	// allowed by the query or right after the last record returned, whichever comes last
+	startAfter := constructVisibilityStartAfter(URI.Path(), request.domainID, request.parsedQuery.latestCloseTime)
+	if request.nextPageToken != nil {
+		token, err := deserializeQueryVisibilityToken(request.nextPageToken)
+		if err != nil {
+			return nil, &shared.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+		lastKey := constructVisibilityKey(URI.Path(), request.domainID, token.LastCloseTime, token.LastRunID)
+		if lastKey > startAfter {
+			startAfter = lastKey
+		}
+	}
+
+	prefix := constructVisibilityKeyPrefix(URI.Path(), request.domainID)
+	response := &archiver.QueryVisibilityResponse{}
+	var queryErr error
+	err := v.s3cli.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:     aws.String(URI.Hostname()),
+		Prefix:     aws.String(prefix),
+		StartAfter: aws.String(startAfter),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			closeTime, err := extractCloseTimestamp(strings.TrimPrefix(aws.StringValue(object.Key), prefix))
+			if err != nil {
+				queryErr = err
+				return false
+			}
+			if closeTime < request.parsedQuery.earliestCloseTime {
+				return false
+			}
+
+			encodedRecord, err := download(ctx, v.s3cli, URI.Hostname(), aws.StringValue(object.Key))
+			if err != nil {
+				queryErr = err
+				return false
+			}
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				queryErr = err
+				return false
+			}
+
+			if matchQuery(record, request.parsedQuery) {
+				response.Executions = append(response.Executions, convertToExecutionInfo(record))
+				if len(response.Executions) == request.pageSize {
+					response.NextPageToken, queryErr = serializeToken(&queryVisibilityToken{
+						LastCloseTime: record.CloseTimestamp,
+						LastRunID:     record.RunID,
+					})
+					return false
+				}
+			}
+		}
+		return true
+	})
+	if err == nil {
+		err = queryErr
+	}
+	if isNotFoundError(err) {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+	if err != nil {
+		return nil, &shared.InternalServiceError{Message: err.Error()}
+	}
+
+	return response, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if err := softValidateURI(URI); err != nil {
+		return err
+	}
+
+	return bucketExists(v.s3cli, URI.Hostname())
+}
+
+func matchQuery(record *visibilityRecord, query *parsedQuery) bool {
+	if record.CloseTimestamp < query.earliestCloseTime || record.CloseTimestamp > query.latestCloseTime {
+		return false
+	}
+	if query.workflowID != nil && record.WorkflowID != *query.workflowID {
+		return false
+	}
+	if query.runID != nil && record.RunID != *query.runID {
+		return false
+	}
+	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
+		return false
+	}
+	if query.closeStatus != nil && record.CloseStatus != *query.closeStatus {
+		return false
+	}
+	return true
+}
+
+func convertToExecutionInfo(record *visibilityRecord) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(record.WorkflowID),
+			RunId:      common.StringPtr(record.RunID),
+		},
+		Type: &shared.WorkflowType{
+			Name: common.StringPtr(record.WorkflowTypeName),
+		},
+		StartTime:     common.Int64Ptr(record.StartTimestamp),
+		ExecutionTime: common.Int64Ptr(record.ExecutionTimestamp),
+		CloseTime:     common.Int64Ptr(record.CloseTimestamp),
+		CloseStatus:   record.CloseStatus.Ptr(),
+		HistoryLength: common.Int64Ptr(record.HistoryLength),
+		Memo:          record.Memo,
+		SearchAttributes: &shared.SearchAttributes{
+			IndexedFields: archiver.ConvertSearchAttrToBytes(record.SearchAttributes),
+		},
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package s3store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"go.uber.org/zap"
+)
+
+const (
+	testWorkflowTypeName = "test-workflow-type"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	container         *archiver.VisibilityBootstrapContainer
+	s3cli             *memoryS3
+	testArchivalURI   archiver.URI
+	visibilityRecords []*visibilityRecord
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupSuite() {
+	var err error
+	s.testArchivalURI, err = archiver.NewURI("s3://" + testBucket + "/visibility")
+	s.Require().NoError(err)
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger: loggerimpl.NewLogger(zap.NewNop()),
+	}
+	s.s3cli = newMemoryS3(testBucket)
+	s.setupVisibilityObjects()
+}
+
+func (s *visibilityArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme://" + testBucket,
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "s3://",
+			expectedErr: errNoBucketSpecified,
+		},
+		{
+			URI:         "s3://unknown-bucket",
+			expectedErr: errBucketNotExists,
+		},
+		{
+			URI:         "s3://" + testBucket + "/visibility",
+			expectedErr: nil,
+		},
+	}
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, visibilityArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(s.visibilityRecords[0]))
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, &archiver.ArchiveVisibilityRequest{})
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_NonRetriableErrorOption() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	nonRetryableErr := errors.New("some non-retryable error")
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, &archiver.ArchiveVisibilityRequest{}, archiver.GetNonRetriableErrorOption(nonRetryableErr))
+	s.Equal(nonRetryableErr, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	record := s.visibilityRecords[0]
+	URI, err := archiver.NewURI("s3://" + testBucket + "/archive")
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record))
+	s.NoError(err)
+
+	key := constructVisibilityKey(URI.Path(), record.DomainID, record.CloseTimestamp, record.RunID)
+	s.Contains(s.s3cli.buckets[testBucket], key)
+	archivedRecord, err := decodeVisibilityRecord(s.s3cli.buckets[testBucket][key])
+	s.NoError(err)
+	s.Equal(record, archivedRecord)
+}
+
+func (s *visibilityArchiverSuite) TestMatchQuery() {
+	testCases := []struct {
+		query       *parsedQuery
+		record      *visibilityRecord
+		shouldMatch bool
+	}{
+		{
+			query: &parsedQuery{
+				earliestCloseTime: 1000,
+				latestCloseTime:   12345,
+			},
+			record: &visibilityRecord{
+				CloseTimestamp: 1999,
+			},
+			shouldMatch: true,
+		},
+		{
+			query: &parsedQuery{
+				earliestCloseTime: 1000,
+				latestCloseTime:   12345,
+			},
+			record: &visibilityRecord{
+				CloseTimestamp: 999,
+			},
+			shouldMatch: false,
+		},
+		{
+			query: &parsedQuery{
+				earliestCloseTime: 1000,
+				latestCloseTime:   12345,
+				workflowID:        &s.visibilityRecords[0].WorkflowID,
+				closeStatus:       shared.WorkflowExecutionCloseStatusFailed.Ptr(),
+			},
+			record: &visibilityRecord{
+				CloseTimestamp: 2000,
+				WorkflowID:     s.visibilityRecords[0].WorkflowID,
+				CloseStatus:    shared.WorkflowExecutionCloseStatusCompleted,
+			},
+			shouldMatch: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.shouldMatch, matchQuery(tc.record, tc.query))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidURI() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	response, err := visibilityArchiver.Query(context.Background(), URI, s.newQueryRequest("CloseTime > 0", 1))
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, s.newQueryRequest("StartTime > 0", 1))
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newQueryRequest("CloseTime > 0", 1)
+	request.NextPageToken = []byte{1, 2, 3}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.Nil(response)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_BucketNotExist() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("s3://unknown-bucket")
+	s.NoError(err)
+	response, err := visibilityArchiver.Query(context.Background(), URI, s.newQueryRequest("CloseTime > 0", 1))
+	s.NoError(err)
+	s.Empty(response.Executions)
+	s.Nil(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newQueryRequest("CloseTime >= 1 and CloseTime <= 10001 and WorkflowID = '"+testWorkflowID+"'", 10)
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_CloseTimeRange() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newQueryRequest("CloseTime >= 10 and CloseTime < 10000", 10)
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), response.Executions[1])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	request := s.newQueryRequest("CloseTime >= 1 and CloseTime <= 10001 and CloseStatus = 'Failed'", 2)
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.Executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), response.Executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), response.Executions[1])
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[3]), response.Executions[0])
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI("s3://" + testBucket + "/archive")
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, (*archiver.ArchiveVisibilityRequest)(record))
+		s.NoError(err)
+	}
+
+	request := s.newQueryRequest("CloseTime >= 10 and CloseTime <= 10001 and CloseStatus = 'Failed' and WorkflowType = '"+testWorkflowTypeName+"'", 1)
+	executions := []*shared.WorkflowExecutionInfo{}
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request)
+		s.NoError(err)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 2)
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[0]), executions[0])
+	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
+	return newVisibilityArchiver(s.container, s.s3cli)
+}
+
+func (s *visibilityArchiverSuite) newQueryRequest(query string, pageSize int) *archiver.QueryVisibilityRequest {
+	return &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: pageSize,
+		Query:    query,
+	}
+}
+
+func (s *visibilityArchiverSuite) setupVisibilityObjects() {
+	s.visibilityRecords = []*visibilityRecord{
+		{
+			DomainID:         testDomainID,
+			WorkflowID:       testWorkflowID,
+			RunID:            testRunID,
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTimestamp:   1,
+			CloseTimestamp:   10000,
+			CloseStatus:      shared.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:    101,
+		},
+		{
+			DomainID:           testDomainID,
+			WorkflowID:         "some random workflow ID",
+			RunID:              "some random run ID",
+			WorkflowTypeName:   testWorkflowTypeName,
+			StartTimestamp:     2,
+			ExecutionTimestamp: 0,
+			CloseTimestamp:     1000,
+			CloseStatus:        shared.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:      123,
+		},
+		{
+			DomainID:           testDomainID,
+			WorkflowID:         "another workflow ID",
+			RunID:              "another run ID",
+			WorkflowTypeName:   testWorkflowTypeName,
+			StartTimestamp:     3,
+			ExecutionTimestamp: 0,
+			CloseTimestamp:     10,
+			CloseStatus:        shared.WorkflowExecutionCloseStatusContinuedAsNew,
+			HistoryLength:      456,
+		},
+		{
+			DomainID:           testDomainID,
+			WorkflowID:         "and another workflow ID",
+			RunID:              "and another run ID",
+			WorkflowTypeName:   testWorkflowTypeName,
+			StartTimestamp:     3,
+			ExecutionTimestamp: 0,
+			CloseTimestamp:     5,
+			CloseStatus:        shared.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:      456,
+		},
+		{
+			DomainID:           "some random domain ID",
+			WorkflowID:         "another workflow ID",
+			RunID:              "another run ID",
+			WorkflowTypeName:   testWorkflowTypeName,
+			StartTimestamp:     3,
+			ExecutionTimestamp: 0,
+			CloseTimestamp:     10000,
+			CloseStatus:        shared.WorkflowExecutionCloseStatusContinuedAsNew,
+			HistoryLength:      456,
+		},
+	}
+
+	for _, record := range s.visibilityRecords {
+		data, err := encode(record)
+		s.Require().NoError(err)
+		key := constructVisibilityKey(s.testArchivalURI.Path(), record.DomainID, record.CloseTimestamp, record.RunID)
+		s.Require().NoError(upload(context.Background(), s.s3cli, testBucket, key, data))
+	}
+}
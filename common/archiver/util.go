@@ -21,6 +21,8 @@
 package archiver
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 
 	"github.com/uber/cadence/common/log"
@@ -102,6 +104,40 @@ func ValidateGetRequest(request *GetHistoryRequest) error {
 	return nil
 }
 
+// ValidateVerifyRequest validates the verify archived history request
+func ValidateVerifyRequest(request *VerifyHistoryRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	return nil
+}
+
+// ValidateGetMutableStateSummaryRequest validates the get mutable state summary request
+func ValidateGetMutableStateSummaryRequest(request *GetMutableStateSummaryRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	return nil
+}
+
+// ComputeChecksum returns the hex encoded sha256 checksum of archived data
+func ComputeChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
 // ValidateVisibilityArchivalRequest validates the archive visibility request
 func ValidateVisibilityArchivalRequest(request *ArchiveVisibilityRequest) error {
 	if request.DomainID == "" {
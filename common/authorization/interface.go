@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// DecisionDeny means the request is not allowed
+	DecisionDeny Decision = iota + 1
+	// DecisionAllow means the request is allowed
+	DecisionAllow
+)
+
+const (
+	// AuthorizerNoop is the name of the authorizer allowing every request
+	AuthorizerNoop = "noop"
+	// AuthorizerStatic is the name of the config driven authorizer
+	AuthorizerStatic = "static"
+)
+
+type (
+	// Decision is the result of an authorization check
+	Decision int
+
+	// Attributes is the input to an authorization check
+	Attributes struct {
+		// Actor is the identity of the caller
+		Actor string
+		// APIName is the name of the API being called
+		APIName string
+		// DomainName is the domain the API operates on, empty if the API is not domain scoped
+		DomainName string
+	}
+
+	// Result is the output of an authorization check
+	Result struct {
+		Decision Decision
+	}
+
+	// Authorizer decides whether a caller is allowed to call an API
+	Authorizer interface {
+		Authorize(ctx context.Context, attributes *Attributes) (Result, error)
+	}
+)
+
+// NewAuthorizer creates the authorizer named in the config
+func NewAuthorizer(cfg config.Authorization) (Authorizer, error) {
+	switch cfg.Authorizer {
+	case "", AuthorizerNoop:
+		return NewNopAuthorizer(), nil
+	case AuthorizerStatic:
+		return NewStaticAuthorizer(cfg.Static), nil
+	default:
+		return nil, fmt.Errorf("unknown authorizer: %v", cfg.Authorizer)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+// Wildcard matches any caller, domain or API in a static policy
+const Wildcard = "*"
+
+type (
+	apiSet map[string]struct{}
+
+	staticAuthorizer struct {
+		// caller -> roles
+		roles map[string][]string
+		// domain -> role -> allowed APIs
+		policies map[string]map[string]apiSet
+	}
+)
+
+// NewStaticAuthorizer creates an authorizer which grants access according
+// to the policy in the config. A caller is allowed to call an API on a domain
+// if any of its roles is allowed to call the API on that domain, or on every
+// domain through the wildcard entry. Requests are denied by default.
+func NewStaticAuthorizer(cfg config.StaticAuthorization) Authorizer {
+	policies := make(map[string]map[string]apiSet, len(cfg.Domains))
+	for domain, rolePolicies := range cfg.Domains {
+		policies[domain] = make(map[string]apiSet, len(rolePolicies))
+		for role, apis := range rolePolicies {
+			allowed := make(apiSet, len(apis))
+			for _, api := range apis {
+				allowed[api] = struct{}{}
+			}
+			policies[domain][role] = allowed
+		}
+	}
+
+	return &staticAuthorizer{
+		roles:    cfg.Roles,
+		policies: policies,
+	}
+}
+
+func (a *staticAuthorizer) Authorize(
+	ctx context.Context,
+	attributes *Attributes,
+) (Result, error) {
+
+	for _, actor := range []string{attributes.Actor, Wildcard} {
+		for _, role := range a.roles[actor] {
+			if a.isAllowed(attributes.DomainName, role, attributes.APIName) ||
+				a.isAllowed(Wildcard, role, attributes.APIName) {
+				return Result{Decision: DecisionAllow}, nil
+			}
+		}
+	}
+	return Result{Decision: DecisionDeny}, nil
+}
+
+func (a *staticAuthorizer) isAllowed(
+	domain string,
+	role string,
+	api string,
+) bool {
+
+	allowed, ok := a.policies[domain][role]
+	if !ok {
+		return false
+	}
+	if _, ok := allowed[api]; ok {
+		return true
+	}
+	_, ok = allowed[Wildcard]
+	return ok
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	staticAuthorizerSuite struct {
+		suite.Suite
+		authorizer Authorizer
+	}
+)
+
+func TestStaticAuthorizerSuite(t *testing.T) {
+	s := new(staticAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *staticAuthorizerSuite) SetupTest() {
+	s.authorizer = NewStaticAuthorizer(config.StaticAuthorization{
+		Roles: map[string][]string{
+			"cadence-cli":  {"admin"},
+			"some-service": {"writer"},
+			Wildcard:       {"reader"},
+		},
+		Domains: map[string]map[string][]string{
+			Wildcard: {
+				"admin":  {Wildcard},
+				"reader": {"DescribeDomain"},
+			},
+			"some-domain": {
+				"writer": {"StartWorkflowExecution", "SignalWorkflowExecution"},
+			},
+		},
+	})
+}
+
+func (s *staticAuthorizerSuite) TestAuthorize() {
+	testCases := []struct {
+		attributes *Attributes
+		decision   Decision
+	}{
+		{&Attributes{Actor: "cadence-cli", APIName: "DeprecateDomain", DomainName: "some-domain"}, DecisionAllow},
+		{&Attributes{Actor: "cadence-cli", APIName: "AdminCloseShard"}, DecisionAllow},
+		{&Attributes{Actor: "some-service", APIName: "StartWorkflowExecution", DomainName: "some-domain"}, DecisionAllow},
+		{&Attributes{Actor: "some-service", APIName: "StartWorkflowExecution", DomainName: "other-domain"}, DecisionDeny},
+		{&Attributes{Actor: "some-service", APIName: "TerminateWorkflowExecution", DomainName: "some-domain"}, DecisionDeny},
+		{&Attributes{Actor: "some-service", APIName: "DescribeDomain", DomainName: "some-domain"}, DecisionAllow},
+		{&Attributes{Actor: "unknown-service", APIName: "DescribeDomain", DomainName: "other-domain"}, DecisionAllow},
+		{&Attributes{Actor: "unknown-service", APIName: "StartWorkflowExecution", DomainName: "some-domain"}, DecisionDeny},
+		{&Attributes{Actor: "", APIName: "AdminCloseShard"}, DecisionDeny},
+	}
+
+	for _, tc := range testCases {
+		result, err := s.authorizer.Authorize(context.Background(), tc.attributes)
+		s.NoError(err)
+		s.Equal(tc.decision, result.Decision, "%+v", tc.attributes)
+	}
+}
+
+func (s *staticAuthorizerSuite) TestEmptyPolicy_DenyAll() {
+	authorizer := NewStaticAuthorizer(config.StaticAuthorization{})
+	result, err := authorizer.Authorize(context.Background(), &Attributes{Actor: "cadence-cli", APIName: "DescribeDomain"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *staticAuthorizerSuite) TestNewAuthorizer() {
+	authorizer, err := NewAuthorizer(config.Authorization{})
+	s.NoError(err)
+	s.IsType(&nopAuthorizer{}, authorizer)
+
+	authorizer, err = NewAuthorizer(config.Authorization{Authorizer: AuthorizerStatic})
+	s.NoError(err)
+	s.IsType(&staticAuthorizer{}, authorizer)
+
+	_, err = NewAuthorizer(config.Authorization{Authorizer: "unknown"})
+	s.Error(err)
+}
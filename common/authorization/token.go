@@ -0,0 +1,237 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/middleware"
+	"go.uber.org/yarpc/api/transport"
+)
+
+const (
+	// TokenHeaderName is the rpc header carrying the token which authenticates the caller
+	TokenHeaderName = "cadence-authorization-token"
+
+	// serviceTokenTTL is the lifetime of the tokens issued to the cadence services
+	serviceTokenTTL = time.Hour
+)
+
+var (
+	// ErrInvalidToken is returned when a token is malformed or its signature does not match
+	ErrInvalidToken = errors.New("invalid authorization token")
+	// ErrTokenExpired is returned when a token is past its expiry time
+	ErrTokenExpired = errors.New("authorization token expired")
+)
+
+type (
+	// Claims is the content of a token
+	Claims struct {
+		// Subject is the identity of the caller the token was issued to
+		Subject string `json:"sub"`
+		// ExpiresAt is the unix time in seconds after which the token is rejected
+		ExpiresAt int64 `json:"exp"`
+	}
+
+	// Authenticator establishes the identity of the caller of a request
+	Authenticator interface {
+		// Authenticate returns the actor of the request, an empty actor means the
+		// caller is anonymous. An error is returned when the caller presents
+		// credentials which cannot be verified.
+		Authenticate(ctx context.Context) (string, error)
+	}
+
+	anonymousAuthenticator struct{}
+
+	tokenAuthenticator struct {
+		key []byte
+	}
+
+	tokenOutboundMiddleware struct {
+		key     []byte
+		subject string
+
+		sync.Mutex
+		token     string
+		refreshAt time.Time
+	}
+)
+
+var _ middleware.UnaryOutbound = (*tokenOutboundMiddleware)(nil)
+
+// NewAuthenticator creates the authenticator for the config. Callers can only be
+// identified by a token signed with the configured key, without a key every caller
+// is anonymous. The caller name sent by the client is never trusted since any client
+// can set it to an arbitrary value.
+func NewAuthenticator(cfg config.Authorization) (Authenticator, error) {
+	if cfg.TokenKey == "" {
+		return NewAnonymousAuthenticator(), nil
+	}
+	key, err := decodeTokenKey(cfg.TokenKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewTokenAuthenticator(key), nil
+}
+
+// NewAnonymousAuthenticator creates an authenticator treating every caller as anonymous
+func NewAnonymousAuthenticator() Authenticator {
+	return &anonymousAuthenticator{}
+}
+
+// NewTokenAuthenticator creates an authenticator which identifies callers by
+// the subject of the token they send, the token must be signed with the key
+func NewTokenAuthenticator(key []byte) Authenticator {
+	return &tokenAuthenticator{key: key}
+}
+
+// NewTokenOutboundMiddleware creates an outbound middleware which authenticates
+// the requests of a cadence service as the subject, using tokens signed with the
+// key from the config. Nil is returned when no key is configured.
+func NewTokenOutboundMiddleware(cfg config.Authorization, subject string) (middleware.UnaryOutbound, error) {
+	if cfg.TokenKey == "" {
+		return nil, nil
+	}
+	key, err := decodeTokenKey(cfg.TokenKey)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenOutboundMiddleware{
+		key:     key,
+		subject: subject,
+	}, nil
+}
+
+// NewToken issues a token for the subject, signed with the key
+func NewToken(key []byte, subject string, expiresAt time.Time) (string, error) {
+	payload, err := json.Marshal(&Claims{
+		Subject:   subject,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	signature := base64.RawURLEncoding.EncodeToString(sign(key, encodedPayload))
+	return encodedPayload + "." + signature, nil
+}
+
+// VerifyToken checks the signature and the expiry time of the token and returns its claims
+func VerifyToken(key []byte, token string, now time.Time) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, ErrInvalidToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(signature, sign(key, parts[0])) {
+		return nil, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	claims := &Claims{}
+	if err := json.Unmarshal(payload, claims); err != nil || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return claims, nil
+}
+
+func (a *anonymousAuthenticator) Authenticate(
+	ctx context.Context,
+) (string, error) {
+
+	return "", nil
+}
+
+func (a *tokenAuthenticator) Authenticate(
+	ctx context.Context,
+) (string, error) {
+
+	token := yarpc.CallFromContext(ctx).Header(TokenHeaderName)
+	if token == "" {
+		return "", nil
+	}
+	claims, err := VerifyToken(a.key, token, time.Now())
+	if err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
+
+func (m *tokenOutboundMiddleware) Call(
+	ctx context.Context,
+	request *transport.Request,
+	out transport.UnaryOutbound,
+) (*transport.Response, error) {
+
+	token, err := m.getToken()
+	if err != nil {
+		return nil, err
+	}
+	request.Headers = request.Headers.With(TokenHeaderName, token)
+	return out.Call(ctx, request)
+}
+
+// getToken returns the cached token, a new one is issued once half of its lifetime passed
+func (m *tokenOutboundMiddleware) getToken() (string, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	now := time.Now()
+	if m.token != "" && now.Before(m.refreshAt) {
+		return m.token, nil
+	}
+	token, err := NewToken(m.key, m.subject, now.Add(serviceTokenTTL))
+	if err != nil {
+		return "", err
+	}
+	m.token = token
+	m.refreshAt = now.Add(serviceTokenTTL / 2)
+	return m.token, nil
+}
+
+func decodeTokenKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("authorization token key must be base64 encoded")
+	}
+	return key, nil
+}
+
+func sign(key []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
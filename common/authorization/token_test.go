@@ -0,0 +1,163 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+)
+
+type (
+	tokenSuite struct {
+		suite.Suite
+		key []byte
+	}
+
+	recordingOutbound struct {
+		transport.UnaryOutbound
+		request *transport.Request
+	}
+)
+
+func TestTokenSuite(t *testing.T) {
+	s := new(tokenSuite)
+	suite.Run(t, s)
+}
+
+func (s *tokenSuite) SetupTest() {
+	s.key = []byte("some random token key")
+}
+
+func (s *tokenSuite) TestVerifyToken() {
+	now := time.Now()
+	token, err := NewToken(s.key, "some-service", now.Add(time.Minute))
+	s.NoError(err)
+
+	claims, err := VerifyToken(s.key, token, now)
+	s.NoError(err)
+	s.Equal("some-service", claims.Subject)
+
+	_, err = VerifyToken([]byte("other token key"), token, now)
+	s.Equal(ErrInvalidToken, err)
+
+	_, err = VerifyToken(s.key, token, now.Add(time.Minute))
+	s.Equal(ErrTokenExpired, err)
+}
+
+func (s *tokenSuite) TestVerifyToken_Tampered() {
+	now := time.Now()
+	token, err := NewToken(s.key, "some-service", now.Add(time.Minute))
+	s.NoError(err)
+	adminToken, err := NewToken(s.key, "admin", now.Add(time.Minute))
+	s.NoError(err)
+
+	// the payload of one token with the signature of another
+	forged := strings.Split(adminToken, ".")[0] + "." + strings.Split(token, ".")[1]
+	_, err = VerifyToken(s.key, forged, now)
+	s.Equal(ErrInvalidToken, err)
+
+	for _, invalid := range []string{"", "some-service", "a.b.c", token + "x", "." + token} {
+		_, err = VerifyToken(s.key, invalid, now)
+		s.Equal(ErrInvalidToken, err, invalid)
+	}
+}
+
+func (s *tokenSuite) TestAuthenticate() {
+	authenticator := NewTokenAuthenticator(s.key)
+
+	actor, err := authenticator.Authenticate(s.contextWithHeaders("some-service", transport.NewHeaders()))
+	s.NoError(err)
+	s.Empty(actor)
+
+	token, err := NewToken(s.key, "some-service", time.Now().Add(time.Minute))
+	s.NoError(err)
+	actor, err = authenticator.Authenticate(s.contextWithHeaders(
+		"other-service",
+		transport.NewHeaders().With(TokenHeaderName, token),
+	))
+	s.NoError(err)
+	s.Equal("some-service", actor)
+
+	_, err = authenticator.Authenticate(s.contextWithHeaders(
+		"some-service",
+		transport.NewHeaders().With(TokenHeaderName, "some random token"),
+	))
+	s.Equal(ErrInvalidToken, err)
+}
+
+func (s *tokenSuite) TestNewAuthenticator() {
+	authenticator, err := NewAuthenticator(config.Authorization{})
+	s.NoError(err)
+	s.IsType(&anonymousAuthenticator{}, authenticator)
+
+	authenticator, err = NewAuthenticator(config.Authorization{TokenKey: base64.StdEncoding.EncodeToString(s.key)})
+	s.NoError(err)
+	s.IsType(&tokenAuthenticator{}, authenticator)
+
+	_, err = NewAuthenticator(config.Authorization{TokenKey: "not base64!"})
+	s.Error(err)
+}
+
+func (s *tokenSuite) TestOutboundMiddleware() {
+	cfg := config.Authorization{TokenKey: base64.StdEncoding.EncodeToString(s.key)}
+	mw, err := NewTokenOutboundMiddleware(cfg, "cadence-worker")
+	s.NoError(err)
+
+	out := &recordingOutbound{}
+	_, err = mw.Call(context.Background(), &transport.Request{}, out)
+	s.NoError(err)
+	token, ok := out.request.Headers.Get(TokenHeaderName)
+	s.True(ok)
+
+	actor, err := NewTokenAuthenticator(s.key).Authenticate(s.contextWithHeaders(
+		"cadence-xdc-client",
+		transport.NewHeaders().With(TokenHeaderName, token),
+	))
+	s.NoError(err)
+	s.Equal("cadence-worker", actor)
+
+	mw, err = NewTokenOutboundMiddleware(config.Authorization{}, "cadence-worker")
+	s.NoError(err)
+	s.Nil(mw)
+}
+
+func (s *tokenSuite) contextWithHeaders(caller string, headers transport.Headers) context.Context {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequestMeta(&transport.RequestMeta{Caller: caller, Headers: headers}))
+	return ctx
+}
+
+func (o *recordingOutbound) Call(
+	ctx context.Context,
+	request *transport.Request,
+) (*transport.Response, error) {
+
+	o.request = request
+	return &transport.Response{}, nil
+}
@@ -659,6 +659,17 @@ func copyResetBinary(bins workflow.BadBinaries) workflow.BadBinaries {
 	}
 }
 
+func copySearchAttributes(attrs map[string]workflow.IndexedValueType) map[string]workflow.IndexedValueType {
+	if attrs == nil {
+		return nil
+	}
+	newAttrs := make(map[string]workflow.IndexedValueType, len(attrs))
+	for k, v := range attrs {
+		newAttrs[k] = v
+	}
+	return newAttrs
+}
+
 func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 	// this is a deep copy
 	result := newDomainCacheEntry(entry.clusterMetadata)
@@ -681,6 +692,7 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 		VisibilityArchivalStatus: entry.config.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    entry.config.VisibilityArchivalURI,
 		BadBinaries:              copyResetBinary(entry.config.BadBinaries),
+		SearchAttributes:         copySearchAttributes(entry.config.SearchAttributes),
 	}
 	result.replicationConfig = &persistence.DomainReplicationConfig{
 		ActiveClusterName: entry.replicationConfig.ActiveClusterName,
@@ -0,0 +1,65 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+// NewDomainSearchAttributesFn returns a function which gives the search attributes valid for a domain
+func NewDomainSearchAttributesFn(
+	domainCache DomainCache,
+	validSearchAttributes dynamicconfig.MapPropertyFn,
+) dynamicconfig.MapPropertyFnWithDomainFilter {
+
+	return func(domain string) map[string]interface{} {
+		return GetDomainSearchAttributes(domainCache, validSearchAttributes(), domain)
+	}
+}
+
+// GetDomainSearchAttributes returns the search attributes valid for a domain, which are the
+// cluster wide search attributes plus the attributes registered in the domain config
+func GetDomainSearchAttributes(
+	domainCache DomainCache,
+	clusterAttributes map[string]interface{},
+	domain string,
+) map[string]interface{} {
+
+	if domain == "" {
+		return clusterAttributes
+	}
+	domainEntry, err := domainCache.GetDomain(domain)
+	if err != nil || len(domainEntry.GetConfig().SearchAttributes) == 0 {
+		return clusterAttributes
+	}
+
+	domainAttributes := domainEntry.GetConfig().SearchAttributes
+	result := make(map[string]interface{}, len(clusterAttributes)+len(domainAttributes))
+	for key, valueType := range clusterAttributes {
+		result[key] = valueType
+	}
+	for key, valueType := range domainAttributes {
+		if _, ok := result[key]; !ok {
+			result[key] = int(valueType)
+		}
+	}
+	return result
+}
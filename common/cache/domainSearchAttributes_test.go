@@ -0,0 +1,66 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+func TestDomainSearchAttributesFn(t *testing.T) {
+	clusterAttributes := map[string]interface{}{
+		"CustomKeywordField": 1,
+	}
+	domainCache := &DomainCacheMock{}
+	domainCache.On("GetDomain", "domain-with-attrs").Return(NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: "domain-with-attrs"},
+		&persistence.DomainConfig{SearchAttributes: map[string]workflow.IndexedValueType{
+			"DomainIntField":     workflow.IndexedValueTypeInt,
+			"CustomKeywordField": workflow.IndexedValueTypeString,
+		}},
+		"active",
+		nil,
+	), nil)
+	domainCache.On("GetDomain", "domain-without-attrs").Return(NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: "domain-without-attrs"},
+		&persistence.DomainConfig{},
+		"active",
+		nil,
+	), nil)
+	domainCache.On("GetDomain", "unknown-domain").Return(nil, errors.New("domain not found"))
+
+	validFn := NewDomainSearchAttributesFn(domainCache, dynamicconfig.GetMapPropertyFn(clusterAttributes))
+
+	assert.Equal(t, clusterAttributes, validFn(""))
+	assert.Equal(t, clusterAttributes, validFn("domain-without-attrs"))
+	assert.Equal(t, clusterAttributes, validFn("unknown-domain"))
+	assert.Equal(t, map[string]interface{}{
+		"CustomKeywordField": 1,
+		"DomainIntField":     int(workflow.IndexedValueTypeInt),
+	}, validFn("domain-with-attrs"))
+	// cluster wide attributes must not be mutated
+	assert.Len(t, clusterAttributes, 1)
+}
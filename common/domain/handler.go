@@ -64,6 +64,11 @@ type (
 			ctx context.Context,
 			updateRequest *shared.UpdateDomainRequest,
 		) (*shared.UpdateDomainResponse, error)
+		UpdateSearchAttributes(
+			ctx context.Context,
+			domainName string,
+			update func(searchAttributes map[string]shared.IndexedValueType) error,
+		) error
 	}
 
 	// HandlerImpl is the domain operation handler implementation
@@ -452,6 +457,11 @@ func (d *HandlerImpl) UpdateDomain(
 				}
 			}
 		}
+		if updatedConfig.SearchAttributes != nil {
+			configurationChanged = true
+			// search attributes are replaced as a whole
+			config.SearchAttributes = updatedConfig.SearchAttributes
+		}
 	}
 
 	if updateRequest.DeleteBadBinary != nil {
@@ -612,6 +622,72 @@ func (d *HandlerImpl) DeprecateDomain(
 	return nil
 }
 
+// UpdateSearchAttributes applies update to the search attributes registered in the domain.
+// The attributes given to update are read within the same conditional update they are written back with,
+// so a concurrent update of the domain makes this call fail instead of being overwritten
+func (d *HandlerImpl) UpdateSearchAttributes(
+	ctx context.Context,
+	domainName string,
+	update func(searchAttributes map[string]shared.IndexedValueType) error,
+) error {
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	// and since we do not know which table will return the domain afterwards
+	// this call has to be made
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		return err
+	}
+	if getResponse.IsGlobalDomain && !d.clusterMetadata.IsMasterCluster() {
+		return errNotMasterCluster
+	}
+
+	config := getResponse.Config
+	searchAttributes := make(map[string]shared.IndexedValueType, len(config.SearchAttributes))
+	for k, v := range config.SearchAttributes {
+		searchAttributes[k] = v
+	}
+	if err := update(searchAttributes); err != nil {
+		return err
+	}
+	config.SearchAttributes = searchAttributes
+
+	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
+	updateReq := &persistence.UpdateDomainRequest{
+		Info:                        getResponse.Info,
+		Config:                      config,
+		ReplicationConfig:           getResponse.ReplicationConfig,
+		ConfigVersion:               getResponse.ConfigVersion,
+		FailoverVersion:             getResponse.FailoverVersion,
+		FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
+		NotificationVersion:         notificationVersion,
+	}
+	if err := d.metadataMgr.UpdateDomain(updateReq); err != nil {
+		return err
+	}
+
+	if getResponse.IsGlobalDomain {
+		err = d.domainReplicator.HandleTransmissionTask(replicator.DomainOperationUpdate,
+			getResponse.Info, config, getResponse.ReplicationConfig, getResponse.ConfigVersion,
+			getResponse.FailoverVersion, getResponse.IsGlobalDomain)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.logger.Info("Update domain search attributes succeeded",
+		tag.WorkflowDomainName(getResponse.Info.Name),
+		tag.WorkflowDomainID(getResponse.Info.ID),
+	)
+	return nil
+}
+
 func (d *HandlerImpl) createResponse(
 	ctx context.Context,
 	info *persistence.DomainInfo,
@@ -636,6 +712,7 @@ func (d *HandlerImpl) createResponse(
 		VisibilityArchivalStatus:               common.ArchivalStatusPtr(config.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  common.StringPtr(config.VisibilityArchivalURI),
 		BadBinaries:                            &config.BadBinaries,
+		SearchAttributes:                       config.SearchAttributes,
 	}
 
 	clusters := []*shared.ClusterReplicationConfiguration{}
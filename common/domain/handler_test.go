@@ -395,6 +395,40 @@ func (s *domainHandlerCommonSuite) TestRegisterDomain_InvalidRetentionPeriod() {
 	s.Equal(errInvalidRetentionPeriod, err)
 }
 
+func (s *domainHandlerCommonSuite) TestUpdateSearchAttributes() {
+	domain := "search attributes domain name"
+	registerRequest := &workflow.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domain),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(int32(10)),
+		IsGlobalDomain:                         common.BoolPtr(false),
+	}
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+
+	addKey := func(key string, valueType workflow.IndexedValueType) func(map[string]workflow.IndexedValueType) error {
+		return func(searchAttributes map[string]workflow.IndexedValueType) error {
+			searchAttributes[key] = valueType
+			return nil
+		}
+	}
+	// each update is merged into the attributes read within the update
+	s.NoError(s.handler.UpdateSearchAttributes(context.Background(), domain, addKey("key1", workflow.IndexedValueTypeKeyword)))
+	s.NoError(s.handler.UpdateSearchAttributes(context.Background(), domain, addKey("key2", workflow.IndexedValueTypeInt)))
+
+	err = s.handler.UpdateSearchAttributes(context.Background(), domain, func(searchAttributes map[string]workflow.IndexedValueType) error {
+		delete(searchAttributes, "key1")
+		return &workflow.BadRequestError{Message: "rejected"}
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	resp, err := s.handler.DescribeDomain(context.Background(), &workflow.DescribeDomainRequest{Name: common.StringPtr(domain)})
+	s.NoError(err)
+	s.Equal(map[string]workflow.IndexedValueType{
+		"key1": workflow.IndexedValueTypeKeyword,
+		"key2": workflow.IndexedValueTypeInt,
+	}, resp.Configuration.SearchAttributes)
+}
+
 func (s *domainHandlerCommonSuite) TestUpdateDomain_InvalidRetentionPeriod() {
 	domain := "random domain name"
 	registerRequest := &workflow.RegisterDomainRequest{
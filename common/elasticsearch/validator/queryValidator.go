@@ -34,11 +34,11 @@ import (
 
 // VisibilityQueryValidator for sql query validation
 type VisibilityQueryValidator struct {
-	validSearchAttributes dynamicconfig.MapPropertyFn
+	validSearchAttributes dynamicconfig.MapPropertyFnWithDomainFilter
 }
 
 // NewQueryValidator create VisibilityQueryValidator
-func NewQueryValidator(validSearchAttributes dynamicconfig.MapPropertyFn) *VisibilityQueryValidator {
+func NewQueryValidator(validSearchAttributes dynamicconfig.MapPropertyFnWithDomainFilter) *VisibilityQueryValidator {
 	return &VisibilityQueryValidator{
 		validSearchAttributes: validSearchAttributes,
 	}
@@ -48,7 +48,7 @@ func NewQueryValidator(validSearchAttributes dynamicconfig.MapPropertyFn) *Visib
 // and add prefix for custom keys
 func (qv *VisibilityQueryValidator) ValidateListRequestForQuery(listRequest *workflow.ListWorkflowExecutionsRequest) error {
 	whereClause := listRequest.GetQuery()
-	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause)
+	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, listRequest.GetDomain())
 	if err != nil {
 		return err
 	}
@@ -60,7 +60,7 @@ func (qv *VisibilityQueryValidator) ValidateListRequestForQuery(listRequest *wor
 // and add prefix for custom keys
 func (qv *VisibilityQueryValidator) ValidateCountRequestForQuery(countRequest *workflow.CountWorkflowExecutionsRequest) error {
 	whereClause := countRequest.GetQuery()
-	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause)
+	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, countRequest.GetDomain())
 	if err != nil {
 		return err
 	}
@@ -70,7 +70,7 @@ func (qv *VisibilityQueryValidator) ValidateCountRequestForQuery(countRequest *w
 
 // validateListOrCountRequestForQuery valid sql for visibility API
 // it also adds attr prefix for customized fields
-func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClause string) (string, error) {
+func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClause string, domain string) (string, error) {
 	if len(whereClause) != 0 {
 		// Build a placeholder query that allows us to easily parse the contents of the where clause.
 		// IMPORTANT: This query is never executed, it is just used to parse and validate whereClause
@@ -95,14 +95,14 @@ func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClau
 		buf := sqlparser.NewTrackedBuffer(nil)
 		// validate where expr
 		if sel.Where != nil {
-			err = qv.validateWhereExpr(sel.Where.Expr)
+			err = qv.validateWhereExpr(sel.Where.Expr, domain)
 			if err != nil {
 				return "", &workflow.BadRequestError{Message: err.Error()}
 			}
 			sel.Where.Expr.Format(buf)
 		}
 		// validate order by
-		err = qv.validateOrderByExpr(sel.OrderBy)
+		err = qv.validateOrderByExpr(sel.OrderBy, domain)
 		if err != nil {
 			return "", &workflow.BadRequestError{Message: err.Error()}
 		}
@@ -113,21 +113,21 @@ func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClau
 	return whereClause, nil
 }
 
-func (qv *VisibilityQueryValidator) validateWhereExpr(expr sqlparser.Expr) error {
+func (qv *VisibilityQueryValidator) validateWhereExpr(expr sqlparser.Expr, domain string) error {
 	if expr == nil {
 		return nil
 	}
 
 	switch expr.(type) {
 	case *sqlparser.AndExpr, *sqlparser.OrExpr:
-		return qv.validateAndOrExpr(expr)
+		return qv.validateAndOrExpr(expr, domain)
 	case *sqlparser.ComparisonExpr:
-		return qv.validateComparisonExpr(expr)
+		return qv.validateComparisonExpr(expr, domain)
 	case *sqlparser.RangeCond:
-		return qv.validateRangeExpr(expr)
+		return qv.validateRangeExpr(expr, domain)
 	case *sqlparser.ParenExpr:
 		parentExpr := expr.(*sqlparser.ParenExpr)
-		return qv.validateWhereExpr(parentExpr.Expr)
+		return qv.validateWhereExpr(parentExpr.Expr, domain)
 	default:
 		return errors.New("invalid where clause")
 	}
@@ -135,7 +135,7 @@ func (qv *VisibilityQueryValidator) validateWhereExpr(expr sqlparser.Expr) error
 	return nil
 }
 
-func (qv *VisibilityQueryValidator) validateAndOrExpr(expr sqlparser.Expr) error {
+func (qv *VisibilityQueryValidator) validateAndOrExpr(expr sqlparser.Expr, domain string) error {
 	var leftExpr sqlparser.Expr
 	var rightExpr sqlparser.Expr
 
@@ -150,20 +150,20 @@ func (qv *VisibilityQueryValidator) validateAndOrExpr(expr sqlparser.Expr) error
 		rightExpr = orExpr.Right
 	}
 
-	if err := qv.validateWhereExpr(leftExpr); err != nil {
+	if err := qv.validateWhereExpr(leftExpr, domain); err != nil {
 		return err
 	}
-	return qv.validateWhereExpr(rightExpr)
+	return qv.validateWhereExpr(rightExpr, domain)
 }
 
-func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr) error {
+func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr, domain string) error {
 	comparisonExpr := expr.(*sqlparser.ComparisonExpr)
 	colName, ok := comparisonExpr.Left.(*sqlparser.ColName)
 	if !ok {
 		return errors.New("invalid comparison expression")
 	}
 	colNameStr := colName.Name.String()
-	if qv.isValidSearchAttributes(colNameStr) {
+	if qv.isValidSearchAttributes(colNameStr, domain) {
 		if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
 			comparisonExpr.Left = &sqlparser.ColName{
 				Metadata:  colName.Metadata,
@@ -176,14 +176,14 @@ func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr)
 	return errors.New("invalid search attribute")
 }
 
-func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr) error {
+func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr, domain string) error {
 	rangeCond := expr.(*sqlparser.RangeCond)
 	colName, ok := rangeCond.Left.(*sqlparser.ColName)
 	if !ok {
 		return errors.New("invalid range expression")
 	}
 	colNameStr := colName.Name.String()
-	if qv.isValidSearchAttributes(colNameStr) {
+	if qv.isValidSearchAttributes(colNameStr, domain) {
 		if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
 			rangeCond.Left = &sqlparser.ColName{
 				Metadata:  colName.Metadata,
@@ -196,14 +196,14 @@ func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr) error
 	return errors.New("invalid search attribute")
 }
 
-func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderBy) error {
+func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderBy, domain string) error {
 	for _, orderByExpr := range orderBy {
 		colName, ok := orderByExpr.Expr.(*sqlparser.ColName)
 		if !ok {
 			return errors.New("invalid order by expression")
 		}
 		colNameStr := colName.Name.String()
-		if qv.isValidSearchAttributes(colNameStr) {
+		if qv.isValidSearchAttributes(colNameStr, domain) {
 			if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
 				orderByExpr.Expr = &sqlparser.ColName{
 					Metadata:  colName.Metadata,
@@ -218,9 +218,9 @@ func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderB
 	return nil
 }
 
-// isValidSearchAttributes return true if key is registered
-func (qv *VisibilityQueryValidator) isValidSearchAttributes(key string) bool {
-	validAttr := qv.validSearchAttributes()
+// isValidSearchAttributes return true if key is registered for the domain
+func (qv *VisibilityQueryValidator) isValidSearchAttributes(key string, domain string) bool {
+	validAttr := qv.validSearchAttributes(domain)
 	_, isValidKey := validAttr[key]
 	return isValidKey
 }
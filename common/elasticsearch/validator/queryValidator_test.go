@@ -39,7 +39,7 @@ func TestQueryValidatorSuite(t *testing.T) {
 }
 
 func (s *queryValidatorSuite) TestValidateListRequestForQuery() {
-	validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+	validSearchAttr := dynamicconfig.GetMapPropertyFnFilteredByDomain(definition.GetDefaultIndexedKeys())
 	qv := NewQueryValidator(validSearchAttr)
 
 	listRequest := &shared.ListWorkflowExecutionsRequest{}
@@ -33,7 +33,7 @@ import (
 type SearchAttributesValidator struct {
 	logger log.Logger
 
-	validSearchAttributes             dynamicconfig.MapPropertyFn
+	validSearchAttributes             dynamicconfig.MapPropertyFnWithDomainFilter
 	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
 	searchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	searchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
@@ -42,7 +42,7 @@ type SearchAttributesValidator struct {
 // NewSearchAttributesValidator create SearchAttributesValidator
 func NewSearchAttributesValidator(
 	logger log.Logger,
-	validSearchAttributes dynamicconfig.MapPropertyFn,
+	validSearchAttributes dynamicconfig.MapPropertyFnWithDomainFilter,
 	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter,
 	searchAttributesSizeOfValueLimit dynamicconfig.IntPropertyFnWithDomainFilter,
 	searchAttributesTotalSizeLimit dynamicconfig.IntPropertyFnWithDomainFilter,
@@ -74,7 +74,7 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *gen.SearchA
 	totalSize := 0
 	for key, val := range fields {
 		// verify: key is whitelisted
-		if !sv.isValidSearchAttributes(key) {
+		if !sv.isValidSearchAttributes(key, domain) {
 			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
 				Error("invalid search attribute")
 			return &gen.BadRequestError{Message: fmt.Sprintf("%s is not valid search attribute", key)}
@@ -104,9 +104,9 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *gen.SearchA
 	return nil
 }
 
-// isValidSearchAttributes return true if key is registered
-func (sv *SearchAttributesValidator) isValidSearchAttributes(key string) bool {
-	validAttr := sv.validSearchAttributes()
+// isValidSearchAttributes return true if key is registered for the domain
+func (sv *SearchAttributesValidator) isValidSearchAttributes(key string, domain string) bool {
+	validAttr := sv.validSearchAttributes(domain)
 	_, isValidKey := validAttr[key]
 	return isValidKey
 }
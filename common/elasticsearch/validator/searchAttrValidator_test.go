@@ -44,7 +44,7 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	sizeOfTotalLimit := 20
 
 	validator := NewSearchAttributesValidator(log.NewNoop(),
-		dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		dynamicconfig.GetMapPropertyFnFilteredByDomain(definition.GetDefaultIndexedKeys()),
 		dynamicconfig.GetIntPropertyFilteredByDomain(numOfKeysLimit),
 		dynamicconfig.GetIntPropertyFilteredByDomain(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFilteredByDomain(sizeOfTotalLimit))
@@ -102,3 +102,26 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	err = validator.ValidateSearchAttributes(attr, domain)
 	s.Equal(`BadRequestError{Message: total size 40 exceed limit}`, err.Error())
 }
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes_DomainScoped() {
+	validSearchAttributes := func(domain string) map[string]interface{} {
+		if domain == "domain-with-attr" {
+			return map[string]interface{}{"DomainKeywordField": 1}
+		}
+		return map[string]interface{}{}
+	}
+	validator := NewSearchAttributesValidator(log.NewNoop(),
+		validSearchAttributes,
+		dynamicconfig.GetIntPropertyFilteredByDomain(10),
+		dynamicconfig.GetIntPropertyFilteredByDomain(100),
+		dynamicconfig.GetIntPropertyFilteredByDomain(1000))
+
+	attr := &gen.SearchAttributes{
+		IndexedFields: map[string][]byte{
+			"DomainKeywordField": []byte(`"keyword"`),
+		},
+	}
+	s.Nil(validator.ValidateSearchAttributes(attr, "domain-with-attr"))
+	err := validator.ValidateSearchAttributes(attr, "other-domain")
+	s.Equal(`BadRequestError{Message: DomainKeywordField is not valid search attribute}`, err.Error())
+}
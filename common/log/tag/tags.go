@@ -470,6 +470,18 @@ func NumberDeleted(n int) Tag {
 	return newInt("number-deleted", n)
 }
 
+// task list partitions
+
+// NumReadPartitions returns tag for the number of read partitions of a task list
+func NumReadPartitions(n int) Tag {
+	return newInt("num-read-partitions", n)
+}
+
+// NumWritePartitions returns tag for the number of write partitions of a task list
+func NumWritePartitions(n int) Tag {
+	return newInt("num-write-partitions", n)
+}
+
 // TimerTaskStatus returns tag for TimerTaskStatus
 func TimerTaskStatus(timerTaskStatus int32) Tag {
 	return newInt32("timer-task-status", timerTaskStatus)
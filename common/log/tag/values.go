@@ -36,6 +36,7 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionWorkflowUpdateAccepted         = workflowAction("add-workflow-update-accepted-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -58,6 +59,10 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskPause           = workflowAction("pause-activitytask")
+	WorkflowActionActivityTaskUnpause         = workflowAction("unpause-activitytask")
+	WorkflowActionActivityTaskReset           = workflowAction("reset-activitytask")
+	WorkflowActionActivityTaskUpdateTimeouts  = workflowAction("update-activitytask-timeouts")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -120,6 +125,7 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentScheduler                = component("scheduler")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
 )
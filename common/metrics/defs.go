@@ -151,6 +151,8 @@ const (
 	PersistenceDeleteTaskScope
 	// PersistenceGetCurrentExecutionScope tracks GetCurrentExecution calls made by service to persistence layer
 	PersistenceGetCurrentExecutionScope
+	// PersistenceListConcreteExecutionsScope tracks ListConcreteExecutions calls made by service to persistence layer
+	PersistenceListConcreteExecutionsScope
 	// PersistenceGetTransferTasksScope tracks GetTransferTasks calls made by service to persistence layer
 	PersistenceGetTransferTasksScope
 	// PersistenceGetReplicationTasksScope tracks GetReplicationTasks calls made by service to persistence layer
@@ -293,8 +295,24 @@ const (
 	HistoryClientGetReplicationTasksScope
 	// HistoryClientQueryWorkflowScope tracks RPC calls to history service
 	HistoryClientQueryWorkflowScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
 	// HistoryClientReapplyEventsScope tracks RPC calls to history service
 	HistoryClientReapplyEventsScope
+	// HistoryClientPauseActivityScope tracks RPC calls to history service
+	HistoryClientPauseActivityScope
+	// HistoryClientUnpauseActivityScope tracks RPC calls to history service
+	HistoryClientUnpauseActivityScope
+	// HistoryClientResetActivityScope tracks RPC calls to history service
+	HistoryClientResetActivityScope
+	// HistoryClientUpdateActivityTimeoutsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityTimeoutsScope
+	// HistoryClientUpdateWorkflowSearchAttributesScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowSearchAttributesScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
+	// HistoryClientImportWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientImportWorkflowExecutionScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -311,6 +329,10 @@ const (
 	MatchingClientCancelOutstandingPollScope
 	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskListScope
+	// MatchingClientUpdateTaskListLimitsScope tracks RPC calls to matching service
+	MatchingClientUpdateTaskListLimitsScope
+	// MatchingClientReleaseTaskScope tracks RPC calls to matching service
+	MatchingClientReleaseTaskScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -335,6 +357,8 @@ const (
 	FrontendClientPollForDecisionTaskScope
 	// FrontendClientQueryWorkflowScope tracks RPC calls to frontend service
 	FrontendClientQueryWorkflowScope
+	// FrontendClientUpdateWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkflowExecutionScope
 	// FrontendClientRecordActivityTaskHeartbeatScope tracks RPC calls to frontend service
 	FrontendClientRecordActivityTaskHeartbeatScope
 	// FrontendClientRecordActivityTaskHeartbeatByIDScope tracks RPC calls to frontend service
@@ -389,6 +413,22 @@ const (
 	FrontendClientGetDomainReplicationTasksScope
 	// FrontendClientReapplyEventsScope tracks RPC calls to frontend service
 	FrontendClientReapplyEventsScope
+	// FrontendClientCreateScheduleScope tracks RPC calls to frontend service
+	FrontendClientCreateScheduleScope
+	// FrontendClientDescribeScheduleScope tracks RPC calls to frontend service
+	FrontendClientDescribeScheduleScope
+	// FrontendClientUpdateScheduleScope tracks RPC calls to frontend service
+	FrontendClientUpdateScheduleScope
+	// FrontendClientPauseScheduleScope tracks RPC calls to frontend service
+	FrontendClientPauseScheduleScope
+	// FrontendClientUnpauseScheduleScope tracks RPC calls to frontend service
+	FrontendClientUnpauseScheduleScope
+	// FrontendClientDeleteScheduleScope tracks RPC calls to frontend service
+	FrontendClientDeleteScheduleScope
+	// FrontendClientBackfillScheduleScope tracks RPC calls to frontend service
+	FrontendClientBackfillScheduleScope
+	// FrontendClientListSchedulesScope tracks RPC calls to frontend service
+	FrontendClientListSchedulesScope
 	// AdminClientAddSearchAttributeScope tracks RPC calls to admin service
 	AdminClientAddSearchAttributeScope
 	// AdminClientCloseShardScope tracks RPC calls to admin service
@@ -399,6 +439,26 @@ const (
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientPauseActivityScope tracks RPC calls to admin service
+	AdminClientPauseActivityScope
+	// AdminClientUnpauseActivityScope tracks RPC calls to admin service
+	AdminClientUnpauseActivityScope
+	// AdminClientResetActivityScope tracks RPC calls to admin service
+	AdminClientResetActivityScope
+	// AdminClientUpdateActivityTimeoutsScope tracks RPC calls to admin service
+	AdminClientUpdateActivityTimeoutsScope
+	// AdminClientUpdateTaskListLimitsScope tracks RPC calls to admin service
+	AdminClientUpdateTaskListLimitsScope
+	// AdminClientVerifyArchivedWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientVerifyArchivedWorkflowExecutionScope
+	// AdminClientRearchiveWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientRearchiveWorkflowExecutionScope
+	// AdminClientImportWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientImportWorkflowExecutionScope
+	// AdminClientRemoveSearchAttributeScope tracks RPC calls to admin service
+	AdminClientRemoveSearchAttributeScope
+	// AdminClientListSearchAttributesScope tracks RPC calls to admin service
+	AdminClientListSearchAttributesScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -431,6 +491,8 @@ const (
 	DCRedirectionPollForDecisionTaskScope
 	// DCRedirectionQueryWorkflowScope tracks RPC calls for dc redirection
 	DCRedirectionQueryWorkflowScope
+	// DCRedirectionUpdateWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkflowExecutionScope
 	// DCRedirectionRecordActivityTaskHeartbeatScope tracks RPC calls for dc redirection
 	DCRedirectionRecordActivityTaskHeartbeatScope
 	// DCRedirectionRecordActivityTaskHeartbeatByIDScope tracks RPC calls for dc redirection
@@ -471,6 +533,22 @@ const (
 	DCRedirectionTerminateWorkflowExecutionScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
+	// DCRedirectionCreateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionCreateScheduleScope
+	// DCRedirectionDescribeScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeScheduleScope
+	// DCRedirectionUpdateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateScheduleScope
+	// DCRedirectionPauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionPauseScheduleScope
+	// DCRedirectionUnpauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseScheduleScope
+	// DCRedirectionDeleteScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDeleteScheduleScope
+	// DCRedirectionBackfillScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionBackfillScheduleScope
+	// DCRedirectionListSchedulesScope tracks RPC calls for dc redirection
+	DCRedirectionListSchedulesScope
 
 	// MessagingPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -576,6 +654,28 @@ const (
 	AdminRemoveTaskScope
 	//AdminCloseShardTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminCloseShardTaskScope
+	// AdminPauseActivityScope is the metric scope for admin.PauseActivity
+	AdminPauseActivityScope
+	// AdminUnpauseActivityScope is the metric scope for admin.UnpauseActivity
+	AdminUnpauseActivityScope
+	// AdminResetActivityScope is the metric scope for admin.ResetActivity
+	AdminResetActivityScope
+	// AdminUpdateActivityTimeoutsScope is the metric scope for admin.UpdateActivityTimeouts
+	AdminUpdateActivityTimeoutsScope
+	// AdminUpdateTaskListLimitsScope is the metric scope for admin.UpdateTaskListLimits
+	AdminUpdateTaskListLimitsScope
+	// AdminVerifyArchivedWorkflowExecutionScope is the metric scope for admin.VerifyArchivedWorkflowExecution
+	AdminVerifyArchivedWorkflowExecutionScope
+	// AdminRearchiveWorkflowExecutionScope is the metric scope for admin.RearchiveWorkflowExecution
+	AdminRearchiveWorkflowExecutionScope
+	// AdminImportWorkflowExecutionScope is the metric scope for admin.ImportWorkflowExecution
+	AdminImportWorkflowExecutionScope
+	// AdminAddSearchAttributeScope is the metric scope for admin.AddSearchAttribute
+	AdminAddSearchAttributeScope
+	// AdminRemoveSearchAttributeScope is the metric scope for admin.RemoveSearchAttribute
+	AdminRemoveSearchAttributeScope
+	// AdminListSearchAttributesScope is the metric scope for admin.ListSearchAttributes
+	AdminListSearchAttributesScope
 
 	NumAdminScopes
 )
@@ -640,6 +740,8 @@ const (
 	FrontendDeprecateDomainScope
 	// FrontendQueryWorkflowScope is the metric scope for frontend.QueryWorkflow
 	FrontendQueryWorkflowScope
+	// FrontendUpdateWorkflowExecutionScope is the metric scope for frontend.UpdateWorkflowExecution
+	FrontendUpdateWorkflowExecutionScope
 	// FrontendDescribeWorkflowExecutionScope is the metric scope for frontend.DescribeWorkflowExecution
 	FrontendDescribeWorkflowExecutionScope
 	// FrontendDescribeTaskListScope is the metric scope for frontend.DescribeTaskList
@@ -658,6 +760,24 @@ const (
 	FrontendGetDomainReplicationMessagesScope
 	// FrontendReapplyEventsScope is the metric scope for frontend.ReapplyEvents
 	FrontendReapplyEventsScope
+	// FrontendCreateScheduleScope is the metric scope for frontend.CreateSchedule
+	FrontendCreateScheduleScope
+	// FrontendDescribeScheduleScope is the metric scope for frontend.DescribeSchedule
+	FrontendDescribeScheduleScope
+	// FrontendUpdateScheduleScope is the metric scope for frontend.UpdateSchedule
+	FrontendUpdateScheduleScope
+	// FrontendPauseScheduleScope is the metric scope for frontend.PauseSchedule
+	FrontendPauseScheduleScope
+	// FrontendUnpauseScheduleScope is the metric scope for frontend.UnpauseSchedule
+	FrontendUnpauseScheduleScope
+	// FrontendDeleteScheduleScope is the metric scope for frontend.DeleteSchedule
+	FrontendDeleteScheduleScope
+	// FrontendBackfillScheduleScope is the metric scope for frontend.BackfillSchedule
+	FrontendBackfillScheduleScope
+	// FrontendListSchedulesScope is the metric scope for frontend.ListSchedules
+	FrontendListSchedulesScope
+	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
+	FrontendListArchivedWorkflowExecutionsScope
 
 	NumFrontendScopes
 )
@@ -720,6 +840,20 @@ const (
 	HistoryShardControllerScope
 	// HistoryReapplyEventsScope is the scope used by event reapplication
 	HistoryReapplyEventsScope
+	// HistoryPauseActivityScope tracks PauseActivity API calls received by service
+	HistoryPauseActivityScope
+	// HistoryUnpauseActivityScope tracks UnpauseActivity API calls received by service
+	HistoryUnpauseActivityScope
+	// HistoryResetActivityScope tracks ResetActivity API calls received by service
+	HistoryResetActivityScope
+	// HistoryUpdateActivityTimeoutsScope tracks UpdateActivityTimeouts API calls received by service
+	HistoryUpdateActivityTimeoutsScope
+	// HistoryUpdateWorkflowSearchAttributesScope tracks UpdateWorkflowSearchAttributes API calls received by service
+	HistoryUpdateWorkflowSearchAttributesScope
+	// HistoryDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution API calls received by service
+	HistoryDeleteWorkflowExecutionScope
+	// HistoryImportWorkflowExecutionScope tracks ImportWorkflowExecution API calls received by service
+	HistoryImportWorkflowExecutionScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
 	TransferQueueProcessorScope
 	// TransferActiveQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -838,6 +972,8 @@ const (
 	HistoryResetWorkflowExecutionScope
 	// HistoryQueryWorkflowScope tracks QueryWorkflow API calls received by service
 	HistoryQueryWorkflowScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
 	// HistoryProcessDeleteHistoryEventScope tracks ProcessDeleteHistoryEvent processing calls
 	HistoryProcessDeleteHistoryEventScope
 	// WorkflowCompletionStatsScope tracks workflow completion updates
@@ -870,6 +1006,10 @@ const (
 	MatchingCancelOutstandingPollScope
 	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
 	MatchingDescribeTaskListScope
+	// MatchingUpdateTaskListLimitsScope tracks UpdateTaskListLimits API calls received by service
+	MatchingUpdateTaskListLimitsScope
+	// MatchingReleaseTaskScope tracks ReleaseTask API calls received by service
+	MatchingReleaseTaskScope
 
 	NumMatchingScopes
 )
@@ -910,8 +1050,14 @@ const (
 	TaskListScavengerScope
 	// BatcherScope is scope used by all metrics emitted by worker.Batcher module
 	BatcherScope
+	// SchedulerScope is scope used by all metrics emitted by worker.Scheduler module
+	SchedulerScope
 	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
 	HistoryScavengerScope
+	// ExecutionsScavengerScope is scope used by all metrics emitted by worker.executions.Scavenger module
+	ExecutionsScavengerScope
+	// QueueScavengerScope is scope used by all metrics emitted by worker.queues.Scavenger module
+	QueueScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
 
@@ -934,6 +1080,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteCurrentWorkflowExecutionScope:           {operation: "DeleteCurrentWorkflowExecution"},
 		PersistenceDeleteTaskScope:                               {operation: "PersistenceDelete"},
 		PersistenceGetCurrentExecutionScope:                      {operation: "GetCurrentExecution"},
+		PersistenceListConcreteExecutionsScope:                   {operation: "ListConcreteExecutions"},
 		PersistenceGetTransferTasksScope:                         {operation: "GetTransferTasks"},
 		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
 		PersistenceCompleteTransferTaskScope:                     {operation: "CompleteTransferTask"},
@@ -1015,7 +1162,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientGetReplicationTasksScope:               {operation: "HistoryClientGetReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientQueryWorkflowScope:                     {operation: "HistoryClientQueryWorkflowScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:           {operation: "HistoryClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientReapplyEventsScope:                     {operation: "HistoryClientReapplyEventsScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseActivityScope:                     {operation: "HistoryClientPauseActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUnpauseActivityScope:                   {operation: "HistoryClientUnpauseActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResetActivityScope:                     {operation: "HistoryClientResetActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateActivityTimeoutsScope:            {operation: "HistoryClientUpdateActivityTimeoutsScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateWorkflowSearchAttributesScope:    {operation: "HistoryClientUpdateWorkflowSearchAttributesScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:           {operation: "HistoryClientDeleteWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientImportWorkflowExecutionScope:           {operation: "HistoryClientImportWorkflowExecutionScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
@@ -1024,6 +1179,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientRespondQueryTaskCompletedScope:        {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientCancelOutstandingPollScope:            {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientDescribeTaskListScope:                 {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientUpdateTaskListLimitsScope:             {operation: "MatchingClientUpdateTaskListLimits", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientReleaseTaskScope:                      {operation: "MatchingClientReleaseTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                  {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeDomainScope:                   {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                 {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
@@ -1036,6 +1193,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientPollForActivityTaskScope:              {operation: "FrontendClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientPollForDecisionTaskScope:              {operation: "FrontendClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientQueryWorkflowScope:                    {operation: "FrontendClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientUpdateWorkflowExecutionScope:          {operation: "FrontendClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientRecordActivityTaskHeartbeatScope:      {operation: "FrontendClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientRecordActivityTaskHeartbeatByIDScope:  {operation: "FrontendClientRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientRegisterDomainScope:                   {operation: "FrontendClientRegisterDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
@@ -1063,11 +1221,29 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientGetReplicationTasksScope:              {operation: "FrontendClientGetReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientGetDomainReplicationTasksScope:        {operation: "FrontendClientGetDomainReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientReapplyEventsScope:                    {operation: "FrontendClientReapplyEventsScope", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientCreateScheduleScope:                   {operation: "FrontendClientCreateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientDescribeScheduleScope:                 {operation: "FrontendClientDescribeSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientUpdateScheduleScope:                   {operation: "FrontendClientUpdateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientPauseScheduleScope:                    {operation: "FrontendClientPauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientUnpauseScheduleScope:                  {operation: "FrontendClientUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientDeleteScheduleScope:                   {operation: "FrontendClientDeleteSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientBackfillScheduleScope:                 {operation: "FrontendClientBackfillSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListSchedulesScope:                    {operation: "FrontendClientListSchedules", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		AdminClientAddSearchAttributeScope:                  {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                          {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientPauseActivityScope:                       {operation: "AdminClientPauseActivity", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientUnpauseActivityScope:                     {operation: "AdminClientUnpauseActivity", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientResetActivityScope:                       {operation: "AdminClientResetActivity", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateActivityTimeoutsScope:              {operation: "AdminClientUpdateActivityTimeouts", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateTaskListLimitsScope:                {operation: "AdminClientUpdateTaskListLimits", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientVerifyArchivedWorkflowExecutionScope:     {operation: "AdminClientVerifyArchivedWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRearchiveWorkflowExecutionScope:          {operation: "AdminClientRearchiveWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientImportWorkflowExecutionScope:             {operation: "AdminClientImportWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRemoveSearchAttributeScope:               {operation: "AdminClientRemoveSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListSearchAttributesScope:                {operation: "AdminClientListSearchAttributes", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                   {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                    {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeTaskListScope:                  {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1084,6 +1260,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionPollForActivityTaskScope:               {operation: "DCRedirectionPollForActivityTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionPollForDecisionTaskScope:               {operation: "DCRedirectionPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionQueryWorkflowScope:                     {operation: "DCRedirectionQueryWorkflow", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkflowExecutionScope:           {operation: "DCRedirectionUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRecordActivityTaskHeartbeatScope:       {operation: "DCRedirectionRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRecordActivityTaskHeartbeatByIDScope:   {operation: "DCRedirectionRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRegisterDomainScope:                    {operation: "DCRedirectionRegisterDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1104,6 +1281,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionStartWorkflowExecutionScope:            {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:        {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                      {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCreateScheduleScope:                    {operation: "DCRedirectionCreateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeScheduleScope:                  {operation: "DCRedirectionDescribeSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateScheduleScope:                    {operation: "DCRedirectionUpdateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseScheduleScope:                     {operation: "DCRedirectionPauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseScheduleScope:                   {operation: "DCRedirectionUnpauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeleteScheduleScope:                    {operation: "DCRedirectionDeleteSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionBackfillScheduleScope:                  {operation: "DCRedirectionBackfillSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListSchedulesScope:                     {operation: "DCRedirectionListSchedules", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -1144,11 +1329,22 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	// Frontend Scope Names
 	Frontend: {
 		// Admin API scope co-locates with with frontend
-		AdminRemoveTaskScope:                     {operation: "AdminRemoveTask"},
-		AdminCloseShardTaskScope:                 {operation: "AdminCloseShardTask"},
-		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
-		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
-		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminRemoveTaskScope:                      {operation: "AdminRemoveTask"},
+		AdminCloseShardTaskScope:                  {operation: "AdminCloseShardTask"},
+		AdminDescribeHistoryHostScope:             {operation: "DescribeHistoryHost"},
+		AdminDescribeWorkflowExecutionScope:       {operation: "DescribeWorkflowExecution"},
+		AdminGetWorkflowExecutionRawHistoryScope:  {operation: "GetWorkflowExecutionRawHistory"},
+		AdminPauseActivityScope:                   {operation: "AdminPauseActivity"},
+		AdminUnpauseActivityScope:                 {operation: "AdminUnpauseActivity"},
+		AdminResetActivityScope:                   {operation: "AdminResetActivity"},
+		AdminUpdateActivityTimeoutsScope:          {operation: "AdminUpdateActivityTimeouts"},
+		AdminUpdateTaskListLimitsScope:            {operation: "AdminUpdateTaskListLimits"},
+		AdminVerifyArchivedWorkflowExecutionScope: {operation: "AdminVerifyArchivedWorkflowExecution"},
+		AdminRearchiveWorkflowExecutionScope:      {operation: "AdminRearchiveWorkflowExecution"},
+		AdminImportWorkflowExecutionScope:         {operation: "AdminImportWorkflowExecution"},
+		AdminAddSearchAttributeScope:              {operation: "AdminAddSearchAttribute"},
+		AdminRemoveSearchAttributeScope:           {operation: "AdminRemoveSearchAttribute"},
+		AdminListSearchAttributesScope:            {operation: "AdminListSearchAttributes"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -1181,6 +1377,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendUpdateDomainScope:                     {operation: "UpdateDomain"},
 		FrontendDeprecateDomainScope:                  {operation: "DeprecateDomain"},
 		FrontendQueryWorkflowScope:                    {operation: "QueryWorkflow"},
+		FrontendUpdateWorkflowExecutionScope:          {operation: "UpdateWorkflowExecution"},
 		FrontendDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
 		FrontendDescribeTaskListScope:                 {operation: "DescribeTaskList"},
 		FrontendResetStickyTaskListScope:              {operation: "ResetStickyTaskList"},
@@ -1188,6 +1385,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendGetReplicationMessagesScope:           {operation: "GetReplicationMessages"},
 		FrontendGetDomainReplicationMessagesScope:     {operation: "GetDomainReplicationMessages"},
 		FrontendReapplyEventsScope:                    {operation: "ReapplyEvents"},
+		FrontendCreateScheduleScope:                   {operation: "CreateSchedule"},
+		FrontendDescribeScheduleScope:                 {operation: "DescribeSchedule"},
+		FrontendUpdateScheduleScope:                   {operation: "UpdateSchedule"},
+		FrontendPauseScheduleScope:                    {operation: "PauseSchedule"},
+		FrontendUnpauseScheduleScope:                  {operation: "UnpauseSchedule"},
+		FrontendDeleteScheduleScope:                   {operation: "DeleteSchedule"},
+		FrontendBackfillScheduleScope:                 {operation: "BackfillSchedule"},
+		FrontendListSchedulesScope:                    {operation: "ListSchedules"},
+		FrontendListArchivedWorkflowExecutionsScope:   {operation: "ListArchivedWorkflowExecutions"},
 	},
 	// History Scope Names
 	History: {
@@ -1209,6 +1415,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryTerminateWorkflowExecutionScope:                 {operation: "TerminateWorkflowExecution"},
 		HistoryResetWorkflowExecutionScope:                     {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                              {operation: "QueryWorkflow"},
+		HistoryUpdateWorkflowExecutionScope:                    {operation: "UpdateWorkflowExecution"},
 		HistoryProcessDeleteHistoryEventScope:                  {operation: "ProcessDeleteHistoryEvent"},
 		HistoryScheduleDecisionTaskScope:                       {operation: "ScheduleDecisionTask"},
 		HistoryRecordChildExecutionCompletedScope:              {operation: "RecordChildExecutionCompleted"},
@@ -1222,6 +1429,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryGetReplicationMessagesScope:                     {operation: "GetReplicationMessages"},
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		HistoryReapplyEventsScope:                              {operation: "EventReapplication"},
+		HistoryPauseActivityScope:                              {operation: "PauseActivity"},
+		HistoryUnpauseActivityScope:                            {operation: "UnpauseActivity"},
+		HistoryResetActivityScope:                              {operation: "ResetActivity"},
+		HistoryUpdateActivityTimeoutsScope:                     {operation: "UpdateActivityTimeouts"},
+		HistoryUpdateWorkflowSearchAttributesScope:             {operation: "UpdateWorkflowSearchAttributes"},
+		HistoryDeleteWorkflowExecutionScope:                    {operation: "DeleteWorkflowExecution"},
+		HistoryImportWorkflowExecutionScope:                    {operation: "ImportWorkflowExecution"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
 		TransferStandbyQueueProcessorScope:                     {operation: "TransferStandbyQueueProcessor"},
@@ -1294,6 +1508,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
 		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
+		MatchingUpdateTaskListLimitsScope:      {operation: "UpdateTaskListLimits"},
+		MatchingReleaseTaskScope:               {operation: "ReleaseTask"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1314,7 +1530,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ArchiverArchivalWorkflowScope:          {operation: "ArchiverArchivalWorkflow"},
 		TaskListScavengerScope:                 {operation: "tasklistscavenger"},
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
+		ExecutionsScavengerScope:               {operation: "executionsscavenger"},
+		QueueScavengerScope:                    {operation: "queuescavenger"},
 		BatcherScope:                           {operation: "batcher"},
+		SchedulerScope:                         {operation: "scheduler"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 	},
 }
@@ -1333,11 +1552,14 @@ const (
 	CadenceErrDomainAlreadyExistsCounter
 	CadenceErrCancellationAlreadyRequestedCounter
 	CadenceErrQueryFailedCounter
+	CadenceErrUpdateRejectedCounter
 	CadenceErrLimitExceededCounter
 	CadenceErrContextTimeoutCounter
 	CadenceErrRetryTaskCounter
 	CadenceErrBadBinaryCounter
 	CadenceErrClientVersionNotSupportedCounter
+	CadenceErrUnauthorizedCounter
+	CadenceAuthorizationLatency
 	PersistenceRequests
 	PersistenceFailures
 	PersistenceLatency
@@ -1436,6 +1658,8 @@ const (
 	DecisionTypeContinueAsNewCounter
 	DecisionTypeSignalExternalWorkflowCounter
 	DecisionTypeUpsertWorkflowSearchAttributesCounter
+	WorkflowUpdateAcceptedCounter
+	WorkflowUpdateRejectedCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
 	StaleMutableStateCounter
@@ -1477,6 +1701,9 @@ const (
 	CompleteDecisionWithStickyEnabledCounter
 	CompleteDecisionWithStickyDisabledCounter
 	DecisionHeartbeatTimeoutCounter
+	ConsistentQueryTimeoutCounter
+	QueryBufferExceededCounter
+	DirectQueryDispatchCounter
 	HistoryEventNotificationQueueingLatency
 	HistoryEventNotificationFanoutLatency
 	HistoryEventNotificationInFlightMessageGauge
@@ -1515,6 +1742,7 @@ const (
 	DeleteRequestCancelInfoCount
 	WorkflowRetryBackoffTimerCount
 	WorkflowCronBackoffTimerCount
+	WorkflowDelayStartBackoffTimerCount
 	WorkflowCleanupDeleteCount
 	WorkflowCleanupArchiveCount
 	WorkflowCleanupNopCount
@@ -1572,6 +1800,9 @@ const (
 	LocalToRemoteMatchCounter
 	RemoteToLocalMatchCounter
 	RemoteToRemoteMatchCounter
+	PartitionUpscaleCounter
+	PartitionDownscaleCounter
+	PartitionDrainedCounter
 
 	NumMatchingMetrics
 )
@@ -1626,9 +1857,27 @@ const (
 	ExecutorTasksDroppedCount
 	BatcherProcessorSuccess
 	BatcherProcessorFailures
+	BatcherProcessorThrottled
+	BatcherProcessorRPS
+	SchedulerActionsStarted
+	SchedulerActionsSkipped
+	SchedulerActionFailures
 	HistoryScavengerSuccessCount
 	HistoryScavengerErrorCount
 	HistoryScavengerSkipCount
+	ExecutionsScavengerExecutionCount
+	ExecutionsScavengerErrorCount
+	ExecutionsScavengerFixedCount
+	ExecutionsScavengerMissingHistoryCount
+	ExecutionsScavengerOpenNotCurrentCount
+	ExecutionsScavengerDanglingCurrentCount
+	ExecutionsScavengerStuckOpenCount
+	QueueScavengerTransferTaskCount
+	QueueScavengerTimerTaskCount
+	QueueScavengerDeadTransferTaskCount
+	QueueScavengerDeadTimerTaskCount
+	QueueScavengerDeletedTaskCount
+	QueueScavengerErrorCount
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
 
@@ -1650,11 +1899,14 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrDomainAlreadyExistsCounter:                {metricName: "cadence_errors_domain_already_exists", metricType: Counter},
 		CadenceErrCancellationAlreadyRequestedCounter:       {metricName: "cadence_errors_cancellation_already_requested", metricType: Counter},
 		CadenceErrQueryFailedCounter:                        {metricName: "cadence_errors_query_failed", metricType: Counter},
+		CadenceErrUpdateRejectedCounter:                     {metricName: "cadence_errors_update_rejected", metricType: Counter},
 		CadenceErrLimitExceededCounter:                      {metricName: "cadence_errors_limit_exceeded", metricType: Counter},
 		CadenceErrContextTimeoutCounter:                     {metricName: "cadence_errors_context_timeout", metricType: Counter},
 		CadenceErrRetryTaskCounter:                          {metricName: "cadence_errors_retry_task", metricType: Counter},
 		CadenceErrBadBinaryCounter:                          {metricName: "cadence_errors_bad_binary", metricType: Counter},
 		CadenceErrClientVersionNotSupportedCounter:          {metricName: "cadence_errors_client_version_not_supported", metricType: Counter},
+		CadenceErrUnauthorizedCounter:                       {metricName: "cadence_errors_unauthorized", metricType: Counter},
+		CadenceAuthorizationLatency:                         {metricName: "cadence_authorization_latency", metricType: Timer},
 		PersistenceRequests:                                 {metricName: "persistence_requests", metricType: Counter},
 		PersistenceFailures:                                 {metricName: "persistence_errors", metricType: Counter},
 		PersistenceLatency:                                  {metricName: "persistence_latency", metricType: Timer},
@@ -1738,6 +1990,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeSignalExternalWorkflowCounter:         {metricName: "signal_external_workflow_decision", metricType: Counter},
 		DecisionTypeUpsertWorkflowSearchAttributesCounter: {metricName: "upsert_workflow_search_attributes_decision", metricType: Counter},
 		DecisionTypeChildWorkflowCounter:                  {metricName: "child_workflow_decision", metricType: Counter},
+		WorkflowUpdateAcceptedCounter:                     {metricName: "workflow_update_accepted", metricType: Counter},
+		WorkflowUpdateRejectedCounter:                     {metricName: "workflow_update_rejected", metricType: Counter},
 		MultipleCompletionDecisionsCounter:                {metricName: "multiple_completion_decisions", metricType: Counter},
 		FailedDecisionsCounter:                            {metricName: "failed_decisions", metricType: Counter},
 		StaleMutableStateCounter:                          {metricName: "stale_mutable_state", metricType: Counter},
@@ -1779,6 +2033,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CompleteDecisionWithStickyEnabledCounter:          {metricName: "complete_decision_sticky_enabled_count", metricType: Counter},
 		CompleteDecisionWithStickyDisabledCounter:         {metricName: "complete_decision_sticky_disabled_count", metricType: Counter},
 		DecisionHeartbeatTimeoutCounter:                   {metricName: "decision_heartbeat_timeout_count", metricType: Counter},
+		ConsistentQueryTimeoutCounter:                     {metricName: "consistent_query_timeout", metricType: Counter},
+		QueryBufferExceededCounter:                        {metricName: "query_buffer_exceeded", metricType: Counter},
+		DirectQueryDispatchCounter:                        {metricName: "direct_query_dispatch", metricType: Counter},
 		HistoryEventNotificationQueueingLatency:           {metricName: "history_event_notification_queueing_latency", metricType: Timer},
 		HistoryEventNotificationFanoutLatency:             {metricName: "history_event_notification_fanout_latency", metricType: Timer},
 		HistoryEventNotificationInFlightMessageGauge:      {metricName: "history_event_notification_inflight_message_gauge", metricType: Gauge},
@@ -1817,6 +2074,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DeleteRequestCancelInfoCount:                      {metricName: "delete_request_cancel_info", metricType: Timer},
 		WorkflowRetryBackoffTimerCount:                    {metricName: "workflow_retry_backoff_timer", metricType: Counter},
 		WorkflowCronBackoffTimerCount:                     {metricName: "workflow_cron_backoff_timer", metricType: Counter},
+		WorkflowDelayStartBackoffTimerCount:               {metricName: "workflow_delay_start_backoff_timer", metricType: Counter},
 		WorkflowCleanupDeleteCount:                        {metricName: "workflow_cleanup_delete", metricType: Counter},
 		WorkflowCleanupArchiveCount:                       {metricName: "workflow_cleanup_archive", metricType: Counter},
 		WorkflowCleanupNopCount:                           {metricName: "workflow_cleanup_nop", metricType: Counter},
@@ -1870,6 +2128,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		LocalToRemoteMatchCounter:     {metricName: "local_to_remote_matches"},
 		RemoteToLocalMatchCounter:     {metricName: "remote_to_local_matches"},
 		RemoteToRemoteMatchCounter:    {metricName: "remote_to_remote_matches"},
+		PartitionUpscaleCounter:       {metricName: "partition_upscale"},
+		PartitionDownscaleCounter:     {metricName: "partition_downscale"},
+		PartitionDrainedCounter:       {metricName: "partition_drained"},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -1920,9 +2181,27 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ExecutorTasksDroppedCount:                     {metricName: "executor_dropped", metricType: Counter},
 		BatcherProcessorSuccess:                       {metricName: "batcher_processor_requests", metricType: Counter},
 		BatcherProcessorFailures:                      {metricName: "batcher_processor_errors", metricType: Counter},
+		BatcherProcessorThrottled:                     {metricName: "batcher_processor_throttled", metricType: Counter},
+		BatcherProcessorRPS:                           {metricName: "batcher_processor_rps", metricType: Gauge},
+		SchedulerActionsStarted:                       {metricName: "scheduler_actions_started", metricType: Counter},
+		SchedulerActionsSkipped:                       {metricName: "scheduler_actions_skipped", metricType: Counter},
+		SchedulerActionFailures:                       {metricName: "scheduler_action_errors", metricType: Counter},
 		HistoryScavengerSuccessCount:                  {metricName: "scavenger_success", metricType: Counter},
 		HistoryScavengerErrorCount:                    {metricName: "scavenger_errors", metricType: Counter},
 		HistoryScavengerSkipCount:                     {metricName: "scavenger_skips", metricType: Counter},
+		ExecutionsScavengerExecutionCount:             {metricName: "executions_scavenger_executions", metricType: Counter},
+		ExecutionsScavengerErrorCount:                 {metricName: "executions_scavenger_errors", metricType: Counter},
+		ExecutionsScavengerFixedCount:                 {metricName: "executions_scavenger_fixed", metricType: Counter},
+		ExecutionsScavengerMissingHistoryCount:        {metricName: "executions_scavenger_missing_history", metricType: Counter},
+		ExecutionsScavengerOpenNotCurrentCount:        {metricName: "executions_scavenger_open_not_current", metricType: Counter},
+		ExecutionsScavengerDanglingCurrentCount:       {metricName: "executions_scavenger_dangling_current", metricType: Counter},
+		ExecutionsScavengerStuckOpenCount:             {metricName: "executions_scavenger_stuck_open", metricType: Counter},
+		QueueScavengerTransferTaskCount:               {metricName: "queue_scavenger_transfer_tasks", metricType: Counter},
+		QueueScavengerTimerTaskCount:                  {metricName: "queue_scavenger_timer_tasks", metricType: Counter},
+		QueueScavengerDeadTransferTaskCount:           {metricName: "queue_scavenger_dead_transfer_tasks", metricType: Counter},
+		QueueScavengerDeadTimerTaskCount:              {metricName: "queue_scavenger_dead_timer_tasks", metricType: Counter},
+		QueueScavengerDeletedTaskCount:                {metricName: "queue_scavenger_deleted_tasks", metricType: Counter},
+		QueueScavengerErrorCount:                      {metricName: "queue_scavenger_errors", metricType: Counter},
 		ParentClosePolicyProcessorSuccess:             {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:            {metricName: "parent_close_policy_processor_errors", metricType: Counter},
 	},
@@ -56,7 +56,8 @@ const (
 		`visibility_archival_status: ?, ` +
 		`visibility_archival_uri: ?, ` +
 		`bad_binaries: ?,` +
-		`bad_binaries_encoding: ?` +
+		`bad_binaries_encoding: ?,` +
+		`search_attributes: ?` +
 		`}`
 
 	templateDomainReplicationConfigType = `{` +
@@ -84,7 +85,7 @@ const (
 		`config.archival_bucket, config.archival_status, ` +
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
-		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.bad_binaries, config.bad_binaries_encoding, config.search_attributes, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -126,7 +127,7 @@ const (
 		`config.archival_bucket, config.archival_status, ` +
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
-		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.bad_binaries, config.bad_binaries_encoding, config.search_attributes, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -220,6 +221,7 @@ func (m *cassandraMetadataPersistenceV2) CreateDomainInV2Table(request *p.Intern
 		request.Config.VisibilityArchivalURI,
 		request.Config.BadBinaries.Data,
 		string(request.Config.BadBinaries.GetEncoding()),
+		serializeSearchAttributes(request.Config.SearchAttributes),
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
 		request.IsGlobalDomain,
@@ -284,6 +286,7 @@ func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.InternalUpdateD
 		request.Config.VisibilityArchivalURI,
 		request.Config.BadBinaries.Data,
 		string(request.Config.BadBinaries.GetEncoding()),
+		serializeSearchAttributes(request.Config.SearchAttributes),
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
 		request.ConfigVersion,
@@ -366,6 +369,7 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
+	var searchAttributes map[string]int
 
 	query = m.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, domainName)
 	err = query.Scan(
@@ -385,6 +389,7 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		&config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&searchAttributes,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&isGlobalDomain,
@@ -402,6 +407,7 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		info.Data = map[string]string{}
 	}
 	config.BadBinaries = p.NewDataBlob(badBinariesData, common.EncodingType(badBinariesDataEncoding))
+	config.SearchAttributes = deserializeSearchAttributes(searchAttributes)
 	replicationConfig.ActiveClusterName = p.GetOrUseDefaultActiveCluster(m.currentClusterName, replicationConfig.ActiveClusterName)
 	replicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
 	replicationConfig.Clusters = p.GetOrUseDefaultClusters(m.currentClusterName, replicationConfig.Clusters)
@@ -438,6 +444,7 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 	var replicationClusters []map[string]interface{}
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
+	var searchAttributes map[string]int
 	response := &p.InternalListDomainsResponse{}
 	for iter.Scan(
 		&name,
@@ -457,6 +464,7 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 		&domain.Config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&searchAttributes,
 		&domain.ReplicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&domain.IsGlobalDomain,
@@ -473,6 +481,8 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 			domain.Config.BadBinaries = p.NewDataBlob(badBinariesData, common.EncodingType(badBinariesDataEncoding))
 			badBinariesData = []byte("")
 			badBinariesDataEncoding = ""
+			domain.Config.SearchAttributes = deserializeSearchAttributes(searchAttributes)
+			searchAttributes = nil
 			domain.ReplicationConfig.ActiveClusterName = p.GetOrUseDefaultActiveCluster(m.currentClusterName, domain.ReplicationConfig.ActiveClusterName)
 			domain.ReplicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
 			domain.ReplicationConfig.Clusters = p.GetOrUseDefaultClusters(m.currentClusterName, domain.ReplicationConfig.Clusters)
@@ -571,3 +581,22 @@ func (m *cassandraMetadataPersistenceV2) deleteDomain(name, ID string) error {
 
 	return nil
 }
+
+func serializeSearchAttributes(searchAttributes map[string]workflow.IndexedValueType) map[string]int {
+	result := make(map[string]int, len(searchAttributes))
+	for key, valueType := range searchAttributes {
+		result[key] = int(valueType)
+	}
+	return result
+}
+
+func deserializeSearchAttributes(searchAttributes map[string]int) map[string]workflow.IndexedValueType {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	result := make(map[string]workflow.IndexedValueType, len(searchAttributes))
+	for key, valueType := range searchAttributes {
+		result[key] = workflow.IndexedValueType(valueType)
+	}
+	return result
+}
@@ -168,6 +168,9 @@ const (
 		`branch_token: ?, ` +
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
+		`delay_start_seconds: ?, ` +
+		`priority: ?, ` +
+		`fairness_key: ?, ` +
 		`search_attributes: ?, ` +
 		`memo: ? ` +
 		`}`
@@ -261,6 +264,9 @@ const (
 		`last_failure_reason: ?, ` +
 		`last_worker_identity: ?, ` +
 		`last_failure_details: ?, ` +
+		`paused: ?, ` +
+		`priority: ?, ` +
+		`fairness_key: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
@@ -311,7 +317,11 @@ const (
 		`type: ?, ` +
 		`ack_level: ?, ` +
 		`kind: ?, ` +
-		`last_updated: ? ` +
+		`last_updated: ?, ` +
+		`num_read_partitions: ?, ` +
+		`num_write_partitions: ?, ` +
+		`max_tasks_per_second: ?, ` +
+		`max_outstanding_tasks: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -319,7 +329,9 @@ const (
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
-		`created_time: ? ` +
+		`created_time: ?, ` +
+		`priority: ?, ` +
+		`fairness_key: ? ` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -425,6 +437,11 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ?`
 
+	templateListWorkflowExecutionQuery = `SELECT run_id, execution ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
 	templateCheckWorkflowExecutionQuery = `UPDATE executions ` +
 		`SET next_event_id = ? ` +
 		`WHERE shard_id = ? ` +
@@ -2030,6 +2047,50 @@ func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutio
 	}, nil
 }
 
+func (d *cassandraPersistence) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+	query := d.session.Query(
+		templateListWorkflowExecutionQuery,
+		d.shardID,
+		rowTypeExecution,
+	).PageSize(request.PageSize).PageState(request.PageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "ListConcreteExecutions operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{}
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		runID := result["run_id"].(gocql.UUID).String()
+		// the current execution rows share the row type with the concrete execution rows
+		if runID != permanentRunID {
+			response.ExecutionInfos = append(response.ExecutionInfos, createWorkflowExecutionInfo(result["execution"].(map[string]interface{})))
+		}
+		result = make(map[string]interface{})
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
 func (d *cassandraPersistence) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
 
 	// Reading transfer tasks need to be quorum level consistent, otherwise we could loose task
@@ -2265,6 +2326,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		taskListTaskID,
 	)
 	var rangeID, ackLevel int64
+	var numReadPartitions, numWritePartitions, maxOutstandingTasks int
+	var maxTasksPerSecond float64
 	var tlDB map[string]interface{}
 	err := query.Scan(&rangeID, &tlDB)
 	if err != nil {
@@ -2282,6 +2345,10 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 				0,
 				request.TaskListKind,
 				now,
+				0,
+				0,
+				0.0,
+				0,
 			)
 		} else if isThrottlingError(err) {
 			return nil, &workflow.ServiceBusyError{
@@ -2306,6 +2373,10 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		}
 		ackLevel = tlDB["ack_level"].(int64)
 		taskListKind := tlDB["kind"].(int)
+		numReadPartitions, _ = tlDB["num_read_partitions"].(int)
+		numWritePartitions, _ = tlDB["num_write_partitions"].(int)
+		maxTasksPerSecond, _ = tlDB["max_tasks_per_second"].(float64)
+		maxOutstandingTasks, _ = tlDB["max_outstanding_tasks"].(int)
 		query = d.session.Query(templateUpdateTaskListQuery,
 			rangeID+1,
 			request.DomainID,
@@ -2314,6 +2385,10 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 			ackLevel,
 			taskListKind,
 			now,
+			numReadPartitions,
+			numWritePartitions,
+			maxTasksPerSecond,
+			maxOutstandingTasks,
 			request.DomainID,
 			&request.TaskList,
 			request.TaskType,
@@ -2350,12 +2425,35 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		Kind:        request.TaskListKind,
 		LastUpdated: now,
 	}
+	if numWritePartitions > 0 {
+		tli.PartitionConfig = &p.TaskListPartitionConfig{
+			NumReadPartitions:  numReadPartitions,
+			NumWritePartitions: numWritePartitions,
+		}
+	}
+	if maxTasksPerSecond > 0 || maxOutstandingTasks > 0 {
+		tli.Limits = &p.TaskListLimits{
+			MaxTasksPerSecond:   maxTasksPerSecond,
+			MaxOutstandingTasks: maxOutstandingTasks,
+		}
+	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
 
 // From TaskManager interface
 func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest) (*p.UpdateTaskListResponse, error) {
 	tli := request.TaskListInfo
+	var numReadPartitions, numWritePartitions int
+	if tli.PartitionConfig != nil {
+		numReadPartitions = tli.PartitionConfig.NumReadPartitions
+		numWritePartitions = tli.PartitionConfig.NumWritePartitions
+	}
+	var maxTasksPerSecond float64
+	var maxOutstandingTasks int
+	if tli.Limits != nil {
+		maxTasksPerSecond = tli.Limits.MaxTasksPerSecond
+		maxOutstandingTasks = tli.Limits.MaxOutstandingTasks
+	}
 
 	if tli.Kind == p.TaskListKindSticky { // if task_list is sticky, then update with TTL
 		query := d.session.Query(templateUpdateTaskListQueryWithTTL,
@@ -2371,6 +2469,10 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 			tli.AckLevel,
 			tli.Kind,
 			time.Now(),
+			numReadPartitions,
+			numWritePartitions,
+			maxTasksPerSecond,
+			maxOutstandingTasks,
 			stickyTaskListTTL,
 		)
 		err := query.Exec()
@@ -2395,6 +2497,10 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 		tli.AckLevel,
 		tli.Kind,
 		time.Now(),
+		numReadPartitions,
+		numWritePartitions,
+		maxTasksPerSecond,
+		maxOutstandingTasks,
 		tli.DomainID,
 		&tli.Name,
 		tli.TaskType,
@@ -2484,7 +2590,9 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
 				scheduleID,
-				cqlNowTimestamp)
+				cqlNowTimestamp,
+				task.Data.Priority,
+				task.Data.FairnessKey)
 		} else {
 			if ttl > maxCassandraTTL {
 				ttl = maxCassandraTTL
@@ -2500,6 +2608,8 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				task.Execution.GetRunId(),
 				scheduleID,
 				cqlNowTimestamp,
+				task.Data.Priority,
+				task.Data.FairnessKey,
 				ttl)
 		}
 	}
@@ -455,6 +455,9 @@ func createExecution(
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.DelayStartSeconds,
+			executionInfo.Priority,
+			executionInfo.FairnessKey,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
 			executionInfo.NextEventID,
@@ -525,6 +528,9 @@ func createExecution(
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.DelayStartSeconds,
+			executionInfo.Priority,
+			executionInfo.FairnessKey,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
 			executionInfo.NextEventID,
@@ -600,6 +606,9 @@ func createExecution(
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.DelayStartSeconds,
+			executionInfo.Priority,
+			executionInfo.FairnessKey,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
 			replicationState.CurrentVersion,
@@ -713,6 +722,9 @@ func updateExecution(
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.DelayStartSeconds,
+			executionInfo.Priority,
+			executionInfo.FairnessKey,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
 			executionInfo.NextEventID,
@@ -784,6 +796,9 @@ func updateExecution(
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.DelayStartSeconds,
+			executionInfo.Priority,
+			executionInfo.FairnessKey,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
 			executionInfo.NextEventID,
@@ -860,6 +875,9 @@ func updateExecution(
 			executionInfo.BranchToken,
 			executionInfo.CronSchedule,
 			executionInfo.ExpirationSeconds,
+			executionInfo.DelayStartSeconds,
+			executionInfo.Priority,
+			executionInfo.FairnessKey,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
 			replicationState.CurrentVersion,
@@ -1311,6 +1329,9 @@ func updateActivityInfos(
 			a.LastFailureReason,
 			a.LastWorkerIdentity,
 			a.LastFailureDetails,
+			a.Paused,
+			a.Priority,
+			a.FairnessKey,
 			scheduleEncoding,
 			shardID,
 			rowTypeExecution,
@@ -1924,6 +1945,12 @@ func createWorkflowExecutionInfo(
 			info.CronSchedule = v.(string)
 		case "expiration_seconds":
 			info.ExpirationSeconds = int32(v.(int))
+		case "delay_start_seconds":
+			info.DelayStartSeconds = int32(v.(int))
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "fairness_key":
+			info.FairnessKey = v.(string)
 		case "search_attributes":
 			info.SearchAttributes = v.(map[string][]byte)
 		case "memo":
@@ -2131,6 +2158,12 @@ func createActivityInfo(
 			info.LastWorkerIdentity = v.(string)
 		case "last_failure_details":
 			info.LastFailureDetails = v.([]byte)
+		case "paused":
+			info.Paused = v.(bool)
+		case "priority":
+			info.Priority = (int32)(v.(int))
+		case "fairness_key":
+			info.FairnessKey = v.(string)
 		case "event_data_encoding":
 			sharedEncoding = common.EncodingType(v.(string))
 		}
@@ -2300,6 +2333,9 @@ func resetActivityInfoMap(
 		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["last_worker_identity"] = a.LastWorkerIdentity
 		aInfo["last_failure_details"] = a.LastFailureDetails
+		aInfo["paused"] = a.Paused
+		aInfo["priority"] = a.Priority
+		aInfo["fairness_key"] = a.FairnessKey
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -2435,6 +2471,10 @@ func createTaskInfo(
 			info.ScheduleID = v.(int64)
 		case "created_time":
 			info.CreatedTime = v.(time.Time)
+		case "priority":
+			info.Priority = int32(v.(int))
+		case "fairness_key":
+			info.FairnessKey = v.(string)
 		}
 	}
 
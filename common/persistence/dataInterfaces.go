@@ -159,6 +159,7 @@ const UnknownNumRowsAffected = -1
 const (
 	WorkflowBackoffTimeoutTypeRetry = iota
 	WorkflowBackoffTimeoutTypeCron
+	WorkflowBackoffTimeoutTypeDelayStart
 )
 
 const (
@@ -321,6 +322,11 @@ type (
 		// Cron
 		CronSchedule      string
 		ExpirationSeconds int32
+		// Delayed start
+		DelayStartSeconds int32
+		// Task dispatch
+		Priority    int32
+		FairnessKey string
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -401,6 +407,23 @@ type (
 		Kind        int
 		Expiry      time.Time
 		LastUpdated time.Time
+		// PartitionConfig is only set on the root partition of a task list
+		// once its partition count has been changed at runtime
+		PartitionConfig *TaskListPartitionConfig
+		// Limits are set when pinned through the admin API
+		Limits *TaskListLimits
+	}
+
+	// TaskListPartitionConfig describes the number of partitions of a task list
+	TaskListPartitionConfig struct {
+		NumReadPartitions  int
+		NumWritePartitions int
+	}
+
+	// TaskListLimits describes the dispatch limits of a task list, a zero value means no limit
+	TaskListLimits struct {
+		MaxTasksPerSecond   float64
+		MaxOutstandingTasks int
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -413,6 +436,8 @@ type (
 		ScheduleToStartTimeout int32
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		Priority               int32
+		FairnessKey            string
 	}
 
 	// Task is the generic interface for workflow tasks
@@ -669,6 +694,9 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		Paused             bool
+		Priority           int32
+		FairnessKey        string
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibility int64
 	}
@@ -781,6 +809,18 @@ type (
 		LastWriteVersion int64
 	}
 
+	// ListConcreteExecutionsRequest is used to list all concrete executions of a shard
+	ListConcreteExecutionsRequest struct {
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListConcreteExecutionsResponse is the response to ListConcreteExecutionsRequest
+	ListConcreteExecutionsResponse struct {
+		ExecutionInfos []*WorkflowExecutionInfo
+		PageToken      []byte
+	}
+
 	// UpdateWorkflowExecutionRequest is used to update a workflow execution
 	UpdateWorkflowExecutionRequest struct {
 		RangeID int64
@@ -1169,6 +1209,7 @@ type (
 		VisibilityArchivalStatus workflow.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              workflow.BadBinaries
+		SearchAttributes         map[string]workflow.IndexedValueType
 	}
 
 	// DomainReplicationConfig describes the cross DC domain replication configuration
@@ -1323,6 +1364,8 @@ type (
 		Encoding common.EncodingType
 		// The shard to get history node data
 		ShardID *int
+		// optional domain of the workflow, selects the key used to encode the payloads of the events
+		DomainID string
 	}
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
@@ -1501,6 +1544,8 @@ type (
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
 		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
+		// ListConcreteExecutions returns all concrete executions of the shard, the current executions are not included
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
 
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
@@ -1581,7 +1626,7 @@ type (
 		ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error)
 		// ReadHistoryBranchByBatch returns history node data for a branch ByBatch
 		ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error)
-		// ReadRawHistoryBranch returns history node raw data for a branch ByBatch
+		// ReadRawHistoryBranch returns history node raw data for a branch ByBatch, with event payloads decoded
 		// NOTE: this API should only be used by 3+DC
 		ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error)
 		// ForkHistoryBranch forks a new branch from a old branch
@@ -527,13 +527,13 @@ func (v *esVisibilityStore) getESQueryDSL(request *p.ListWorkflowExecutionsReque
 		return "", err
 	}
 
-	sortField, err := v.processSortField(dsl)
+	sortField, err := v.processSortField(dsl, request.Domain)
 	if err != nil {
 		return "", err
 	}
 
 	if shouldSearchAfter(token) {
-		valueOfSearchAfter, err := v.getValueOfSearchAfterInJSON(token, sortField)
+		valueOfSearchAfter, err := v.getValueOfSearchAfterInJSON(token, sortField, request.Domain)
 		if err != nil {
 			return "", err
 		}
@@ -626,7 +626,7 @@ func addMustQuery(dsl *fastjson.Value, queryString string) {
 	valOfTopQuery.Set("bool", fastjson.MustParse(newValOfBool))
 }
 
-func (v *esVisibilityStore) processSortField(dsl *fastjson.Value) (string, error) {
+func (v *esVisibilityStore) processSortField(dsl *fastjson.Value, domain string) (string, error) {
 	isSorted := dsl.Exists(dslFieldSort)
 	var sortField string
 
@@ -643,7 +643,7 @@ func (v *esVisibilityStore) processSortField(dsl *fastjson.Value) (string, error
 		obj.Visit(func(k []byte, v *fastjson.Value) { // visit is only way to get object key in fastjson
 			sortField = string(k)
 		})
-		if v.getFieldType(sortField) == workflow.IndexedValueTypeString {
+		if v.getFieldType(sortField, domain) == workflow.IndexedValueTypeString {
 			return "", errors.New("not able to sort by IndexedValueTypeString field, use IndexedValueTypeKeyword field")
 		}
 		// add RunID as tie-breaker
@@ -653,11 +653,11 @@ func (v *esVisibilityStore) processSortField(dsl *fastjson.Value) (string, error
 	return sortField, nil
 }
 
-func (v *esVisibilityStore) getFieldType(fieldName string) workflow.IndexedValueType {
+func (v *esVisibilityStore) getFieldType(fieldName string, domain string) workflow.IndexedValueType {
 	if strings.HasPrefix(fieldName, definition.Attr) {
 		fieldName = fieldName[len(definition.Attr)+1:] // remove prefix
 	}
-	validMap := v.config.ValidSearchAttributes()
+	validMap := v.config.ValidSearchAttributes(domain)
 	fieldType, ok := validMap[fieldName]
 	if !ok {
 		v.logger.Error("Unknown fieldName, validation should be done in frontend already", tag.Value(fieldName))
@@ -669,10 +669,10 @@ func shouldSearchAfter(token *esVisibilityPageToken) bool {
 	return token.TieBreaker != ""
 }
 
-func (v *esVisibilityStore) getValueOfSearchAfterInJSON(token *esVisibilityPageToken, sortField string) (string, error) {
+func (v *esVisibilityStore) getValueOfSearchAfterInJSON(token *esVisibilityPageToken, sortField string, domain string) (string, error) {
 	var sortVal interface{}
 	var err error
-	switch v.getFieldType(sortField) {
+	switch v.getFieldType(sortField, domain) {
 	case workflow.IndexedValueTypeInt, workflow.IndexedValueTypeDatetime, workflow.IndexedValueTypeBool:
 		sortVal, err = token.SortValue.(json.Number).Int64()
 		if err != nil {
@@ -101,7 +101,7 @@ func (s *ESVisibilitySuite) SetupTest() {
 	s.mockESClient = &esMocks.Client{}
 	config := &config.VisibilityConfig{
 		ESIndexMaxResultWindow: dynamicconfig.GetIntPropertyFn(3),
-		ValidSearchAttributes:  dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		ValidSearchAttributes:  dynamicconfig.GetMapPropertyFnFilteredByDomain(definition.GetDefaultIndexedKeys()),
 	}
 
 	s.mockProducer = &mocks.KafkaProducer{}
@@ -1015,8 +1015,8 @@ func (s *ESVisibilitySuite) TestProcessAllValuesForKey() {
 }
 
 func (s *ESVisibilitySuite) TestGetFieldType() {
-	s.Equal(workflow.IndexedValueTypeInt, s.visibilityStore.getFieldType("StartTime"))
-	s.Equal(workflow.IndexedValueTypeDatetime, s.visibilityStore.getFieldType("Attr.CustomDatetimeField"))
+	s.Equal(workflow.IndexedValueTypeInt, s.visibilityStore.getFieldType("StartTime", testDomain))
+	s.Equal(workflow.IndexedValueTypeDatetime, s.visibilityStore.getFieldType("Attr.CustomDatetimeField", testDomain))
 }
 
 func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
@@ -1025,7 +1025,7 @@ func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
 	// Int field
 	token := s.getTokenHelper(123)
 	sortField := definition.CustomIntField
-	res, err := v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err := v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[123, "t"]`, res)
 
@@ -1034,7 +1034,7 @@ func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
 	dec.UseNumber()
 	err = dec.Decode(&token)
 	s.Nil(err)
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[-9223372036854775808, "t"]`, res)
 
@@ -1043,14 +1043,14 @@ func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
 	dec.UseNumber()
 	err = dec.Decode(&token)
 	s.Nil(err)
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[9223372036854775807, "t"]`, res)
 
 	// Double field
 	token = s.getTokenHelper(1.11)
 	sortField = definition.CustomDoubleField
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[1.11, "t"]`, res)
 
@@ -1059,19 +1059,19 @@ func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
 	dec.UseNumber()
 	err = dec.Decode(&token)
 	s.Nil(err)
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`["-Infinity", "t"]`, res)
 
 	// Keyword field
 	token = s.getTokenHelper("keyword")
 	sortField = definition.CustomKeywordField
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`["keyword", "t"]`, res)
 
 	token = s.getTokenHelper(nil)
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[null, "t"]`, res)
 }
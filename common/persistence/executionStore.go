@@ -41,11 +41,12 @@ var _ ExecutionManager = (*executionManagerImpl)(nil)
 // NewExecutionManagerImpl returns new ExecutionManager
 func NewExecutionManagerImpl(
 	persistence ExecutionStore,
+	serializer PayloadSerializer,
 	logger log.Logger,
 ) ExecutionManager {
 
 	return &executionManagerImpl{
-		serializer:    NewPayloadSerializer(),
+		serializer:    serializer,
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
@@ -73,12 +74,15 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 		State: &WorkflowMutableState{
 			TimerInfos:         response.State.TimerInfos,
 			RequestCancelInfos: response.State.RequestCancelInfos,
-			SignalInfos:        response.State.SignalInfos,
 			SignalRequestedIDs: response.State.SignalRequestedIDs,
 			ReplicationState:   response.State.ReplicationState,
 		},
 	}
 
+	newResponse.State.SignalInfos, err = m.DeserializeSignalInfos(response.State.SignalInfos)
+	if err != nil {
+		return nil, err
+	}
 	newResponse.State.ActivityInfos, err = m.DeserializeActivityInfos(response.State.ActivityInfos)
 	if err != nil {
 		return nil, err
@@ -173,6 +177,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		BranchToken:                        info.BranchToken,
 		CronSchedule:                       info.CronSchedule,
 		ExpirationSeconds:                  info.ExpirationSeconds,
+		DelayStartSeconds:                  info.DelayStartSeconds,
+		Priority:                           info.Priority,
+		FairnessKey:                        info.FairnessKey,
 		AutoResetPoints:                    autoResetPoints,
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
@@ -198,6 +205,20 @@ func (m *executionManagerImpl) DeserializeBufferedEvents(
 	return events, nil
 }
 
+func (m *executionManagerImpl) DeserializeSignalInfos(
+	infos map[int64]*SignalInfo,
+) (map[int64]*SignalInfo, error) {
+
+	for _, v := range infos {
+		input, err := m.serializer.DecodePayload(v.Input)
+		if err != nil {
+			return nil, err
+		}
+		v.Input = input
+	}
+	return infos, nil
+}
+
 func (m *executionManagerImpl) DeserializeChildExecutionInfos(
 	infos map[int64]*InternalChildExecutionInfo,
 ) (map[int64]*ChildExecutionInfo, error) {
@@ -258,6 +279,14 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		details, err := m.serializer.DecodePayload(v.Details)
+		if err != nil {
+			return nil, err
+		}
+		lastFailureDetails, err := m.serializer.DecodePayload(v.LastFailureDetails)
+		if err != nil {
+			return nil, err
+		}
 		a := &ActivityInfo{
 			ScheduledEvent: scheduledEvent,
 			StartedEvent:   startedEvent,
@@ -270,7 +299,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			StartedTime:                    v.StartedTime,
 			ActivityID:                     v.ActivityID,
 			RequestID:                      v.RequestID,
-			Details:                        v.Details,
+			Details:                        details,
 			ScheduleToStartTimeout:         v.ScheduleToStartTimeout,
 			ScheduleToCloseTimeout:         v.ScheduleToCloseTimeout,
 			StartToCloseTimeout:            v.StartToCloseTimeout,
@@ -292,7 +321,10 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			NonRetriableErrors:             v.NonRetriableErrors,
 			LastFailureReason:              v.LastFailureReason,
 			LastWorkerIdentity:             v.LastWorkerIdentity,
-			LastFailureDetails:             v.LastFailureDetails,
+			LastFailureDetails:             lastFailureDetails,
+			Paused:                         v.Paused,
+			Priority:                       v.Priority,
+			FairnessKey:                    v.FairnessKey,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos[k] = a
@@ -331,16 +363,17 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 
 func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(
 	infos []*ChildExecutionInfo,
+	domainID string,
 	encoding common.EncodingType,
 ) ([]*InternalChildExecutionInfo, error) {
 
 	newInfos := make([]*InternalChildExecutionInfo, 0)
 	for _, v := range infos {
-		initiatedEvent, err := m.serializer.SerializeEvent(v.InitiatedEvent, encoding)
+		initiatedEvent, err := m.serializer.SerializeDomainEvent(domainID, v.InitiatedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
-		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
+		startedEvent, err := m.serializer.SerializeDomainEvent(domainID, v.StartedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -371,11 +404,19 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 
 	newInfos := make([]*InternalActivityInfo, 0)
 	for _, v := range infos {
-		scheduledEvent, err := m.serializer.SerializeEvent(v.ScheduledEvent, encoding)
+		scheduledEvent, err := m.serializer.SerializeDomainEvent(v.DomainID, v.ScheduledEvent, encoding)
+		if err != nil {
+			return nil, err
+		}
+		startedEvent, err := m.serializer.SerializeDomainEvent(v.DomainID, v.StartedEvent, encoding)
+		if err != nil {
+			return nil, err
+		}
+		details, err := m.serializer.EncodePayload(v.DomainID, v.Details)
 		if err != nil {
 			return nil, err
 		}
-		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
+		lastFailureDetails, err := m.serializer.EncodePayload(v.DomainID, v.LastFailureDetails)
 		if err != nil {
 			return nil, err
 		}
@@ -390,7 +431,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			StartedTime:                    v.StartedTime,
 			ActivityID:                     v.ActivityID,
 			RequestID:                      v.RequestID,
-			Details:                        v.Details,
+			Details:                        details,
 			ScheduleToStartTimeout:         v.ScheduleToStartTimeout,
 			ScheduleToCloseTimeout:         v.ScheduleToCloseTimeout,
 			StartToCloseTimeout:            v.StartToCloseTimeout,
@@ -412,7 +453,10 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			NonRetriableErrors:             v.NonRetriableErrors,
 			LastFailureReason:              v.LastFailureReason,
 			LastWorkerIdentity:             v.LastWorkerIdentity,
-			LastFailureDetails:             v.LastFailureDetails,
+			LastFailureDetails:             lastFailureDetails,
+			Paused:                         v.Paused,
+			Priority:                       v.Priority,
+			FairnessKey:                    v.FairnessKey,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos = append(newInfos, i)
@@ -420,6 +464,24 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 	return newInfos, nil
 }
 
+func (m *executionManagerImpl) SerializeUpsertSignalInfos(
+	infos []*SignalInfo,
+	domainID string,
+) ([]*SignalInfo, error) {
+
+	newInfos := make([]*SignalInfo, 0, len(infos))
+	for _, v := range infos {
+		input, err := m.serializer.EncodePayload(domainID, v.Input)
+		if err != nil {
+			return nil, err
+		}
+		i := *v
+		i.Input = input
+		newInfos = append(newInfos, &i)
+	}
+	return newInfos, nil
+}
+
 func (m *executionManagerImpl) SerializeExecutionInfo(
 	info *WorkflowExecutionInfo,
 	stats *ExecutionStats,
@@ -429,7 +491,7 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 	if info == nil {
 		return &InternalWorkflowExecutionInfo{}, nil
 	}
-	completionEvent, err := m.serializer.SerializeEvent(info.CompletionEvent, encoding)
+	completionEvent, err := m.serializer.SerializeDomainEvent(info.DomainID, info.CompletionEvent, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -493,6 +555,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		BranchToken:                        info.BranchToken,
 		CronSchedule:                       info.CronSchedule,
 		ExpirationSeconds:                  info.ExpirationSeconds,
+		DelayStartSeconds:                  info.DelayStartSeconds,
+		Priority:                           info.Priority,
+		FairnessKey:                        info.FairnessKey,
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
 
@@ -610,6 +675,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 	encoding common.EncodingType,
 ) (*InternalWorkflowMutation, error) {
 
+	domainID := getDomainID(input.ExecutionInfo)
 	serializedExecutionInfo, err := m.SerializeExecutionInfo(
 		input.ExecutionInfo,
 		input.ExecutionStats,
@@ -626,13 +692,21 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 	if err != nil {
 		return nil, err
 	}
-	serializedUpsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.UpsertChildExecutionInfos, encoding)
+	serializedUpsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(
+		input.UpsertChildExecutionInfos,
+		domainID,
+		encoding,
+	)
+	if err != nil {
+		return nil, err
+	}
+	serializedUpsertSignalInfos, err := m.SerializeUpsertSignalInfos(input.UpsertSignalInfos, domainID)
 	if err != nil {
 		return nil, err
 	}
 	var serializedNewBufferedEvents *DataBlob
 	if input.NewBufferedEvents != nil {
-		serializedNewBufferedEvents, err = m.serializer.SerializeBatchEvents(input.NewBufferedEvents, encoding)
+		serializedNewBufferedEvents, err = m.serializer.SerializeDomainBatchEvents(domainID, input.NewBufferedEvents, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -662,7 +736,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 		DeleteChildExecutionInfo:  input.DeleteChildExecutionInfo,
 		UpsertRequestCancelInfos:  input.UpsertRequestCancelInfos,
 		DeleteRequestCancelInfo:   input.DeleteRequestCancelInfo,
-		UpsertSignalInfos:         input.UpsertSignalInfos,
+		UpsertSignalInfos:         serializedUpsertSignalInfos,
 		DeleteSignalInfo:          input.DeleteSignalInfo,
 		UpsertSignalRequestedIDs:  input.UpsertSignalRequestedIDs,
 		DeleteSignalRequestedID:   input.DeleteSignalRequestedID,
@@ -682,6 +756,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 	encoding common.EncodingType,
 ) (*InternalWorkflowSnapshot, error) {
 
+	domainID := getDomainID(input.ExecutionInfo)
 	serializedExecutionInfo, err := m.SerializeExecutionInfo(
 		input.ExecutionInfo,
 		input.ExecutionStats,
@@ -698,7 +773,15 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 	if err != nil {
 		return nil, err
 	}
-	serializedChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.ChildExecutionInfos, encoding)
+	serializedChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(
+		input.ChildExecutionInfos,
+		domainID,
+		encoding,
+	)
+	if err != nil {
+		return nil, err
+	}
+	serializedSignalInfos, err := m.SerializeUpsertSignalInfos(input.SignalInfos, domainID)
 	if err != nil {
 		return nil, err
 	}
@@ -723,7 +806,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 		TimerInfos:          input.TimerInfos,
 		ChildExecutionInfos: serializedChildExecutionInfos,
 		RequestCancelInfos:  input.RequestCancelInfos,
-		SignalInfos:         input.SignalInfos,
+		SignalInfos:         serializedSignalInfos,
 		SignalRequestedIDs:  input.SignalRequestedIDs,
 
 		TransferTasks:    input.TransferTasks,
@@ -765,6 +848,27 @@ func (m *executionManagerImpl) DeleteTask(
 	return m.persistence.DeleteTask(request)
 }
 
+func (m *executionManagerImpl) ListConcreteExecutions(
+	request *ListConcreteExecutionsRequest,
+) (*ListConcreteExecutionsResponse, error) {
+	response, err := m.persistence.ListConcreteExecutions(request)
+	if err != nil {
+		return nil, err
+	}
+	newResponse := &ListConcreteExecutionsResponse{
+		ExecutionInfos: make([]*WorkflowExecutionInfo, 0, len(response.ExecutionInfos)),
+		PageToken:      response.NextPageToken,
+	}
+	for _, info := range response.ExecutionInfos {
+		executionInfo, _, err := m.DeserializeExecutionInfo(info)
+		if err != nil {
+			return nil, err
+		}
+		newResponse.ExecutionInfos = append(newResponse.ExecutionInfos, executionInfo)
+	}
+	return newResponse, nil
+}
+
 func (m *executionManagerImpl) DeleteWorkflowExecution(
 	request *DeleteWorkflowExecutionRequest,
 ) error {
@@ -838,6 +942,16 @@ func (m *executionManagerImpl) Close() {
 	m.persistence.Close()
 }
 
+func getDomainID(
+	executionInfo *WorkflowExecutionInfo,
+) string {
+
+	if executionInfo == nil {
+		return ""
+	}
+	return executionInfo.DomainID
+}
+
 func getStartVersion(
 	versionHistories *VersionHistories,
 	replicationState *ReplicationState,
@@ -51,9 +51,10 @@ type (
 var _ HistoryManager = (*historyManagerImpl)(nil)
 
 //NewHistoryManagerImpl returns new HistoryManager
-func NewHistoryManagerImpl(persistence HistoryStore, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryManager {
+func NewHistoryManagerImpl(persistence HistoryStore, serializer PayloadSerializer, logger log.Logger,
+	transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryManager {
 	return &historyManagerImpl{
-		serializer:           NewPayloadSerializer(),
+		serializer:           serializer,
 		persistence:          persistence,
 		logger:               logger,
 		transactionSizeLimit: transactionSizeLimit,
@@ -68,7 +69,7 @@ func (m *historyManagerImpl) AppendHistoryEvents(request *AppendHistoryEventsReq
 	if len(request.Events) == 0 {
 		return nil, fmt.Errorf("events to be appended cannot be empty")
 	}
-	eventsData, err := m.serializer.SerializeBatchEvents(request.Events, request.Encoding)
+	eventsData, err := m.serializer.SerializeDomainBatchEvents(request.DomainID, request.Events, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
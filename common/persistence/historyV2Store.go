@@ -55,12 +55,13 @@ var _ HistoryV2Manager = (*historyV2ManagerImpl)(nil)
 // NewHistoryV2ManagerImpl returns new HistoryManager
 func NewHistoryV2ManagerImpl(
 	persistence HistoryV2Store,
+	serializer PayloadSerializer,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
 ) HistoryV2Manager {
 
 	return &historyV2ManagerImpl{
-		historySerializer:     NewPayloadSerializer(),
+		historySerializer:     serializer,
 		persistence:           persistence,
 		logger:                logger,
 		thriftEncoder:         codec.NewThriftRWEncoder(),
@@ -226,7 +227,7 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 	}
 
 	// nodeID will be the first eventID
-	blob, err := m.historySerializer.SerializeBatchEvents(request.Events, request.Encoding)
+	blob, err := m.historySerializer.SerializeDomainBatchEvents(request.DomainID, request.Events, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -293,6 +294,7 @@ func (m *historyV2ManagerImpl) ReadHistoryBranch(
 
 // ReadRawHistoryBranch returns raw history binary data for a branch
 // Pagination is implemented here, the actual minNodeID passing to persistence layer is calculated along with token's LastNodeID
+// Event payloads are returned decoded, the receiving cluster encodes them again with its own keys
 // NOTE: this API should only be used by 3+DC
 func (m *historyV2ManagerImpl) ReadRawHistoryBranch(
 	request *ReadHistoryBranchRequest,
@@ -302,6 +304,11 @@ func (m *historyV2ManagerImpl) ReadRawHistoryBranch(
 	if err != nil {
 		return nil, err
 	}
+	for i, blob := range dataBlobs {
+		if dataBlobs[i], err = m.historySerializer.DecodeBatchEventsBlob(blob); err != nil {
+			return nil, err
+		}
+	}
 
 	nextPageToken, err := m.serializeToken(token)
 	if err != nil {
@@ -160,6 +160,7 @@ func (m *metadataManagerImpl) serializeDomainConfig(c *DomainConfig) (InternalDo
 		VisibilityArchivalStatus: c.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    c.VisibilityArchivalURI,
 		BadBinaries:              badBinaries,
+		SearchAttributes:         c.SearchAttributes,
 	}, nil
 }
 
@@ -182,6 +183,7 @@ func (m *metadataManagerImpl) deserializeDomainConfig(ic *InternalDomainConfig)
 		VisibilityArchivalStatus: ic.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    ic.VisibilityArchivalURI,
 		BadBinaries:              *badBinaries,
+		SearchAttributes:         ic.SearchAttributes,
 	}, nil
 }
 
@@ -0,0 +1,306 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// PayloadCodec encodes the binary payloads of a workflow, i.e. inputs, results, details and
+	// signal inputs, before they are written to the data store and decodes them when they are read back.
+	// Encoded payloads carry a header with the ID of the key used, so Decode does not need to know the domain
+	PayloadCodec interface {
+		Encode(domainID string, payload []byte) ([]byte, error)
+		Decode(payload []byte) ([]byte, error)
+	}
+
+	noopPayloadCodec struct{}
+
+	aesGCMPayloadCodec struct {
+		ciphers      map[string]cipher.AEAD
+		domainKeyIDs map[string]string
+		defaultKeyID string
+	}
+)
+
+const (
+	// payloadCodecEscaped flags a plaintext payload which starts with payloadCodecMagic
+	payloadCodecEscaped   = byte(0)
+	payloadCodecVersion   = byte(1)
+	payloadCodecMaxKeyLen = 255
+)
+
+// payloadCodecMagic prefixes every encoded payload, payloads without it are returned as is by Decode
+// so that data written before the codec was enabled can still be read. Plaintext payloads which happen
+// to start with it are escaped by Encode, so that Decode doesn't mistake them for encrypted ones
+var payloadCodecMagic = []byte{0x00, 'c', 'p', 'c'}
+
+var _ PayloadCodec = (*noopPayloadCodec)(nil)
+var _ PayloadCodec = (*aesGCMPayloadCodec)(nil)
+
+// NewPayloadCodec returns the PayloadCodec described by the given config,
+// payloads are left untouched when encryption is not enabled
+func NewPayloadCodec(cfg config.PayloadEncryption) (PayloadCodec, error) {
+	if !cfg.Enabled {
+		return NewNoopPayloadCodec(), nil
+	}
+	keys := make(map[string][]byte, len(cfg.Keys))
+	for keyID, encodedKey := range cfg.Keys {
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload encryption key %v: %v", keyID, err)
+		}
+		keys[keyID] = key
+	}
+	return NewAESGCMPayloadCodec(keys, cfg.DomainKeys, cfg.DefaultKeyID)
+}
+
+// NewNoopPayloadCodec returns a PayloadCodec which stores payloads in plaintext
+func NewNoopPayloadCodec() PayloadCodec {
+	return &noopPayloadCodec{}
+}
+
+func (c *noopPayloadCodec) Encode(domainID string, payload []byte) ([]byte, error) {
+	return payload, nil
+}
+
+func (c *noopPayloadCodec) Decode(payload []byte) ([]byte, error) {
+	return payload, nil
+}
+
+// NewAESGCMPayloadCodec returns a PayloadCodec which encrypts payloads with AES-GCM.
+// keys maps a key ID to a 16, 24 or 32 byte AES key, domainKeyIDs maps a domain ID to the ID of the key
+// used to encrypt new payloads of that domain and defaultKeyID is used for all other domains.
+// Payloads of domains without a key are stored in plaintext. Keys no longer referenced should be
+// kept around for as long as payloads encrypted with them are retained.
+func NewAESGCMPayloadCodec(
+	keys map[string][]byte,
+	domainKeyIDs map[string]string,
+	defaultKeyID string,
+) (PayloadCodec, error) {
+
+	ciphers := make(map[string]cipher.AEAD, len(keys))
+	for keyID, key := range keys {
+		if len(keyID) == 0 || len(keyID) > payloadCodecMaxKeyLen {
+			return nil, fmt.Errorf("payload encryption key ID %q must be between 1 and %v bytes", keyID, payloadCodecMaxKeyLen)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload encryption key %v: %v", keyID, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload encryption key %v: %v", keyID, err)
+		}
+		ciphers[keyID] = aead
+	}
+	for domainID, keyID := range domainKeyIDs {
+		if _, ok := ciphers[keyID]; !ok {
+			return nil, fmt.Errorf("unknown payload encryption key %v for domain %v", keyID, domainID)
+		}
+	}
+	if _, ok := ciphers[defaultKeyID]; defaultKeyID != "" && !ok {
+		return nil, fmt.Errorf("unknown default payload encryption key %v", defaultKeyID)
+	}
+
+	return &aesGCMPayloadCodec{
+		ciphers:      ciphers,
+		domainKeyIDs: domainKeyIDs,
+		defaultKeyID: defaultKeyID,
+	}, nil
+}
+
+// Encode encrypts the payload with the key of the domain. The result is laid out as
+// magic | version | key ID length | key ID | nonce | ciphertext
+// Payloads of domains without a key are kept in plaintext, unless they start with the magic,
+// in which case they are laid out as magic | escaped | payload
+func (c *aesGCMPayloadCodec) Encode(domainID string, payload []byte) ([]byte, error) {
+	if len(payload) == 0 {
+		return payload, nil
+	}
+	keyID, ok := c.domainKeyIDs[domainID]
+	if !ok {
+		keyID = c.defaultKeyID
+	}
+	if keyID == "" {
+		if !isEncodedPayload(payload) {
+			return payload, nil
+		}
+		escaped := make([]byte, 0, len(payloadCodecMagic)+1+len(payload))
+		escaped = append(escaped, payloadCodecMagic...)
+		escaped = append(escaped, payloadCodecEscaped)
+		return append(escaped, payload...), nil
+	}
+	aead := c.ciphers[keyID]
+
+	header := make([]byte, 0, len(payloadCodecMagic)+2+len(keyID)+aead.NonceSize())
+	header = append(header, payloadCodecMagic...)
+	header = append(header, payloadCodecVersion, byte(len(keyID)))
+	header = append(header, keyID...)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	header = append(header, nonce...)
+	return aead.Seal(header, nonce, payload, nil), nil
+}
+
+// Decode decrypts a payload produced by Encode, payloads without the header are returned as is
+func (c *aesGCMPayloadCodec) Decode(payload []byte) ([]byte, error) {
+	if !isEncodedPayload(payload) {
+		return payload, nil
+	}
+	data := payload[len(payloadCodecMagic):]
+	if len(data) > 0 && data[0] == payloadCodecEscaped {
+		return data[1:], nil
+	}
+	if len(data) < 2 || data[0] != payloadCodecVersion {
+		return nil, fmt.Errorf("unsupported encrypted payload version")
+	}
+	keyLen := int(data[1])
+	data = data[2:]
+	if len(data) < keyLen {
+		return nil, fmt.Errorf("malformed encrypted payload")
+	}
+	keyID := string(data[:keyLen])
+	data = data[keyLen:]
+	aead, ok := c.ciphers[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown payload encryption key %v", keyID)
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, fmt.Errorf("malformed encrypted payload")
+	}
+	return aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+}
+
+func isEncodedPayload(payload []byte) bool {
+	return bytes.HasPrefix(payload, payloadCodecMagic)
+}
+
+// transformEventPayloads applies fn to the binary payloads of the event in place
+func transformEventPayloads(event *workflow.HistoryEvent, fn func([]byte) ([]byte, error)) error {
+	for _, payload := range eventPayloads(event) {
+		result, err := fn(*payload)
+		if err != nil {
+			return err
+		}
+		*payload = result
+	}
+	return nil
+}
+
+// eventPayloads returns pointers to the binary payloads carried by the event's attributes
+func eventPayloads(event *workflow.HistoryEvent) []*[]byte {
+	switch event.GetEventType() {
+	case workflow.EventTypeWorkflowExecutionStarted:
+		if attr := event.WorkflowExecutionStartedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input, &attr.LastCompletionResult, &attr.ContinuedFailureDetails}
+		}
+	case workflow.EventTypeWorkflowExecutionCompleted:
+		if attr := event.WorkflowExecutionCompletedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Result}
+		}
+	case workflow.EventTypeWorkflowExecutionFailed:
+		if attr := event.WorkflowExecutionFailedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case workflow.EventTypeWorkflowExecutionCanceled:
+		if attr := event.WorkflowExecutionCanceledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case workflow.EventTypeWorkflowExecutionTerminated:
+		if attr := event.WorkflowExecutionTerminatedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case workflow.EventTypeWorkflowExecutionContinuedAsNew:
+		if attr := event.WorkflowExecutionContinuedAsNewEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input, &attr.LastCompletionResult, &attr.FailureDetails}
+		}
+	case workflow.EventTypeWorkflowExecutionSignaled:
+		if attr := event.WorkflowExecutionSignaledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input}
+		}
+	case workflow.EventTypeWorkflowExecutionUpdateAccepted:
+		if attr := event.WorkflowExecutionUpdateAcceptedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input, &attr.Result}
+		}
+	case workflow.EventTypeActivityTaskScheduled:
+		if attr := event.ActivityTaskScheduledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input}
+		}
+	case workflow.EventTypeActivityTaskCompleted:
+		if attr := event.ActivityTaskCompletedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Result}
+		}
+	case workflow.EventTypeActivityTaskFailed:
+		if attr := event.ActivityTaskFailedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case workflow.EventTypeActivityTaskTimedOut:
+		if attr := event.ActivityTaskTimedOutEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case workflow.EventTypeActivityTaskCanceled:
+		if attr := event.ActivityTaskCanceledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case workflow.EventTypeDecisionTaskFailed:
+		if attr := event.DecisionTaskFailedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case workflow.EventTypeMarkerRecorded:
+		if attr := event.MarkerRecordedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case workflow.EventTypeSignalExternalWorkflowExecutionInitiated:
+		if attr := event.SignalExternalWorkflowExecutionInitiatedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input}
+		}
+	case workflow.EventTypeStartChildWorkflowExecutionInitiated:
+		if attr := event.StartChildWorkflowExecutionInitiatedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Input}
+		}
+	case workflow.EventTypeChildWorkflowExecutionCompleted:
+		if attr := event.ChildWorkflowExecutionCompletedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Result}
+		}
+	case workflow.EventTypeChildWorkflowExecutionFailed:
+		if attr := event.ChildWorkflowExecutionFailedEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	case workflow.EventTypeChildWorkflowExecutionCanceled:
+		if attr := event.ChildWorkflowExecutionCanceledEventAttributes; attr != nil {
+			return []*[]byte{&attr.Details}
+		}
+	}
+	return nil
+}
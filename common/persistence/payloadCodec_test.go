@@ -0,0 +1,262 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	payloadCodecSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+const (
+	testCodecDomainID      = "test-domain-id"
+	testCodecOtherDomainID = "test-other-domain-id"
+)
+
+func TestPayloadCodecSuite(t *testing.T) {
+	s := new(payloadCodecSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadCodecSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *payloadCodecSuite) TestNewPayloadCodec() {
+	payloadCodec, err := NewPayloadCodec(config.PayloadEncryption{})
+	s.NoError(err)
+	s.IsType(&noopPayloadCodec{}, payloadCodec)
+
+	_, err = NewPayloadCodec(config.PayloadEncryption{
+		Enabled: true,
+		Keys:    map[string]string{"k1": "not base64"},
+	})
+	s.Error(err)
+
+	_, err = NewPayloadCodec(config.PayloadEncryption{
+		Enabled: true,
+		Keys:    map[string]string{"k1": base64.StdEncoding.EncodeToString([]byte("short"))},
+	})
+	s.Error(err)
+
+	_, err = NewPayloadCodec(config.PayloadEncryption{
+		Enabled:    true,
+		Keys:       map[string]string{"k1": base64.StdEncoding.EncodeToString(make([]byte, 32))},
+		DomainKeys: map[string]string{testCodecDomainID: "k2"},
+	})
+	s.Error(err)
+
+	_, err = NewPayloadCodec(config.PayloadEncryption{
+		Enabled:      true,
+		Keys:         map[string]string{"k1": base64.StdEncoding.EncodeToString(make([]byte, 32))},
+		DefaultKeyID: "k2",
+	})
+	s.Error(err)
+
+	payloadCodec, err = NewPayloadCodec(config.PayloadEncryption{
+		Enabled:    true,
+		Keys:       map[string]string{"k1": base64.StdEncoding.EncodeToString(make([]byte, 32))},
+		DomainKeys: map[string]string{testCodecDomainID: "k1"},
+	})
+	s.NoError(err)
+	s.IsType(&aesGCMPayloadCodec{}, payloadCodec)
+}
+
+func (s *payloadCodecSuite) TestAESGCMPayloadCodec() {
+	payloadCodec := s.newAESGCMPayloadCodec()
+	payload := []byte("some workflow input")
+
+	encoded, err := payloadCodec.Encode(testCodecDomainID, payload)
+	s.NoError(err)
+	s.NotEqual(payload, encoded)
+	s.True(isEncodedPayload(encoded))
+	s.NotContains(string(encoded), string(payload))
+
+	decoded, err := payloadCodec.Decode(encoded)
+	s.NoError(err)
+	s.Equal(payload, decoded)
+
+	// an encoded payload passed in again is opaque user data and is encrypted again
+	reencoded, err := payloadCodec.Encode(testCodecDomainID, encoded)
+	s.NoError(err)
+	s.NotEqual(encoded, reencoded)
+	decoded, err = payloadCodec.Decode(reencoded)
+	s.NoError(err)
+	s.Equal(encoded, decoded)
+
+	// domains without a key and empty payloads are left in plaintext
+	encoded, err = payloadCodec.Encode(testCodecOtherDomainID, payload)
+	s.NoError(err)
+	s.Equal(payload, encoded)
+	encoded, err = payloadCodec.Encode(testCodecDomainID, nil)
+	s.NoError(err)
+	s.Nil(encoded)
+
+	// plaintext written before encryption was enabled is still readable
+	decoded, err = payloadCodec.Decode(payload)
+	s.NoError(err)
+	s.Equal(payload, decoded)
+}
+
+func (s *payloadCodecSuite) TestAESGCMPayloadCodec_PayloadWithMagic() {
+	payloadCodec := s.newAESGCMPayloadCodec()
+	payloads := [][]byte{
+		append(append([]byte{}, payloadCodecMagic...), []byte("user payload")...),
+		append(append([]byte{}, payloadCodecMagic...), payloadCodecVersion, 2, 'k', '1'),
+		append(append([]byte{}, payloadCodecMagic...), payloadCodecEscaped),
+		append([]byte{}, payloadCodecMagic...),
+	}
+
+	for _, payload := range payloads {
+		for _, domainID := range []string{testCodecDomainID, testCodecOtherDomainID} {
+			encoded, err := payloadCodec.Encode(domainID, payload)
+			s.NoError(err)
+			s.NotEqual(payload, encoded)
+			decoded, err := payloadCodec.Decode(encoded)
+			s.NoError(err)
+			s.Equal(payload, decoded)
+		}
+	}
+}
+
+func (s *payloadCodecSuite) TestAESGCMPayloadCodec_KeyRotation() {
+	oldKey := make([]byte, 16)
+	newKey := make([]byte, 32)
+	newKey[0] = 1
+
+	oldCodec, err := NewAESGCMPayloadCodec(
+		map[string][]byte{"old": oldKey},
+		map[string]string{testCodecDomainID: "old"},
+		"",
+	)
+	s.NoError(err)
+	encoded, err := oldCodec.Encode(testCodecDomainID, []byte("payload"))
+	s.NoError(err)
+
+	newCodec, err := NewAESGCMPayloadCodec(
+		map[string][]byte{"old": oldKey, "new": newKey},
+		map[string]string{testCodecDomainID: "new"},
+		"",
+	)
+	s.NoError(err)
+	decoded, err := newCodec.Decode(encoded)
+	s.NoError(err)
+	s.Equal([]byte("payload"), decoded)
+
+	withoutOldKey, err := NewAESGCMPayloadCodec(map[string][]byte{"new": newKey}, nil, "new")
+	s.NoError(err)
+	_, err = withoutOldKey.Decode(encoded)
+	s.Error(err)
+
+	// tampered payloads fail authentication
+	encoded[len(encoded)-1] ^= 0xff
+	_, err = newCodec.Decode(encoded)
+	s.Error(err)
+}
+
+func (s *payloadCodecSuite) TestSerializer_EncodesEventPayloads() {
+	serializer := NewPayloadSerializerWithCodec(s.newAESGCMPayloadCodec())
+	events := []*workflow.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionStarted),
+			WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{
+				Input: []byte("workflow input"),
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(2),
+			EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionSignaled),
+			WorkflowExecutionSignaledEventAttributes: &workflow.WorkflowExecutionSignaledEventAttributes{
+				SignalName: common.StringPtr("signal"),
+				Input:      []byte("signal input"),
+			},
+		},
+		{
+			EventId:   common.Int64Ptr(3),
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+		},
+	}
+
+	blob, err := serializer.SerializeDomainBatchEvents(testCodecDomainID, events, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	s.NotContains(string(blob.Data), "workflow input")
+	s.NotContains(string(blob.Data), "signal input")
+	// the events passed in keep their cleartext payloads
+	s.Equal([]byte("workflow input"), events[0].WorkflowExecutionStartedEventAttributes.Input)
+
+	decoded, err := serializer.DeserializeBatchEvents(blob)
+	s.NoError(err)
+	s.Equal(events, decoded)
+
+	plaintextSerializer := NewPayloadSerializer()
+	raw, err := plaintextSerializer.DeserializeBatchEvents(blob)
+	s.NoError(err)
+	s.True(isEncodedPayload(raw[0].WorkflowExecutionStartedEventAttributes.Input))
+
+	eventBlob, err := serializer.SerializeDomainEvent(testCodecDomainID, events[1], common.EncodingTypeThriftRW)
+	s.NoError(err)
+	s.NotContains(string(eventBlob.Data), "signal input")
+	event, err := serializer.DeserializeEvent(eventBlob)
+	s.NoError(err)
+	s.Equal(events[1], event)
+
+	// decoding the blob for another cluster yields cleartext payloads
+	decodedBlob, err := serializer.DecodeBatchEventsBlob(blob)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeThriftRW, decodedBlob.Encoding)
+	s.Contains(string(decodedBlob.Data), "workflow input")
+	raw, err = plaintextSerializer.DeserializeBatchEvents(decodedBlob)
+	s.NoError(err)
+	s.Equal(events, raw)
+
+	// without a codec the serialized events are the same as with SerializeBatchEvents
+	blob, err = plaintextSerializer.SerializeDomainBatchEvents(testCodecDomainID, events, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	s.Contains(string(blob.Data), "workflow input")
+}
+
+func (s *payloadCodecSuite) newAESGCMPayloadCodec() PayloadCodec {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	payloadCodec, err := NewAESGCMPayloadCodec(
+		map[string][]byte{"k1": key},
+		map[string]string{testCodecDomainID: "k1"},
+		"",
+	)
+	s.NoError(err)
+	return payloadCodec
+}
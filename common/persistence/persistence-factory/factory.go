@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
@@ -89,6 +90,7 @@ type (
 		metricsClient metrics.Client
 		logger        log.Logger
 		datastores    map[storeType]Datastore
+		serializer    p.PayloadSerializer
 	}
 
 	storeType int
@@ -127,10 +129,15 @@ func New(
 	metricsClient metrics.Client,
 	logger log.Logger,
 ) Factory {
+	payloadCodec, err := p.NewPayloadCodec(cfg.PayloadEncryption)
+	if err != nil {
+		logger.Fatal("invalid payload encryption config", tag.Error(err))
+	}
 	factory := &factoryImpl{
 		config:        cfg,
 		metricsClient: metricsClient,
 		logger:        logger,
+		serializer:    p.NewPayloadSerializerWithCodec(payloadCodec),
 	}
 	limiters := buildRatelimiters(cfg)
 	factory.init(clusterName, limiters)
@@ -176,7 +183,7 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryManagerImpl(store, f.serializer, f.logger, f.config.TransactionSizeLimit)
 	if ds.ratelimit != nil {
 		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -193,7 +200,7 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryV2ManagerImpl(store, f.serializer, f.logger, f.config.TransactionSizeLimit)
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -230,7 +237,7 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger)
+	result := p.NewExecutionManagerImpl(store, f.serializer, f.logger)
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
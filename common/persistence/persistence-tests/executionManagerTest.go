@@ -1127,6 +1127,9 @@ func (s *ExecutionManagerSuite) TestGetWorkflow() {
 				NonRetriableErrors:   []string{"badRequestError", "accessDeniedError"},
 				CronSchedule:         "* * * * *",
 				ExpirationSeconds:    rand.Int31(),
+				DelayStartSeconds:    rand.Int31(),
+				Priority:             rand.Int31(),
+				FairnessKey:          "some random fairness key",
 				AutoResetPoints:      &testResetPoints,
 				SearchAttributes:     testSearchAttr,
 				Memo:                 testMemo,
@@ -1189,6 +1192,9 @@ func (s *ExecutionManagerSuite) TestGetWorkflow() {
 	s.Equal(createReq.NewWorkflowSnapshot.ExecutionInfo.MaximumAttempts, info.MaximumAttempts)
 	s.Equal(createReq.NewWorkflowSnapshot.ExecutionInfo.MaximumInterval, info.MaximumInterval)
 	s.Equal(createReq.NewWorkflowSnapshot.ExecutionInfo.ExpirationSeconds, info.ExpirationSeconds)
+	s.Equal(createReq.NewWorkflowSnapshot.ExecutionInfo.DelayStartSeconds, info.DelayStartSeconds)
+	s.Equal(createReq.NewWorkflowSnapshot.ExecutionInfo.Priority, info.Priority)
+	s.Equal(createReq.NewWorkflowSnapshot.ExecutionInfo.FairnessKey, info.FairnessKey)
 	s.EqualTimes(createReq.NewWorkflowSnapshot.ExecutionInfo.ExpirationTime, info.ExpirationTime)
 	s.Equal(createReq.NewWorkflowSnapshot.ExecutionInfo.CronSchedule, info.CronSchedule)
 	s.Equal(createReq.NewWorkflowSnapshot.ExecutionInfo.NonRetriableErrors, info.NonRetriableErrors)
@@ -1692,6 +1698,41 @@ func (s *ExecutionManagerSuite) TestGetCurrentWorkflow() {
 	s.Empty(task1, "Expected empty task identifier.")
 }
 
+// TestListConcreteExecutions test
+func (s *ExecutionManagerSuite) TestListConcreteExecutions() {
+	domainID := "ad4e5b0a-37d9-42f4-8c1b-2b4d1f1c3b71"
+	runIDs := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		workflowExecution := gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(fmt.Sprintf("list-concrete-executions-test-%v", i)),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		_, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+		s.NoError(err)
+		runIDs[workflowExecution.GetRunId()] = true
+	}
+
+	var pageToken []byte
+	for {
+		response, err := s.ExecutionManager.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		s.NoError(err)
+		for _, info := range response.ExecutionInfos {
+			if info.DomainID == domainID {
+				s.True(runIDs[info.RunID], "unexpected run %v", info.RunID)
+				delete(runIDs, info.RunID)
+			}
+		}
+		if len(response.PageToken) == 0 {
+			break
+		}
+		pageToken = response.PageToken
+	}
+	s.Empty(runIDs)
+}
+
 // TestTransferTasksThroughUpdate test
 func (s *ExecutionManagerSuite) TestTransferTasksThroughUpdate() {
 	domainID := "b785a8ba-bd7d-4760-bb05-41b115f3e10a"
@@ -2430,6 +2471,9 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateActivities() {
 		LastFailureReason:        "some random error",
 		LastWorkerIdentity:       uuid.New(),
 		LastFailureDetails:       []byte(uuid.New()),
+		Paused:                   true,
+		Priority:                 3,
+		FairnessKey:              "some random fairness key",
 	}}
 	err2 := s.UpdateWorkflowExecution(updatedInfo, updatedStats, nil, []int64{int64(4)}, nil, int64(3), nil, activityInfos, nil, nil, nil)
 	s.NoError(err2)
@@ -2475,6 +2519,9 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateActivities() {
 	s.Equal(activityInfos[0].LastFailureReason, ai.LastFailureReason)
 	s.Equal(activityInfos[0].LastWorkerIdentity, ai.LastWorkerIdentity)
 	s.Equal(activityInfos[0].LastFailureDetails, ai.LastFailureDetails)
+	s.Equal(activityInfos[0].Paused, ai.Paused)
+	s.Equal(activityInfos[0].Priority, ai.Priority)
+	s.Equal(activityInfos[0].FairnessKey, ai.FairnessKey)
 
 	err2 = s.UpdateWorkflowExecution(updatedInfo, updatedStats, nil, nil, nil, int64(5), nil, nil, []int64{1}, nil, nil)
 	s.NoError(err2)
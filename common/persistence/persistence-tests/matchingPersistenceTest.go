@@ -129,6 +129,48 @@ func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	s.Equal(int64(5), tasks1Response.Tasks[0].ScheduleID)
 }
 
+// TestGetTasksWithPriority test
+func (s *MatchingPersistenceSuite) TestGetTasksWithPriority() {
+	domainID := "0a5bf6c8-bd35-4f4e-8d1c-1c2f2b4dbd1a"
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("get-tasks-with-priority-test"),
+		RunId: common.StringPtr("8c1d6b86-3b0c-4c8e-9a7f-5f3f1b1b2c3d")}
+	taskList := "5f3f1b1b2c3d"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(&p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:    domainID,
+					WorkflowID:  workflowExecution.GetWorkflowId(),
+					RunID:       workflowExecution.GetRunId(),
+					TaskID:      taskID,
+					ScheduleID:  7,
+					Priority:    3,
+					FairnessKey: "tenant-a",
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.GetTasks(domainID, taskList, p.TaskListTypeActivity, 1)
+	s.NoError(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal(int64(7), resp.Tasks[0].ScheduleID)
+	s.Equal(int32(3), resp.Tasks[0].Priority)
+	s.Equal("tenant-a", resp.Tasks[0].FairnessKey)
+}
+
 // TestGetTasksWithNoMaxReadLevel test
 func (s *MatchingPersistenceSuite) TestGetTasksWithNoMaxReadLevel() {
 	if s.TaskMgr.GetName() == "cassandra" {
@@ -344,6 +386,84 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.Error(err)
 }
 
+// TestLeaseAndUpdateTaskListPartitionConfig test
+func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListPartitionConfig() {
+	domainID := uuid.New()
+	taskList := "partitioned-tl"
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	s.Nil(response.TaskListInfo.PartitionConfig)
+
+	partitionConfig := &p.TaskListPartitionConfig{NumReadPartitions: 4, NumWritePartitions: 2}
+	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		TaskListInfo: &p.TaskListInfo{
+			DomainID:        domainID,
+			Name:            taskList,
+			TaskType:        p.TaskListTypeDecision,
+			RangeID:         response.TaskListInfo.RangeID,
+			AckLevel:        10,
+			Kind:            p.TaskListKindNormal,
+			PartitionConfig: partitionConfig,
+		},
+	})
+	s.NoError(err)
+
+	// the partition config must survive lease renewals
+	for i := 0; i < 2; i++ {
+		response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+			DomainID: domainID,
+			TaskList: taskList,
+			TaskType: p.TaskListTypeDecision,
+		})
+		s.NoError(err)
+		s.EqualValues(10, response.TaskListInfo.AckLevel)
+		s.Equal(partitionConfig, response.TaskListInfo.PartitionConfig)
+	}
+}
+
+// TestLeaseAndUpdateTaskListLimits test
+func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListLimits() {
+	domainID := uuid.New()
+	taskList := "limited-tl"
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Nil(response.TaskListInfo.Limits)
+
+	limits := &p.TaskListLimits{MaxTasksPerSecond: 12.5, MaxOutstandingTasks: 20}
+	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		TaskListInfo: &p.TaskListInfo{
+			DomainID: domainID,
+			Name:     taskList,
+			TaskType: p.TaskListTypeActivity,
+			RangeID:  response.TaskListInfo.RangeID,
+			AckLevel: 10,
+			Kind:     p.TaskListKindNormal,
+			Limits:   limits,
+		},
+	})
+	s.NoError(err)
+
+	// the limits must survive lease renewals
+	for i := 0; i < 2; i++ {
+		response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+			DomainID: domainID,
+			TaskList: taskList,
+			TaskType: p.TaskListTypeActivity,
+		})
+		s.NoError(err)
+		s.EqualValues(10, response.TaskListInfo.AckLevel)
+		s.Equal(limits, response.TaskListInfo.Limits)
+	}
+}
+
 // TestLeaseAndUpdateTaskListSticky test
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
@@ -68,6 +68,7 @@ type (
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
 		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*InternalListConcreteExecutionsResponse, error)
 
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
@@ -236,6 +237,9 @@ type (
 		BranchToken       []byte
 		CronSchedule      string
 		ExpirationSeconds int32
+		DelayStartSeconds int32
+		Priority          int32
+		FairnessKey       string
 		Memo              map[string][]byte
 		SearchAttributes  map[string][]byte
 
@@ -294,6 +298,9 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		Paused             bool
+		Priority           int32
+		FairnessKey        string
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibility int64
 	}
@@ -451,6 +458,12 @@ type (
 		State *InternalWorkflowMutableState
 	}
 
+	// InternalListConcreteExecutionsResponse is the response to ListConcreteExecutionsRequest for Persistence Interface
+	InternalListConcreteExecutionsResponse struct {
+		ExecutionInfos []*InternalWorkflowExecutionInfo
+		NextPageToken  []byte
+	}
+
 	// InternalGetWorkflowExecutionHistoryRequest is used to retrieve history of a workflow execution
 	InternalGetWorkflowExecutionHistoryRequest struct {
 		// an extra field passing from GetWorkflowExecutionHistoryRequest
@@ -634,6 +647,7 @@ type (
 		VisibilityArchivalStatus workflow.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *DataBlob
+		SearchAttributes         map[string]workflow.IndexedValueType
 	}
 
 	// InternalCreateDomainRequest is used to create the domain
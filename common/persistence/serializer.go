@@ -56,6 +56,18 @@ type (
 		// serialize/deserialize version histories
 		SerializeVersionHistories(histories *workflow.VersionHistories, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeVersionHistories(data *DataBlob) (*workflow.VersionHistories, error)
+
+		// serialize history event(s), encoding their payloads with the payload codec using the domain's key,
+		// deserializing history event(s) always decodes their payloads
+		SerializeDomainBatchEvents(domainID string, batch []*workflow.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error)
+		SerializeDomainEvent(domainID string, event *workflow.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error)
+
+		// decode the payloads of serialized history events, so the blob can be handed to a cluster not sharing the keys
+		DecodeBatchEventsBlob(data *DataBlob) (*DataBlob, error)
+
+		// encode/decode a single payload kept outside of history events with the payload codec
+		EncodePayload(domainID string, payload []byte) ([]byte, error)
+		DecodePayload(payload []byte) ([]byte, error)
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -75,13 +87,20 @@ type (
 
 	serializerImpl struct {
 		thriftrwEncoder codec.BinaryEncoder
+		payloadCodec    PayloadCodec
 	}
 )
 
-// NewPayloadSerializer returns a PayloadSerializer
+// NewPayloadSerializer returns a PayloadSerializer which stores payloads in plaintext
 func NewPayloadSerializer() PayloadSerializer {
+	return NewPayloadSerializerWithCodec(NewNoopPayloadCodec())
+}
+
+// NewPayloadSerializerWithCodec returns a PayloadSerializer which applies the given PayloadCodec to payloads
+func NewPayloadSerializerWithCodec(payloadCodec PayloadCodec) PayloadSerializer {
 	return &serializerImpl{
 		thriftrwEncoder: codec.NewThriftRWEncoder(),
+		payloadCodec:    payloadCodec,
 	}
 }
 
@@ -97,8 +116,15 @@ func (t *serializerImpl) DeserializeBatchEvents(data *DataBlob) ([]*workflow.His
 	if data != nil && len(data.Data) == 0 {
 		return events, nil
 	}
-	err := t.deserialize(data, &events)
-	return events, err
+	if err := t.deserialize(data, &events); err != nil {
+		return events, err
+	}
+	for _, event := range events {
+		if err := t.decodeEventPayloads(event); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
 }
 
 func (t *serializerImpl) SerializeEvent(event *workflow.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
@@ -113,8 +139,103 @@ func (t *serializerImpl) DeserializeEvent(data *DataBlob) (*workflow.HistoryEven
 		return nil, nil
 	}
 	var event workflow.HistoryEvent
-	err := t.deserialize(data, &event)
-	return &event, err
+	if err := t.deserialize(data, &event); err != nil {
+		return &event, err
+	}
+	if err := t.decodeEventPayloads(&event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (t *serializerImpl) SerializeDomainBatchEvents(
+	domainID string,
+	events []*workflow.HistoryEvent,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+
+	if _, ok := t.payloadCodec.(*noopPayloadCodec); ok {
+		return t.SerializeBatchEvents(events, encodingType)
+	}
+	// encode the payloads on a copy of the events, the caller keeps using the cleartext ones
+	var encodedEvents []*workflow.HistoryEvent
+	if err := t.copyThrough(events, &encodedEvents, encodingType); err != nil {
+		return nil, err
+	}
+	for _, event := range encodedEvents {
+		if err := t.encodeEventPayloads(domainID, event); err != nil {
+			return nil, err
+		}
+	}
+	return t.serialize(encodedEvents, encodingType)
+}
+
+func (t *serializerImpl) SerializeDomainEvent(
+	domainID string,
+	event *workflow.HistoryEvent,
+	encodingType common.EncodingType,
+) (*DataBlob, error) {
+
+	if event == nil {
+		return nil, nil
+	}
+	if _, ok := t.payloadCodec.(*noopPayloadCodec); ok {
+		return t.SerializeEvent(event, encodingType)
+	}
+	var encodedEvent workflow.HistoryEvent
+	if err := t.copyThrough(event, &encodedEvent, encodingType); err != nil {
+		return nil, err
+	}
+	if err := t.encodeEventPayloads(domainID, &encodedEvent); err != nil {
+		return nil, err
+	}
+	return t.serialize(&encodedEvent, encodingType)
+}
+
+func (t *serializerImpl) DecodeBatchEventsBlob(data *DataBlob) (*DataBlob, error) {
+	if _, ok := t.payloadCodec.(*noopPayloadCodec); ok || data == nil || len(data.Data) == 0 {
+		return data, nil
+	}
+	events, err := t.DeserializeBatchEvents(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.SerializeBatchEvents(events, data.Encoding)
+}
+
+func (t *serializerImpl) EncodePayload(domainID string, payload []byte) ([]byte, error) {
+	result, err := t.payloadCodec.Encode(domainID, payload)
+	if err != nil {
+		return nil, NewCadenceSerializationError(err.Error())
+	}
+	return result, nil
+}
+
+func (t *serializerImpl) DecodePayload(payload []byte) ([]byte, error) {
+	result, err := t.payloadCodec.Decode(payload)
+	if err != nil {
+		return nil, NewCadenceDeserializationError(err.Error())
+	}
+	return result, nil
+}
+
+func (t *serializerImpl) encodeEventPayloads(domainID string, event *workflow.HistoryEvent) error {
+	return transformEventPayloads(event, func(payload []byte) ([]byte, error) {
+		return t.EncodePayload(domainID, payload)
+	})
+}
+
+func (t *serializerImpl) decodeEventPayloads(event *workflow.HistoryEvent) error {
+	return transformEventPayloads(event, t.DecodePayload)
+}
+
+// copyThrough deep copies input into target by round tripping it through the encoding
+func (t *serializerImpl) copyThrough(input interface{}, target interface{}, encodingType common.EncodingType) error {
+	data, err := t.serialize(input, encodingType)
+	if err != nil {
+		return err
+	}
+	return t.deserialize(data, target)
 }
 
 func (t *serializerImpl) SerializeResetPoints(rp *workflow.ResetPoints, encodingType common.EncodingType) (*DataBlob, error) {
@@ -29,6 +29,7 @@ import (
 	"time"
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/collection"
 	"github.com/uber/cadence/common/log"
@@ -234,55 +235,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(
 	}
 
 	var state p.InternalWorkflowMutableState
-	state.ExecutionInfo = &p.InternalWorkflowExecutionInfo{
-		DomainID:                           execution.DomainID.String(),
-		WorkflowID:                         execution.WorkflowID,
-		RunID:                              execution.RunID.String(),
-		NextEventID:                        execution.NextEventID,
-		TaskList:                           info.GetTaskList(),
-		WorkflowTypeName:                   info.GetWorkflowTypeName(),
-		WorkflowTimeout:                    info.GetWorkflowTimeoutSeconds(),
-		DecisionTimeoutValue:               info.GetDecisionTaskTimeoutSeconds(),
-		State:                              int(info.GetState()),
-		CloseStatus:                        int(info.GetCloseStatus()),
-		LastFirstEventID:                   info.GetLastFirstEventID(),
-		LastProcessedEvent:                 info.GetLastProcessedEvent(),
-		StartTimestamp:                     time.Unix(0, info.GetStartTimeNanos()),
-		LastUpdatedTimestamp:               time.Unix(0, info.GetLastUpdatedTimeNanos()),
-		CreateRequestID:                    info.GetCreateRequestID(),
-		DecisionVersion:                    info.GetDecisionVersion(),
-		DecisionScheduleID:                 info.GetDecisionScheduleID(),
-		DecisionStartedID:                  info.GetDecisionStartedID(),
-		DecisionRequestID:                  info.GetDecisionRequestID(),
-		DecisionTimeout:                    info.GetDecisionTimeout(),
-		DecisionAttempt:                    info.GetDecisionAttempt(),
-		DecisionStartedTimestamp:           info.GetDecisionStartedTimestampNanos(),
-		DecisionScheduledTimestamp:         info.GetDecisionScheduledTimestampNanos(),
-		DecisionOriginalScheduledTimestamp: info.GetDecisionOriginalScheduledTimestampNanos(),
-		StickyTaskList:                     info.GetStickyTaskList(),
-		StickyScheduleToStartTimeout:       int32(info.GetStickyScheduleToStartTimeout()),
-		ClientLibraryVersion:               info.GetClientLibraryVersion(),
-		ClientFeatureVersion:               info.GetClientFeatureVersion(),
-		ClientImpl:                         info.GetClientImpl(),
-		SignalCount:                        int32(info.GetSignalCount()),
-		HistorySize:                        info.GetHistorySize(),
-		CronSchedule:                       info.GetCronSchedule(),
-		CompletionEventBatchID:             common.EmptyEventID,
-		HasRetryPolicy:                     info.GetHasRetryPolicy(),
-		Attempt:                            int32(info.GetRetryAttempt()),
-		InitialInterval:                    info.GetRetryInitialIntervalSeconds(),
-		BackoffCoefficient:                 info.GetRetryBackoffCoefficient(),
-		MaximumInterval:                    info.GetRetryMaximumIntervalSeconds(),
-		MaximumAttempts:                    info.GetRetryMaximumAttempts(),
-		ExpirationSeconds:                  info.GetRetryExpirationSeconds(),
-		ExpirationTime:                     time.Unix(0, info.GetRetryExpirationTimeNanos()),
-		EventStoreVersion:                  info.GetEventStoreVersion(),
-		BranchToken:                        info.GetEventBranchToken(),
-		ExecutionContext:                   info.GetExecutionContext(),
-		NonRetriableErrors:                 info.GetRetryNonRetryableErrors(),
-		SearchAttributes:                   info.GetSearchAttributes(),
-		Memo:                               info.GetMemo(),
-	}
+	state.ExecutionInfo = createExecutionInfo(execution, info)
 
 	if info.LastWriteEventID != nil {
 		state.ReplicationState = &p.ReplicationState{}
@@ -303,35 +256,6 @@ func (m *sqlExecutionManager) GetWorkflowExecution(
 		)
 	}
 
-	if info.ParentDomainID != nil {
-		state.ExecutionInfo.ParentDomainID = sqldb.UUID(info.ParentDomainID).String()
-		state.ExecutionInfo.ParentWorkflowID = info.GetParentWorkflowID()
-		state.ExecutionInfo.ParentRunID = sqldb.UUID(info.ParentRunID).String()
-		state.ExecutionInfo.InitiatedID = info.GetInitiatedID()
-		if state.ExecutionInfo.CompletionEvent != nil {
-			state.ExecutionInfo.CompletionEvent = nil
-		}
-	}
-
-	if info.GetCancelRequested() {
-		state.ExecutionInfo.CancelRequested = true
-		state.ExecutionInfo.CancelRequestID = info.GetCancelRequestID()
-	}
-
-	if info.CompletionEventBatchID != nil {
-		state.ExecutionInfo.CompletionEventBatchID = info.GetCompletionEventBatchID()
-	}
-
-	if info.CompletionEvent != nil {
-		state.ExecutionInfo.CompletionEvent = p.NewDataBlob(info.CompletionEvent,
-			common.EncodingType(info.GetCompletionEventEncoding()))
-	}
-
-	if info.AutoResetPoints != nil {
-		state.ExecutionInfo.AutoResetPoints = p.NewDataBlob(info.AutoResetPoints,
-			common.EncodingType(info.GetAutoResetPointsEncoding()))
-	}
-
 	{
 		var err error
 		state.ActivityInfos, err = getActivityInfoMap(m.db,
@@ -836,6 +760,153 @@ func (m *sqlExecutionManager) GetCurrentExecution(
 	}, nil
 }
 
+func createExecutionInfo(
+	execution *sqldb.ExecutionsRow,
+	info *sqlblobs.WorkflowExecutionInfo,
+) *p.InternalWorkflowExecutionInfo {
+
+	executionInfo := &p.InternalWorkflowExecutionInfo{
+		DomainID:                           execution.DomainID.String(),
+		WorkflowID:                         execution.WorkflowID,
+		RunID:                              execution.RunID.String(),
+		NextEventID:                        execution.NextEventID,
+		TaskList:                           info.GetTaskList(),
+		WorkflowTypeName:                   info.GetWorkflowTypeName(),
+		WorkflowTimeout:                    info.GetWorkflowTimeoutSeconds(),
+		DecisionTimeoutValue:               info.GetDecisionTaskTimeoutSeconds(),
+		State:                              int(info.GetState()),
+		CloseStatus:                        int(info.GetCloseStatus()),
+		LastFirstEventID:                   info.GetLastFirstEventID(),
+		LastProcessedEvent:                 info.GetLastProcessedEvent(),
+		StartTimestamp:                     time.Unix(0, info.GetStartTimeNanos()),
+		LastUpdatedTimestamp:               time.Unix(0, info.GetLastUpdatedTimeNanos()),
+		CreateRequestID:                    info.GetCreateRequestID(),
+		DecisionVersion:                    info.GetDecisionVersion(),
+		DecisionScheduleID:                 info.GetDecisionScheduleID(),
+		DecisionStartedID:                  info.GetDecisionStartedID(),
+		DecisionRequestID:                  info.GetDecisionRequestID(),
+		DecisionTimeout:                    info.GetDecisionTimeout(),
+		DecisionAttempt:                    info.GetDecisionAttempt(),
+		DecisionStartedTimestamp:           info.GetDecisionStartedTimestampNanos(),
+		DecisionScheduledTimestamp:         info.GetDecisionScheduledTimestampNanos(),
+		DecisionOriginalScheduledTimestamp: info.GetDecisionOriginalScheduledTimestampNanos(),
+		StickyTaskList:                     info.GetStickyTaskList(),
+		StickyScheduleToStartTimeout:       int32(info.GetStickyScheduleToStartTimeout()),
+		ClientLibraryVersion:               info.GetClientLibraryVersion(),
+		ClientFeatureVersion:               info.GetClientFeatureVersion(),
+		ClientImpl:                         info.GetClientImpl(),
+		SignalCount:                        int32(info.GetSignalCount()),
+		HistorySize:                        info.GetHistorySize(),
+		CronSchedule:                       info.GetCronSchedule(),
+		CompletionEventBatchID:             common.EmptyEventID,
+		HasRetryPolicy:                     info.GetHasRetryPolicy(),
+		Attempt:                            int32(info.GetRetryAttempt()),
+		InitialInterval:                    info.GetRetryInitialIntervalSeconds(),
+		BackoffCoefficient:                 info.GetRetryBackoffCoefficient(),
+		MaximumInterval:                    info.GetRetryMaximumIntervalSeconds(),
+		MaximumAttempts:                    info.GetRetryMaximumAttempts(),
+		ExpirationSeconds:                  info.GetRetryExpirationSeconds(),
+		DelayStartSeconds:                  info.GetDelayStartSeconds(),
+		Priority:                           info.GetPriority(),
+		FairnessKey:                        info.GetFairnessKey(),
+		ExpirationTime:                     time.Unix(0, info.GetRetryExpirationTimeNanos()),
+		EventStoreVersion:                  info.GetEventStoreVersion(),
+		BranchToken:                        info.GetEventBranchToken(),
+		ExecutionContext:                   info.GetExecutionContext(),
+		NonRetriableErrors:                 info.GetRetryNonRetryableErrors(),
+		SearchAttributes:                   info.GetSearchAttributes(),
+		Memo:                               info.GetMemo(),
+	}
+
+	if info.ParentDomainID != nil {
+		executionInfo.ParentDomainID = sqldb.UUID(info.ParentDomainID).String()
+		executionInfo.ParentWorkflowID = info.GetParentWorkflowID()
+		executionInfo.ParentRunID = sqldb.UUID(info.ParentRunID).String()
+		executionInfo.InitiatedID = info.GetInitiatedID()
+		if executionInfo.CompletionEvent != nil {
+			executionInfo.CompletionEvent = nil
+		}
+	}
+
+	if info.GetCancelRequested() {
+		executionInfo.CancelRequested = true
+		executionInfo.CancelRequestID = info.GetCancelRequestID()
+	}
+
+	if info.CompletionEventBatchID != nil {
+		executionInfo.CompletionEventBatchID = info.GetCompletionEventBatchID()
+	}
+
+	if info.CompletionEvent != nil {
+		executionInfo.CompletionEvent = p.NewDataBlob(info.CompletionEvent,
+			common.EncodingType(info.GetCompletionEventEncoding()))
+	}
+
+	if info.AutoResetPoints != nil {
+		executionInfo.AutoResetPoints = p.NewDataBlob(info.AutoResetPoints,
+			common.EncodingType(info.GetAutoResetPointsEncoding()))
+	}
+
+	return executionInfo
+}
+
+type executionPageToken struct {
+	DomainID   sqldb.UUID
+	WorkflowID string
+	RunID      sqldb.UUID
+}
+
+func (m *sqlExecutionManager) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+
+	pageToken := &executionPageToken{DomainID: sqldb.MustParseUUID(minUUID), RunID: sqldb.MustParseUUID(minUUID)}
+	if len(request.PageToken) > 0 {
+		if err := gobDeserialize(request.PageToken, pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error deserializing page token: %v", err),
+			}
+		}
+	}
+
+	rows, err := m.db.SelectPageFromExecutions(&sqldb.ExecutionsPageFilter{
+		ShardID:    m.shardID,
+		DomainID:   pageToken.DomainID,
+		WorkflowID: pageToken.WorkflowID,
+		RunID:      pageToken.RunID,
+		PageSize:   request.PageSize,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConcreteExecutions operation failed. Select failed. Error: %v", err),
+		}
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{}
+	for i := range rows {
+		info, err := workflowExecutionInfoFromBlob(rows[i].Data, rows[i].DataEncoding)
+		if err != nil {
+			return nil, err
+		}
+		response.ExecutionInfos = append(response.ExecutionInfos, createExecutionInfo(&rows[i], info))
+	}
+
+	if len(rows) >= request.PageSize {
+		lastRow := &rows[len(rows)-1]
+		response.NextPageToken, err = gobSerialize(&executionPageToken{
+			DomainID:   lastRow.DomainID,
+			WorkflowID: lastRow.WorkflowID,
+			RunID:      lastRow.RunID,
+		})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error serializing page token: %v", err),
+			}
+		}
+	}
+	return response, nil
+}
+
 func (m *sqlExecutionManager) GetTransferTasks(
 	request *p.GetTransferTasksRequest,
 ) (*p.GetTransferTasksResponse, error) {
@@ -1248,6 +1248,9 @@ func buildExecutionRow(
 		RetryMaximumIntervalSeconds:             &executionInfo.MaximumInterval,
 		RetryMaximumAttempts:                    &executionInfo.MaximumAttempts,
 		RetryExpirationSeconds:                  &executionInfo.ExpirationSeconds,
+		DelayStartSeconds:                       &executionInfo.DelayStartSeconds,
+		Priority:                                &executionInfo.Priority,
+		FairnessKey:                             &executionInfo.FairnessKey,
 		RetryExpirationTimeNanos:                common.Int64Ptr(executionInfo.ExpirationTime.UnixNano()),
 		RetryNonRetryableErrors:                 executionInfo.NonRetriableErrors,
 		EventStoreVersion:                       &executionInfo.EventStoreVersion,
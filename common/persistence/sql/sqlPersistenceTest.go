@@ -28,6 +28,8 @@ import (
 	"github.com/jmoiron/sqlx"
 	log "github.com/sirupsen/logrus"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence/sql/storage/postgres"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/environment"
@@ -37,6 +39,10 @@ const (
 	testUser      = "uber"
 	testPassword  = "uber"
 	testSchemaDir = "schema/mysql/v57"
+
+	testPostgresUser      = "postgres"
+	testPostgresPassword  = "cadence"
+	testPostgresSchemaDir = "schema/postgres"
 )
 
 // TestCluster allows executing cassandra operations in testing.
@@ -47,23 +53,46 @@ type TestCluster struct {
 	cfg       config.SQL
 }
 
-// NewTestCluster returns a new SQL test cluster
-func NewTestCluster(dbName string, port int, schemaDir string) *TestCluster {
+// NewTestCluster returns a new SQL test cluster, driverName
+// defaults to mysql when empty
+func NewTestCluster(driverName string, dbName string, port int, schemaDir string) *TestCluster {
 	var result TestCluster
 	result.dbName = dbName
+	if driverName == "" {
+		driverName = defaultDriverName
+	}
+	if driverName == sqlite.DriverName {
+		// sqlite tests run against an in-memory database
+		result.cfg = config.SQL{
+			DriverName:        driverName,
+			DatabaseName:      dbName,
+			ConnectAttributes: map[string]string{"mode": "memory"},
+			NumShards:         4,
+		}
+		return &result
+	}
+	user, password, addr := testUser, testPassword, environment.GetMySQLAddress()
+	defaultSchemaDir := testSchemaDir
+	if driverName == postgres.DriverName {
+		user, password, addr = testPostgresUser, testPostgresPassword, environment.GetPostgresAddress()
+		defaultSchemaDir = testPostgresSchemaDir
+		if port == 0 {
+			port = environment.GetPostgresPort()
+		}
+	}
 	if port == 0 {
 		port = environment.GetMySQLPort()
 	}
 	if schemaDir == "" {
-		schemaDir = testSchemaDir
+		schemaDir = defaultSchemaDir
 	}
 	result.schemaDir = schemaDir
 	result.cfg = config.SQL{
-		User:            testUser,
-		Password:        testPassword,
-		ConnectAddr:     fmt.Sprintf("%v:%v", environment.GetMySQLAddress(), port),
+		User:            user,
+		Password:        password,
+		ConnectAddr:     fmt.Sprintf("%v:%v", addr, port),
 		ConnectProtocol: "tcp",
-		DriverName:      defaultDriverName,
+		DriverName:      driverName,
 		DatabaseName:    dbName,
 		NumShards:       4,
 	}
@@ -77,6 +106,11 @@ func (s *TestCluster) DatabaseName() string {
 
 // SetupTestDatabase from PersistenceTestCluster interface
 func (s *TestCluster) SetupTestDatabase() {
+	if s.cfg.DriverName == sqlite.DriverName {
+		// the session keeps the in-memory database alive and creates the schema
+		s.CreateSession()
+		return
+	}
 	s.CreateDatabase()
 	s.CreateSession()
 
@@ -122,7 +156,7 @@ func (s *TestCluster) CreateSession() {
 
 // CreateDatabase from PersistenceTestCluster interface
 func (s *TestCluster) CreateDatabase() {
-	err := createDatabase(s.cfg.DriverName, s.cfg.ConnectAddr, testUser, testPassword, s.dbName, true)
+	err := createDatabase(s.cfg.DriverName, s.cfg.ConnectAddr, s.cfg.User, s.cfg.Password, s.dbName, true)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -130,7 +164,21 @@ func (s *TestCluster) CreateDatabase() {
 
 // DropDatabase from PersistenceTestCluster interface
 func (s *TestCluster) DropDatabase() {
-	err := dropDatabase(s.db, s.dbName)
+	if s.cfg.DriverName == sqlite.DriverName {
+		// in-memory database is dropped along with its last connection
+		return
+	}
+	db := s.db
+	if s.cfg.DriverName == postgres.DriverName {
+		// postgres does not allow dropping the database of the current connection
+		adminDB, err := connect(s.cfg.DriverName, s.cfg.ConnectProtocol, s.cfg.ConnectAddr, s.cfg.User, s.cfg.Password, "")
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer adminDB.Close()
+		db = adminDB
+	}
+	err := dropDatabase(db, s.dbName)
 	if err != nil {
 		log.Fatal(err)
 	}
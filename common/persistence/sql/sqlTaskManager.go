@@ -156,6 +156,18 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 			Kind:        request.TaskListKind,
 			LastUpdated: now,
 		}}
+		if tlInfo.GetNumWritePartitions() > 0 {
+			resp.TaskListInfo.PartitionConfig = &persistence.TaskListPartitionConfig{
+				NumReadPartitions:  int(tlInfo.GetNumReadPartitions()),
+				NumWritePartitions: int(tlInfo.GetNumWritePartitions()),
+			}
+		}
+		if tlInfo.GetMaxTasksPerSecond() > 0 || tlInfo.GetMaxOutstandingTasks() > 0 {
+			resp.TaskListInfo.Limits = &persistence.TaskListLimits{
+				MaxTasksPerSecond:   tlInfo.GetMaxTasksPerSecond(),
+				MaxOutstandingTasks: int(tlInfo.GetMaxOutstandingTasks()),
+			}
+		}
 		return nil
 	})
 	return resp, err
@@ -170,6 +182,14 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 		ExpiryTimeNanos:  common.Int64Ptr(0),
 		LastUpdatedNanos: common.TimeNowNanosPtr(),
 	}
+	if partitionConfig := request.TaskListInfo.PartitionConfig; partitionConfig != nil {
+		tlInfo.NumReadPartitions = common.Int32Ptr(int32(partitionConfig.NumReadPartitions))
+		tlInfo.NumWritePartitions = common.Int32Ptr(int32(partitionConfig.NumWritePartitions))
+	}
+	if limits := request.TaskListInfo.Limits; limits != nil {
+		tlInfo.MaxTasksPerSecond = common.Float64Ptr(limits.MaxTasksPerSecond)
+		tlInfo.MaxOutstandingTasks = common.Int32Ptr(int32(limits.MaxOutstandingTasks))
+	}
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		tlInfo.ExpiryTimeNanos = common.Int64Ptr(stickyTaskListTTL().UnixNano())
 		blob, err := taskListInfoToBlob(tlInfo)
@@ -336,6 +356,8 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 			ScheduleID:       &v.Data.ScheduleID,
 			ExpiryTimeNanos:  common.Int64Ptr(expiryTime.UnixNano()),
 			CreatedTimeNanos: common.Int64Ptr(time.Now().UnixNano()),
+			Priority:         common.Int32Ptr(v.Data.Priority),
+			FairnessKey:      common.StringPtr(v.Data.FairnessKey),
 		})
 		if err != nil {
 			return nil, err
@@ -398,6 +420,8 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 			ScheduleID:  info.GetScheduleID(),
 			Expiry:      time.Unix(0, info.GetExpiryTimeNanos()),
 			CreatedTime: time.Unix(0, info.GetCreatedTimeNanos()),
+			Priority:    info.GetPriority(),
+			FairnessKey: info.GetFairnessKey(),
 		}
 	}
 
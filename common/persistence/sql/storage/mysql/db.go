@@ -21,10 +21,18 @@
 package mysql
 
 import (
+	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
 
+// DriverName refers to the name of the mysql driver
+const DriverName = "mysql"
+
+// ErrDupEntry MySQL Error 1062 indicates a duplicate primary key i.e. the row already exists,
+// so we don't do the insert and return a ConditionalUpdate error.
+const ErrDupEntry = 1062
+
 // DB represents a logical connection to mysql database
 type DB struct {
 	db        *sqlx.DB
@@ -76,3 +84,9 @@ func (mdb *DB) Close() error {
 func (mdb *DB) DriverName() string {
 	return mdb.db.DriverName()
 }
+
+// IsDupEntryError returns true if the error is a duplicate key error
+func (mdb *DB) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(*mysql.MySQLError)
+	return ok && sqlErr.Number == ErrDupEntry
+}
@@ -39,6 +39,10 @@ const (
 	getExecutionQry = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
 
+	listExecutionsQry = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND (domain_id, workflow_id, run_id) > (?, ?, ?)
+ ORDER BY domain_id, workflow_id, run_id LIMIT ?`
+
 	deleteExecutionQry = `DELETE FROM executions 
  WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
 
@@ -140,6 +144,17 @@ func (mdb *DB) SelectFromExecutions(filter *sqldb.ExecutionsFilter) (*sqldb.Exec
 	return &row, err
 }
 
+// SelectPageFromExecutions reads one page of rows of a shard from executions table
+func (mdb *DB) SelectPageFromExecutions(filter *sqldb.ExecutionsPageFilter) ([]sqldb.ExecutionsRow, error) {
+	var rows []sqldb.ExecutionsRow
+	err := mdb.conn.Select(&rows, listExecutionsQry,
+		filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *DB) DeleteFromExecutions(filter *sqldb.ExecutionsFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
@@ -0,0 +1,138 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	shardID = 54321
+
+	createDomainQry = `INSERT INTO 
+ domains (id, name, is_global, data, data_encoding)
+ VALUES($1, $2, $3, $4, $5)`
+
+	updateDomainQry = `UPDATE domains 
+ SET name = $1, data = $2, data_encoding = $3
+ WHERE shard_id=54321 AND id = $4`
+
+	getDomainPart = `SELECT id, name, is_global, data, data_encoding FROM domains`
+
+	getDomainByIDQry   = getDomainPart + ` WHERE shard_id=$1 AND id = $2`
+	getDomainByNameQry = getDomainPart + ` WHERE shard_id=$1 AND name = $2`
+
+	listDomainsQry      = getDomainPart + ` WHERE shard_id=$1 ORDER BY id LIMIT $2`
+	listDomainsRangeQry = getDomainPart + ` WHERE shard_id=$1 AND id > $2 ORDER BY id LIMIT $3`
+
+	deleteDomainByIDQry   = `DELETE FROM domains WHERE shard_id=$1 AND id = $2`
+	deleteDomainByNameQry = `DELETE FROM domains WHERE shard_id=$1 AND name = $2`
+
+	getDomainMetadataQry    = `SELECT notification_version FROM domain_metadata`
+	lockDomainMetadataQry   = `SELECT notification_version FROM domain_metadata FOR UPDATE`
+	updateDomainMetadataQry = `UPDATE domain_metadata SET notification_version = $1 WHERE notification_version = $2`
+)
+
+var errMissingArgs = errors.New("missing one or more args for API")
+
+// InsertIntoDomain inserts a single row into domains table
+func (pdb *DB) InsertIntoDomain(row *sqldb.DomainRow) (sql.Result, error) {
+	return pdb.conn.Exec(createDomainQry, row.ID, row.Name, row.IsGlobal, row.Data, row.DataEncoding)
+}
+
+// UpdateDomain updates a single row in domains table
+func (pdb *DB) UpdateDomain(row *sqldb.DomainRow) (sql.Result, error) {
+	return pdb.conn.Exec(updateDomainQry, row.Name, row.Data, row.DataEncoding, row.ID)
+}
+
+// SelectFromDomain reads one or more rows from domains table
+func (pdb *DB) SelectFromDomain(filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
+	switch {
+	case filter.ID != nil || filter.Name != nil:
+		return pdb.selectFromDomain(filter)
+	case filter.PageSize != nil && *filter.PageSize > 0:
+		return pdb.selectAllFromDomain(filter)
+	default:
+		return nil, errMissingArgs
+	}
+}
+
+func (pdb *DB) selectFromDomain(filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
+	var err error
+	var row sqldb.DomainRow
+	switch {
+	case filter.ID != nil:
+		err = pdb.conn.Get(&row, getDomainByIDQry, shardID, *filter.ID)
+	case filter.Name != nil:
+		err = pdb.conn.Get(&row, getDomainByNameQry, shardID, *filter.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []sqldb.DomainRow{row}, err
+}
+
+func (pdb *DB) selectAllFromDomain(filter *sqldb.DomainFilter) ([]sqldb.DomainRow, error) {
+	var err error
+	var rows []sqldb.DomainRow
+	switch {
+	case filter.GreaterThanID != nil:
+		err = pdb.conn.Select(&rows, listDomainsRangeQry, shardID, *filter.GreaterThanID, *filter.PageSize)
+	default:
+		err = pdb.conn.Select(&rows, listDomainsQry, shardID, filter.PageSize)
+	}
+	return rows, err
+}
+
+// DeleteFromDomain deletes a single row in domains table
+func (pdb *DB) DeleteFromDomain(filter *sqldb.DomainFilter) (sql.Result, error) {
+	var err error
+	var result sql.Result
+	switch {
+	case filter.ID != nil:
+		result, err = pdb.conn.Exec(deleteDomainByIDQry, shardID, filter.ID)
+	default:
+		result, err = pdb.conn.Exec(deleteDomainByNameQry, shardID, filter.Name)
+	}
+	return result, err
+}
+
+// LockDomainMetadata acquires a write lock on a single row in domain_metadata table
+func (pdb *DB) LockDomainMetadata() error {
+	var row sqldb.DomainMetadataRow
+	err := pdb.conn.Get(&row.NotificationVersion, lockDomainMetadataQry)
+	return err
+}
+
+// SelectFromDomainMetadata reads a single row in domain_metadata table
+func (pdb *DB) SelectFromDomainMetadata() (*sqldb.DomainMetadataRow, error) {
+	var row sqldb.DomainMetadataRow
+	err := pdb.conn.Get(&row.NotificationVersion, getDomainMetadataQry)
+	return &row, err
+}
+
+// UpdateDomainMetadata updates a single row in domain_metadata table
+func (pdb *DB) UpdateDomainMetadata(row *sqldb.DomainMetadataRow) (sql.Result, error) {
+	return pdb.conn.Exec(updateDomainMetadataQry, row.NotificationVersion+1, row.NotificationVersion)
+}
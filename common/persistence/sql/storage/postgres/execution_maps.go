@@ -0,0 +1,388 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	deleteMapQryTemplate = `DELETE FROM %v
+WHERE
+shard_id = $1 AND
+domain_id = $2 AND
+workflow_id = $3 AND
+run_id = $4`
+
+	// %[2]v is the columns of the value struct (i.e. no primary key columns), comma separated
+	// %[3]v should be %[2]v with colons prepended.
+	// i.e. %[3]v = ",".join(":" + s for s in %[2]v)
+	// So that this query can be used with BindNamed
+	// %[4]v should be the name of the key associated with the map
+	// e.g. for ActivityInfo it is "schedule_id"
+	setKeyInMapQryTemplate = `INSERT INTO %[1]v
+(shard_id, domain_id, workflow_id, run_id, %[4]v, %[2]v)
+VALUES
+(:shard_id, :domain_id, :workflow_id, :run_id, :%[4]v, %[3]v)`
+
+	// %[1]v is the name of the key
+	// %[2]v is the value columns, each assigned from the excluded row
+	// i.e. %[2]v = ",".join(s + " = excluded." + s for s in columns)
+	// This clause turns setKeyInMapQryTemplate into an upsert, it must be
+	// appended after the query is bound so that batch inserts still work
+	setKeyInMapConflictTemplate = `
+ON CONFLICT (shard_id, domain_id, workflow_id, run_id, %[1]v) DO UPDATE SET %[2]v`
+
+	// %[2]v is the name of the key
+	deleteKeyInMapQryTemplate = `DELETE FROM %[1]v
+WHERE
+shard_id = $1 AND
+domain_id = $2 AND
+workflow_id = $3 AND
+run_id = $4 AND
+%[2]v = $5`
+
+	// %[1]v is the name of the table
+	// %[2]v is the name of the key
+	// %[3]v is the value columns, separated by commas
+	getMapQryTemplate = `SELECT %[2]v, %[3]v FROM %[1]v
+WHERE
+shard_id = $1 AND
+domain_id = $2 AND
+workflow_id = $3 AND
+run_id = $4`
+)
+
+func stringMap(a []string, f func(string) string) []string {
+	b := make([]string, len(a))
+	for i, v := range a {
+		b[i] = f(v)
+	}
+	return b
+}
+
+func makeDeleteMapQry(tableName string) string {
+	return fmt.Sprintf(deleteMapQryTemplate, tableName)
+}
+
+func makeSetKeyInMapQry(tableName string, nonPrimaryKeyColumns []string, mapKeyName string) string {
+	return fmt.Sprintf(setKeyInMapQryTemplate,
+		tableName,
+		strings.Join(nonPrimaryKeyColumns, ","),
+		strings.Join(stringMap(nonPrimaryKeyColumns, func(x string) string {
+			return ":" + x
+		}), ","),
+		mapKeyName)
+}
+
+func makeSetKeyInMapConflictClause(nonPrimaryKeyColumns []string, mapKeyName string) string {
+	return fmt.Sprintf(setKeyInMapConflictTemplate,
+		mapKeyName,
+		strings.Join(stringMap(nonPrimaryKeyColumns, func(x string) string {
+			return x + " = excluded." + x
+		}), ", "))
+}
+
+func makeDeleteKeyInMapQry(tableName string, mapKeyName string) string {
+	return fmt.Sprintf(deleteKeyInMapQryTemplate,
+		tableName,
+		mapKeyName)
+}
+
+func makeGetMapQryTemplate(tableName string, nonPrimaryKeyColumns []string, mapKeyName string) string {
+	return fmt.Sprintf(getMapQryTemplate,
+		tableName,
+		mapKeyName,
+		strings.Join(nonPrimaryKeyColumns, ","))
+}
+
+var (
+	// Omit shard_id, run_id, domain_id, workflow_id, schedule_id since they're in the primary key
+	activityInfoColumns = []string{
+		"data",
+		"data_encoding",
+		"last_heartbeat_details",
+		"last_heartbeat_updated_time",
+	}
+	activityInfoTableName = "activity_info_maps"
+	activityInfoKey       = "schedule_id"
+
+	deleteActivityInfoMapQry        = makeDeleteMapQry(activityInfoTableName)
+	setKeyInActivityInfoMapQry      = makeSetKeyInMapQry(activityInfoTableName, activityInfoColumns, activityInfoKey)
+	setKeyInActivityInfoMapConflict = makeSetKeyInMapConflictClause(activityInfoColumns, activityInfoKey)
+	deleteKeyInActivityInfoMapQry   = makeDeleteKeyInMapQry(activityInfoTableName, activityInfoKey)
+	getActivityInfoMapQry           = makeGetMapQryTemplate(activityInfoTableName, activityInfoColumns, activityInfoKey)
+)
+
+// ReplaceIntoActivityInfoMaps replaces one or more rows in activity_info_maps table
+func (pdb *DB) ReplaceIntoActivityInfoMaps(rows []sqldb.ActivityInfoMapsRow) (sql.Result, error) {
+	for i := range rows {
+		rows[i].LastHeartbeatUpdatedTime = pdb.converter.ToPostgresDateTime(rows[i].LastHeartbeatUpdatedTime)
+	}
+	return pdb.namedExec(setKeyInActivityInfoMapQry, setKeyInActivityInfoMapConflict, rows)
+}
+
+// SelectFromActivityInfoMaps reads one or more rows from activity_info_maps table
+func (pdb *DB) SelectFromActivityInfoMaps(filter *sqldb.ActivityInfoMapsFilter) ([]sqldb.ActivityInfoMapsRow, error) {
+	var rows []sqldb.ActivityInfoMapsRow
+	err := pdb.conn.Select(&rows, getActivityInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].ShardID = int64(filter.ShardID)
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+		rows[i].LastHeartbeatUpdatedTime = pdb.converter.FromPostgresDateTime(rows[i].LastHeartbeatUpdatedTime)
+	}
+	return rows, err
+}
+
+// DeleteFromActivityInfoMaps deletes one or more rows from activity_info_maps table
+func (pdb *DB) DeleteFromActivityInfoMaps(filter *sqldb.ActivityInfoMapsFilter) (sql.Result, error) {
+	if filter.ScheduleID != nil {
+		return pdb.conn.Exec(deleteKeyInActivityInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.ScheduleID)
+	}
+	return pdb.conn.Exec(deleteActivityInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
+var (
+	timerInfoColumns = []string{
+		"data",
+		"data_encoding",
+	}
+	timerInfoTableName = "timer_info_maps"
+	timerInfoKey       = "timer_id"
+
+	deleteTimerInfoMapSQLQuery      = makeDeleteMapQry(timerInfoTableName)
+	setKeyInTimerInfoMapSQLQuery    = makeSetKeyInMapQry(timerInfoTableName, timerInfoColumns, timerInfoKey)
+	setKeyInTimerInfoMapSQLConflict = makeSetKeyInMapConflictClause(timerInfoColumns, timerInfoKey)
+	deleteKeyInTimerInfoMapSQLQuery = makeDeleteKeyInMapQry(timerInfoTableName, timerInfoKey)
+	getTimerInfoMapSQLQuery         = makeGetMapQryTemplate(timerInfoTableName, timerInfoColumns, timerInfoKey)
+)
+
+// ReplaceIntoTimerInfoMaps replaces one or more rows in timer_info_maps table
+func (pdb *DB) ReplaceIntoTimerInfoMaps(rows []sqldb.TimerInfoMapsRow) (sql.Result, error) {
+	return pdb.namedExec(setKeyInTimerInfoMapSQLQuery, setKeyInTimerInfoMapSQLConflict, rows)
+}
+
+// SelectFromTimerInfoMaps reads one or more rows from timer_info_maps table
+func (pdb *DB) SelectFromTimerInfoMaps(filter *sqldb.TimerInfoMapsFilter) ([]sqldb.TimerInfoMapsRow, error) {
+	var rows []sqldb.TimerInfoMapsRow
+	err := pdb.conn.Select(&rows, getTimerInfoMapSQLQuery, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].ShardID = int64(filter.ShardID)
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+	}
+	return rows, err
+}
+
+// DeleteFromTimerInfoMaps deletes one or more rows from timer_info_maps table
+func (pdb *DB) DeleteFromTimerInfoMaps(filter *sqldb.TimerInfoMapsFilter) (sql.Result, error) {
+	if filter.TimerID != nil {
+		return pdb.conn.Exec(deleteKeyInTimerInfoMapSQLQuery, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.TimerID)
+	}
+	return pdb.conn.Exec(deleteTimerInfoMapSQLQuery, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
+var (
+	childExecutionInfoColumns = []string{
+		"data",
+		"data_encoding",
+	}
+	childExecutionInfoTableName = "child_execution_info_maps"
+	childExecutionInfoKey       = "initiated_id"
+
+	deleteChildExecutionInfoMapQry        = makeDeleteMapQry(childExecutionInfoTableName)
+	setKeyInChildExecutionInfoMapQry      = makeSetKeyInMapQry(childExecutionInfoTableName, childExecutionInfoColumns, childExecutionInfoKey)
+	setKeyInChildExecutionInfoMapConflict = makeSetKeyInMapConflictClause(childExecutionInfoColumns, childExecutionInfoKey)
+	deleteKeyInChildExecutionInfoMapQry   = makeDeleteKeyInMapQry(childExecutionInfoTableName, childExecutionInfoKey)
+	getChildExecutionInfoMapQry           = makeGetMapQryTemplate(childExecutionInfoTableName, childExecutionInfoColumns, childExecutionInfoKey)
+)
+
+// ReplaceIntoChildExecutionInfoMaps replaces one or more rows in child_execution_info_maps table
+func (pdb *DB) ReplaceIntoChildExecutionInfoMaps(rows []sqldb.ChildExecutionInfoMapsRow) (sql.Result, error) {
+	return pdb.namedExec(setKeyInChildExecutionInfoMapQry, setKeyInChildExecutionInfoMapConflict, rows)
+}
+
+// SelectFromChildExecutionInfoMaps reads one or more rows from child_execution_info_maps table
+func (pdb *DB) SelectFromChildExecutionInfoMaps(filter *sqldb.ChildExecutionInfoMapsFilter) ([]sqldb.ChildExecutionInfoMapsRow, error) {
+	var rows []sqldb.ChildExecutionInfoMapsRow
+	err := pdb.conn.Select(&rows, getChildExecutionInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].ShardID = int64(filter.ShardID)
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+	}
+	return rows, err
+}
+
+// DeleteFromChildExecutionInfoMaps deletes one or more rows from child_execution_info_maps table
+func (pdb *DB) DeleteFromChildExecutionInfoMaps(filter *sqldb.ChildExecutionInfoMapsFilter) (sql.Result, error) {
+	if filter.InitiatedID != nil {
+		return pdb.conn.Exec(deleteKeyInChildExecutionInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.InitiatedID)
+	}
+	return pdb.conn.Exec(deleteChildExecutionInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
+var (
+	requestCancelInfoColumns = []string{
+		"data",
+		"data_encoding",
+	}
+	requestCancelInfoTableName = "request_cancel_info_maps"
+	requestCancelInfoKey       = "initiated_id"
+
+	deleteRequestCancelInfoMapQry        = makeDeleteMapQry(requestCancelInfoTableName)
+	setKeyInRequestCancelInfoMapQry      = makeSetKeyInMapQry(requestCancelInfoTableName, requestCancelInfoColumns, requestCancelInfoKey)
+	setKeyInRequestCancelInfoMapConflict = makeSetKeyInMapConflictClause(requestCancelInfoColumns, requestCancelInfoKey)
+	deleteKeyInRequestCancelInfoMapQry   = makeDeleteKeyInMapQry(requestCancelInfoTableName, requestCancelInfoKey)
+	getRequestCancelInfoMapQry           = makeGetMapQryTemplate(requestCancelInfoTableName, requestCancelInfoColumns, requestCancelInfoKey)
+)
+
+// ReplaceIntoRequestCancelInfoMaps replaces one or more rows in request_cancel_info_maps table
+func (pdb *DB) ReplaceIntoRequestCancelInfoMaps(rows []sqldb.RequestCancelInfoMapsRow) (sql.Result, error) {
+	return pdb.namedExec(setKeyInRequestCancelInfoMapQry, setKeyInRequestCancelInfoMapConflict, rows)
+}
+
+// SelectFromRequestCancelInfoMaps reads one or more rows from request_cancel_info_maps table
+func (pdb *DB) SelectFromRequestCancelInfoMaps(filter *sqldb.RequestCancelInfoMapsFilter) ([]sqldb.RequestCancelInfoMapsRow, error) {
+	var rows []sqldb.RequestCancelInfoMapsRow
+	err := pdb.conn.Select(&rows, getRequestCancelInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].ShardID = int64(filter.ShardID)
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+	}
+	return rows, err
+}
+
+// DeleteFromRequestCancelInfoMaps deletes one or more rows from request_cancel_info_maps table
+func (pdb *DB) DeleteFromRequestCancelInfoMaps(filter *sqldb.RequestCancelInfoMapsFilter) (sql.Result, error) {
+	if filter.InitiatedID != nil {
+		return pdb.conn.Exec(deleteKeyInRequestCancelInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.InitiatedID)
+	}
+	return pdb.conn.Exec(deleteRequestCancelInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
+var (
+	signalInfoColumns = []string{
+		"data",
+		"data_encoding",
+	}
+	signalInfoTableName = "signal_info_maps"
+	signalInfoKey       = "initiated_id"
+
+	deleteSignalInfoMapQry        = makeDeleteMapQry(signalInfoTableName)
+	setKeyInSignalInfoMapQry      = makeSetKeyInMapQry(signalInfoTableName, signalInfoColumns, signalInfoKey)
+	setKeyInSignalInfoMapConflict = makeSetKeyInMapConflictClause(signalInfoColumns, signalInfoKey)
+	deleteKeyInSignalInfoMapQry   = makeDeleteKeyInMapQry(signalInfoTableName, signalInfoKey)
+	getSignalInfoMapQry           = makeGetMapQryTemplate(signalInfoTableName, signalInfoColumns, signalInfoKey)
+)
+
+// ReplaceIntoSignalInfoMaps replaces one or more rows in signal_info_maps table
+func (pdb *DB) ReplaceIntoSignalInfoMaps(rows []sqldb.SignalInfoMapsRow) (sql.Result, error) {
+	return pdb.namedExec(setKeyInSignalInfoMapQry, setKeyInSignalInfoMapConflict, rows)
+}
+
+// SelectFromSignalInfoMaps reads one or more rows from signal_info_maps table
+func (pdb *DB) SelectFromSignalInfoMaps(filter *sqldb.SignalInfoMapsFilter) ([]sqldb.SignalInfoMapsRow, error) {
+	var rows []sqldb.SignalInfoMapsRow
+	err := pdb.conn.Select(&rows, getSignalInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].ShardID = int64(filter.ShardID)
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+	}
+	return rows, err
+}
+
+// DeleteFromSignalInfoMaps deletes one or more rows from signal_info_maps table
+func (pdb *DB) DeleteFromSignalInfoMaps(filter *sqldb.SignalInfoMapsFilter) (sql.Result, error) {
+	if filter.InitiatedID != nil {
+		return pdb.conn.Exec(deleteKeyInSignalInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.InitiatedID)
+	}
+	return pdb.conn.Exec(deleteSignalInfoMapQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
+const (
+	deleteAllSignalsRequestedSetQry = `DELETE FROM signals_requested_sets
+WHERE
+shard_id = $1 AND
+domain_id = $2 AND
+workflow_id = $3 AND
+run_id = $4
+`
+
+	createSignalsRequestedSetQry = `INSERT INTO signals_requested_sets
+(shard_id, domain_id, workflow_id, run_id, signal_id) VALUES
+(:shard_id, :domain_id, :workflow_id, :run_id, :signal_id)`
+
+	createSignalsRequestedSetConflict = `
+ON CONFLICT (shard_id, domain_id, workflow_id, run_id, signal_id) DO NOTHING`
+
+	deleteSignalsRequestedSetQry = `DELETE FROM signals_requested_sets
+WHERE 
+shard_id = $1 AND
+domain_id = $2 AND
+workflow_id = $3 AND
+run_id = $4 AND
+signal_id = $5`
+
+	getSignalsRequestedSetQry = `SELECT signal_id FROM signals_requested_sets WHERE
+shard_id = $1 AND
+domain_id = $2 AND
+workflow_id = $3 AND
+run_id = $4`
+)
+
+// InsertIntoSignalsRequestedSets inserts one or more rows into signals_requested_sets table
+func (pdb *DB) InsertIntoSignalsRequestedSets(rows []sqldb.SignalsRequestedSetsRow) (sql.Result, error) {
+	return pdb.namedExec(createSignalsRequestedSetQry, createSignalsRequestedSetConflict, rows)
+}
+
+// SelectFromSignalsRequestedSets reads one or more rows from signals_requested_sets table
+func (pdb *DB) SelectFromSignalsRequestedSets(filter *sqldb.SignalsRequestedSetsFilter) ([]sqldb.SignalsRequestedSetsRow, error) {
+	var rows []sqldb.SignalsRequestedSetsRow
+	err := pdb.conn.Select(&rows, getSignalsRequestedSetQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].ShardID = int64(filter.ShardID)
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+	}
+	return rows, err
+}
+
+// DeleteFromSignalsRequestedSets deletes one or more rows from signals_requested_sets table
+func (pdb *DB) DeleteFromSignalsRequestedSets(filter *sqldb.SignalsRequestedSetsFilter) (sql.Result, error) {
+	if filter.SignalID != nil {
+		return pdb.conn.Exec(deleteSignalsRequestedSetQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.SignalID)
+	}
+	return pdb.conn.Exec(deleteAllSignalsRequestedSetQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
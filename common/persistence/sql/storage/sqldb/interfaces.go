@@ -106,6 +106,16 @@ type (
 		RunID      UUID
 	}
 
+	// ExecutionsPageFilter contains the column names within executions table that
+	// can be used to page through the executions of a shard in primary key order
+	ExecutionsPageFilter struct {
+		ShardID    int
+		DomainID   UUID
+		WorkflowID string
+		RunID      UUID
+		PageSize   int
+	}
+
 	// CurrentExecutionsRow represents a row in current_executions table
 	CurrentExecutionsRow struct {
 		ShardID          int64
@@ -527,6 +537,10 @@ type (
 		InsertIntoExecutions(row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(filter *ExecutionsFilter) (*ExecutionsRow, error)
+		// SelectPageFromExecutions returns up to pageSize rows of the shard from executions table,
+		// ordered by primary key and starting after the {domainID, workflowID, runID} key
+		// Required params - {shardID, domainID, workflowID, runID, pageSize}
+		SelectPageFromExecutions(filter *ExecutionsPageFilter) ([]ExecutionsRow, error)
 		DeleteFromExecutions(filter *ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(filter *ExecutionsFilter) (int, error)
 		WriteLockExecutions(filter *ExecutionsFilter) (int, error)
@@ -670,6 +684,9 @@ type (
 		tableCRUD
 		BeginTx() (Tx, error)
 		DriverName() string
+		// IsDupEntryError returns true if the given error was returned
+		// by the database for a duplicate primary / unique key
+		IsDupEntryError(err error) bool
 		Close() error
 	}
 
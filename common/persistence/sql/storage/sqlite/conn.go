@@ -0,0 +1,233 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// goDriverName is the name the go-sqlite3 driver is registered with
+	goDriverName = "sqlite3"
+
+	modeAttrName        = "mode"
+	modeMemory          = "memory"
+	cacheAttrName       = "cache"
+	cacheShared         = "shared"
+	busyTimeoutAttrName = "_busy_timeout"
+	defaultBusyTimeout  = "10000"
+)
+
+var (
+	// lockTimeout bounds the wait for the connection of a database,
+	// in line with the default busy timeout of sqlite
+	lockTimeout = 10 * time.Second
+
+	errLockTimeout = errors.New("timed out waiting for the sqlite connection, " +
+		"it is held by a transaction which did not complete in time")
+)
+
+type (
+	// connPool keeps track of the sqlite connections opened by this process,
+	// keyed by dsn, so that all users of a database share the same connection
+	connPool struct {
+		sync.Mutex
+		conns map[string]*refCountedConn
+	}
+
+	refCountedConn struct {
+		db     *sqlx.DB
+		lock   connLock
+		refCnt int
+	}
+
+	// connLock serializes the statements and transactions issued against a
+	// database. The database/sql pool holds a single connection and waits for
+	// it without a deadline, a statement issued outside of the transaction
+	// which holds the connection would block forever. Waiting on the lock
+	// instead fails such a statement after lockTimeout.
+	connLock chan struct{}
+
+	// lockedConn runs each statement outside of a transaction under the lock
+	lockedConn struct {
+		conn sqldb.Conn
+		lock connLock
+	}
+)
+
+var pool = &connPool{conns: make(map[string]*refCountedConn)}
+
+// NewConnection returns a connection to the sqlite database described by the
+// config. sqlite allows a single writer at a time, so one connection is shared
+// by all the users of a database within the process. The schema is created when
+// the database is opened for the first time. Setting the connect attribute
+// mode to memory creates an in-memory database, named after the database name,
+// which lives until its last user closes it.
+func NewConnection(cfg *config.SQL) (*sqlx.DB, error) {
+	dsn := BuildDSN(cfg)
+
+	pool.Lock()
+	defer pool.Unlock()
+	if conn, ok := pool.conns[dsn]; ok {
+		conn.refCnt++
+		return conn.db, nil
+	}
+	db, err := Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := SetupSchema(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	pool.conns[dsn] = &refCountedConn{db: db, lock: newConnLock(), refCnt: 1}
+	return db, nil
+}
+
+// Open returns a new connection to the sqlite database identified by dsn,
+// unlike NewConnection the returned connection is not shared
+func Open(dsn string) (*sqlx.DB, error) {
+	db, err := sqlx.Connect(goDriverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	// never let the connection go, an in-memory database
+	// is dropped along with its last connection
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}
+
+// SetupSchema creates the cadence and visibility tables, if they don't already exist
+func SetupSchema(db *sqlx.DB) error {
+	_, err := db.Exec(schema)
+	return err
+}
+
+// BuildDSN returns the sqlite dsn for the given config, the database name is
+// the path to the database file or the name of the in-memory database
+func BuildDSN(cfg *config.SQL) string {
+	params := url.Values{}
+	for k, v := range cfg.ConnectAttributes {
+		params.Set(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	if params.Get(modeAttrName) == modeMemory && params.Get(cacheAttrName) == "" {
+		params.Set(cacheAttrName, cacheShared)
+	}
+	if params.Get(busyTimeoutAttrName) == "" {
+		params.Set(busyTimeoutAttrName, defaultBusyTimeout)
+	}
+	return "file:" + cfg.DatabaseName + "?" + params.Encode()
+}
+
+// lockOf returns the lock shared by the users of the given connection,
+// connections which are not shared get a lock of their own
+func lockOf(db *sqlx.DB) connLock {
+	pool.Lock()
+	defer pool.Unlock()
+	for _, conn := range pool.conns {
+		if conn.db == db {
+			return conn.lock
+		}
+	}
+	return newConnLock()
+}
+
+func releaseConnection(db *sqlx.DB) error {
+	pool.Lock()
+	defer pool.Unlock()
+	for dsn, conn := range pool.conns {
+		if conn.db != db {
+			continue
+		}
+		conn.refCnt--
+		if conn.refCnt > 0 {
+			return nil
+		}
+		delete(pool.conns, dsn)
+		break
+	}
+	return db.Close()
+}
+
+func newConnLock() connLock {
+	return make(connLock, 1)
+}
+
+func (l connLock) acquire() error {
+	timer := time.NewTimer(lockTimeout)
+	defer timer.Stop()
+	select {
+	case l <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return errLockTimeout
+	}
+}
+
+func (l connLock) release() {
+	<-l
+}
+
+func (c *lockedConn) Exec(query string, args ...interface{}) (sql.Result, error) {
+	if err := c.lock.acquire(); err != nil {
+		return nil, err
+	}
+	defer c.lock.release()
+	return c.conn.Exec(query, args...)
+}
+
+func (c *lockedConn) NamedExec(query string, arg interface{}) (sql.Result, error) {
+	if err := c.lock.acquire(); err != nil {
+		return nil, err
+	}
+	defer c.lock.release()
+	return c.conn.NamedExec(query, arg)
+}
+
+func (c *lockedConn) Get(dest interface{}, query string, args ...interface{}) error {
+	if err := c.lock.acquire(); err != nil {
+		return err
+	}
+	defer c.lock.release()
+	return c.conn.Get(dest, query, args...)
+}
+
+func (c *lockedConn) Select(dest interface{}, query string, args ...interface{}) error {
+	if err := c.lock.acquire(); err != nil {
+		return err
+	}
+	defer c.lock.release()
+	return c.conn.Select(dest, query, args...)
+}
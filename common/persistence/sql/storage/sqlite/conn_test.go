@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type connSuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestConnSuite(t *testing.T) {
+	suite.Run(t, new(connSuite))
+}
+
+func (s *connSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *connSuite) TestStatementOutsideOfTransaction() {
+	defer func(timeout time.Duration) { lockTimeout = timeout }(lockTimeout)
+	lockTimeout = 100 * time.Millisecond
+
+	db := s.newDB("conn_test_statement_outside_of_transaction")
+	defer db.Close()
+
+	tx, err := db.BeginTx()
+	s.NoError(err)
+	_, err = tx.InsertIntoShards(&sqldb.ShardsRow{ShardID: 1, RangeID: 1, Data: []byte{}, DataEncoding: "json"})
+	s.NoError(err)
+
+	// the statement waits for the transaction holding the connection and fails instead of blocking forever
+	_, err = db.SelectFromShards(&sqldb.ShardsFilter{ShardID: 1})
+	s.Equal(errLockTimeout, err)
+
+	s.NoError(tx.Commit())
+	s.Error(tx.Rollback())
+	row, err := db.SelectFromShards(&sqldb.ShardsFilter{ShardID: 1})
+	s.NoError(err)
+	s.Equal(int64(1), row.RangeID)
+}
+
+func (s *connSuite) TestConcurrentTransactions() {
+	db := s.newDB("conn_test_concurrent_transactions")
+	defer db.Close()
+
+	var wg sync.WaitGroup
+	for i := int64(0); i < 10; i++ {
+		wg.Add(1)
+		go func(shardID int64) {
+			defer wg.Done()
+			tx, err := db.BeginTx()
+			s.NoError(err)
+			_, err = tx.InsertIntoShards(&sqldb.ShardsRow{ShardID: shardID, RangeID: 1, Data: []byte{}, DataEncoding: "json"})
+			s.NoError(err)
+			s.NoError(tx.Commit())
+		}(i)
+	}
+	wg.Wait()
+
+	for i := int64(0); i < 10; i++ {
+		_, err := db.SelectFromShards(&sqldb.ShardsFilter{ShardID: i})
+		s.NoError(err)
+	}
+}
+
+func (s *connSuite) newDB(name string) *DB {
+	xdb, err := NewConnection(&config.SQL{
+		DatabaseName:      name,
+		ConnectAttributes: map[string]string{modeAttrName: modeMemory},
+	})
+	s.NoError(err)
+	return NewDB(xdb, nil)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+// DriverName refers to the name of the sqlite plugin used in the
+// persistence config, the underlying go driver is registered as sqlite3
+const DriverName = "sqlite"
+
+// DB represents a logical connection to sqlite database
+type DB struct {
+	db        *sqlx.DB
+	tx        *sqlx.Tx
+	conn      sqldb.Conn
+	lock      connLock
+	unlock    sync.Once
+	converter DataConverter
+}
+
+var _ sqldb.Tx = (*DB)(nil)
+var _ sqldb.Interface = (*DB)(nil)
+
+// NewDB returns an instance of DB, which is a logical
+// connection to the underlying sqlite database
+func NewDB(xdb *sqlx.DB, tx *sqlx.Tx) *DB {
+	return newDB(xdb, tx, lockOf(xdb))
+}
+
+func newDB(xdb *sqlx.DB, tx *sqlx.Tx, lock connLock) *DB {
+	sdb := &DB{db: xdb, tx: tx, lock: lock}
+	sdb.conn = &lockedConn{conn: xdb, lock: lock}
+	if tx != nil {
+		// the transaction holds the lock until it completes
+		sdb.conn = tx
+	}
+	sdb.converter = &converter{}
+	return sdb
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (sdb *DB) BeginTx() (sqldb.Tx, error) {
+	if err := sdb.lock.acquire(); err != nil {
+		return nil, err
+	}
+	xtx, err := sdb.db.Beginx()
+	if err != nil {
+		sdb.lock.release()
+		return nil, err
+	}
+	return newDB(sdb.db, xtx, sdb.lock), nil
+}
+
+// Commit commits a previously started transaction
+func (sdb *DB) Commit() error {
+	defer sdb.releaseTx()
+	return sdb.tx.Commit()
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (sdb *DB) Rollback() error {
+	defer sdb.releaseTx()
+	return sdb.tx.Rollback()
+}
+
+// releaseTx releases the lock held by the transaction, only once
+// in case the transaction is rolled back after a failed commit
+func (sdb *DB) releaseTx() {
+	sdb.unlock.Do(sdb.lock.release)
+}
+
+// Close releases the connection to the sqlite db, the underlying
+// database is closed once all of its users have released it
+func (sdb *DB) Close() error {
+	return releaseConnection(sdb.db)
+}
+
+// DriverName returns the name of the sqlite plugin
+func (sdb *DB) DriverName() string {
+	return DriverName
+}
+
+// IsDupEntryError returns true if the error is a duplicate key error
+func (sdb *DB) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(sqlite3.Error)
+	return ok && (sqlErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey ||
+		sqlErr.ExtendedCode == sqlite3.ErrConstraintUnique)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+)
+
+const (
+	templateEnqueueMessageQuery   = `INSERT INTO queue (queue_type, message_id, message_payload) VALUES(:queue_type, :message_id, :message_payload)`
+	templateGetLastMessageIDQuery = `SELECT message_id FROM queue WHERE queue_type=? ORDER BY message_id DESC LIMIT 1`
+	templateGetMessagesQuery      = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? and message_id > ? ORDER BY message_id LIMIT ?`
+)
+
+// InsertIntoQueue inserts a new row into queue table
+func (sdb *DB) InsertIntoQueue(row *sqldb.QueueRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(templateEnqueueMessageQuery, row)
+}
+
+// GetLastEnqueuedMessageIDForUpdate returns the last enqueued message ID
+func (sdb *DB) GetLastEnqueuedMessageIDForUpdate(queueType int) (int, error) {
+	var lastMessageID int
+	err := sdb.conn.Get(&lastMessageID, templateGetLastMessageIDQuery, queueType)
+	return lastMessageID, err
+}
+
+// GetMessagesFromQueue retrieves messages from the queue
+func (sdb *DB) GetMessagesFromQueue(queueType, lastMessageID, maxRows int) ([]sqldb.QueueRow, error) {
+	var rows []sqldb.QueueRow
+	err := sdb.conn.Select(&rows, templateGetMessagesQuery, queueType, lastMessageID, maxRows)
+	return rows, err
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+// schema is the cadence and the visibility schema, see schema/mysql/v57 for the
+// mysql equivalent. Both live in the same sqlite database and the statements are
+// safe to run against an existing database.
+const schema = `
+CREATE TABLE IF NOT EXISTS domains(
+  shard_id INT NOT NULL DEFAULT 54321,
+  id BINARY(16) NOT NULL,
+  name VARCHAR(255) UNIQUE NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  is_global TINYINT(1) NOT NULL,
+  PRIMARY KEY(shard_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS domain_metadata (
+  notification_version BIGINT NOT NULL
+);
+
+INSERT INTO domain_metadata (notification_version)
+  SELECT 1 WHERE NOT EXISTS (SELECT 1 FROM domain_metadata);
+
+CREATE TABLE IF NOT EXISTS shards (
+  shard_id INT NOT NULL,
+  --
+  range_id BIGINT NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id)
+);
+
+CREATE TABLE IF NOT EXISTS transfer_tasks(
+  shard_id INT NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS executions(
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  --
+  next_event_id BIGINT NOT NULL,
+  last_write_version BIGINT NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
+);
+
+CREATE TABLE IF NOT EXISTS current_executions(
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  --
+  run_id BINARY(16) NOT NULL,
+  create_request_id VARCHAR(64) NOT NULL,
+  state INT NOT NULL,
+  close_status INT NOT NULL,
+  start_version BIGINT NOT NULL,
+  last_write_version BIGINT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id)
+);
+
+CREATE TABLE IF NOT EXISTS buffered_events (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  --
+  data MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS buffered_events_by_events_ids ON buffered_events(shard_id, domain_id, workflow_id, run_id);
+
+CREATE TABLE IF NOT EXISTS tasks (
+  domain_id BINARY(16) NOT NULL,
+  task_list_name VARCHAR(255) NOT NULL,
+  task_type TINYINT NOT NULL, -- {Activity, Decision}
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (domain_id, task_list_name, task_type, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS task_lists (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  task_type TINYINT NOT NULL, -- {Activity, Decision}
+  --
+  range_id BIGINT NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, name, task_type)
+);
+
+CREATE TABLE IF NOT EXISTS replication_tasks (
+  shard_id INT NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS timer_tasks (
+  shard_id INT NOT NULL,
+  visibility_timestamp DATETIME NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, visibility_timestamp, task_id)
+);
+
+-- Deprecated in favor of history eventsV2
+CREATE TABLE IF NOT EXISTS events (
+  domain_id      BINARY(16) NOT NULL,
+  workflow_id    VARCHAR(255) NOT NULL,
+  run_id         BINARY(16) NOT NULL,
+  first_event_id BIGINT NOT NULL,
+  --
+  batch_version  BIGINT,
+  range_id       BIGINT NOT NULL,
+  tx_id          BIGINT NOT NULL,
+  data MEDIUMBLOB NOT NULL,
+  data_encoding  VARCHAR(16) NOT NULL,
+  PRIMARY KEY (domain_id, workflow_id, run_id, first_event_id)
+);
+
+CREATE TABLE IF NOT EXISTS activity_info_maps (
+-- each row corresponds to one key of one map<string, ActivityInfo>
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  schedule_id BIGINT NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  last_heartbeat_details BLOB,
+  last_heartbeat_updated_time DATETIME NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, schedule_id)
+);
+
+CREATE TABLE IF NOT EXISTS timer_info_maps (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  timer_id VARCHAR(255) NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, timer_id)
+);
+
+CREATE TABLE IF NOT EXISTS child_execution_info_maps (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  initiated_id BIGINT NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE IF NOT EXISTS request_cancel_info_maps (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  initiated_id BIGINT NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE IF NOT EXISTS signal_info_maps (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  initiated_id BIGINT NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE IF NOT EXISTS buffered_replication_task_maps (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  first_event_id BIGINT NOT NULL,
+--
+  version BIGINT NOT NULL,
+  next_event_id BIGINT NOT NULL,
+  history MEDIUMBLOB,
+  history_encoding VARCHAR(16) NOT NULL,
+  new_run_history BLOB,
+  new_run_history_encoding VARCHAR(16) NOT NULL DEFAULT 'json',
+  event_store_version          INT NOT NULL, -- indiciates which version of event store to query
+  new_run_event_store_version  INT NOT NULL, -- indiciates which version of event store to query for new run(continueAsNew)
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, first_event_id)
+);
+
+CREATE TABLE IF NOT EXISTS signals_requested_sets (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  signal_id VARCHAR(64) NOT NULL,
+  --
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, signal_id)
+);
+
+-- history eventsV2: history_node stores history event data
+CREATE TABLE IF NOT EXISTS history_node (
+  shard_id       INT NOT NULL,
+  tree_id        BINARY(16) NOT NULL,
+  branch_id      BINARY(16) NOT NULL,
+  node_id        BIGINT NOT NULL,
+  txn_id         BIGINT NOT NULL,
+  --
+  data           MEDIUMBLOB NOT NULL,
+  data_encoding  VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, tree_id, branch_id, node_id, txn_id)
+);
+
+-- history eventsV2: history_tree stores branch metadata
+CREATE TABLE IF NOT EXISTS history_tree (
+  shard_id       INT NOT NULL,
+  tree_id        BINARY(16) NOT NULL,
+  branch_id      BINARY(16) NOT NULL,
+  --
+  in_progress    BOOLEAN NOT NULL,
+  data           BLOB NOT NULL,
+  data_encoding  VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, tree_id, branch_id)
+);
+
+CREATE TABLE IF NOT EXISTS queue (
+  queue_type INT NOT NULL,
+  message_id BIGINT NOT NULL,
+  message_payload BLOB NOT NULL,
+  PRIMARY KEY(queue_type, message_id)
+);
+
+CREATE TABLE IF NOT EXISTS executions_visibility (
+  domain_id            CHAR(64) NOT NULL,
+  run_id               CHAR(64) NOT NULL,
+  start_time           DATETIME NOT NULL,
+  execution_time       DATETIME NOT NULL,
+  workflow_id          VARCHAR(255) NOT NULL,
+  workflow_type_name   VARCHAR(255) NOT NULL,
+  close_status         INT,  -- enum WorkflowExecutionCloseStatus {COMPLETED, FAILED, CANCELED, TERMINATED, CONTINUED_AS_NEW, TIMED_OUT}
+  close_time           DATETIME NULL,
+  history_length       BIGINT,
+  memo                 BLOB,
+  encoding             VARCHAR(64) NOT NULL,
+
+  PRIMARY KEY  (domain_id, run_id)
+);
+
+CREATE INDEX IF NOT EXISTS by_type_start_time ON executions_visibility (domain_id, workflow_type_name, close_status, start_time DESC, run_id);
+CREATE INDEX IF NOT EXISTS by_workflow_id_start_time ON executions_visibility (domain_id, workflow_id, close_status, start_time DESC, run_id);
+CREATE INDEX IF NOT EXISTS by_status_by_close_time ON executions_visibility (domain_id, close_status, start_time DESC, run_id);
+`
@@ -29,7 +29,9 @@ import (
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/common/persistence/sql/storage/mysql"
+	"github.com/uber/cadence/common/persistence/sql/storage/postgres"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
 	"github.com/uber/cadence/common/service/config"
 )
 
@@ -38,6 +40,9 @@ const (
 	isolationLevelAttrName       = "transaction_isolation"
 	isolationLevelAttrNameLegacy = "tx_isolation"
 	defaultIsolationLevel        = "'READ-COMMITTED'"
+
+	postgresSSLModeAttrName = "sslmode"
+	postgresDefaultSSLMode  = "disable"
 )
 
 var dsnAttrOverrides = map[string]string{
@@ -51,7 +56,32 @@ var dsnAttrOverrides = map[string]string{
 // SQL database and the object can be used to perform CRUD operations on
 // the tables in the database
 func NewSQLDB(cfg *config.SQL) (sqldb.Interface, error) {
-	db, err := sqlx.Connect(cfg.DriverName, buildDSN(cfg))
+	switch cfg.DriverName {
+	case mysql.DriverName:
+		db, err := newConnection(cfg, buildDSN(cfg))
+		if err != nil {
+			return nil, err
+		}
+		return mysql.NewDB(db, nil), nil
+	case postgres.DriverName:
+		db, err := newConnection(cfg, buildPostgresDSN(cfg))
+		if err != nil {
+			return nil, err
+		}
+		return postgres.NewDB(db, nil), nil
+	case sqlite.DriverName:
+		db, err := sqlite.NewConnection(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return sqlite.NewDB(db, nil), nil
+	default:
+		return nil, fmt.Errorf("unsupported sql driver: %v", cfg.DriverName)
+	}
+}
+
+func newConnection(cfg *config.SQL, dsn string) (*sqlx.DB, error) {
+	db, err := sqlx.Connect(cfg.DriverName, dsn)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +96,7 @@ func NewSQLDB(cfg *config.SQL) (sqldb.Interface, error) {
 	}
 	// Maps struct names in CamelCase to snake without need for db struct tags.
 	db.MapperFunc(strcase.ToSnake)
-	return mysql.NewDB(db, nil), nil
+	return db, nil
 }
 
 func buildDSN(cfg *config.SQL) string {
@@ -110,6 +140,26 @@ func buildDSNAttrs(cfg *config.SQL) string {
 	return url.PathEscape(buf.String())
 }
 
+// buildPostgresDSN returns a postgres connection url, the connect attributes
+// are passed through as url parameters e.g. sslmode, connect_timeout etc
+func buildPostgresDSN(cfg *config.SQL) string {
+	params := url.Values{}
+	for k, v := range cfg.ConnectAttributes {
+		params.Set(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	if params.Get(postgresSSLModeAttrName) == "" {
+		params.Set(postgresSSLModeAttrName, postgresDefaultSSLMode)
+	}
+	dsn := url.URL{
+		Scheme:   postgres.DriverName,
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     cfg.ConnectAddr,
+		Path:     "/" + cfg.DatabaseName,
+		RawQuery: params.Encode(),
+	}
+	return dsn.String()
+}
+
 func hasAttr(attrs map[string]string, key string) bool {
 	_, ok := attrs[key]
 	return ok
@@ -27,17 +27,43 @@ import (
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
 	log "github.com/sirupsen/logrus"
+	"github.com/uber/cadence/common/persistence/sql/storage/postgres"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
 	"github.com/uber/cadence/common/service/config"
 )
 
 const (
-	defaultDriverName = "mysql"
-	dataSourceName    = "%s:%s@%v(%v)/%s?multiStatements=true&transaction_isolation=%%27READ-COMMITTED%%27&parseTime=true&clientFoundRows=true"
+	defaultDriverName      = "mysql"
+	dataSourceName         = "%s:%s@%v(%v)/%s?multiStatements=true&transaction_isolation=%%27READ-COMMITTED%%27&parseTime=true&clientFoundRows=true"
+	postgresDataSourceName = "postgres://%s:%s@%v/%s?sslmode=disable"
+	// postgresDefaultDatabase is used for connections that are not tied to
+	// a test database e.g. to create or drop the test database
+	postgresDefaultDatabase = "postgres"
 )
 
 func newConnection(cfg config.SQL) (*sqlx.DB, error) {
-	var db, err = sqlx.Connect(cfg.DriverName,
-		fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName))
+	if cfg.DriverName == sqlite.DriverName {
+		db, err := sqlite.Open(sqlite.BuildDSN(&cfg))
+		if err != nil {
+			return nil, err
+		}
+		return db, sqlite.SetupSchema(db)
+	}
+	return connect(cfg.DriverName, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.User, cfg.Password, cfg.DatabaseName)
+}
+
+func connect(driver string, protocol string, addr string, username, password, dbName string) (*sqlx.DB, error) {
+	var dsn string
+	switch driver {
+	case postgres.DriverName:
+		if dbName == "" {
+			dbName = postgresDefaultDatabase
+		}
+		dsn = fmt.Sprintf(postgresDataSourceName, username, password, addr, dbName)
+	default:
+		dsn = fmt.Sprintf(dataSourceName, username, password, protocol, addr, dbName)
+	}
+	var db, err = sqlx.Connect(driver, dsn)
 	if err != nil {
 		return nil, err
 	}
@@ -47,10 +73,11 @@ func newConnection(cfg config.SQL) (*sqlx.DB, error) {
 }
 
 func createDatabase(driver string, addr string, username, password, dbName string, overwrite bool) error {
-	var db, err = sqlx.Connect(driver, fmt.Sprintf(dataSourceName, username, password, "tcp", addr, ""))
+	var db, err = connect(driver, "tcp", addr, username, password, "")
 	if err != nil {
-		return fmt.Errorf("failure connecting to mysql database: %v", err)
+		return fmt.Errorf("failure connecting to %v database: %v", driver, err)
 	}
+	defer db.Close()
 
 	if overwrite {
 		dropDatabase(db, dbName)
@@ -63,8 +90,15 @@ func createDatabase(driver string, addr string, username, password, dbName strin
 	return nil
 }
 
-// DropCassandraKeyspace drops the given keyspace, if it exists
+// dropDatabase drops the given database, if it exists
 func dropDatabase(db *sqlx.DB, dbName string) (err error) {
+	if db.DriverName() == postgres.DriverName {
+		// postgres refuses to drop a database that still has open connections
+		_, err = db.Exec("SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1 AND pid <> pg_backend_pid()", dbName)
+		if err != nil {
+			return err
+		}
+	}
 	_, err = db.Exec("DROP DATABASE " + dbName)
 	if err != nil {
 		return err
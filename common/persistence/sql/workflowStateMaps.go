@@ -80,6 +80,9 @@ func updateActivityInfos(
 				RetryLastFailureReason:        &v.LastFailureReason,
 				RetryLastWorkerIdentity:       &v.LastWorkerIdentity,
 				RetryLastFailureDetails:       v.LastFailureDetails,
+				Paused:                        &v.Paused,
+				Priority:                      &v.Priority,
+				FairnessKey:                   &v.FairnessKey,
 			}
 			blob, err := activityInfoToBlob(info)
 			if err != nil {
@@ -195,6 +198,9 @@ func getActivityInfoMap(
 			LastFailureReason:        decoded.GetRetryLastFailureReason(),
 			LastWorkerIdentity:       decoded.GetRetryLastWorkerIdentity(),
 			LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
+			Paused:                   decoded.GetPaused(),
+			Priority:                 decoded.GetPriority(),
+			FairnessKey:              decoded.GetFairnessKey(),
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, common.EncodingType(decoded.GetStartedEventEncoding()))
@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package schedule contains the definitions shared by the schedule APIs of the frontend
+// and the scheduler workflows of the worker service. It must stay free of side effects,
+// the workflows and activities are registered by the worker's scheduler package.
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	// TaskListName is the tasklist name
+	TaskListName = "cadence-sys-scheduler-tasklist"
+	// WorkflowTypeName is the workflow type
+	WorkflowTypeName = "cadence-sys-scheduler-workflow"
+	// WorkflowIDPrefix is the prefix of the IDs of the workflows backing schedules
+	WorkflowIDPrefix = "cadence-sys-scheduler"
+
+	// SignalNameUpdate is the signal carrying an UpdateScheduleRequest
+	SignalNameUpdate = "update"
+	// SignalNamePause is the signal carrying a PauseScheduleRequest
+	SignalNamePause = "pause"
+	// SignalNameUnpause is the signal carrying an UnpauseScheduleRequest
+	SignalNameUnpause = "unpause"
+	// SignalNameBackfill is the signal carrying a BackfillScheduleRequest
+	SignalNameBackfill = "backfill"
+	// SignalNameDelete is the signal carrying a DeleteScheduleRequest
+	SignalNameDelete = "delete"
+	// QueryTypeDescribe is the query type returning the DescribeScheduleResponse of the schedule
+	QueryTypeDescribe = "describe"
+
+	// WorkflowStartToCloseTimeout is the execution timeout of the scheduler workflow, a schedule never times out
+	WorkflowStartToCloseTimeout = 20 * 365 * 24 * time.Hour
+	// DecisionTaskStartToCloseTimeout is the decision task timeout of the scheduler workflow
+	DecisionTaskStartToCloseTimeout = 10 * time.Second
+)
+
+type (
+	// Params is the input of the scheduler workflow, it is carried over when the workflow continues as new
+	Params struct {
+		Domain     string
+		ScheduleID string
+		Spec       *shared.ScheduleSpec
+		Action     *shared.ScheduleAction
+		Policies   *shared.SchedulePolicies
+		State      *shared.ScheduleState
+		Memo       *shared.Memo
+		Info       *shared.ScheduleInfo
+		// LastProcessedTime is the time up to which the scheduled actions have been processed
+		LastProcessedTime time.Time
+		// BufferedActionTime is the scheduled time of the action buffered by BufferOne overlap policy
+		BufferedActionTime *time.Time
+	}
+)
+
+// WorkflowID returns the ID of the workflow backing the given schedule. The length of the
+// domain name is part of the ID, so that the IDs stay unique when a domain name or a schedule
+// ID contains the separator, and WorkflowID(domain, "") is a prefix matching only the schedules
+// of the domain.
+func WorkflowID(domain string, scheduleID string) string {
+	return fmt.Sprintf("%v:%v:%v:%v", WorkflowIDPrefix, len(domain), domain, scheduleID)
+}
@@ -52,6 +52,8 @@ type (
 		ClusterMetadata *ClusterMetadata `yaml:"clusterMetadata"`
 		// DCRedirectionPolicy contains the frontend datacenter redirection policy
 		DCRedirectionPolicy DCRedirectionPolicy `yaml:"dcRedirectionPolicy"`
+		// Authorization contains the frontend authorization config
+		Authorization Authorization `yaml:"authorization"`
 		// Services is a map of service name to service config items
 		Services map[string]Service `yaml:"services"`
 		// Kafka is the config for connecting to kafka
@@ -97,6 +99,26 @@ type (
 		DisableLogging bool `yaml:"disableLogging"`
 		// LogLevel is the desired log level
 		LogLevel string `yaml:"logLevel"`
+		// TLS is the TLS config for the listener and the connections made by the service
+		TLS TLS `yaml:"tls"`
+	}
+
+	// TLS contains the config for securing connections with TLS
+	TLS struct {
+		// Enabled turns on TLS, all other fields are ignored when it is false
+		Enabled bool `yaml:"enabled"`
+		// CertFile is the path of the PEM encoded certificate presented to peers
+		CertFile string `yaml:"certFile"`
+		// KeyFile is the path of the PEM encoded private key of the certificate
+		KeyFile string `yaml:"keyFile"`
+		// CaFile is the path of the PEM encoded CA certificates used to verify peers,
+		// the system roots are used to verify servers when it is empty
+		CaFile string `yaml:"caFile"`
+		// RequireClientAuth makes a listener require and verify client certificates (mutual TLS)
+		RequireClientAuth bool `yaml:"requireClientAuth"`
+		// ServerName overrides the name verified against server certificates,
+		// by default the host of the dialed address is used
+		ServerName string `yaml:"serverName"`
 	}
 
 	// Ringpop contains the ringpop config items
@@ -135,6 +157,23 @@ type (
 		VisibilityConfig *VisibilityConfig
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn
+		// PayloadEncryption is the config for encrypting workflow payloads at rest
+		PayloadEncryption PayloadEncryption `yaml:"payloadEncryption"`
+	}
+
+	// PayloadEncryption configures encryption of workflow inputs, results, details and
+	// signal inputs before they are written to history and mutable state. Payloads are
+	// decrypted before history is replicated or exported, so clusters don't need to share keys
+	PayloadEncryption struct {
+		// Enabled turns on payload encryption
+		Enabled bool `yaml:"enabled"`
+		// Keys maps a key ID to a base64 encoded AES key of 16, 24 or 32 bytes
+		Keys map[string]string `yaml:"keys"`
+		// DomainKeys maps a domain ID to the ID of the key used to encrypt its new payloads
+		DomainKeys map[string]string `yaml:"domainKeys"`
+		// DefaultKeyID is the key used for domains not listed in DomainKeys,
+		// payloads of those domains are stored in plaintext when it is empty
+		DefaultKeyID string `yaml:"defaultKeyID"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -163,8 +202,8 @@ type (
 		ESIndexMaxResultWindow dynamicconfig.IntPropertyFn
 		// MaxQPS is overall max QPS
 		MaxQPS dynamicconfig.IntPropertyFn
-		// ValidSearchAttributes is legal indexed keys that can be used in list APIs
-		ValidSearchAttributes dynamicconfig.MapPropertyFn
+		// ValidSearchAttributes is legal indexed keys that can be used in list APIs of a domain
+		ValidSearchAttributes dynamicconfig.MapPropertyFnWithDomainFilter
 	}
 
 	// Cassandra contains configuration to connect to Cassandra cluster
@@ -199,10 +238,10 @@ type (
 		DriverName string `yaml:"driverName" validate:"nonzero"`
 		// DatabaseName is the name of SQL database to connect to
 		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
-		// ConnectAddr is the remote addr of the database
-		ConnectAddr string `yaml:"connectAddr" validate:"nonzero"`
+		// ConnectAddr is the remote addr of the database, not needed for embedded databases e.g. sqlite
+		ConnectAddr string `yaml:"connectAddr"`
 		// ConnectProtocol is the protocol that goes with the ConnectAddr ex - tcp, unix
-		ConnectProtocol string `yaml:"connectProtocol" validate:"nonzero"`
+		ConnectProtocol string `yaml:"connectProtocol"`
 		// ConnectAttributes is a set of key-value attributes to be sent as part of connect data_source_name url
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 		// MaxQPS the max request rate on this datastore
@@ -288,6 +327,26 @@ type (
 		ToDC   string `yaml:"toDC"`
 	}
 
+	// Authorization contains the frontend authorization config
+	Authorization struct {
+		// Authorizer is the name of the authorizer to use, either noop (default) or static
+		Authorizer string `yaml:"authorizer"`
+		// Static is the policy used by the static authorizer
+		Static StaticAuthorization `yaml:"static"`
+		// TokenKey is the base64 encoded key signing the tokens which identify callers,
+		// every caller is anonymous to the authorizer when it is empty
+		TokenKey string `yaml:"tokenKey"`
+	}
+
+	// StaticAuthorization is a config driven authorization policy. The wildcard "*"
+	// can be used in place of a caller, a domain or an API name to match all of them
+	StaticAuthorization struct {
+		// Roles maps a caller identity to the roles granted to it
+		Roles map[string][]string `yaml:"roles"`
+		// Domains maps a domain name to the APIs each role is allowed to call on it
+		Domains map[string]map[string][]string `yaml:"domains"`
+	}
+
 	// Metrics contains the config items for metrics subsystem
 	Metrics struct {
 		// M3 is the configuration for m3 metrics reporter
@@ -337,6 +396,7 @@ type (
 	// HistoryArchiverProvider contains the config for all history archivers
 	HistoryArchiverProvider struct {
 		Filestore *FilestoreArchiver `yaml:"filestore"`
+		S3store   *S3Archiver        `yaml:"s3store"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -352,12 +412,30 @@ type (
 	// VisibilityArchiverProvider contains the config for all visibility archivers
 	VisibilityArchiverProvider struct {
 		Filestore *FilestoreArchiver `yaml:"filestore"`
+		S3store   *S3Archiver        `yaml:"s3store"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// Compression is the default codec for history files, one of none, gzip, zstd or snappy.
+		// Domains can override it with the codec query parameter of their history archival URI.
+		Compression string `yaml:"compression"`
+	}
+
+	// S3Archiver contains the config for the s3 archiver
+	S3Archiver struct {
+		// Region is the region of the buckets, required even by most S3 compatible stores
+		Region string `yaml:"region"`
+		// Endpoint overrides the default AWS endpoint, used to target S3 compatible stores
+		Endpoint string `yaml:"endpoint"`
+		// S3ForcePathStyle addresses buckets as endpoint/bucket instead of bucket.endpoint
+		S3ForcePathStyle bool `yaml:"s3ForcePathStyle"`
+		// AccessKeyID and SecretAccessKey are static credentials, the default
+		// AWS credential chain is used when they are empty
+		AccessKeyID     string `yaml:"accessKeyID"`
+		SecretAccessKey string `yaml:"secretAccessKey"`
 	}
 
 	// PublicClient is config for connecting to cadence frontend
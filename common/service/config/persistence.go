@@ -27,6 +27,10 @@ const (
 	StoreTypeSQL = "sql"
 	// StoreTypeCassandra refers to cassandra as persistence store
 	StoreTypeCassandra = "cassandra"
+
+	// sqliteDriverName is the name of the embedded sqlite plugin,
+	// which needs no remote address to connect to
+	sqliteDriverName = "sqlite"
 )
 
 // SetMaxQPS sets the MaxQPS value for the given datastore
@@ -68,6 +72,14 @@ func (c *Persistence) Validate() error {
 			ds.SQL.NumShards = 1
 		}
 	}
+	for name, ds := range c.DataStores {
+		if ds.SQL == nil || ds.SQL.DriverName == sqliteDriverName {
+			continue
+		}
+		if ds.SQL.ConnectAddr == "" || ds.SQL.ConnectProtocol == "" {
+			return fmt.Errorf("persistence config: datastore %v: connectAddr and connectProtocol are required for driver %v", name, ds.SQL.DriverName)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,68 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type PersistenceSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestPersistenceSuite(t *testing.T) {
+	suite.Run(t, new(PersistenceSuite))
+}
+
+func (s *PersistenceSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *PersistenceSuite) TestValidateSQLConnectAddr() {
+	testCases := []struct {
+		sql   SQL
+		valid bool
+	}{
+		{sql: SQL{DriverName: "mysql", DatabaseName: "cadence", ConnectAddr: "127.0.0.1:3306", ConnectProtocol: "tcp"}, valid: true},
+		{sql: SQL{DriverName: "mysql", DatabaseName: "cadence", ConnectProtocol: "tcp"}, valid: false},
+		{sql: SQL{DriverName: "postgres", DatabaseName: "cadence", ConnectAddr: "127.0.0.1:5432"}, valid: false},
+		{sql: SQL{DriverName: "sqlite", DatabaseName: "cadence"}, valid: true},
+	}
+
+	for _, tc := range testCases {
+		sql := tc.sql
+		cfg := &Persistence{
+			DefaultStore:    "default",
+			VisibilityStore: "default",
+			DataStores:      map[string]DataStore{"default": {SQL: &sql}},
+		}
+		err := cfg.Validate()
+		if tc.valid {
+			s.NoError(err, sql.DriverName)
+		} else {
+			s.Error(err, sql.DriverName)
+		}
+	}
+}
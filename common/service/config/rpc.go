@@ -21,6 +21,7 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 
@@ -54,9 +55,13 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 	// Setup dispatcher for onebox
 	var err error
 	hostAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.Port)
-	d.ch, err = tchannel.NewChannelTransport(
-		tchannel.ServiceName(d.serviceName),
-		tchannel.ListenAddr(hostAddress))
+	if d.config.TLS.Enabled {
+		d.ch, err = d.newTLSChannelTransport(hostAddress)
+	} else {
+		d.ch, err = tchannel.NewChannelTransport(
+			tchannel.ServiceName(d.serviceName),
+			tchannel.ListenAddr(hostAddress))
+	}
 	if err != nil {
 		d.logger.Fatal("Failed to create transport channel", tag.Error(err))
 	}
@@ -84,6 +89,29 @@ func (d *RPCFactory) CreateDispatcherForOutbound(
 	return dispatcher
 }
 
+// newTLSChannelTransport creates a transport serving TLS on the host address,
+// outbound connections of the transport are made over TLS as well
+func (d *RPCFactory) newTLSChannelTransport(hostAddress string) (*tchannel.ChannelTransport, error) {
+	serverConfig, err := d.config.TLS.NewServerConfig()
+	if err != nil {
+		return nil, err
+	}
+	ch, err := d.config.TLS.NewChannel(d.serviceName)
+	if err != nil {
+		return nil, err
+	}
+	listener, err := tls.Listen("tcp", hostAddress, serverConfig)
+	if err != nil {
+		ch.Close()
+		return nil, err
+	}
+	if err := ch.Serve(listener); err != nil {
+		ch.Close()
+		return nil, err
+	}
+	return tchannel.NewChannelTransport(tchannel.WithChannel(ch))
+}
+
 func (d *RPCFactory) getListenIP() net.IP {
 	if d.config.BindOnLocalHost && len(d.config.BindOnIP) > 0 {
 		d.logger.Fatal("ListenIP failed, bindOnLocalHost and bindOnIP are mutually exclusive")
@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+
+	"github.com/opentracing/opentracing-go"
+	tcg "github.com/uber/tchannel-go"
+)
+
+// NewServerConfig builds the TLS config of a listener
+func (t *TLS) NewServerConfig() (*tls.Config, error) {
+	if t.CertFile == "" || t.KeyFile == "" {
+		return nil, errors.New("certFile and keyFile are required to serve TLS")
+	}
+	cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if t.RequireClientAuth {
+		if t.CaFile == "" {
+			return nil, errors.New("caFile is required to verify client certificates")
+		}
+		config.ClientCAs, err = loadCertPool(t.CaFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// NewClientConfig builds the TLS config of outbound connections,
+// the certificate is presented to servers requiring client certificates
+func (t *TLS) NewClientConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName: t.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if t.CaFile != "" {
+		pool, err := loadCertPool(t.CaFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if t.CertFile != "" || t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// NewChannel creates a tchannel channel, its outbound connections
+// are made over TLS when TLS is enabled
+func (t *TLS) NewChannel(serviceName string) (*tcg.Channel, error) {
+	opts := &tcg.ChannelOptions{Tracer: opentracing.GlobalTracer()}
+	if t.Enabled {
+		config, err := t.NewClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts.Dialer = NewTLSDialer(config)
+	}
+	return tcg.NewChannel(serviceName, opts)
+}
+
+// NewTLSDialer creates a dialer making TLS connections with the given config,
+// the deadline of the context bounds both the connect and the handshake
+func NewTLSDialer(config *tls.Config) func(ctx context.Context, network, hostPort string) (net.Conn, error) {
+	return func(ctx context.Context, network, hostPort string) (net.Conn, error) {
+		dialer := &net.Dialer{}
+		if deadline, ok := ctx.Deadline(); ok {
+			dialer.Deadline = deadline
+		}
+		return tls.DialWithDialer(dialer, network, hostPort, config)
+	}
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	caBytes, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caBytes) {
+		return nil, fmt.Errorf("no valid certificate found in %v", caFile)
+	}
+	return pool, nil
+}
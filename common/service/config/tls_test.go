@@ -0,0 +1,249 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/health"
+	"github.com/uber/cadence/.gen/go/health/metaclient"
+	"github.com/uber/cadence/.gen/go/health/metaserver"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	tcg "github.com/uber/tchannel-go"
+)
+
+type healthHandler struct{}
+
+type TLSSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	dir        string
+	caFile     string
+	serverCert string
+	serverKey  string
+	clientCert string
+	clientKey  string
+	servers    []*tcg.Channel
+}
+
+func TestTLSSuite(t *testing.T) {
+	suite.Run(t, new(TLSSuite))
+}
+
+func (s *TLSSuite) SetupSuite() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.dir, err = ioutil.TempDir("", "TestTLSSuite")
+	s.NoError(err)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	s.NoError(err)
+	s.caFile = s.writePEM("ca.pem", "CERTIFICATE", caDER)
+
+	s.serverCert, s.serverKey = s.issue("server", 2, caTemplate, caKey, x509.ExtKeyUsageServerAuth)
+	s.clientCert, s.clientKey = s.issue("client", 3, caTemplate, caKey, x509.ExtKeyUsageClientAuth)
+}
+
+func (s *TLSSuite) TearDownSuite() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *TLSSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *TLSSuite) TearDownTest() {
+	for _, ch := range s.servers {
+		ch.Close()
+	}
+	s.servers = nil
+}
+
+func (s *TLSSuite) TestNewServerConfig_Invalid() {
+	_, err := (&TLS{Enabled: true}).NewServerConfig()
+	s.Error(err)
+
+	_, err = (&TLS{Enabled: true, CertFile: s.serverCert, KeyFile: s.serverKey, RequireClientAuth: true}).NewServerConfig()
+	s.Error(err)
+
+	_, err = (&TLS{Enabled: true, CertFile: s.serverCert, KeyFile: s.serverKey, CaFile: s.serverKey, RequireClientAuth: true}).NewServerConfig()
+	s.Error(err)
+}
+
+func (s *TLSSuite) TestMutualTLS() {
+	hostPort := s.startServer(TLS{
+		Enabled:           true,
+		CertFile:          s.serverCert,
+		KeyFile:           s.serverKey,
+		CaFile:            s.caFile,
+		RequireClientAuth: true,
+	})
+
+	s.NoError(s.ping(TLS{
+		Enabled:  true,
+		CertFile: s.clientCert,
+		KeyFile:  s.clientKey,
+		CaFile:   s.caFile,
+	}, hostPort))
+
+	// client certificate is required
+	s.Error(s.ping(TLS{Enabled: true, CaFile: s.caFile}, hostPort))
+	// plaintext connections are rejected
+	s.Error(s.ping(TLS{}, hostPort))
+}
+
+func (s *TLSSuite) TestServerOnlyTLS() {
+	hostPort := s.startServer(TLS{
+		Enabled:  true,
+		CertFile: s.serverCert,
+		KeyFile:  s.serverKey,
+	})
+
+	s.NoError(s.ping(TLS{Enabled: true, CaFile: s.caFile}, hostPort))
+	s.NoError(s.ping(TLS{Enabled: true, CaFile: s.caFile, ServerName: "localhost"}, hostPort))
+	// server certificate is not trusted by the system roots
+	s.Error(s.ping(TLS{Enabled: true}, hostPort))
+	// server certificate is not valid for the server name
+	s.Error(s.ping(TLS{Enabled: true, CaFile: s.caFile, ServerName: "some.other.host"}, hostPort))
+}
+
+func (s *TLSSuite) TestRPCFactory() {
+	serverTLS := TLS{
+		Enabled:           true,
+		CertFile:          s.serverCert,
+		KeyFile:           s.serverKey,
+		CaFile:            s.caFile,
+		RequireClientAuth: true,
+	}
+	server := newRPCFactory(&RPC{BindOnLocalHost: true, TLS: serverTLS}, "tls-test-server", loggerimpl.NewNopLogger())
+	serverDispatcher := server.CreateDispatcher()
+	serverDispatcher.Register(metaserver.New(&healthHandler{}))
+	s.NoError(serverDispatcher.Start())
+	defer serverDispatcher.Stop()
+	hostPort := server.ch.ListenAddr()
+
+	clientTLS := TLS{
+		Enabled:  true,
+		CertFile: s.clientCert,
+		KeyFile:  s.clientKey,
+		CaFile:   s.caFile,
+	}
+	client := newRPCFactory(&RPC{BindOnLocalHost: true, TLS: clientTLS}, "tls-test-client", loggerimpl.NewNopLogger())
+	clientDispatcher := client.CreateDispatcher()
+	s.NoError(clientDispatcher.Start())
+	defer clientDispatcher.Stop()
+	outboundDispatcher := client.CreateDispatcherForOutbound("tls-test-client", "tls-test-server", hostPort)
+	defer outboundDispatcher.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	status, err := metaclient.New(outboundDispatcher.ClientConfig("tls-test-server")).Health(ctx)
+	s.NoError(err)
+	s.True(status.GetOk())
+}
+
+func (s *TLSSuite) startServer(cfg TLS) string {
+	serverConfig, err := cfg.NewServerConfig()
+	s.NoError(err)
+	ch, err := cfg.NewChannel("tls-test-server")
+	s.NoError(err)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	s.NoError(err)
+	s.NoError(ch.Serve(listener))
+	s.servers = append(s.servers, ch)
+	return ch.PeerInfo().HostPort
+}
+
+func (s *TLSSuite) ping(cfg TLS, hostPort string) error {
+	ch, err := cfg.NewChannel("tls-test-client")
+	s.NoError(err)
+	defer ch.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return ch.Ping(ctx, hostPort)
+}
+
+func (s *TLSSuite) issue(
+	name string,
+	serial int64,
+	ca *x509.Certificate,
+	caKey *ecdsa.PrivateKey,
+	usage x509.ExtKeyUsage,
+) (string, string) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	s.NoError(err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	s.NoError(err)
+	return s.writePEM(name+".pem", "CERTIFICATE", der), s.writePEM(name+"-key.pem", "EC PRIVATE KEY", keyDER)
+}
+
+func (h *healthHandler) Health(ctx context.Context) (*health.HealthStatus, error) {
+	return &health.HealthStatus{Ok: true}, nil
+}
+
+func (s *TLSSuite) writePEM(name string, blockType string, der []byte) string {
+	path := filepath.Join(s.dir, name)
+	s.NoError(ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+	return path
+}
@@ -93,6 +93,9 @@ type StringPropertyFn func(opts ...FilterOption) string
 // MapPropertyFn is a wrapper to get map property from dynamic config
 type MapPropertyFn func(opts ...FilterOption) map[string]interface{}
 
+// MapPropertyFnWithDomainFilter is a wrapper to get map property from dynamic config
+type MapPropertyFnWithDomainFilter func(domain string) map[string]interface{}
+
 // StringPropertyFnWithDomainFilter is a wrapper to get string property from dynamic config
 type StringPropertyFnWithDomainFilter func(domain string) string
 
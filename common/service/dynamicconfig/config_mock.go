@@ -78,3 +78,8 @@ func GetStringPropertyFn(value string) func(opts ...FilterOption) string {
 func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) map[string]interface{} {
 	return func(...FilterOption) map[string]interface{} { return value }
 }
+
+// GetMapPropertyFnFilteredByDomain returns value as MapPropertyFnWithDomainFilter
+func GetMapPropertyFnFilteredByDomain(value map[string]interface{}) func(domain string) map[string]interface{} {
+	return func(domain string) map[string]interface{} { return value }
+}
@@ -66,6 +66,7 @@ var keys = map[Key]string{
 	MinRetentionDays:                    "system.minRetentionDays",
 	MaxDecisionStartToCloseSeconds:      "system.maxDecisionStartToCloseSeconds",
 	EnableBatcher:                       "worker.enableBatcher",
+	EnableScheduler:                     "worker.enableScheduler",
 	EnableParentClosePolicyWorker:       "system.enableParentClosePolicyWorker",
 
 	// size limit
@@ -116,6 +117,15 @@ var keys = map[Key]string{
 	MatchingForwarderMaxOutstandingTasks:    "matching.forwarderMaxOutstandingTasks",
 	MatchingForwarderMaxRatePerSecond:       "matching.forwarderMaxRatePerSecond",
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
+	MatchingEnablePartitionAutoScale:        "matching.enablePartitionAutoScale",
+	MatchingMaxTasklistPartitions:           "matching.maxTasklistPartitions",
+	MatchingPartitionUpscaleRPS:             "matching.partitionUpscaleRPS",
+	MatchingPartitionDownscaleFactor:        "matching.partitionDownscaleFactor",
+	MatchingPartitionUpscaleSustainedTime:   "matching.partitionUpscaleSustainedTime",
+	MatchingPartitionDownscaleSustainedTime: "matching.partitionDownscaleSustainedTime",
+	MatchingPartitionScaleInterval:          "matching.partitionScaleInterval",
+	MatchingPartitionConfigRefreshInterval:  "matching.partitionConfigRefreshInterval",
+	MatchingOutstandingDecisionTaskTimeout:  "matching.outstandingDecisionTaskTimeout",
 
 	// history settings
 	HistoryRPS:                                            "history.rps",
@@ -187,6 +197,7 @@ var keys = map[Key]string{
 	HistoryThrottledLogRPS:                                "history.throttledLogRPS",
 	StickyTTL:                                             "history.stickyTTL",
 	DecisionHeartbeatTimeout:                              "history.decisionHeartbeatTimeout",
+	MaxBufferedQueryCount:                                 "history.maxBufferedQueryCount",
 	ParentClosePolicyThreshold:                            "history.parentClosePolicyThreshold",
 	NumParentClosePolicySystemWorkflows:                   "history.numParentClosePolicySystemWorkflows",
 
@@ -213,6 +224,10 @@ var keys = map[Key]string{
 	WorkerTimeLimitPerArchivalIteration:             "worker.TimeLimitPerArchivalIteration",
 	WorkerThrottledLogRPS:                           "worker.throttledLogRPS",
 	ScannerPersistenceMaxQPS:                        "worker.scannerPersistenceMaxQPS",
+	ExecutionsScannerEnabled:                        "worker.executionsScannerEnabled",
+	ExecutionsScannerFixEnabled:                     "worker.executionsScannerFixEnabled",
+	QueueScannerEnabled:                             "worker.queueScannerEnabled",
+	QueueScannerDryRun:                              "worker.queueScannerDryRun",
 }
 
 const (
@@ -360,6 +375,25 @@ const (
 	MatchingForwarderMaxRatePerSecond
 	// MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task list partition tree
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingEnablePartitionAutoScale is to enable adding/removing task list partitions at runtime based on load
+	MatchingEnablePartitionAutoScale
+	// MatchingMaxTasklistPartitions is the upper bound of partitions a task list can be scaled out to
+	MatchingMaxTasklistPartitions
+	// MatchingPartitionUpscaleRPS is the add/poll rate per partition above which a task list is scaled out
+	MatchingPartitionUpscaleRPS
+	// MatchingPartitionDownscaleFactor is the fraction of the upscale rate per partition below which a task list is scaled in
+	MatchingPartitionDownscaleFactor
+	// MatchingPartitionUpscaleSustainedTime is how long the upscale condition must hold before partitions are added
+	MatchingPartitionUpscaleSustainedTime
+	// MatchingPartitionDownscaleSustainedTime is how long the downscale condition must hold before partitions are removed
+	MatchingPartitionDownscaleSustainedTime
+	// MatchingPartitionScaleInterval is the interval at which the root partition evaluates its partition count
+	MatchingPartitionScaleInterval
+	// MatchingPartitionConfigRefreshInterval is the interval at which clients refresh the partition config of a task list
+	MatchingPartitionConfigRefreshInterval
+	// MatchingOutstandingDecisionTaskTimeout is the max time a decision task counts against the max outstanding
+	// tasks of its task list when the worker never responds
+	MatchingOutstandingDecisionTaskTimeout
 
 	// key for history
 
@@ -507,6 +541,8 @@ const (
 	StickyTTL
 	// DecisionHeartbeatTimeout for decision heartbeat
 	DecisionHeartbeatTimeout
+	// MaxBufferedQueryCount indicates the maximum number of strongly consistent queries which can be buffered for a workflow
+	MaxBufferedQueryCount
 
 	// key for worker
 
@@ -556,8 +592,18 @@ const (
 	WorkerThrottledLogRPS
 	// ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner
 	ScannerPersistenceMaxQPS
+	// ExecutionsScannerEnabled decides whether start the executions scanner in worker.Scanner
+	ExecutionsScannerEnabled
+	// ExecutionsScannerFixEnabled decides whether the executions scanner fixes the corrupted executions it finds
+	ExecutionsScannerFixEnabled
+	// QueueScannerEnabled decides whether start the transfer and timer queue scanner in worker.Scanner
+	QueueScannerEnabled
+	// QueueScannerDryRun decides whether the queue scanner only reports the dead tasks instead of deleting them
+	QueueScannerDryRun
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
+	// EnableScheduler decides whether start scheduler in our worker
+	EnableScheduler
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker
 
@@ -76,6 +76,7 @@ type (
 		DynamicConfig       dynamicconfig.Client
 		DispatcherProvider  client.DispatcherProvider
 		DCRedirectionPolicy config.DCRedirectionPolicy
+		Authorization       config.Authorization
 		PublicClient        workflowserviceclient.Interface
 		ArchivalMetadata    archiver.ArchivalMetadata
 		ArchiverProvider    provider.ArchiverProvider
@@ -141,9 +142,14 @@ func New(params *BootstrapParams) Service {
 		dynamicCollection:     dynamicconfig.NewCollection(params.DynamicConfig, params.Logger),
 		archivalMetadata:      params.ArchivalMetadata,
 		archiverProvider:      params.ArchiverProvider,
-		serializer:            persistence.NewPayloadSerializer(),
 	}
 
+	payloadCodec, err := persistence.NewPayloadCodec(params.PersistenceConfig.PayloadEncryption)
+	if err != nil {
+		sVice.logger.WithTags(tag.Error(err)).Fatal("Invalid payload encryption config")
+	}
+	sVice.serializer = persistence.NewPayloadSerializerWithCodec(payloadCodec)
+
 	sVice.runtimeMetricsReporter = metrics.NewRuntimeMetricsReporter(params.MetricScope, time.Minute, sVice.GetLogger(), params.InstanceID)
 	sVice.dispatcher = sVice.rpcFactory.CreateDispatcher()
 	if sVice.dispatcher == nil {
@@ -82,6 +82,9 @@ func NewTestService(
 		panic(err)
 	}
 	logger := loggerimpl.NewLogger(zapLogger)
+	if serializer == nil {
+		serializer = persistence.NewPayloadSerializer()
+	}
 
 	return &serviceTestBase{
 		hostInfo:         testHostInfo,
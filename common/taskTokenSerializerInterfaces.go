@@ -37,6 +37,9 @@ type (
 		ScheduleID      int64  `json:"scheduleId"`
 		ScheduleAttempt int64  `json:"scheduleAttempt"`
 		ActivityID      string `json:"activityId"`
+		// TaskList is only set when the task holds a slot on a task list partition
+		// capping outstanding tasks, the slot is released once the worker responds
+		TaskList string `json:"taskList,omitempty"`
 	}
 
 	// QueryTaskToken identifies a query task
@@ -307,6 +307,8 @@ func CreateMatchingPollForDecisionTaskResponse(historyResponse *h.RecordDecision
 		BranchToken:               historyResponse.BranchToken,
 		ScheduledTimestamp:        historyResponse.ScheduledTimestamp,
 		StartedTimestamp:          historyResponse.StartedTimestamp,
+		Updates:                   historyResponse.Updates,
+		Queries:                   historyResponse.Queries,
 	}
 	if historyResponse.GetPreviousStartedEventId() != EmptyEventID {
 		matchingResp.PreviousStartedEventId = historyResponse.PreviousStartedEventId
@@ -394,12 +396,17 @@ func CreateHistoryStartWorkflowRequest(
 		DomainUUID:   StringPtr(domainID),
 		StartRequest: startRequest,
 	}
+	// the workflow is considered started only after the requested delay,
+	// so both the retry deadline and the cron schedule are computed from that point
+	delayStartSeconds := startRequest.GetDelayStartSeconds()
+	delayedStartTime := now.Add(time.Second * time.Duration(delayStartSeconds))
 	if startRequest.RetryPolicy != nil && startRequest.RetryPolicy.GetExpirationIntervalInSeconds() > 0 {
 		expirationInSeconds := startRequest.RetryPolicy.GetExpirationIntervalInSeconds()
-		deadline := now.Add(time.Second * time.Duration(expirationInSeconds))
+		deadline := delayedStartTime.Add(time.Second * time.Duration(expirationInSeconds))
 		histRequest.ExpirationTimestamp = Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
 	}
-	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(backoff.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), now, now))
+	cronBackoffSeconds := backoff.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), delayedStartTime, delayedStartTime)
+	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(delayStartSeconds + cronBackoffSeconds)
 	return histRequest
 }
 
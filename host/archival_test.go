@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/admin"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/tag"
@@ -100,6 +101,84 @@ func (s *integrationSuite) TestArchival_ArchiverWorker() {
 	s.True(s.isMutableStateDeleted(domainID, execution))
 }
 
+func (s *integrationSuite) TestArchival_VerifyAndRearchive() {
+	s.True(s.testCluster.archiverBase.metadata.GetHistoryConfig().ClusterConfiguredForArchival())
+
+	domainID := s.getDomainID(s.archivalDomainName)
+	workflowID := "archival-verify-workflow-id"
+	workflowType := "archival-verify-workflow-type"
+	taskList := "archival-verify-task-list"
+	runID := s.startAndFinishWorkflow(workflowID, workflowType, taskList, s.archivalDomainName, domainID, 1, 1)[0]
+
+	execution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+	s.True(s.isHistoryArchived(s.archivalDomainName, execution))
+
+	verifyResp, err := s.adminClient.VerifyArchivedWorkflowExecution(createContext(), &admin.VerifyArchivedWorkflowExecutionRequest{
+		Domain:    common.StringPtr(s.archivalDomainName),
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Equal(admin.ArchivalVerificationStatusVerified, verifyResp.GetStatus())
+
+	verifyResp, err = s.adminClient.VerifyArchivedWorkflowExecution(createContext(), &admin.VerifyArchivedWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.archivalDomainName),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+	})
+	s.NoError(err)
+	s.Equal(admin.ArchivalVerificationStatusMissing, verifyResp.GetStatus())
+
+	// history is deleted once archived so it can no longer be rearchived
+	s.True(s.isMutableStateDeleted(domainID, execution))
+	err = s.adminClient.RearchiveWorkflowExecution(createContext(), &admin.RearchiveWorkflowExecutionRequest{
+		Domain:    common.StringPtr(s.archivalDomainName),
+		Execution: execution,
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *integrationSuite) TestArchival_DescribeArchivedWorkflow() {
+	s.True(s.testCluster.archiverBase.metadata.GetHistoryConfig().ClusterConfiguredForArchival())
+
+	domainID := s.getDomainID(s.archivalDomainName)
+	workflowID := "archival-describe-workflow-id"
+	workflowType := "archival-describe-workflow-type"
+	taskList := "archival-describe-task-list"
+	runID := s.startAndFinishWorkflow(workflowID, workflowType, taskList, s.archivalDomainName, domainID, 1, 1)[0]
+
+	execution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+	s.True(s.isHistoryArchived(s.archivalDomainName, execution))
+	s.True(s.isMutableStateDeleted(domainID, execution))
+
+	describeResp, err := s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(s.archivalDomainName),
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Equal(workflowID, describeResp.WorkflowExecutionInfo.Execution.GetWorkflowId())
+	s.Equal(runID, describeResp.WorkflowExecutionInfo.Execution.GetRunId())
+	s.Equal(workflowType, describeResp.WorkflowExecutionInfo.Type.GetName())
+	s.NotNil(describeResp.WorkflowExecutionInfo.CloseStatus)
+	s.NotNil(describeResp.WorkflowExecutionInfo.CloseTime)
+
+	_, err = s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.archivalDomainName),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
 func (s *integrationSuite) TestVisibilityArchival() {
 	s.True(s.testCluster.archiverBase.metadata.GetVisibilityConfig().ClusterConfiguredForArchival())
 
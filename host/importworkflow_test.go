@@ -0,0 +1,185 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package host
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/admin"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+func (s *integrationSuite) TestImportWorkflowExecution() {
+	workflowID := "integration-import-workflow-test"
+	importedWorkflowID := workflowID + "-imported"
+	workflowType := &workflow.WorkflowType{Name: common.StringPtr("integration-import-workflow-test-type")}
+	taskList := &workflow.TaskList{Name: common.StringPtr("integration-import-workflow-test-tasklist")}
+	identity := "worker1"
+	activityName := "activity_type1"
+
+	we, err := s.engine.StartWorkflowExecution(createContext(), &workflow.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(s.domainName),
+		WorkflowId:                          common.StringPtr(workflowID),
+		WorkflowType:                        workflowType,
+		TaskList:                            taskList,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		Identity:                            common.StringPtr(identity),
+	})
+	s.Nil(err)
+	execution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      we.RunId,
+	}
+
+	activityScheduled := false
+	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision, error) {
+
+		if !activityScheduled {
+			activityScheduled = true
+			buf := new(bytes.Buffer)
+			s.Nil(binary.Write(buf, binary.LittleEndian, int32(1)))
+
+			return nil, []*workflow.Decision{{
+				DecisionType: common.DecisionTypePtr(workflow.DecisionTypeScheduleActivityTask),
+				ScheduleActivityTaskDecisionAttributes: &workflow.ScheduleActivityTaskDecisionAttributes{
+					ActivityId:                    common.StringPtr(strconv.Itoa(1)),
+					ActivityType:                  &workflow.ActivityType{Name: common.StringPtr(activityName)},
+					TaskList:                      taskList,
+					Input:                         buf.Bytes(),
+					ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+					ScheduleToStartTimeoutSeconds: common.Int32Ptr(25),
+					StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+					HeartbeatTimeoutSeconds:       common.Int32Ptr(25),
+				},
+			}}, nil
+		}
+
+		return nil, []*workflow.Decision{{
+			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+			CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("Done."),
+			},
+		}}, nil
+	}
+	atHandler := func(execution *workflow.WorkflowExecution, activityType *workflow.ActivityType,
+		activityID string, input []byte, taskToken []byte) ([]byte, bool, error) {
+
+		s.Equal(importedWorkflowID, execution.GetWorkflowId())
+		return []byte("Activity Result."), false, nil
+	}
+
+	poller := &TaskPoller{
+		Engine:          s.engine,
+		Domain:          s.domainName,
+		TaskList:        taskList,
+		Identity:        identity,
+		DecisionHandler: dtHandler,
+		ActivityHandler: atHandler,
+		Logger:          s.Logger,
+		T:               s.T(),
+	}
+
+	// schedule the activity, so the workflow is exported with a pending activity
+	_, err = poller.PollAndProcessDecisionTask(false, false)
+	s.Nil(err)
+
+	var historyBatches []*workflow.DataBlob
+	var token []byte
+	for continuePaging := true; continuePaging; continuePaging = len(token) != 0 {
+		resp, err := s.adminClient.GetWorkflowExecutionRawHistory(createContext(), &admin.GetWorkflowExecutionRawHistoryRequest{
+			Domain:          common.StringPtr(s.domainName),
+			Execution:       execution,
+			FirstEventId:    common.Int64Ptr(common.FirstEventID),
+			NextEventId:     common.Int64Ptr(common.EndEventID),
+			MaximumPageSize: common.Int32Ptr(100),
+			NextPageToken:   token,
+		})
+		s.Nil(err)
+		historyBatches = append(historyBatches, resp.HistoryBatches...)
+		token = resp.NextPageToken
+	}
+	s.Equal(3, len(historyBatches))
+
+	// the exported workflow is still running
+	_, err = s.adminClient.ImportWorkflowExecution(createContext(), &admin.ImportWorkflowExecutionRequest{
+		Domain:         common.StringPtr(s.domainName),
+		Execution:      &workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+		HistoryBatches: historyBatches,
+	})
+	s.IsType(&workflow.WorkflowExecutionAlreadyStartedError{}, err)
+
+	// the original workflow is terminated, so its activity cannot be processed anymore
+	err = s.engine.TerminateWorkflowExecution(createContext(), &workflow.TerminateWorkflowExecutionRequest{
+		Domain:            common.StringPtr(s.domainName),
+		WorkflowExecution: execution,
+		Reason:            common.StringPtr("imported"),
+		Identity:          common.StringPtr(identity),
+	})
+	s.Nil(err)
+
+	importResp, err := s.adminClient.ImportWorkflowExecution(createContext(), &admin.ImportWorkflowExecutionRequest{
+		Domain:         common.StringPtr(s.domainName),
+		Execution:      &workflow.WorkflowExecution{WorkflowId: common.StringPtr(importedWorkflowID)},
+		HistoryBatches: historyBatches,
+	})
+	s.Nil(err)
+	s.NotEqual(execution.GetRunId(), importResp.GetRunId())
+	importedExecution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(importedWorkflowID),
+		RunId:      importResp.RunId,
+	}
+
+	descResp, err := s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(s.domainName),
+		Execution: importedExecution,
+	})
+	s.Nil(err)
+	s.Nil(descResp.WorkflowExecutionInfo.CloseStatus)
+	s.Equal(int64(5), descResp.WorkflowExecutionInfo.GetHistoryLength())
+	s.Equal(1, len(descResp.PendingActivities))
+
+	// the imported workflow continues from the rebuilt mutable state
+	for i := 0; i < 10; i++ {
+		if err = poller.PollAndProcessActivityTask(false); err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	s.Nil(err)
+	_, err = poller.PollAndProcessDecisionTask(false, false)
+	s.Nil(err)
+
+	historyResp, err := s.engine.GetWorkflowExecutionHistory(createContext(), &workflow.GetWorkflowExecutionHistoryRequest{
+		Domain:    common.StringPtr(s.domainName),
+		Execution: importedExecution,
+	})
+	s.Nil(err)
+	events := historyResp.History.Events
+	s.Equal(workflow.EventTypeWorkflowExecutionCompleted, events[len(events)-1].GetEventType())
+}
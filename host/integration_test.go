@@ -1037,6 +1037,124 @@ func (s *integrationSuite) TestCronWorkflow() {
 	}
 }
 
+func (s *integrationSuite) TestDelayStartWorkflow() {
+	id := "integration-wf-delay-start-test"
+	wt := "integration-wf-delay-start-type"
+	tl := "integration-wf-delay-start-tasklist"
+	identity := "worker1"
+
+	targetBackoffDuration := time.Second * 3
+	backoffDurationTolerance := time.Millisecond * 500
+
+	workflowType := &workflow.WorkflowType{}
+	workflowType.Name = common.StringPtr(wt)
+
+	taskList := &workflow.TaskList{}
+	taskList.Name = common.StringPtr(tl)
+
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(s.domainName),
+		WorkflowId:                          common.StringPtr(id),
+		WorkflowType:                        workflowType,
+		TaskList:                            taskList,
+		Input:                               nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		Identity:                            common.StringPtr(identity),
+		DelayStartSeconds:                   common.Int32Ptr(int32(targetBackoffDuration.Seconds())),
+	}
+
+	startWorkflowTS := time.Now()
+	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
+	s.Nil(err0)
+
+	s.Logger.Info("StartWorkflowExecution", tag.WorkflowRunID(*we.RunId))
+
+	// a signal must not cause the first decision to be scheduled before the delay elapses
+	err := s.engine.SignalWorkflowExecution(createContext(), &workflow.SignalWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(id),
+			RunId:      we.RunId,
+		},
+		SignalName: common.StringPtr("delay-start-signal"),
+		Identity:   common.StringPtr(identity),
+	})
+	s.Nil(err)
+
+	decisionCount := 0
+	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision, error) {
+		decisionCount++
+		return nil, []*workflow.Decision{
+			{
+				DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+				CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+					Result: []byte("delay-start-result"),
+				},
+			}}, nil
+	}
+
+	poller := &TaskPoller{
+		Engine:          s.engine,
+		Domain:          s.domainName,
+		TaskList:        taskList,
+		Identity:        identity,
+		DecisionHandler: dtHandler,
+		Logger:          s.Logger,
+		T:               s.T(),
+	}
+
+	dweResponse, err := s.engine.DescribeWorkflowExecution(createContext(), &workflow.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(id),
+			RunId:      we.RunId,
+		},
+	})
+	s.Nil(err)
+	executionInfo := dweResponse.WorkflowExecutionInfo
+	s.Equal(targetBackoffDuration.Nanoseconds(), executionInfo.GetExecutionTime()-executionInfo.GetStartTime())
+
+	startFilter := &workflow.StartTimeFilter{}
+	startFilter.EarliestTime = common.Int64Ptr(startWorkflowTS.UnixNano())
+	startFilter.LatestTime = common.Int64Ptr(time.Now().UnixNano())
+	resp, err := s.engine.ListOpenWorkflowExecutions(createContext(), &workflow.ListOpenWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(s.domainName),
+		MaximumPageSize: common.Int32Ptr(100),
+		StartTimeFilter: startFilter,
+		ExecutionFilter: &workflow.WorkflowExecutionFilter{
+			WorkflowId: common.StringPtr(id),
+		},
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.GetExecutions()))
+	executionInfo = resp.GetExecutions()[0]
+	s.Equal(targetBackoffDuration.Nanoseconds(), executionInfo.GetExecutionTime()-executionInfo.GetStartTime())
+
+	_, err = poller.PollAndProcessDecisionTask(false, false)
+	s.True(err == nil, err)
+	s.Equal(1, decisionCount)
+
+	// Make sure the workflow start running at a proper time, in this case 3 seconds after the
+	// startWorkflowExecution request
+	backoffDuration := time.Now().Sub(startWorkflowTS)
+	s.True(backoffDuration > targetBackoffDuration)
+	s.True(backoffDuration < targetBackoffDuration+backoffDurationTolerance)
+
+	events := s.getHistory(s.domainName, &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      we.RunId,
+	})
+	startAttributes := events[0].WorkflowExecutionStartedEventAttributes
+	s.Equal(int32(targetBackoffDuration.Seconds()), startAttributes.GetDelayStartSeconds())
+	s.Equal(int32(targetBackoffDuration.Seconds()), startAttributes.GetFirstDecisionTaskBackoffSeconds())
+	s.Equal(workflow.EventTypeWorkflowExecutionSignaled, events[1].GetEventType())
+	s.Equal(workflow.EventTypeDecisionTaskScheduled, events[2].GetEventType())
+	s.Equal(workflow.EventTypeWorkflowExecutionCompleted, events[len(events)-1].GetEventType())
+}
+
 func (s *integrationSuite) TestSequential_UserTimers() {
 	id := "integration-sequential-user-timers-test"
 	wt := "integration-sequential-user-timers-test-type"
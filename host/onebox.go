@@ -39,8 +39,10 @@ import (
 	"github.com/uber/cadence/common"
 	carchiver "github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -59,6 +61,7 @@ import (
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/replicator"
+	"github.com/uber/cadence/service/worker/scheduler"
 	cwsc "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
@@ -103,6 +106,7 @@ type (
 		replicator             *replicator.Replicator
 		clientWorker           archiver.ClientWorker
 		indexer                *indexer.Indexer
+		scheduler              *scheduler.Scheduler
 		enableEventsV2         bool
 		enbaleNDC              bool
 		archiverMetadata       carchiver.ArchivalMetadata
@@ -242,6 +246,9 @@ func (c *cadenceImpl) Stop() {
 	if c.workerConfig.EnableArchiver {
 		c.clientWorker.Stop()
 	}
+	if c.workerConfig.EnableScheduler {
+		c.scheduler.Stop()
+	}
 	close(c.shutdownCh)
 	c.shutdownWG.Wait()
 }
@@ -431,12 +438,24 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 
 	c.frontEndService = service.New(params)
 
-	c.adminHandler = frontend.NewAdminHandler(
-		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr, params)
-	c.adminHandler.RegisterHandler()
-
 	dc := dynamicconfig.NewCollection(params.DynamicConfig, c.logger)
 	frontendConfig := frontend.NewConfig(dc, c.historyConfig.NumHistoryShards, c.esConfig != nil)
+
+	domainHandler := domain.NewHandler(
+		frontendConfig.MinRetentionDays(),
+		frontendConfig.MaxBadBinaries,
+		c.logger,
+		c.metadataMgr,
+		c.clusterMetadata,
+		domain.NewDomainReplicator(replicationMessageSink, c.logger),
+		c.archiverMetadata,
+		c.archiverProvider,
+	)
+	c.adminHandler = frontend.NewAdminHandler(
+		c.frontEndService, c.historyConfig.NumHistoryShards, c.metadataMgr, c.historyMgr, c.historyV2Mgr, domainHandler, params)
+	accessControlledAdminHandler := frontend.NewAccessControlledAdminHandler(c.adminHandler, authorization.NewAnonymousAuthenticator(), authorization.NewNopAuthorizer())
+	accessControlledAdminHandler.RegisterHandler()
+
 	domainCache := cache.NewDomainCache(c.metadataMgr, c.clusterMetadata, c.frontEndService.GetMetricsClient(), c.logger)
 
 	historyArchiverBootstrapContainer := &carchiver.HistoryBootstrapContainer{
@@ -469,7 +488,8 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 		c.domainReplicationQueue,
 		domainCache)
 	dcRedirectionHandler := frontend.NewDCRedirectionHandler(c.frontendHandler, params.DCRedirectionPolicy)
-	dcRedirectionHandler.RegisterHandler()
+	accessControlledWorkflowHandler := frontend.NewAccessControlledWorkflowHandler(dcRedirectionHandler, authorization.NewAnonymousAuthenticator(), authorization.NewNopAuthorizer())
+	accessControlledWorkflowHandler.RegisterHandler()
 
 	// must start base service first
 	c.frontEndService.Start()
@@ -482,11 +502,11 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 		}
 	}
 
-	err = c.adminHandler.Start()
+	err = accessControlledAdminHandler.Start()
 	if err != nil {
 		c.logger.Fatal("Failed to start admin", tag.Error(err))
 	}
-	err = dcRedirectionHandler.Start()
+	err = accessControlledWorkflowHandler.Start()
 	if err != nil {
 		c.logger.Fatal("Failed to start frontend", tag.Error(err))
 	}
@@ -679,7 +699,14 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 	}
 
 	if c.workerConfig.EnableIndexer {
-		c.startWorkerIndexer(params, service)
+		metadataManager := persistence.NewMetadataPersistenceMetricsClient(c.metadataMgr, service.GetMetricsClient(), c.logger)
+		indexerDomainCache := cache.NewDomainCache(metadataManager, params.ClusterMetadata, service.GetMetricsClient(), service.GetLogger())
+		indexerDomainCache.Start()
+		c.startWorkerIndexer(params, service, indexerDomainCache)
+	}
+
+	if c.workerConfig.EnableScheduler {
+		c.startWorkerScheduler(params, service)
 	}
 
 	startWG.Done()
@@ -745,7 +772,7 @@ func (c *cadenceImpl) startWorkerClientWorker(params *service.BootstrapParams, s
 	}
 }
 
-func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, service service.Service) {
+func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, service service.Service, domainCache cache.DomainCache) {
 	params.DynamicConfig.UpdateValue(dynamicconfig.AdvancedVisibilityWritingMode, common.AdvancedVisibilityWritingModeDual)
 	workerConfig := worker.NewConfig(params)
 	c.indexer = indexer.NewIndexer(
@@ -753,6 +780,7 @@ func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, servic
 		c.messagingClient,
 		c.esClient,
 		c.esConfig,
+		domainCache,
 		c.logger,
 		service.GetMetricsClient())
 	if err := c.indexer.Start(); err != nil {
@@ -761,6 +789,20 @@ func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, servic
 	}
 }
 
+func (c *cadenceImpl) startWorkerScheduler(params *service.BootstrapParams, service service.Service) {
+	c.scheduler = scheduler.New(&scheduler.BootstrapParams{
+		ServiceClient: params.PublicClient,
+		MetricsClient: service.GetMetricsClient(),
+		Logger:        c.logger,
+		TallyScope:    params.MetricScope,
+		ClientBean:    service.GetClientBean(),
+	})
+	if err := c.scheduler.Start(); err != nil {
+		c.scheduler.Stop()
+		c.logger.Fatal("Fail to start scheduler when start worker", tag.Error(err))
+	}
+}
+
 func (c *cadenceImpl) createSystemDomain() error {
 
 	_, err := c.metadataMgr.CreateDomain(&persistence.CreateDomainRequest{
@@ -25,6 +25,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/pborman/uuid"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -683,3 +684,138 @@ func (s *integrationSuite) TestQueryWorkflow_BeforeFirstDecision() {
 	queryResultString := string(queryResult.Resp.QueryResult)
 	s.Equal("query-result", queryResultString)
 }
+
+func (s *integrationSuite) TestQueryWorkflow_Consistent() {
+	id := "interation-query-workflow-test-consistent"
+	wt := "interation-query-workflow-test-consistent-type"
+	tl := "interation-query-workflow-test-consistent-tasklist"
+	identity := "worker1"
+	queryType := "signal-count"
+
+	workflowType := &workflow.WorkflowType{}
+	workflowType.Name = common.StringPtr(wt)
+
+	taskList := &workflow.TaskList{}
+	taskList.Name = common.StringPtr(tl)
+
+	// Start workflow execution
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(s.domainName),
+		WorkflowId:                          common.StringPtr(id),
+		WorkflowType:                        workflowType,
+		TaskList:                            taskList,
+		Input:                               nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr(identity),
+	}
+
+	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
+	s.Nil(err0)
+
+	s.Logger.Info("StartWorkflowExecution", tag.WorkflowRunID(*we.RunId))
+
+	// decider logic, the workflow state is the number of signals received
+	signalCount := 0
+	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision, error) {
+
+		signalCount = 0
+		for _, event := range history.Events {
+			if event.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled {
+				signalCount++
+			}
+		}
+		return nil, []*workflow.Decision{}, nil
+	}
+
+	queriesOnDecisionTask := 0
+	queryHandler := func(task *workflow.PollForDecisionTaskResponse) ([]byte, error) {
+		s.NotNil(task.Query)
+		if len(task.QueriesByID) > 0 {
+			queriesOnDecisionTask++
+		}
+		if task.Query.GetQueryType() == queryType {
+			return []byte(strconv.Itoa(signalCount)), nil
+		}
+		return nil, errors.New("unknown-query-type")
+	}
+
+	poller := &TaskPoller{
+		Engine:          s.engine,
+		Domain:          s.domainName,
+		TaskList:        taskList,
+		Identity:        identity,
+		DecisionHandler: dtHandler,
+		QueryHandler:    queryHandler,
+		Logger:          s.Logger,
+		T:               s.T(),
+	}
+
+	// Make first decision
+	_, err := poller.PollAndProcessDecisionTask(false, false)
+	s.Logger.Info("PollAndProcessDecisionTask", tag.Error(err))
+	s.Nil(err)
+
+	type QueryResult struct {
+		Resp *workflow.QueryWorkflowResponse
+		Err  error
+	}
+	queryResultCh := make(chan QueryResult)
+	queryWorkflowFn := func(queryType string) {
+		queryResp, err := s.engine.QueryWorkflow(createContext(), &workflow.QueryWorkflowRequest{
+			Domain: common.StringPtr(s.domainName),
+			Execution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(id),
+				RunId:      common.StringPtr(*we.RunId),
+			},
+			Query: &workflow.WorkflowQuery{
+				QueryType: common.StringPtr(queryType),
+			},
+			QueryConsistencyLevel: workflow.QueryConsistencyLevelStrong.Ptr(),
+		})
+		queryResultCh <- QueryResult{Resp: queryResp, Err: err}
+	}
+
+	// the signal is not yet processed by the worker, a strongly consistent query is
+	// buffered and answered with the decision task which processes the signal
+	err = s.engine.SignalWorkflowExecution(createContext(), &workflow.SignalWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(id),
+			RunId:      common.StringPtr(*we.RunId),
+		},
+		SignalName: common.StringPtr("signal"),
+		Identity:   common.StringPtr(identity),
+	})
+	s.Nil(err)
+
+	go queryWorkflowFn(queryType)
+	// give the query time to be buffered before the decision task is started
+	time.Sleep(500 * time.Millisecond)
+	_, err = poller.PollAndProcessDecisionTask(false, false)
+	s.Nil(err)
+	queryResult := <-queryResultCh
+	s.NoError(queryResult.Err)
+	s.NotNil(queryResult.Resp)
+	s.Equal("1", string(queryResult.Resp.QueryResult))
+	s.Equal(1, queriesOnDecisionTask)
+
+	// without a pending decision task, a strongly consistent query is dispatched directly to the worker
+	go queryWorkflowFn(queryType)
+	for {
+		// loop until process the query task
+		isQueryTask, errInner := poller.PollAndProcessDecisionTask(false, false)
+		s.Logger.Info("PollAndProcessDecisionTask", tag.Error(errInner))
+		s.Nil(errInner)
+		if isQueryTask {
+			break
+		}
+	}
+	queryResult = <-queryResultCh
+	s.NoError(queryResult.Err)
+	s.NotNil(queryResult.Resp)
+	s.Equal("1", string(queryResult.Resp.QueryResult))
+	s.Equal(1, queriesOnDecisionTask)
+}
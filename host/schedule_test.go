@@ -0,0 +1,134 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package host
+
+import (
+	"time"
+
+	"github.com/pborman/uuid"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+func (s *integrationSuite) TestScheduleLifecycle() {
+	scheduleID := "integration-schedule-lifecycle-test"
+	identity := "worker1"
+
+	createRequest := &workflow.CreateScheduleRequest{
+		Domain:     common.StringPtr(s.domainName),
+		ScheduleId: common.StringPtr(scheduleID),
+		Spec: &workflow.ScheduleSpec{
+			CronExpression: common.StringPtr("0 0 1 1 *"),
+		},
+		Action: &workflow.ScheduleAction{
+			StartWorkflow: &workflow.StartWorkflowExecutionRequest{
+				WorkflowId:                          common.StringPtr("integration-schedule-lifecycle-test-wf"),
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("integration-schedule-lifecycle-test-type")},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr("integration-schedule-lifecycle-test-tasklist")},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+		Identity:  common.StringPtr(identity),
+		RequestId: common.StringPtr(uuid.New()),
+	}
+	s.Nil(s.engine.CreateSchedule(createContext(), createRequest))
+
+	createRequest.RequestId = common.StringPtr(uuid.New())
+	err := s.engine.CreateSchedule(createContext(), createRequest)
+	s.IsType(&workflow.WorkflowExecutionAlreadyStartedError{}, err)
+
+	describeRequest := &workflow.DescribeScheduleRequest{
+		Domain:     common.StringPtr(s.domainName),
+		ScheduleId: common.StringPtr(scheduleID),
+	}
+	describe := func() (*workflow.DescribeScheduleResponse, error) {
+		var resp *workflow.DescribeScheduleResponse
+		var err error
+		for i := 0; i < 10; i++ {
+			resp, err = s.engine.DescribeSchedule(createContext(), describeRequest)
+			if err == nil {
+				break
+			}
+			if _, ok := err.(*workflow.EntityNotExistsError); ok {
+				break
+			}
+			time.Sleep(500 * time.Millisecond)
+		}
+		return resp, err
+	}
+
+	resp, err := describe()
+	s.Nil(err)
+	s.Equal("0 0 1 1 *", resp.Spec.GetCronExpression())
+	s.False(resp.State.GetPaused())
+	s.Equal(int64(0), resp.Info.GetNumActions())
+	s.NotEmpty(resp.Info.NextActionTimestamps)
+
+	err = s.engine.PauseSchedule(createContext(), &workflow.PauseScheduleRequest{
+		Domain:     common.StringPtr(s.domainName),
+		ScheduleId: common.StringPtr(scheduleID),
+		Reason:     common.StringPtr("pause for test"),
+		Identity:   common.StringPtr(identity),
+	})
+	s.Nil(err)
+
+	for i := 0; i < 10; i++ {
+		resp, err = describe()
+		s.Nil(err)
+		if resp.State.GetPaused() {
+			break
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	s.True(resp.State.GetPaused())
+	s.Equal("pause for test", resp.State.GetNotes())
+
+	var listed bool
+	for i := 0; i < 20 && !listed; i++ {
+		listResp, err := s.engine.ListSchedules(createContext(), &workflow.ListSchedulesRequest{
+			Domain: common.StringPtr(s.domainName),
+		})
+		s.Nil(err)
+		for _, entry := range listResp.Schedules {
+			listed = listed || entry.GetScheduleId() == scheduleID
+		}
+		if !listed {
+			time.Sleep(500 * time.Millisecond)
+		}
+	}
+	s.True(listed)
+
+	err = s.engine.DeleteSchedule(createContext(), &workflow.DeleteScheduleRequest{
+		Domain:     common.StringPtr(s.domainName),
+		ScheduleId: common.StringPtr(scheduleID),
+		Identity:   common.StringPtr(identity),
+	})
+	s.Nil(err)
+
+	for i := 0; i < 10; i++ {
+		if _, err = describe(); err != nil {
+			break
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
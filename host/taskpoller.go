@@ -43,6 +43,8 @@ type (
 
 	queryHandler func(task *workflow.PollForDecisionTaskResponse) ([]byte, error)
 
+	updateHandler func(update *workflow.WorkflowUpdate) *workflow.WorkflowUpdateResult
+
 	// TaskPoller is used in integration tests to poll decision or activity tasks
 	TaskPoller struct {
 		Engine                              FrontendClient
@@ -54,6 +56,7 @@ type (
 		DecisionHandler                     decisionTaskHandler
 		ActivityHandler                     activityTaskHandler
 		QueryHandler                        queryHandler
+		UpdateHandler                       updateHandler
 		Logger                              log.Logger
 		T                                   *testing.T
 	}
@@ -208,6 +211,37 @@ Loop:
 			})
 		}
 
+		// answer the strongly consistent queries delivered with the decision task
+		var queryResults map[string]*workflow.WorkflowQueryResult
+		if p.QueryHandler != nil && len(response.QueriesByID) > 0 {
+			queryResults = make(map[string]*workflow.WorkflowQueryResult)
+			for id, query := range response.QueriesByID {
+				queryTask := *response
+				queryTask.Query = query
+				blob, err := p.QueryHandler(&queryTask)
+				if err != nil {
+					queryResults[id] = &workflow.WorkflowQueryResult{
+						ResultType:  workflow.QueryResultTypeFailed.Ptr(),
+						ErrorReason: common.StringPtr(err.Error()),
+					}
+				} else {
+					queryResults[id] = &workflow.WorkflowQueryResult{
+						ResultType: workflow.QueryResultTypeAnswered.Ptr(),
+						Answer:     blob,
+					}
+				}
+			}
+		}
+
+		var updateResults []*workflow.WorkflowUpdateResult
+		if p.UpdateHandler != nil {
+			for _, update := range response.Updates {
+				if result := p.UpdateHandler(update); result != nil {
+					updateResults = append(updateResults, result)
+				}
+			}
+		}
+
 		p.Logger.Info("Completing Decision.  Decisions", tag.Value(decisions))
 		if !respondStickyTaskList {
 			// non sticky tasklist
@@ -216,6 +250,8 @@ Loop:
 				Identity:                   common.StringPtr(p.Identity),
 				ExecutionContext:           executionCtx,
 				Decisions:                  decisions,
+				QueryResultsByID:           queryResults,
+				UpdateResults:              updateResults,
 				ReturnNewDecisionTask:      common.BoolPtr(forceCreateNewDecision),
 				ForceCreateNewDecisionTask: common.BoolPtr(forceCreateNewDecision),
 			})
@@ -229,6 +265,8 @@ Loop:
 				Identity:         common.StringPtr(p.Identity),
 				ExecutionContext: executionCtx,
 				Decisions:        decisions,
+				QueryResultsByID: queryResults,
+				UpdateResults:    updateResults,
 				StickyAttributes: &workflow.StickyExecutionAttributes{
 					WorkerTaskList:                p.StickyTaskList,
 					ScheduleToStartTimeoutSeconds: p.StickyScheduleToStartTimeoutSeconds,
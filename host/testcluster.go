@@ -92,6 +92,7 @@ type (
 		EnableArchiver   bool
 		EnableIndexer    bool
 		EnableReplicator bool
+		EnableScheduler  bool
 	}
 )
 
@@ -117,6 +118,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 	}
 
 	options.Persistence.StoreType = TestFlags.PersistenceType
+	options.Persistence.SQLDBPluginName = TestFlags.SQLPluginName
 	options.Persistence.ClusterMetadata = clusterMetadata
 	testBase := persistencetests.NewTestBase(&options.Persistence)
 	testBase.Setup()
@@ -142,7 +144,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		visConfig := &config.VisibilityConfig{
 			VisibilityListMaxQPS:   dynamicconfig.GetIntPropertyFilteredByDomain(2000),
 			ESIndexMaxResultWindow: dynamicconfig.GetIntPropertyFn(defaultTestValueOfESIndexMaxResultWindow),
-			ValidSearchAttributes:  dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+			ValidSearchAttributes:  dynamicconfig.GetMapPropertyFnFilteredByDomain(definition.GetDefaultIndexedKeys()),
 		}
 		esVisibilityStore := pes.NewElasticSearchVisibilityStore(esClient, indexName, visProducer, visConfig, logger)
 		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, logger)
@@ -155,7 +157,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 	cadenceParams := &CadenceParams{
 		ClusterMetadata:        clusterMetadata,
 		PersistenceConfig:      pConfig,
-		DispatcherProvider:     client.NewDNSYarpcDispatcherProvider(logger, 0),
+		DispatcherProvider:     client.NewDNSYarpcDispatcherProvider(logger, 0, config.TLS{}, nil),
 		MessagingClient:        messagingClient,
 		MetadataMgr:            testBase.MetadataManager,
 		ShardMgr:               testBase.ShardMgr,
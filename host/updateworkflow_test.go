@@ -0,0 +1,200 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package host
+
+import (
+	"time"
+
+	"github.com/pborman/uuid"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+func (s *integrationSuite) TestUpdateWorkflow() {
+	id := "integration-update-workflow-test"
+	wt := "integration-update-workflow-test-type"
+	tl := "integration-update-workflow-test-tasklist"
+	identity := "worker1"
+
+	workflowType := &workflow.WorkflowType{Name: common.StringPtr(wt)}
+	taskList := &workflow.TaskList{Name: common.StringPtr(tl)}
+
+	// Start workflow execution
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(s.domainName),
+		WorkflowId:                          common.StringPtr(id),
+		WorkflowType:                        workflowType,
+		TaskList:                            taskList,
+		Input:                               nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr(identity),
+	}
+
+	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
+	s.Nil(err0)
+
+	s.Logger.Info("StartWorkflowExecution", tag.WorkflowRunID(*we.RunId))
+
+	execution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      we.RunId,
+	}
+
+	// decider logic, the workflow only handles updates until it is asked to complete
+	workflowComplete := false
+	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision, error) {
+
+		if !workflowComplete {
+			return nil, []*workflow.Decision{}, nil
+		}
+		return nil, []*workflow.Decision{{
+			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+			CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("Done."),
+			},
+		}}, nil
+	}
+
+	// update handler accepts "accept" updates by echoing the input, and rejects everything else
+	var handledUpdates []*workflow.WorkflowUpdate
+	updateHandler := func(update *workflow.WorkflowUpdate) *workflow.WorkflowUpdateResult {
+		handledUpdates = append(handledUpdates, update)
+		if update.GetUpdateName() == "accept" {
+			return &workflow.WorkflowUpdateResult{
+				UpdateId:   update.UpdateId,
+				ResultType: workflow.WorkflowUpdateResultTypeAccepted.Ptr(),
+				Result:     update.Input,
+			}
+		}
+		return &workflow.WorkflowUpdateResult{
+			UpdateId:         update.UpdateId,
+			ResultType:       workflow.WorkflowUpdateResultTypeRejected.Ptr(),
+			RejectionReason:  common.StringPtr("unknown update"),
+			RejectionDetails: []byte(update.GetUpdateName()),
+		}
+	}
+
+	poller := &TaskPoller{
+		Engine:          s.engine,
+		Domain:          s.domainName,
+		TaskList:        taskList,
+		Identity:        identity,
+		DecisionHandler: dtHandler,
+		UpdateHandler:   updateHandler,
+		Logger:          s.Logger,
+		T:               s.T(),
+	}
+
+	// Make first decision
+	_, err := poller.PollAndProcessDecisionTask(false, false)
+	s.Logger.Info("PollAndProcessDecisionTask", tag.Error(err))
+	s.Nil(err)
+
+	type updateResult struct {
+		resp *workflow.UpdateWorkflowExecutionResponse
+		err  error
+	}
+	sendUpdate := func(name string, input []byte) <-chan updateResult {
+		resultCh := make(chan updateResult, 1)
+		go func() {
+			resp, err := s.engine.UpdateWorkflowExecution(createContext(), &workflow.UpdateWorkflowExecutionRequest{
+				Domain:            common.StringPtr(s.domainName),
+				WorkflowExecution: execution,
+				UpdateName:        common.StringPtr(name),
+				Input:             input,
+				Identity:          common.StringPtr(identity),
+			})
+			resultCh <- updateResult{resp: resp, err: err}
+		}()
+		return resultCh
+	}
+
+	// Accepted update is returned to the caller and recorded in history
+	resultCh := sendUpdate("accept", []byte("update input"))
+	_, err = poller.PollAndProcessDecisionTask(false, false)
+	s.Nil(err)
+	select {
+	case result := <-resultCh:
+		s.Nil(result.err)
+		s.Equal([]byte("update input"), result.resp.Result)
+	case <-time.After(10 * time.Second):
+		s.Fail("timed out waiting for update result")
+	}
+	s.Len(handledUpdates, 1)
+	s.Equal("accept", handledUpdates[0].GetUpdateName())
+
+	// Rejected update is returned to the caller as an error and not recorded in history
+	resultCh = sendUpdate("unknown", nil)
+	_, err = poller.PollAndProcessDecisionTask(false, false)
+	s.Nil(err)
+	select {
+	case result := <-resultCh:
+		s.IsType(&workflow.WorkflowUpdateRejectedError{}, result.err)
+		rejectedErr := result.err.(*workflow.WorkflowUpdateRejectedError)
+		s.Equal("unknown update", rejectedErr.Message)
+		s.Equal([]byte("unknown"), rejectedErr.Details)
+	case <-time.After(10 * time.Second):
+		s.Fail("timed out waiting for update result")
+	}
+	s.Len(handledUpdates, 2)
+
+	historyResponse, err := s.engine.GetWorkflowExecutionHistory(createContext(), &workflow.GetWorkflowExecutionHistoryRequest{
+		Domain:    common.StringPtr(s.domainName),
+		Execution: execution,
+	})
+	s.Nil(err)
+	var acceptedEvents []*workflow.HistoryEvent
+	for _, event := range historyResponse.History.Events {
+		if event.GetEventType() == workflow.EventTypeWorkflowExecutionUpdateAccepted {
+			acceptedEvents = append(acceptedEvents, event)
+		}
+	}
+	s.Len(acceptedEvents, 1)
+	attributes := acceptedEvents[0].WorkflowExecutionUpdateAcceptedEventAttributes
+	s.Equal("accept", attributes.GetUpdateName())
+	s.Equal([]byte("update input"), attributes.Input)
+	s.Equal([]byte("update input"), attributes.Result)
+	s.Equal(identity, attributes.GetIdentity())
+
+	// Complete the workflow, updates to a closed workflow fail
+	workflowComplete = true
+	err = s.engine.SignalWorkflowExecution(createContext(), &workflow.SignalWorkflowExecutionRequest{
+		Domain:            common.StringPtr(s.domainName),
+		WorkflowExecution: execution,
+		SignalName:        common.StringPtr("complete"),
+		Identity:          common.StringPtr(identity),
+	})
+	s.Nil(err)
+	_, err = poller.PollAndProcessDecisionTask(false, false)
+	s.Nil(err)
+
+	_, err = s.engine.UpdateWorkflowExecution(createContext(), &workflow.UpdateWorkflowExecutionRequest{
+		Domain:            common.StringPtr(s.domainName),
+		WorkflowExecution: execution,
+		UpdateName:        common.StringPtr("accept"),
+		Identity:          common.StringPtr(identity),
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
@@ -72,16 +72,150 @@ service AdminService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
-  * AddSearchAttribute whitelist search attribute in request.
+  * AddSearchAttribute whitelist search attribute in request. The search attributes are registered
+  * for the domain in request, or cluster wide when no domain is given.
   **/
   void AddSearchAttribute(1: AddSearchAttributeRequest request)
     throws (
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+      5: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * RemoveSearchAttribute removes search attributes registered for a domain.
+  **/
+  void RemoveSearchAttribute(1: RemoveSearchAttributeRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+      5: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ListSearchAttributes returns the search attributes registered for a domain.
+  **/
+  shared.GetSearchAttributesResponse ListSearchAttributes(1: ListSearchAttributesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+      5: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * PauseActivity stops dispatching the pending activity of a workflow to workers until it is unpaused
+  **/
+  void PauseActivity(1: shared.PauseActivityRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * UnpauseActivity resumes dispatching a paused activity of a workflow
+  **/
+  void UnpauseActivity(1: shared.UnpauseActivityRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ResetActivity resets the attempt counter and the retry state of a pending activity of a workflow
+  **/
+  void ResetActivity(1: shared.ResetActivityRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * UpdateActivityTimeouts updates the timeouts of a pending activity of a workflow
+  **/
+  void UpdateActivityTimeouts(1: shared.UpdateActivityTimeoutsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * UpdateTaskListLimits pins the dispatch rate and the max outstanding tasks of a task list,
+  * overriding the limits sent by pollers
+  **/
+  void UpdateTaskListLimits(1: shared.UpdateTaskListLimitsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * VerifyArchivedWorkflowExecution checks that the archived history of a workflow execution still matches
+  * the checksum stored when it was archived and can be read back
+  **/
+  VerifyArchivedWorkflowExecutionResponse VerifyArchivedWorkflowExecution(1: VerifyArchivedWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * RearchiveWorkflowExecution archives the history of a closed workflow execution again. It fails with
+  * 'EntityNotExistError' if the history of the workflow execution has already been deleted.
+  **/
+  void RearchiveWorkflowExecution(1: RearchiveWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ImportWorkflowExecution creates a workflow execution from history batches returned by
+  * GetWorkflowExecutionRawHistory, e.g. to move a workflow execution from another cluster. The run ID of the
+  * execution is kept unless it is empty, in which case a new run ID is generated.
+  **/
+  ImportWorkflowExecutionResponse ImportWorkflowExecution(1: ImportWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 }
 
@@ -115,4 +249,44 @@ struct GetWorkflowExecutionRawHistoryResponse {
 
 struct AddSearchAttributeRequest {
   10: optional map<string, shared.IndexedValueType> searchAttribute
+  20: optional string domain
+}
+
+struct RemoveSearchAttributeRequest {
+  10: optional list<string> keys
+  20: optional string domain
+}
+
+struct ListSearchAttributesRequest {
+  10: optional string domain
+}
+
+enum ArchivalVerificationStatus {
+  VERIFIED,
+  MISSING,
+  CORRUPTED,
+}
+
+struct VerifyArchivedWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+}
+
+struct VerifyArchivedWorkflowExecutionResponse {
+  10: optional ArchivalVerificationStatus status
+}
+
+struct RearchiveWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+}
+
+struct ImportWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional shared.WorkflowExecution execution
+  30: optional list<shared.DataBlob> historyBatches
+}
+
+struct ImportWorkflowExecutionResponse {
+  10: optional string runId
 }
@@ -45,6 +45,7 @@ service WorkflowService {
       3: shared.DomainAlreadyExistsError domainExistsError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -57,6 +58,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -69,6 +71,7 @@ service WorkflowService {
         3: shared.EntityNotExistsError entityNotExistError,
         4: shared.ServiceBusyError serviceBusyError,
         5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+        6: shared.AccessDeniedError accessDeniedError,
       )
 
   /**
@@ -82,6 +85,7 @@ service WorkflowService {
         4: shared.ServiceBusyError serviceBusyError,
         5: shared.DomainNotActiveError domainNotActiveError,
         6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+        7: shared.AccessDeniedError accessDeniedError,
       )
 
   /**
@@ -97,6 +101,7 @@ service WorkflowService {
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -115,6 +120,7 @@ service WorkflowService {
       6: shared.LimitExceededError limitExceededError,
       7: shared.EntityNotExistsError entityNotExistError,
       8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      9: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -128,6 +134,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -146,6 +153,7 @@ service WorkflowService {
       5: shared.EntityNotExistsError entityNotExistError,
       6: shared.DomainNotActiveError domainNotActiveError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -165,6 +173,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -182,6 +191,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -202,6 +212,7 @@ service WorkflowService {
       5: shared.EntityNotExistsError entityNotExistError,
       6: shared.DomainNotActiveError domainNotActiveError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -220,6 +231,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -238,6 +250,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -256,6 +269,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -274,6 +288,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -292,6 +307,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -310,6 +326,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -328,6 +345,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -346,6 +364,7 @@ service WorkflowService {
       5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -364,6 +383,7 @@ service WorkflowService {
       6: shared.DomainNotActiveError domainNotActiveError,
       7: shared.LimitExceededError limitExceededError,
       8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      9: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -379,6 +399,7 @@ service WorkflowService {
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.LimitExceededError limitExceededError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -398,6 +419,7 @@ service WorkflowService {
       6: shared.LimitExceededError limitExceededError,
       7: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,
       8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      9: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -413,6 +435,7 @@ service WorkflowService {
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.LimitExceededError limitExceededError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -428,6 +451,7 @@ service WorkflowService {
       5: shared.DomainNotActiveError domainNotActiveError,
       6: shared.LimitExceededError limitExceededError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -441,6 +465,7 @@ service WorkflowService {
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.LimitExceededError limitExceededError,
       6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -453,6 +478,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -465,6 +491,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -477,6 +504,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -489,6 +517,7 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -501,16 +530,21 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
-  * GetSearchAttributes is a visibility API to get all legal keys that could be used in list APIs
+  * GetSearchAttributes is a visibility API to get all legal keys that could be used in list APIs.
+  * When a domain is given, the search attributes registered for that domain are included.
   **/
-  shared.GetSearchAttributesResponse GetSearchAttributes()
+  shared.GetSearchAttributesResponse GetSearchAttributes(1: shared.GetSearchAttributesRequest request)
     throws (
       1: shared.InternalServiceError internalServiceError,
       2: shared.ServiceBusyError serviceBusyError,
       3: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      4: shared.AccessDeniedError accessDeniedError,
+      5: shared.BadRequestError badRequestError,
+      6: shared.EntityNotExistsError entityNotExistError,
     )
 
   /**
@@ -527,6 +561,7 @@ service WorkflowService {
       5: shared.ServiceBusyError serviceBusyError,
       6: shared.DomainNotActiveError domainNotActiveError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -547,6 +582,7 @@ service WorkflowService {
       5: shared.ServiceBusyError serviceBusyError,
       6: shared.DomainNotActiveError domainNotActiveError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -561,8 +597,28 @@ service WorkflowService {
 	  5: shared.LimitExceededError limitExceededError,
       6: shared.ServiceBusyError serviceBusyError,
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      8: shared.AccessDeniedError accessDeniedError,
 	)
 
+  /**
+  * UpdateWorkflowExecution delivers an update to a running workflow execution and blocks until the workflow
+  * worker accepts or rejects it. The update is dispatched to the worker as part of a decision task, accepted
+  * updates are recorded as WorkflowExecutionUpdateAccepted event in the history and their result is returned
+  * to the caller. Rejected updates are not recorded and fail with WorkflowUpdateRejectedError.
+  **/
+  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: shared.UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.WorkflowUpdateRejectedError workflowUpdateRejectedError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.DomainNotActiveError domainNotActiveError,
+      7: shared.LimitExceededError limitExceededError,
+      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      9: shared.AccessDeniedError accessDeniedError,
+    )
+
   /**
   * DescribeWorkflowExecution returns information about the specified workflow execution.
   **/
@@ -574,6 +630,7 @@ service WorkflowService {
       4: shared.LimitExceededError limitExceededError,
       5: shared.ServiceBusyError serviceBusyError,
       6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -588,6 +645,7 @@ service WorkflowService {
       4: shared.LimitExceededError limitExceededError,
       5: shared.ServiceBusyError serviceBusyError,
       6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 
   replicator.GetReplicationMessagesResponse GetReplicationMessages(1: replicator.GetReplicationMessagesRequest request)
@@ -597,6 +655,7 @@ service WorkflowService {
       3: shared.LimitExceededError limitExceededError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   replicator.GetDomainReplicationMessagesResponse GetDomainReplicationMessages(1: replicator.GetDomainReplicationMessagesRequest request)
@@ -606,6 +665,7 @@ service WorkflowService {
       3: shared.LimitExceededError limitExceededError,
       4: shared.ServiceBusyError serviceBusyError,
       5: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      6: shared.AccessDeniedError accessDeniedError,
     )
 
   /**
@@ -619,5 +679,120 @@ service WorkflowService {
       4: shared.LimitExceededError limitExceededError,
       5: shared.ServiceBusyError serviceBusyError,
       6: shared.EntityNotExistsError entityNotExistError,
+      7: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * CreateSchedule creates a schedule which starts workflow executions in the domain according to its spec and policies.
+  **/
+  void CreateSchedule(1: shared.CreateScheduleRequest createRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.EntityNotExistsError entityNotExistError,
+      8: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      9: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DescribeSchedule returns the spec, policies, state and recent actions of a schedule.
+  **/
+  shared.DescribeScheduleResponse DescribeSchedule(1: shared.DescribeScheduleRequest describeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * UpdateSchedule replaces the spec, action or policies of a schedule. Fields not set on the request are left untouched.
+  **/
+  void UpdateSchedule(1: shared.UpdateScheduleRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * PauseSchedule stops a schedule from taking any action until it is unpaused.
+  **/
+  void PauseSchedule(1: shared.PauseScheduleRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * UnpauseSchedule resumes a paused schedule. Actions that were due while paused are not taken.
+  **/
+  void UnpauseSchedule(1: shared.UnpauseScheduleRequest unpauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DeleteSchedule deletes a schedule. Workflow executions started by the schedule are not affected.
+  **/
+  void DeleteSchedule(1: shared.DeleteScheduleRequest deleteRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * BackfillSchedule takes all the actions the schedule would have taken in the given time range.
+  **/
+  void BackfillSchedule(1: shared.BackfillScheduleRequest backfillRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ListSchedules returns the schedules of a domain.
+  **/
+  shared.ListSchedulesResponse ListSchedules(1: shared.ListSchedulesRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.LimitExceededError limitExceededError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+      7: shared.AccessDeniedError accessDeniedError,
     )
 }
@@ -209,6 +209,8 @@ struct RecordDecisionTaskStartedResponse {
   110: optional binary branchToken
   120:  optional i64 (js.type = "Long") scheduledTimestamp
   130:  optional i64 (js.type = "Long") startedTimestamp
+  140:  optional list<shared.WorkflowUpdate> updates
+  150:  optional map<string, shared.WorkflowQuery> queries
 }
 
 struct SignalWorkflowExecutionRequest {
@@ -229,6 +231,43 @@ struct RemoveSignalMutableStateRequest {
   30: optional string requestId
 }
 
+struct PauseActivityRequest {
+  10: optional string domainUUID
+  20: optional shared.PauseActivityRequest pauseRequest
+}
+
+struct UnpauseActivityRequest {
+  10: optional string domainUUID
+  20: optional shared.UnpauseActivityRequest unpauseRequest
+}
+
+struct ResetActivityRequest {
+  10: optional string domainUUID
+  20: optional shared.ResetActivityRequest resetRequest
+}
+
+struct UpdateActivityTimeoutsRequest {
+  10: optional string domainUUID
+  20: optional shared.UpdateActivityTimeoutsRequest updateRequest
+}
+
+struct UpdateWorkflowSearchAttributesRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution workflowExecution
+  30: optional shared.SearchAttributes upsertSearchAttributes
+}
+
+struct DeleteWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution workflowExecution
+}
+
+struct ImportWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+  30: optional list<shared.DataBlob> historyBatches
+}
+
 struct TerminateWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
@@ -331,6 +370,7 @@ struct SyncActivityRequest {
   120: optional string lastFailureReason
   130: optional string lastWorkerIdentity
   140: optional binary lastFailureDetails
+  150: optional bool paused
 }
 
 struct QueryWorkflowRequest {
@@ -343,6 +383,11 @@ struct QueryWorkflowResponse {
   10: optional binary queryResult
 }
 
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.UpdateWorkflowExecutionRequest updateRequest
+}
+
 struct ReapplyEventsRequest {
   10: optional string domainUUID
   20: optional shared.ReapplyEventsRequest request
@@ -827,6 +872,22 @@ service HistoryService {
 	  7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
 	)
 
+  /**
+  * UpdateWorkflowExecution delivers an update to the workflow through a decision task and blocks until
+  * the worker accepts or rejects it.
+  **/
+  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.WorkflowUpdateRejectedError workflowUpdateRejectedError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: ShardOwnershipLostError shardOwnershipLostError,
+      7: shared.DomainNotActiveError domainNotActiveError,
+      8: shared.LimitExceededError limitExceededError,
+    )
+
   /**
   * ReapplyEvents applies stale events to the current workflow and current run
   **/
@@ -840,4 +901,106 @@ service HistoryService {
       6: ShardOwnershipLostError shardOwnershipLostError,
       7: shared.EntityNotExistsError entityNotExistError,
     )
+
+  /**
+  * PauseActivity stops dispatching the pending activity to workers until it is unpaused, an attempt
+  * which is already running is not affected
+  **/
+  void PauseActivity(1: PauseActivityRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UnpauseActivity resumes dispatching a paused activity
+  **/
+  void UnpauseActivity(1: UnpauseActivityRequest unpauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ResetActivity resets the attempt counter and the retry state of a pending activity
+  **/
+  void ResetActivity(1: ResetActivityRequest resetRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UpdateActivityTimeouts updates the timeouts of a pending activity
+  **/
+  void UpdateActivityTimeouts(1: UpdateActivityTimeoutsRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UpdateWorkflowSearchAttributes upserts search attributes of a running workflow execution by recording an
+  * UpsertWorkflowSearchAttributes event which is not tied to any decision
+  **/
+  void UpdateWorkflowSearchAttributes(1: UpdateWorkflowSearchAttributesRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DeleteWorkflowExecution deletes a closed workflow execution, including its history and visibility record,
+  * without waiting for the retention period of the domain
+  **/
+  void DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest deleteRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ImportWorkflowExecution rebuilds the mutable state of a workflow execution from the supplied history batches
+  * and creates the workflow execution under the given run ID
+  **/
+  void ImportWorkflowExecution(1: ImportWorkflowExecutionRequest importRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.WorkflowExecutionAlreadyStartedError workflowAlreadyStartedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
 }
@@ -46,6 +46,8 @@ struct PollForDecisionTaskResponse {
   120: optional binary branchToken
   130:  optional i64 (js.type = "Long") scheduledTimestamp
   140:  optional i64 (js.type = "Long") startedTimestamp
+  150:  optional list<shared.WorkflowUpdate> updates
+  160:  optional map<string, shared.WorkflowQuery> queries
 }
 
 struct PollForActivityTaskRequest {
@@ -62,6 +64,8 @@ struct AddDecisionTaskRequest {
   40: optional i64 (js.type = "Long") scheduleId
   50: optional i32 scheduleToStartTimeoutSeconds
   60: optional string forwardedFrom
+  70: optional i32 priority
+  80: optional string fairnessKey
 }
 
 struct AddActivityTaskRequest {
@@ -72,6 +76,8 @@ struct AddActivityTaskRequest {
   50: optional i64 (js.type = "Long") scheduleId
   60: optional i32 scheduleToStartTimeoutSeconds
   70: optional string forwardedFrom
+  80: optional i32 priority
+  90: optional string fairnessKey
 }
 
 struct QueryWorkflowRequest {
@@ -100,6 +106,22 @@ struct DescribeTaskListRequest {
   20: optional shared.DescribeTaskListRequest descRequest
 }
 
+struct UpdateTaskListLimitsRequest {
+  10: optional string domainUUID
+  20: optional shared.UpdateTaskListLimitsRequest updateRequest
+}
+
+struct ReleaseTaskRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional i32 taskListType
+  40: optional shared.WorkflowExecution workflowExecution
+  50: optional i64 (js.type = "Long") scheduleId
+  // the partition the task was dispatched from is unknown, e.g. the activity was completed by ID,
+  // so the root partition releases the slot on every partition of the task list
+  60: optional bool allPartitions
+}
+
 /**
 * MatchingService API is exposed to provide support for polling from long running applications.
 * Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
@@ -210,4 +232,26 @@ service MatchingService {
         3: shared.EntityNotExistsError entityNotExistError,
         4: shared.ServiceBusyError serviceBusyError,
       )
+
+  /**
+  * UpdateTaskListLimits persists the limits of a tasklist. When called on the root partition
+  * the limits are propagated to all the partitions of the tasklist.
+  **/
+  void UpdateTaskListLimits(1: UpdateTaskListLimitsRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+        3: shared.ServiceBusyError serviceBusyError,
+      )
+
+  /**
+  * ReleaseTask frees the slot held by a task handed to a worker once the worker responds,
+  * this is only needed for tasklists with a cap on outstanding tasks.
+  **/
+  void ReleaseTask(1: ReleaseTaskRequest request)
+    throws (
+        1: shared.BadRequestError badRequestError,
+        2: shared.InternalServiceError internalServiceError,
+        3: shared.ServiceBusyError serviceBusyError,
+      )
 }
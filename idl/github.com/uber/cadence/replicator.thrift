@@ -93,6 +93,7 @@ struct SyncActicvityTaskAttributes {
   120: optional string lastFailureReason
   130: optional string lastWorkerIdentity
   140: optional binary lastFailureDetails
+  150: optional bool paused
 }
 
 struct HistoryTaskV2Attributes {
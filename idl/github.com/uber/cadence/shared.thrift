@@ -54,6 +54,11 @@ exception QueryFailedError {
   1: required string message
 }
 
+exception WorkflowUpdateRejectedError {
+  1: required string message
+  2: optional binary details
+}
+
 exception DomainNotActiveError {
   1: required string message
   2: required string domainName
@@ -193,6 +198,7 @@ enum EventType {
   SignalExternalWorkflowExecutionFailed,
   ExternalWorkflowExecutionSignaled,
   UpsertWorkflowSearchAttributes,
+  WorkflowExecutionUpdateAccepted,
 }
 
 enum DecisionTaskFailedCause {
@@ -219,6 +225,7 @@ enum DecisionTaskFailedCause {
   BAD_BINARY,
   SCHEDULE_ACTIVITY_DUPLICATE_ID,
   BAD_SEARCH_ATTRIBUTES,
+  BAD_UPDATE_RESULT,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
@@ -255,6 +262,11 @@ enum QueryResultType {
   FAILED,
 }
 
+enum WorkflowUpdateResultType {
+  ACCEPTED,
+  REJECTED,
+}
+
 enum PendingActivityState {
   SCHEDULED,
   STARTED,
@@ -314,6 +326,32 @@ enum QueryRejectCondition {
   NOT_COMPLETED_CLEANLY
 }
 
+enum QueryConsistencyLevel {
+  // EVENTUAL indicates that query should be eventually consistent
+  EVENTUAL
+  // STRONG indicates that any events that came before query should be reflected in workflow state before running query
+  STRONG
+}
+
+enum ScheduleOverlapPolicy {
+  /*
+   * skip the new run if the previous run started by the schedule is still running
+   */
+  SkipNew,
+  /*
+   * buffer at most one run, it is started once the previous run closes
+   */
+  BufferOne,
+  /*
+   * request cancellation of the previous run and start the new run right away
+   */
+  CancelOther,
+  /*
+   * start the new run regardless of the previous runs
+   */
+  AllowAll,
+}
+
 struct DataBlob {
   10: optional EncodingType EncodingType
   20: optional binary Data
@@ -380,6 +418,8 @@ struct ScheduleActivityTaskDecisionAttributes {
   60: optional i32 heartbeatTimeoutSeconds
   70: optional RetryPolicy retryPolicy
   80: optional Header header
+  90: optional i32 priority
+  100: optional string fairnessKey
 }
 
 struct RequestCancelActivityTaskDecisionAttributes {
@@ -516,6 +556,9 @@ struct WorkflowExecutionStartedEventAttributes {
   121: optional SearchAttributes searchAttributes
   130: optional ResetPoints prevAutoResetPoints
   140: optional Header header
+  150: optional i32 delayStartSeconds
+  160: optional i32 priority
+  170: optional string fairnessKey
 }
 
 struct ResetPoints{
@@ -622,6 +665,8 @@ struct ActivityTaskScheduledEventAttributes {
   90: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   110: optional RetryPolicy retryPolicy
   120: optional Header header
+  130: optional i32 priority
+  140: optional string fairnessKey
 }
 
 struct ActivityTaskStartedEventAttributes {
@@ -782,6 +827,15 @@ struct UpsertWorkflowSearchAttributesEventAttributes {
   20: optional SearchAttributes searchAttributes
 }
 
+struct WorkflowExecutionUpdateAcceptedEventAttributes {
+  10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  20: optional string updateId
+  30: optional string updateName
+  40: optional binary input
+  50: optional binary result
+  60: optional string identity
+}
+
 struct StartChildWorkflowExecutionInitiatedEventAttributes {
   10:  optional string domain
   20:  optional string workflowId
@@ -913,6 +967,7 @@ struct HistoryEvent {
   430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
   440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
   450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes
+  460: optional WorkflowExecutionUpdateAcceptedEventAttributes workflowExecutionUpdateAcceptedEventAttributes
 }
 
 struct History {
@@ -951,6 +1006,7 @@ struct DomainConfiguration {
   90: optional string historyArchivalURI
   100: optional ArchivalStatus visibilityArchivalStatus
   110: optional string visibilityArchivalURI
+  120: optional map<string, IndexedValueType> searchAttributes
 }
 
 struct BadBinaries{
@@ -1059,6 +1115,9 @@ struct StartWorkflowExecutionRequest {
   140: optional Memo memo
   141: optional SearchAttributes searchAttributes
   150: optional Header header
+  160: optional i32 delayStartSeconds
+  170: optional i32 priority
+  180: optional string fairnessKey
 }
 
 struct StartWorkflowExecutionResponse {
@@ -1087,6 +1146,8 @@ struct PollForDecisionTaskResponse {
   100:  optional i64 (js.type = "Long") scheduledTimestamp
   110:  optional i64 (js.type = "Long") startedTimestamp
   120:  optional list<WorkflowQuery> queries
+  130:  optional list<WorkflowUpdate> updates
+  140:  optional map<string, WorkflowQuery> queriesByID
 }
 
 struct StickyExecutionAttributes {
@@ -1104,6 +1165,8 @@ struct RespondDecisionTaskCompletedRequest {
   70: optional bool forceCreateNewDecisionTask
   80: optional string binaryChecksum
   90: optional list<WorkflowQueryResult> queryResults
+  100: optional list<WorkflowUpdateResult> updateResults
+  110: optional map<string,WorkflowQueryResult> queryResultsByID
 }
 
 struct RespondDecisionTaskCompletedResponse {
@@ -1260,6 +1323,9 @@ struct SignalWithStartWorkflowExecutionRequest {
   160: optional Memo memo
   161: optional SearchAttributes searchAttributes
   170: optional Header header
+  180: optional i32 delayStartSeconds
+  190: optional i32 priority
+  200: optional string fairnessKey
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1344,6 +1410,10 @@ struct CountWorkflowExecutionsResponse {
   10: optional i64 count
 }
 
+struct GetSearchAttributesRequest {
+  10: optional string domain
+}
+
 struct GetSearchAttributesResponse {
   10: optional map<string, IndexedValueType> keys
 }
@@ -1354,6 +1424,7 @@ struct QueryWorkflowRequest {
   30: optional WorkflowQuery query
   // QueryRejectCondition can used to reject the query if workflow state does not satisify condition
   40: optional QueryRejectCondition queryRejectCondition
+  50: optional QueryConsistencyLevel queryConsistencyLevel
 }
 
 struct QueryRejected {
@@ -1394,6 +1465,32 @@ struct WorkflowQueryResult {
   40: optional binary errorDetails
 }
 
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string updateName
+  40: optional binary input
+  50: optional string identity
+}
+
+struct UpdateWorkflowExecutionResponse {
+  10: optional binary result
+}
+
+struct WorkflowUpdate {
+  10: optional string updateId
+  20: optional string updateName
+  30: optional binary input
+}
+
+struct WorkflowUpdateResult {
+  10: optional string updateId
+  20: optional WorkflowUpdateResultType resultType
+  30: optional binary result
+  40: optional string rejectionReason
+  50: optional binary rejectionDetails
+}
+
 struct DescribeWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution execution
@@ -1435,11 +1532,45 @@ struct DescribeTaskListRequest {
   20: optional TaskList taskList
   30: optional TaskListType taskListType
   40: optional bool includeTaskListStatus
+  // includeTaskListPartitions asks for the partitions of the task list and the hosts owning them
+  50: optional bool includeTaskListPartitions
 }
 
 struct DescribeTaskListResponse {
   10: optional list<PollerInfo> pollers
   20: optional TaskListStatus taskListStatus
+  // partitionConfig is only returned when describing the root partition of a task list
+  30: optional TaskListPartitionConfig partitionConfig
+  40: optional list<TaskListPartitionMetadata> partitions
+  // limits pinned on the task list through the admin API
+  50: optional TaskListLimits limits
+}
+
+// TaskListLimits are enforced by the server regardless of the settings sent by pollers
+struct TaskListLimits {
+  // maxTasksPerSecond overrides the dispatch rate set by pollers when greater than zero
+  10: optional double maxTasksPerSecond
+  // maxOutstandingTasks caps the number of tasks handed to workers and not yet completed when greater than zero
+  20: optional i32 maxOutstandingTasks
+}
+
+struct UpdateTaskListLimitsRequest {
+  10: optional string domain
+  20: optional TaskList taskList
+  30: optional TaskListType taskListType
+  40: optional TaskListLimits limits
+}
+
+struct TaskListPartitionConfig {
+  10: optional i32 numReadPartitions
+  20: optional i32 numWritePartitions
+}
+
+struct TaskListPartitionMetadata {
+  10: optional string key
+  20: optional string ownerHostName
+  // draining partitions still serve pollers but no longer receive new tasks
+  30: optional bool draining
 }
 
 struct TaskListStatus {
@@ -1448,6 +1579,7 @@ struct TaskListStatus {
   30: optional i64 (js.type = "Long") ackLevel
   35: optional double ratePerSecond
   40: optional TaskIDBlock taskIDBlock
+  50: optional i32 outstandingTasks
 }
 
 struct TaskIDBlock {
@@ -1472,6 +1604,39 @@ struct CloseShardRequest {
   10: optional i32               shardID
 }
 
+struct PauseActivityRequest {
+  10: optional string               domain
+  20: optional WorkflowExecution    workflowExecution
+  30: optional string               activityID
+  40: optional string               identity
+}
+
+struct UnpauseActivityRequest {
+  10: optional string               domain
+  20: optional WorkflowExecution    workflowExecution
+  30: optional string               activityID
+  40: optional string               identity
+}
+
+struct ResetActivityRequest {
+  10: optional string               domain
+  20: optional WorkflowExecution    workflowExecution
+  30: optional string               activityID
+  40: optional bool                 resetHeartbeatDetails
+  50: optional string               identity
+}
+
+struct UpdateActivityTimeoutsRequest {
+  10: optional string               domain
+  20: optional WorkflowExecution    workflowExecution
+  30: optional string               activityID
+  40: optional i32                  scheduleToStartTimeoutSeconds
+  50: optional i32                  scheduleToCloseTimeoutSeconds
+  60: optional i32                  startToCloseTimeoutSeconds
+  70: optional i32                  heartbeatTimeoutSeconds
+  80: optional string               identity
+}
+
 struct DescribeHistoryHostResponse{
   10: optional i32                  numberOfShards
   20: optional list<i32>            shardIDs
@@ -1567,4 +1732,127 @@ struct ReapplyEventsRequest{
   10: optional string domainName
   20: optional WorkflowExecution workflowExecution
   30: optional DataBlob events
-}
\ No newline at end of file
+}
+
+// ScheduleSpec describes when the actions of a schedule are taken
+struct ScheduleSpec {
+  10: optional string cronExpression
+  // actions are not taken before startTimestamp or after endTimestamp if they are set
+  20: optional i64 (js.type = "Long") startTimestamp
+  30: optional i64 (js.type = "Long") endTimestamp
+  // each action is delayed by a random duration up to jitterInSeconds
+  40: optional i32 jitterInSeconds
+}
+
+// ScheduleAction describes the workflow started by a schedule. The workflowId is used as a prefix,
+// the scheduled time is appended to it for each run. Domain and requestId are ignored.
+struct ScheduleAction {
+  10: optional StartWorkflowExecutionRequest startWorkflow
+}
+
+struct SchedulePolicies {
+  10: optional ScheduleOverlapPolicy overlapPolicy
+  // actions that could not be taken within the window after their scheduled time are dropped
+  20: optional i32 catchupWindowInSeconds
+}
+
+struct ScheduleState {
+  10: optional bool paused
+  20: optional string notes
+}
+
+struct ScheduleActionResult {
+  10: optional i64 (js.type = "Long") scheduledTimestamp
+  20: optional i64 (js.type = "Long") actualTimestamp
+  30: optional WorkflowExecution startedWorkflow
+}
+
+struct ScheduleInfo {
+  10: optional i64 (js.type = "Long") numActions
+  20: optional i64 (js.type = "Long") numActionsMissedCatchupWindow
+  30: optional i64 (js.type = "Long") numActionsSkippedOverlap
+  40: optional list<WorkflowExecution> runningWorkflows
+  50: optional list<ScheduleActionResult> recentActions
+  60: optional list<i64> nextActionTimestamps
+  70: optional i64 (js.type = "Long") createTimestamp
+  80: optional i64 (js.type = "Long") updateTimestamp
+}
+
+struct CreateScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional ScheduleSpec spec
+  40: optional ScheduleAction action
+  50: optional SchedulePolicies policies
+  60: optional ScheduleState state
+  70: optional string identity
+  80: optional string requestId
+  90: optional Memo memo
+}
+
+struct DescribeScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+}
+
+struct DescribeScheduleResponse {
+  10: optional ScheduleSpec spec
+  20: optional ScheduleAction action
+  30: optional SchedulePolicies policies
+  40: optional ScheduleState state
+  50: optional ScheduleInfo info
+  60: optional Memo memo
+}
+
+struct UpdateScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional ScheduleSpec spec
+  40: optional ScheduleAction action
+  50: optional SchedulePolicies policies
+  60: optional string identity
+}
+
+struct PauseScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional string reason
+  40: optional string identity
+}
+
+struct UnpauseScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional string reason
+  40: optional string identity
+}
+
+struct DeleteScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional string identity
+}
+
+struct BackfillScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional i64 (js.type = "Long") startTimestamp
+  40: optional i64 (js.type = "Long") endTimestamp
+  50: optional ScheduleOverlapPolicy overlapPolicy
+  60: optional string identity
+}
+
+struct ListSchedulesRequest {
+  10: optional string domain
+  20: optional i32 pageSize
+  30: optional binary nextPageToken
+}
+
+struct ScheduleListEntry {
+  10: optional string scheduleId
+}
+
+struct ListSchedulesResponse {
+  10: optional list<ScheduleListEntry> schedules
+  20: optional binary nextPageToken
+}
@@ -57,6 +57,7 @@ struct DomainInfo {
   44: optional string historyArchivalURI
   46: optional i16 visibilityArchivalStatus
   48: optional string visibilityArchivalURI
+  50: optional map<string, shared.IndexedValueType> searchAttributes
 }
 
 struct HistoryTreeInfo {
@@ -131,6 +132,9 @@ struct WorkflowExecutionInfo {
   120: optional map<string, binary> memo
   122: optional binary versionHistories
   124: optional string versionHistoriesEncoding
+  126: optional i32 delayStartSeconds
+  128: optional i32 priority
+  130: optional string fairnessKey
 }
 
 struct ActivityInfo {
@@ -165,6 +169,9 @@ struct ActivityInfo {
   66: optional string retryLastFailureReason
   68: optional string retryLastWorkerIdentity
   70: optional binary retryLastFailureDetails
+  72: optional bool paused
+  74: optional i32 priority
+  76: optional string fairnessKey
 }
 
 struct ChildExecutionInfo {
@@ -211,6 +218,8 @@ struct TaskInfo {
   13: optional i64 (js.type = "Long") scheduleID
   14: optional i64 (js.type = "Long") expiryTimeNanos
   15: optional i64 (js.type = "Long") createdTimeNanos
+  16: optional i32 priority
+  17: optional string fairnessKey
 }
 
 struct TaskListInfo {
@@ -218,6 +227,10 @@ struct TaskListInfo {
   12: optional i64 (js.type = "Long") ackLevel
   14: optional i64 (js.type = "Long") expiryTimeNanos
   16: optional i64 (js.type = "Long") lastUpdatedNanos
+  18: optional i32 numReadPartitions
+  20: optional i32 numWritePartitions
+  22: optional double maxTasksPerSecond
+  24: optional i32 maxOutstandingTasks
 }
 
 struct TransferTaskInfo {
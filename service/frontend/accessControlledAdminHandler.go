@@ -0,0 +1,266 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/metrics"
+)
+
+var _ adminserviceserver.Interface = (*AccessControlledAdminHandler)(nil)
+
+type (
+	// AccessControlledAdminHandler is a wrapper over the admin service,
+	// checking every request against the authorizer
+	AccessControlledAdminHandler struct {
+		accessControl
+		adminHandler *AdminHandler
+	}
+)
+
+// NewAccessControlledAdminHandler creates a thrift handler for the cadence admin service
+func NewAccessControlledAdminHandler(
+	adminHandler *AdminHandler,
+	authenticator authorization.Authenticator,
+	authorizer authorization.Authorizer,
+) *AccessControlledAdminHandler {
+
+	return &AccessControlledAdminHandler{
+		accessControl: accessControl{
+			authenticator: authenticator,
+			authorizer:    authorizer,
+			metricsClient: adminHandler.GetMetricsClient(),
+		},
+		adminHandler: adminHandler,
+	}
+}
+
+// RegisterHandler register this handler, must be called before Start()
+func (handler *AccessControlledAdminHandler) RegisterHandler() {
+	handler.adminHandler.GetDispatcher().Register(adminserviceserver.New(handler))
+}
+
+// Start starts the handler
+func (handler *AccessControlledAdminHandler) Start() error {
+	return handler.adminHandler.Start()
+}
+
+// Stop stops the handler
+func (handler *AccessControlledAdminHandler) Stop() {
+	handler.adminHandler.Stop()
+}
+
+// AddSearchAttribute API call
+func (handler *AccessControlledAdminHandler) AddSearchAttribute(
+	ctx context.Context,
+	request *admin.AddSearchAttributeRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminAddSearchAttributeScope, "AdminAddSearchAttribute", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.adminHandler.AddSearchAttribute(ctx, request)
+}
+
+// CloseShard API call
+func (handler *AccessControlledAdminHandler) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminCloseShardTaskScope, "AdminCloseShard", ""); err != nil {
+		return err
+	}
+	return handler.adminHandler.CloseShard(ctx, request)
+}
+
+// DescribeHistoryHost API call
+func (handler *AccessControlledAdminHandler) DescribeHistoryHost(
+	ctx context.Context,
+	request *shared.DescribeHistoryHostRequest,
+) (*shared.DescribeHistoryHostResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.AdminDescribeHistoryHostScope, "AdminDescribeHistoryHost", ""); err != nil {
+		return nil, err
+	}
+	return handler.adminHandler.DescribeHistoryHost(ctx, request)
+}
+
+// DescribeWorkflowExecution API call
+func (handler *AccessControlledAdminHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *admin.DescribeWorkflowExecutionRequest,
+) (*admin.DescribeWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.AdminDescribeWorkflowExecutionScope, "AdminDescribeWorkflowExecution", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.adminHandler.DescribeWorkflowExecution(ctx, request)
+}
+
+// GetWorkflowExecutionRawHistory API call
+func (handler *AccessControlledAdminHandler) GetWorkflowExecutionRawHistory(
+	ctx context.Context,
+	request *admin.GetWorkflowExecutionRawHistoryRequest,
+) (*admin.GetWorkflowExecutionRawHistoryResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.AdminGetWorkflowExecutionRawHistoryScope, "AdminGetWorkflowExecutionRawHistory", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.adminHandler.GetWorkflowExecutionRawHistory(ctx, request)
+}
+
+// ImportWorkflowExecution API call
+func (handler *AccessControlledAdminHandler) ImportWorkflowExecution(
+	ctx context.Context,
+	request *admin.ImportWorkflowExecutionRequest,
+) (*admin.ImportWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.AdminImportWorkflowExecutionScope, "AdminImportWorkflowExecution", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.adminHandler.ImportWorkflowExecution(ctx, request)
+}
+
+// ListSearchAttributes API call
+func (handler *AccessControlledAdminHandler) ListSearchAttributes(
+	ctx context.Context,
+	request *admin.ListSearchAttributesRequest,
+) (*shared.GetSearchAttributesResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.AdminListSearchAttributesScope, "AdminListSearchAttributes", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.adminHandler.ListSearchAttributes(ctx, request)
+}
+
+// PauseActivity API call
+func (handler *AccessControlledAdminHandler) PauseActivity(
+	ctx context.Context,
+	request *shared.PauseActivityRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminPauseActivityScope, "AdminPauseActivity", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.adminHandler.PauseActivity(ctx, request)
+}
+
+// RearchiveWorkflowExecution API call
+func (handler *AccessControlledAdminHandler) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *admin.RearchiveWorkflowExecutionRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminRearchiveWorkflowExecutionScope, "AdminRearchiveWorkflowExecution", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.adminHandler.RearchiveWorkflowExecution(ctx, request)
+}
+
+// RemoveSearchAttribute API call
+func (handler *AccessControlledAdminHandler) RemoveSearchAttribute(
+	ctx context.Context,
+	request *admin.RemoveSearchAttributeRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminRemoveSearchAttributeScope, "AdminRemoveSearchAttribute", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.adminHandler.RemoveSearchAttribute(ctx, request)
+}
+
+// RemoveTask API call
+func (handler *AccessControlledAdminHandler) RemoveTask(
+	ctx context.Context,
+	request *shared.RemoveTaskRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminRemoveTaskScope, "AdminRemoveTask", ""); err != nil {
+		return err
+	}
+	return handler.adminHandler.RemoveTask(ctx, request)
+}
+
+// ResetActivity API call
+func (handler *AccessControlledAdminHandler) ResetActivity(
+	ctx context.Context,
+	request *shared.ResetActivityRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminResetActivityScope, "AdminResetActivity", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.adminHandler.ResetActivity(ctx, request)
+}
+
+// UnpauseActivity API call
+func (handler *AccessControlledAdminHandler) UnpauseActivity(
+	ctx context.Context,
+	request *shared.UnpauseActivityRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminUnpauseActivityScope, "AdminUnpauseActivity", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.adminHandler.UnpauseActivity(ctx, request)
+}
+
+// UpdateActivityTimeouts API call
+func (handler *AccessControlledAdminHandler) UpdateActivityTimeouts(
+	ctx context.Context,
+	request *shared.UpdateActivityTimeoutsRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminUpdateActivityTimeoutsScope, "AdminUpdateActivityTimeouts", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.adminHandler.UpdateActivityTimeouts(ctx, request)
+}
+
+// UpdateTaskListLimits API call
+func (handler *AccessControlledAdminHandler) UpdateTaskListLimits(
+	ctx context.Context,
+	request *shared.UpdateTaskListLimitsRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.AdminUpdateTaskListLimitsScope, "AdminUpdateTaskListLimits", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.adminHandler.UpdateTaskListLimits(ctx, request)
+}
+
+// VerifyArchivedWorkflowExecution API call
+func (handler *AccessControlledAdminHandler) VerifyArchivedWorkflowExecution(
+	ctx context.Context,
+	request *admin.VerifyArchivedWorkflowExecutionRequest,
+) (*admin.VerifyArchivedWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.AdminVerifyArchivedWorkflowExecutionScope, "AdminVerifyArchivedWorkflowExecution", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.adminHandler.VerifyArchivedWorkflowExecution(ctx, request)
+}
@@ -0,0 +1,796 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/health"
+	"github.com/uber/cadence/.gen/go/health/metaserver"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+)
+
+var _ workflowserviceserver.Interface = (*AccessControlledWorkflowHandler)(nil)
+
+type (
+	// accessControl authenticates and authorizes callers before their requests reach the wrapped handler
+	accessControl struct {
+		authenticator authorization.Authenticator
+		authorizer    authorization.Authorizer
+		metricsClient metrics.Client
+	}
+
+	// AccessControlledWorkflowHandler is a wrapper over the frontend service,
+	// checking every request against the authorizer
+	AccessControlledWorkflowHandler struct {
+		accessControl
+		domainCache     cache.DomainCache
+		tokenSerializer common.TaskTokenSerializer
+		service         service.Service
+		frontendHandler workflowserviceserver.Interface
+
+		startFn func() error
+		stopFn  func()
+	}
+)
+
+// NewAccessControlledWorkflowHandler creates a thrift handler for the cadence service, frontend
+func NewAccessControlledWorkflowHandler(
+	dcRedirectionHandler *DCRedirectionHandlerImpl,
+	authenticator authorization.Authenticator,
+	authorizer authorization.Authorizer,
+) *AccessControlledWorkflowHandler {
+
+	return &AccessControlledWorkflowHandler{
+		accessControl: accessControl{
+			authenticator: authenticator,
+			authorizer:    authorizer,
+			metricsClient: dcRedirectionHandler.metricsClient,
+		},
+		domainCache:     dcRedirectionHandler.domainCache,
+		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+		service:         dcRedirectionHandler.service,
+		frontendHandler: dcRedirectionHandler,
+		startFn:         func() error { return dcRedirectionHandler.Start() },
+		stopFn:          func() { dcRedirectionHandler.Stop() },
+	}
+}
+
+// RegisterHandler register this handler, must be called before Start()
+func (handler *AccessControlledWorkflowHandler) RegisterHandler() {
+	handler.service.GetDispatcher().Register(workflowserviceserver.New(handler))
+	handler.service.GetDispatcher().Register(metaserver.New(handler))
+}
+
+// Start starts the handler
+func (handler *AccessControlledWorkflowHandler) Start() error {
+	return handler.startFn()
+}
+
+// Stop stops the handler
+func (handler *AccessControlledWorkflowHandler) Stop() {
+	handler.stopFn()
+}
+
+// Health is for health check
+func (handler *AccessControlledWorkflowHandler) Health(ctx context.Context) (*health.HealthStatus, error) {
+	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr("access control good")}
+	return hs, nil
+}
+
+// BackfillSchedule API call
+func (handler *AccessControlledWorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	request *shared.BackfillScheduleRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendBackfillScheduleScope, "BackfillSchedule", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.BackfillSchedule(ctx, request)
+}
+
+// CountWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) CountWorkflowExecutions(
+	ctx context.Context,
+	request *shared.CountWorkflowExecutionsRequest,
+) (*shared.CountWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendCountWorkflowExecutionsScope, "CountWorkflowExecutions", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.CountWorkflowExecutions(ctx, request)
+}
+
+// CreateSchedule API call
+func (handler *AccessControlledWorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	request *shared.CreateScheduleRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendCreateScheduleScope, "CreateSchedule", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.CreateSchedule(ctx, request)
+}
+
+// DeleteSchedule API call
+func (handler *AccessControlledWorkflowHandler) DeleteSchedule(
+	ctx context.Context,
+	request *shared.DeleteScheduleRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendDeleteScheduleScope, "DeleteSchedule", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.DeleteSchedule(ctx, request)
+}
+
+// DeprecateDomain API call
+func (handler *AccessControlledWorkflowHandler) DeprecateDomain(
+	ctx context.Context,
+	request *shared.DeprecateDomainRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendDeprecateDomainScope, "DeprecateDomain", request.GetName()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.DeprecateDomain(ctx, request)
+}
+
+// DescribeDomain API call
+func (handler *AccessControlledWorkflowHandler) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+) (*shared.DescribeDomainResponse, error) {
+
+	domainName, err := handler.getDomainNameFromDescribeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := handler.authorize(ctx, metrics.FrontendDescribeDomainScope, "DescribeDomain", domainName); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.DescribeDomain(ctx, request)
+}
+
+// DescribeSchedule API call
+func (handler *AccessControlledWorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	request *shared.DescribeScheduleRequest,
+) (*shared.DescribeScheduleResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendDescribeScheduleScope, "DescribeSchedule", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.DescribeSchedule(ctx, request)
+}
+
+// DescribeTaskList API call
+func (handler *AccessControlledWorkflowHandler) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+) (*shared.DescribeTaskListResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendDescribeTaskListScope, "DescribeTaskList", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.DescribeTaskList(ctx, request)
+}
+
+// DescribeWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendDescribeWorkflowExecutionScope, "DescribeWorkflowExecution", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.DescribeWorkflowExecution(ctx, request)
+}
+
+// GetDomainReplicationMessages API call
+func (handler *AccessControlledWorkflowHandler) GetDomainReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetDomainReplicationMessagesRequest,
+) (*replicator.GetDomainReplicationMessagesResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendGetDomainReplicationMessagesScope, "GetDomainReplicationMessages", ""); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.GetDomainReplicationMessages(ctx, request)
+}
+
+// GetReplicationMessages API call
+func (handler *AccessControlledWorkflowHandler) GetReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetReplicationMessagesRequest,
+) (*replicator.GetReplicationMessagesResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendGetReplicationMessagesScope, "GetReplicationMessages", ""); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.GetReplicationMessages(ctx, request)
+}
+
+// GetSearchAttributes API call
+func (handler *AccessControlledWorkflowHandler) GetSearchAttributes(
+	ctx context.Context,
+	request *shared.GetSearchAttributesRequest,
+) (*shared.GetSearchAttributesResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendGetSearchAttributesScope, "GetSearchAttributes", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.GetSearchAttributes(ctx, request)
+}
+
+// GetWorkflowExecutionHistory API call
+func (handler *AccessControlledWorkflowHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendGetWorkflowExecutionHistoryScope, "GetWorkflowExecutionHistory", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.GetWorkflowExecutionHistory(ctx, request)
+}
+
+// ListArchivedWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListArchivedWorkflowExecutionsRequest,
+) (*shared.ListArchivedWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendListArchivedWorkflowExecutionsScope, "ListArchivedWorkflowExecutions", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListArchivedWorkflowExecutions(ctx, request)
+}
+
+// ListClosedWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+) (*shared.ListClosedWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendListClosedWorkflowExecutionsScope, "ListClosedWorkflowExecutions", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListClosedWorkflowExecutions(ctx, request)
+}
+
+// ListDomains API call
+func (handler *AccessControlledWorkflowHandler) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+) (*shared.ListDomainsResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendListDomainsScope, "ListDomains", ""); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListDomains(ctx, request)
+}
+
+// ListOpenWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendListOpenWorkflowExecutionsScope, "ListOpenWorkflowExecutions", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListOpenWorkflowExecutions(ctx, request)
+}
+
+// ListSchedules API call
+func (handler *AccessControlledWorkflowHandler) ListSchedules(
+	ctx context.Context,
+	request *shared.ListSchedulesRequest,
+) (*shared.ListSchedulesResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendListSchedulesScope, "ListSchedules", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListSchedules(ctx, request)
+}
+
+// ListWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ListWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendListWorkflowExecutionsScope, "ListWorkflowExecutions", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ListWorkflowExecutions(ctx, request)
+}
+
+// PauseSchedule API call
+func (handler *AccessControlledWorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	request *shared.PauseScheduleRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendPauseScheduleScope, "PauseSchedule", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.PauseSchedule(ctx, request)
+}
+
+// PollForActivityTask API call
+func (handler *AccessControlledWorkflowHandler) PollForActivityTask(
+	ctx context.Context,
+	request *shared.PollForActivityTaskRequest,
+) (*shared.PollForActivityTaskResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendPollForActivityTaskScope, "PollForActivityTask", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.PollForActivityTask(ctx, request)
+}
+
+// PollForDecisionTask API call
+func (handler *AccessControlledWorkflowHandler) PollForDecisionTask(
+	ctx context.Context,
+	request *shared.PollForDecisionTaskRequest,
+) (*shared.PollForDecisionTaskResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendPollForDecisionTaskScope, "PollForDecisionTask", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.PollForDecisionTask(ctx, request)
+}
+
+// QueryWorkflow API call
+func (handler *AccessControlledWorkflowHandler) QueryWorkflow(
+	ctx context.Context,
+	request *shared.QueryWorkflowRequest,
+) (*shared.QueryWorkflowResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendQueryWorkflowScope, "QueryWorkflow", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.QueryWorkflow(ctx, request)
+}
+
+// ReapplyEvents API call
+func (handler *AccessControlledWorkflowHandler) ReapplyEvents(
+	ctx context.Context,
+	request *shared.ReapplyEventsRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendReapplyEventsScope, "ReapplyEvents", request.GetDomainName()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.ReapplyEvents(ctx, request)
+}
+
+// RecordActivityTaskHeartbeat API call
+func (handler *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatRequest,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	domainName, err := handler.getDomainNameFromTaskToken(request.TaskToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := handler.authorize(ctx, metrics.FrontendRecordActivityTaskHeartbeatScope, "RecordActivityTaskHeartbeat", domainName); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.RecordActivityTaskHeartbeat(ctx, request)
+}
+
+// RecordActivityTaskHeartbeatByID API call
+func (handler *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatByIDRequest,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendRecordActivityTaskHeartbeatByIDScope, "RecordActivityTaskHeartbeatByID", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.RecordActivityTaskHeartbeatByID(ctx, request)
+}
+
+// RegisterDomain API call
+func (handler *AccessControlledWorkflowHandler) RegisterDomain(
+	ctx context.Context,
+	request *shared.RegisterDomainRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendRegisterDomainScope, "RegisterDomain", request.GetName()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RegisterDomain(ctx, request)
+}
+
+// RequestCancelWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *shared.RequestCancelWorkflowExecutionRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendRequestCancelWorkflowExecutionScope, "RequestCancelWorkflowExecution", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RequestCancelWorkflowExecution(ctx, request)
+}
+
+// ResetStickyTaskList API call
+func (handler *AccessControlledWorkflowHandler) ResetStickyTaskList(
+	ctx context.Context,
+	request *shared.ResetStickyTaskListRequest,
+) (*shared.ResetStickyTaskListResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendResetStickyTaskListScope, "ResetStickyTaskList", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ResetStickyTaskList(ctx, request)
+}
+
+// ResetWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendResetWorkflowExecutionScope, "ResetWorkflowExecution", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ResetWorkflowExecution(ctx, request)
+}
+
+// RespondActivityTaskCanceled API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledRequest,
+) error {
+
+	domainName, err := handler.getDomainNameFromTaskToken(request.TaskToken)
+	if err != nil {
+		return err
+	}
+	if err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskCanceledScope, "RespondActivityTaskCanceled", domainName); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskCanceled(ctx, request)
+}
+
+// RespondActivityTaskCanceledByID API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledByIDRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskCanceledByIDScope, "RespondActivityTaskCanceledByID", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskCanceledByID(ctx, request)
+}
+
+// RespondActivityTaskCompleted API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedRequest,
+) error {
+
+	domainName, err := handler.getDomainNameFromTaskToken(request.TaskToken)
+	if err != nil {
+		return err
+	}
+	if err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskCompletedScope, "RespondActivityTaskCompleted", domainName); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskCompleted(ctx, request)
+}
+
+// RespondActivityTaskCompletedByID API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedByIDRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskCompletedByIDScope, "RespondActivityTaskCompletedByID", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskCompletedByID(ctx, request)
+}
+
+// RespondActivityTaskFailed API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedRequest,
+) error {
+
+	domainName, err := handler.getDomainNameFromTaskToken(request.TaskToken)
+	if err != nil {
+		return err
+	}
+	if err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskFailedScope, "RespondActivityTaskFailed", domainName); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskFailed(ctx, request)
+}
+
+// RespondActivityTaskFailedByID API call
+func (handler *AccessControlledWorkflowHandler) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedByIDRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendRespondActivityTaskFailedByIDScope, "RespondActivityTaskFailedByID", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondActivityTaskFailedByID(ctx, request)
+}
+
+// RespondDecisionTaskCompleted API call
+func (handler *AccessControlledWorkflowHandler) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskCompletedRequest,
+) (*shared.RespondDecisionTaskCompletedResponse, error) {
+
+	domainName, err := handler.getDomainNameFromTaskToken(request.TaskToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := handler.authorize(ctx, metrics.FrontendRespondDecisionTaskCompletedScope, "RespondDecisionTaskCompleted", domainName); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.RespondDecisionTaskCompleted(ctx, request)
+}
+
+// RespondDecisionTaskFailed API call
+func (handler *AccessControlledWorkflowHandler) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskFailedRequest,
+) error {
+
+	domainName, err := handler.getDomainNameFromTaskToken(request.TaskToken)
+	if err != nil {
+		return err
+	}
+	if err := handler.authorize(ctx, metrics.FrontendRespondDecisionTaskFailedScope, "RespondDecisionTaskFailed", domainName); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondDecisionTaskFailed(ctx, request)
+}
+
+// RespondQueryTaskCompleted API call
+func (handler *AccessControlledWorkflowHandler) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondQueryTaskCompletedRequest,
+) error {
+
+	domainName, err := handler.getDomainNameFromQueryTaskToken(request.TaskToken)
+	if err != nil {
+		return err
+	}
+	if err := handler.authorize(ctx, metrics.FrontendRespondQueryTaskCompletedScope, "RespondQueryTaskCompleted", domainName); err != nil {
+		return err
+	}
+	return handler.frontendHandler.RespondQueryTaskCompleted(ctx, request)
+}
+
+// ScanWorkflowExecutions API call
+func (handler *AccessControlledWorkflowHandler) ScanWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListWorkflowExecutionsRequest,
+) (*shared.ListWorkflowExecutionsResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendScanWorkflowExecutionsScope, "ScanWorkflowExecutions", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.ScanWorkflowExecutions(ctx, request)
+}
+
+// SignalWithStartWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendSignalWithStartWorkflowExecutionScope, "SignalWithStartWorkflowExecution", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.SignalWithStartWorkflowExecution(ctx, request)
+}
+
+// SignalWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendSignalWorkflowExecutionScope, "SignalWorkflowExecution", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.SignalWorkflowExecution(ctx, request)
+}
+
+// StartWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendStartWorkflowExecutionScope, "StartWorkflowExecution", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.StartWorkflowExecution(ctx, request)
+}
+
+// TerminateWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *shared.TerminateWorkflowExecutionRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendTerminateWorkflowExecutionScope, "TerminateWorkflowExecution", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.TerminateWorkflowExecution(ctx, request)
+}
+
+// UnpauseSchedule API call
+func (handler *AccessControlledWorkflowHandler) UnpauseSchedule(
+	ctx context.Context,
+	request *shared.UnpauseScheduleRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendUnpauseScheduleScope, "UnpauseSchedule", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.UnpauseSchedule(ctx, request)
+}
+
+// UpdateDomain API call
+func (handler *AccessControlledWorkflowHandler) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+) (*shared.UpdateDomainResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendUpdateDomainScope, "UpdateDomain", request.GetName()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.UpdateDomain(ctx, request)
+}
+
+// UpdateSchedule API call
+func (handler *AccessControlledWorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	request *shared.UpdateScheduleRequest,
+) error {
+
+	if err := handler.authorize(ctx, metrics.FrontendUpdateScheduleScope, "UpdateSchedule", request.GetDomain()); err != nil {
+		return err
+	}
+	return handler.frontendHandler.UpdateSchedule(ctx, request)
+}
+
+// UpdateWorkflowExecution API call
+func (handler *AccessControlledWorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	if err := handler.authorize(ctx, metrics.FrontendUpdateWorkflowExecutionScope, "UpdateWorkflowExecution", request.GetDomain()); err != nil {
+		return nil, err
+	}
+	return handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+}
+
+func (handler *AccessControlledWorkflowHandler) getDomainNameFromTaskToken(
+	taskToken []byte,
+) (string, error) {
+
+	token, err := handler.tokenSerializer.Deserialize(taskToken)
+	if err != nil {
+		return "", errInvalidTaskToken
+	}
+	return handler.getDomainNameByID(token.DomainID)
+}
+
+func (handler *AccessControlledWorkflowHandler) getDomainNameFromQueryTaskToken(
+	taskToken []byte,
+) (string, error) {
+
+	token, err := handler.tokenSerializer.DeserializeQueryTaskToken(taskToken)
+	if err != nil {
+		return "", errInvalidTaskToken
+	}
+	return handler.getDomainNameByID(token.DomainID)
+}
+
+func (handler *AccessControlledWorkflowHandler) getDomainNameFromDescribeRequest(
+	request *shared.DescribeDomainRequest,
+) (string, error) {
+
+	if request.GetName() != "" || request.GetUUID() == "" {
+		return request.GetName(), nil
+	}
+	return handler.getDomainNameByID(request.GetUUID())
+}
+
+func (handler *AccessControlledWorkflowHandler) getDomainNameByID(
+	domainID string,
+) (string, error) {
+
+	domainEntry, err := handler.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return "", err
+	}
+	return domainEntry.GetInfo().Name, nil
+}
+
+// authorize checks whether the authenticated caller is allowed to call the API on the domain,
+// an empty domain name is used for APIs which are not domain scoped
+func (a *accessControl) authorize(
+	ctx context.Context,
+	scope int,
+	apiName string,
+	domainName string,
+) error {
+
+	var metricsScope metrics.Scope
+	if domainName != "" {
+		metricsScope = a.metricsClient.Scope(scope).Tagged(metrics.DomainTag(domainName))
+	} else {
+		metricsScope = a.metricsClient.Scope(scope).Tagged(metrics.DomainUnknownTag())
+	}
+
+	actor, err := a.authenticator.Authenticate(ctx)
+	if err != nil {
+		metricsScope.IncCounter(metrics.CadenceErrUnauthorizedCounter)
+		return errUnauthorized
+	}
+
+	sw := metricsScope.StartTimer(metrics.CadenceAuthorizationLatency)
+	result, err := a.authorizer.Authorize(ctx, &authorization.Attributes{
+		Actor:      actor,
+		APIName:    apiName,
+		DomainName: domainName,
+	})
+	sw.Stop()
+
+	if err != nil {
+		metricsScope.IncCounter(metrics.CadenceFailures)
+		return &shared.InternalServiceError{Message: err.Error()}
+	}
+	if result.Decision != authorization.DecisionAllow {
+		metricsScope.IncCounter(metrics.CadenceErrUnauthorizedCounter)
+		return errUnauthorized
+	}
+	return nil
+}
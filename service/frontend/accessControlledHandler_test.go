@@ -0,0 +1,205 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+)
+
+type (
+	accessControlledHandlerSuite struct {
+		suite.Suite
+		domainName string
+		domainID   string
+		tokenKey   []byte
+
+		controller          *gomock.Controller
+		mockFrontendHandler *MockWorkflowHandler
+		mockDomainCache     *cache.DomainCacheMock
+
+		handler *AccessControlledWorkflowHandler
+	}
+
+	errorAuthorizer struct{}
+)
+
+func TestAccessControlledHandlerSuite(t *testing.T) {
+	s := new(accessControlledHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *accessControlledHandlerSuite) SetupTest() {
+	s.domainName = "some random domain name"
+	s.domainID = "some random domain ID"
+	s.tokenKey = []byte("some random token key")
+	s.controller = gomock.NewController(s.T())
+	s.mockFrontendHandler = NewMockWorkflowHandler(s.controller)
+	s.mockDomainCache = &cache.DomainCacheMock{}
+
+	authorizer := authorization.NewStaticAuthorizer(config.StaticAuthorization{
+		Roles: map[string][]string{
+			"some-worker": {"worker"},
+		},
+		Domains: map[string]map[string][]string{
+			s.domainName: {
+				"worker": {"StartWorkflowExecution", "RespondDecisionTaskCompleted"},
+			},
+		},
+	})
+	s.handler = &AccessControlledWorkflowHandler{
+		accessControl: accessControl{
+			authenticator: authorization.NewTokenAuthenticator(s.tokenKey),
+			authorizer:    authorizer,
+			metricsClient: metrics.NewClient(tally.NoopScope, metrics.Frontend),
+		},
+		domainCache:     s.mockDomainCache,
+		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+		frontendHandler: s.mockFrontendHandler,
+	}
+}
+
+func (s *accessControlledHandlerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockDomainCache.AssertExpectations(s.T())
+}
+
+func (s *accessControlledHandlerSuite) TestStartWorkflowExecution_Allowed() {
+	ctx := s.contextWithToken("some-worker")
+	req := &shared.StartWorkflowExecutionRequest{Domain: common.StringPtr(s.domainName)}
+	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("some random run ID")}
+	s.mockFrontendHandler.EXPECT().StartWorkflowExecution(ctx, req).Return(resp, nil).Times(1)
+
+	result, err := s.handler.StartWorkflowExecution(ctx, req)
+	s.NoError(err)
+	s.Equal(resp, result)
+}
+
+func (s *accessControlledHandlerSuite) TestStartWorkflowExecution_Denied() {
+	req := &shared.StartWorkflowExecutionRequest{Domain: common.StringPtr("other domain name")}
+	_, err := s.handler.StartWorkflowExecution(s.contextWithToken("some-worker"), req)
+	s.Equal(errUnauthorized, err)
+
+	req = &shared.StartWorkflowExecutionRequest{Domain: common.StringPtr(s.domainName)}
+	_, err = s.handler.StartWorkflowExecution(s.contextWithToken("other-worker"), req)
+	s.Equal(errUnauthorized, err)
+
+	_, err = s.handler.StartWorkflowExecution(context.Background(), req)
+	s.Equal(errUnauthorized, err)
+}
+
+func (s *accessControlledHandlerSuite) TestStartWorkflowExecution_SpoofedCallerDenied() {
+	req := &shared.StartWorkflowExecutionRequest{Domain: common.StringPtr(s.domainName)}
+	_, err := s.handler.StartWorkflowExecution(s.contextWithHeaders("some-worker", transport.NewHeaders()), req)
+	s.Equal(errUnauthorized, err)
+
+	token, err := authorization.NewToken([]byte("other token key"), "some-worker", time.Now().Add(time.Minute))
+	s.NoError(err)
+	_, err = s.handler.StartWorkflowExecution(s.contextWithHeaders(
+		"some-worker",
+		transport.NewHeaders().With(authorization.TokenHeaderName, token),
+	), req)
+	s.Equal(errUnauthorized, err)
+
+	token, err = authorization.NewToken(s.tokenKey, "some-worker", time.Now().Add(-time.Minute))
+	s.NoError(err)
+	_, err = s.handler.StartWorkflowExecution(s.contextWithHeaders(
+		"some-worker",
+		transport.NewHeaders().With(authorization.TokenHeaderName, token),
+	), req)
+	s.Equal(errUnauthorized, err)
+}
+
+func (s *accessControlledHandlerSuite) TestTerminateWorkflowExecution_Denied() {
+	req := &shared.TerminateWorkflowExecutionRequest{Domain: common.StringPtr(s.domainName)}
+	err := s.handler.TerminateWorkflowExecution(s.contextWithToken("some-worker"), req)
+	s.IsType(&shared.AccessDeniedError{}, err)
+}
+
+func (s *accessControlledHandlerSuite) TestRespondDecisionTaskCompleted_DomainFromTaskToken() {
+	token, err := s.handler.tokenSerializer.Serialize(&common.TaskToken{
+		DomainID:   s.domainID,
+		WorkflowID: "some random workflow ID",
+		RunID:      "some random run ID",
+		ScheduleID: 2,
+	})
+	s.NoError(err)
+	s.mockDomainCache.On("GetDomainByID", s.domainID).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName}, nil, "", nil,
+	), nil).Once()
+
+	ctx := s.contextWithToken("some-worker")
+	req := &shared.RespondDecisionTaskCompletedRequest{TaskToken: token}
+	resp := &shared.RespondDecisionTaskCompletedResponse{}
+	s.mockFrontendHandler.EXPECT().RespondDecisionTaskCompleted(ctx, req).Return(resp, nil).Times(1)
+
+	result, err := s.handler.RespondDecisionTaskCompleted(ctx, req)
+	s.NoError(err)
+	s.Equal(resp, result)
+}
+
+func (s *accessControlledHandlerSuite) TestRespondDecisionTaskCompleted_InvalidTaskToken() {
+	req := &shared.RespondDecisionTaskCompletedRequest{TaskToken: []byte("some random task token")}
+	_, err := s.handler.RespondDecisionTaskCompleted(s.contextWithToken("some-worker"), req)
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *accessControlledHandlerSuite) TestAuthorizerError() {
+	s.handler.authorizer = &errorAuthorizer{}
+	req := &shared.StartWorkflowExecutionRequest{Domain: common.StringPtr(s.domainName)}
+	_, err := s.handler.StartWorkflowExecution(s.contextWithToken("some-worker"), req)
+	s.IsType(&shared.InternalServiceError{}, err)
+}
+
+func (s *accessControlledHandlerSuite) contextWithToken(subject string) context.Context {
+	token, err := authorization.NewToken(s.tokenKey, subject, time.Now().Add(time.Minute))
+	s.NoError(err)
+	return s.contextWithHeaders("cadence-client", transport.NewHeaders().With(authorization.TokenHeaderName, token))
+}
+
+func (s *accessControlledHandlerSuite) contextWithHeaders(caller string, headers transport.Headers) context.Context {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequestMeta(&transport.RequestMeta{Caller: caller, Headers: headers}))
+	return ctx
+}
+
+func (a *errorAuthorizer) Authorize(
+	ctx context.Context,
+	attributes *authorization.Attributes,
+) (authorization.Result, error) {
+
+	return authorization.Result{}, errors.New("some random error")
+}
@@ -35,11 +35,15 @@ import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
 	h "github.com/uber/cadence/.gen/go/history"
 	hist "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -58,10 +62,12 @@ type (
 		numberOfHistoryShards int
 		service.Service
 		history       history.Client
+		matching      matching.Client
 		domainCache   cache.DomainCache
 		metricsClient metrics.Client
 		historyMgr    persistence.HistoryManager
 		historyV2Mgr  persistence.HistoryV2Manager
+		domainHandler domain.Handler
 		startWG       sync.WaitGroup
 		params        *service.BootstrapParams
 	}
@@ -74,6 +80,7 @@ func NewAdminHandler(
 	metadataMgr persistence.MetadataManager,
 	historyMgr persistence.HistoryManager,
 	historyV2Mgr persistence.HistoryV2Manager,
+	domainHandler domain.Handler,
 	params *service.BootstrapParams,
 ) *AdminHandler {
 	handler := &AdminHandler{
@@ -83,6 +90,7 @@ func NewAdminHandler(
 		domainCache:           cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
 		historyMgr:            historyMgr,
 		historyV2Mgr:          historyV2Mgr,
+		domainHandler:         domainHandler,
 		params:                params,
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
@@ -104,6 +112,11 @@ func (adh *AdminHandler) Start() error {
 	adh.domainCache.Start()
 
 	adh.history = adh.GetClientBean().GetHistoryClient()
+	matchingRawClient, err := adh.GetClientBean().GetMatchingClient(adh.domainCache.GetDomainName)
+	if err != nil {
+		return err
+	}
+	adh.matching = matching.NewRetryableClient(matchingRawClient, common.CreateMatchingServiceRetryPolicy(), common.IsWhitelistServiceTransientError)
 	adh.metricsClient = adh.Service.GetMetricsClient()
 	adh.startWG.Done()
 	return nil
@@ -118,8 +131,10 @@ func (adh *AdminHandler) Stop() {
 	adh.domainCache.Stop()
 }
 
-// AddSearchAttribute add search attribute to whitelist
-func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.AddSearchAttributeRequest) error {
+// AddSearchAttribute add search attribute to whitelist, or registers it in the domain if one is provided
+func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.AddSearchAttributeRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminAddSearchAttributeScope
 	// validate request
 	if request == nil {
 		return &gen.BadRequestError{Message: "Request is not provided"}
@@ -138,23 +153,39 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.
 		if _, exist := currentValidAttr[k]; exist {
 			return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is already whitelist", k)}
 		}
+		if len(convertIndexedValueTypeToESDataType(v)) == 0 {
+			return &gen.BadRequestError{Message: fmt.Sprintf("Unknown value type, %v", v)}
+		}
 
 		currentValidAttr[k] = int(v)
 	}
 
-	// update dynamic config
-	err := adh.params.DynamicConfig.UpdateValue(dynamicconfig.ValidSearchAttributes, currentValidAttr)
-	if err != nil {
-		return &gen.InternalServiceError{Message: fmt.Sprintf("Failed to update dynamic config, err: %v", err)}
+	if len(request.GetDomain()) != 0 {
+		// register in domain config, which is replicated along with the domain
+		err := adh.domainHandler.UpdateSearchAttributes(ctx, request.GetDomain(), func(domainAttr map[string]gen.IndexedValueType) error {
+			for k, v := range searchAttr {
+				if _, exist := domainAttr[k]; exist {
+					return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is already registered in domain %v", k, request.GetDomain())}
+				}
+				domainAttr[k] = v
+			}
+			return nil
+		})
+		if err != nil {
+			return adh.error(err, scope)
+		}
+	} else {
+		// update dynamic config
+		err := adh.params.DynamicConfig.UpdateValue(dynamicconfig.ValidSearchAttributes, currentValidAttr)
+		if err != nil {
+			return &gen.InternalServiceError{Message: fmt.Sprintf("Failed to update dynamic config, err: %v", err)}
+		}
 	}
 
 	// update elasticsearch mapping, new added field will not be able to remove or update
 	index := adh.params.ESConfig.GetVisibilityIndex()
 	for k, v := range searchAttr {
 		valueType := convertIndexedValueTypeToESDataType(v)
-		if len(valueType) == 0 {
-			return &gen.BadRequestError{Message: fmt.Sprintf("Unknown value type, %v", v)}
-		}
 		err := adh.params.ESClient.PutMapping(ctx, index, definition.Attr, k, valueType)
 		if elastic.IsNotFound(err) {
 			err = adh.params.ESClient.CreateIndex(ctx, index)
@@ -171,6 +202,55 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.
 	return nil
 }
 
+// RemoveSearchAttribute removes search attributes registered in a domain, the elasticsearch mapping is kept
+func (adh *AdminHandler) RemoveSearchAttribute(ctx context.Context, request *admin.RemoveSearchAttributeRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminRemoveSearchAttributeScope
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if len(request.GetDomain()) == 0 {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if len(request.GetKeys()) == 0 {
+		return adh.error(&gen.BadRequestError{Message: "Keys are not provided"}, scope)
+	}
+
+	err := adh.domainHandler.UpdateSearchAttributes(ctx, request.GetDomain(), func(domainAttr map[string]gen.IndexedValueType) error {
+		for _, k := range request.GetKeys() {
+			if _, exist := domainAttr[k]; !exist {
+				return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is not registered in domain %v", k, request.GetDomain())}
+			}
+			delete(domainAttr, k)
+		}
+		return nil
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ListSearchAttributes returns the search attributes registered in a domain
+func (adh *AdminHandler) ListSearchAttributes(ctx context.Context, request *admin.ListSearchAttributesRequest) (resp *gen.GetSearchAttributesResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminListSearchAttributesScope
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if len(request.GetDomain()) == 0 {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+
+	domainAttr, err := adh.getDomainSearchAttributes(ctx, request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &gen.GetSearchAttributesResponse{
+		Keys: domainAttr,
+	}, nil
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request *admin.DescribeWorkflowExecutionRequest) (resp *admin.DescribeWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
@@ -421,6 +501,424 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	return result, nil
 }
 
+// PauseActivity stops dispatching further attempts of a pending activity
+func (adh *AdminHandler) PauseActivity(ctx context.Context, request *gen.PauseActivityRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminPauseActivityScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainID, err := adh.validateActivityRequest(request.GetDomain(), request.WorkflowExecution, request.GetActivityID())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.PauseActivity(ctx, &hist.PauseActivityRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		PauseRequest: request,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UnpauseActivity resumes dispatching of a paused activity
+func (adh *AdminHandler) UnpauseActivity(ctx context.Context, request *gen.UnpauseActivityRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminUnpauseActivityScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainID, err := adh.validateActivityRequest(request.GetDomain(), request.WorkflowExecution, request.GetActivityID())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.UnpauseActivity(ctx, &hist.UnpauseActivityRequest{
+		DomainUUID:     common.StringPtr(domainID),
+		UnpauseRequest: request,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ResetActivity resets the attempt counter and retry state of a pending activity
+func (adh *AdminHandler) ResetActivity(ctx context.Context, request *gen.ResetActivityRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminResetActivityScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainID, err := adh.validateActivityRequest(request.GetDomain(), request.WorkflowExecution, request.GetActivityID())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.ResetActivity(ctx, &hist.ResetActivityRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		ResetRequest: request,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UpdateActivityTimeouts updates the timeouts of a pending activity
+func (adh *AdminHandler) UpdateActivityTimeouts(ctx context.Context, request *gen.UpdateActivityTimeoutsRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminUpdateActivityTimeoutsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainID, err := adh.validateActivityRequest(request.GetDomain(), request.WorkflowExecution, request.GetActivityID())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.UpdateActivityTimeouts(ctx, &hist.UpdateActivityTimeoutsRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		UpdateRequest: request,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UpdateTaskListLimits pins the dispatch rate and the max number of outstanding tasks of a task list
+func (adh *AdminHandler) UpdateTaskListLimits(ctx context.Context, request *gen.UpdateTaskListLimitsRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminUpdateTaskListLimitsScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if request.TaskList == nil || request.TaskList.GetName() == "" {
+		return adh.error(errTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return adh.error(errTaskListTypeNotSet, scope)
+	}
+	if request.Limits == nil {
+		return adh.error(errTaskListLimitsNotSet, scope)
+	}
+	if request.Limits.GetMaxTasksPerSecond() < 0 || request.Limits.GetMaxOutstandingTasks() < 0 {
+		return adh.error(errInvalidTaskListLimits, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.matching.UpdateTaskListLimits(ctx, &m.UpdateTaskListLimitsRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		UpdateRequest: request,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// VerifyArchivedWorkflowExecution checks the archived history of a workflow execution against its checksum
+func (adh *AdminHandler) VerifyArchivedWorkflowExecution(
+	ctx context.Context,
+	request *admin.VerifyArchivedWorkflowExecutionRequest,
+) (resp *admin.VerifyArchivedWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminVerifyArchivedWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	domainEntry, URI, historyArchiver, err := adh.getHistoryArchiver(request.GetDomain(), request.Execution)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	err = historyArchiver.Verify(ctx, URI, &archiver.VerifyHistoryRequest{
+		DomainID:   domainEntry.GetInfo().ID,
+		WorkflowID: request.Execution.GetWorkflowId(),
+		RunID:      request.Execution.GetRunId(),
+	})
+	switch err {
+	case nil:
+		return &admin.VerifyArchivedWorkflowExecutionResponse{Status: admin.ArchivalVerificationStatusVerified.Ptr()}, nil
+	case archiver.ErrHistoryNotExist:
+		return &admin.VerifyArchivedWorkflowExecutionResponse{Status: admin.ArchivalVerificationStatusMissing.Ptr()}, nil
+	case archiver.ErrHistoryCorrupted:
+		return &admin.VerifyArchivedWorkflowExecutionResponse{Status: admin.ArchivalVerificationStatusCorrupted.Ptr()}, nil
+	default:
+		return nil, adh.error(err, scope)
+	}
+}
+
+// RearchiveWorkflowExecution archives the history of a closed workflow execution again,
+// the history must not have been deleted yet
+func (adh *AdminHandler) RearchiveWorkflowExecution(
+	ctx context.Context,
+	request *admin.RearchiveWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminRearchiveWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	domainEntry, URI, historyArchiver, err := adh.getHistoryArchiver(request.GetDomain(), request.Execution)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID := domainEntry.GetInfo().ID
+	mutableState, err := adh.history.GetMutableState(ctx, &h.GetMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if mutableState.GetIsWorkflowRunning() {
+		return adh.error(errWorkflowNotClosed, scope)
+	}
+
+	closeFailoverVersion, err := adh.getLastEventVersion(domainID, *request.Execution, mutableState)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	mutableStateSummary, err := adh.history.DescribeWorkflowExecution(ctx, &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &gen.DescribeWorkflowExecutionRequest{
+			Domain:    request.Domain,
+			Execution: request.Execution,
+		},
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := historyArchiver.Archive(ctx, URI, &archiver.ArchiveHistoryRequest{
+		ShardID:              common.WorkflowIDToHistoryShard(request.Execution.GetWorkflowId(), adh.numberOfHistoryShards),
+		DomainID:             domainID,
+		DomainName:           domainEntry.GetInfo().Name,
+		WorkflowID:           request.Execution.GetWorkflowId(),
+		RunID:                request.Execution.GetRunId(),
+		EventStoreVersion:    mutableState.GetEventStoreVersion(),
+		BranchToken:          mutableState.GetCurrentBranchToken(),
+		NextEventID:          mutableState.GetNextEventId(),
+		CloseFailoverVersion: closeFailoverVersion,
+		MutableStateSummary:  mutableStateSummary,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ImportWorkflowExecution creates a workflow execution from history batches exported from another cluster,
+// the run ID of the execution is kept unless it is empty
+func (adh *AdminHandler) ImportWorkflowExecution(
+	ctx context.Context,
+	request *admin.ImportWorkflowExecutionRequest,
+) (resp *admin.ImportWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminImportWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if len(request.HistoryBatches) == 0 {
+		return nil, adh.error(errHistoryBatchesNotSet, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	runID := request.Execution.GetRunId()
+	if runID == "" {
+		runID = uuid.New()
+	}
+	if err := adh.history.ImportWorkflowExecution(ctx, &h.ImportWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution: &gen.WorkflowExecution{
+			WorkflowId: request.Execution.WorkflowId,
+			RunId:      common.StringPtr(runID),
+		},
+		HistoryBatches: request.HistoryBatches,
+	}); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.ImportWorkflowExecutionResponse{
+		RunId: common.StringPtr(runID),
+	}, nil
+}
+
+// getHistoryArchiver validates the domain and execution of an archival request and
+// returns the history archiver for the archival URI of the domain
+func (adh *AdminHandler) getHistoryArchiver(
+	domain string,
+	execution *gen.WorkflowExecution,
+) (*cache.DomainCacheEntry, archiver.URI, archiver.HistoryArchiver, error) {
+
+	if domain == "" {
+		return nil, nil, nil, errDomainNotSet
+	}
+	if err := validateExecution(execution); err != nil {
+		return nil, nil, nil, err
+	}
+	if execution.GetRunId() == "" {
+		return nil, nil, nil, errRunIDNotSet
+	}
+
+	domainEntry, err := adh.domainCache.GetDomain(domain)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	URIString := domainEntry.GetConfig().HistoryArchivalURI
+	if URIString == "" {
+		return nil, nil, nil, errHistoryArchivalURINotSet
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	historyArchiver, err := adh.GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), common.FrontendServiceName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return domainEntry, URI, historyArchiver, nil
+}
+
+// getLastEventVersion reads the last batch of the workflow history and returns the version of its last event,
+// which is the close failover version the history is archived under
+func (adh *AdminHandler) getLastEventVersion(
+	domainID string,
+	execution gen.WorkflowExecution,
+	mutableState *h.GetMutableStateResponse,
+) (int64, error) {
+
+	var lastEvent *gen.HistoryEvent
+	var nextPageToken []byte
+	for {
+		var events []*gen.HistoryEvent
+		var err error
+		if len(mutableState.GetCurrentBranchToken()) != 0 {
+			shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowId(), adh.numberOfHistoryShards)
+			events, _, nextPageToken, err = persistence.ReadFullPageV2Events(adh.historyV2Mgr, &persistence.ReadHistoryBranchRequest{
+				BranchToken:   mutableState.GetCurrentBranchToken(),
+				MinEventID:    mutableState.GetLastFirstEventId(),
+				MaxEventID:    mutableState.GetNextEventId(),
+				PageSize:      common.GetHistoryMaxPageSize,
+				NextPageToken: nextPageToken,
+				ShardID:       common.IntPtr(shardID),
+			})
+		} else {
+			var response *persistence.GetWorkflowExecutionHistoryResponse
+			response, err = adh.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+				DomainID:      domainID,
+				Execution:     execution,
+				FirstEventID:  mutableState.GetLastFirstEventId(),
+				NextEventID:   mutableState.GetNextEventId(),
+				PageSize:      common.GetHistoryMaxPageSize,
+				NextPageToken: nextPageToken,
+			})
+			if response != nil {
+				events = response.History.Events
+				nextPageToken = response.NextPageToken
+			}
+		}
+		if err != nil {
+			return 0, err
+		}
+		if len(events) > 0 {
+			lastEvent = events[len(events)-1]
+		}
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	if lastEvent == nil {
+		return 0, &gen.EntityNotExistsError{Message: "Workflow history is empty."}
+	}
+	return lastEvent.GetVersion(), nil
+}
+
+func (adh *AdminHandler) validateActivityRequest(
+	domain string,
+	execution *gen.WorkflowExecution,
+	activityID string,
+) (string, error) {
+
+	if domain == "" {
+		return "", errDomainNotSet
+	}
+	if err := validateExecution(execution); err != nil {
+		return "", err
+	}
+	if activityID == "" {
+		return "", errActivityIDNotSet
+	}
+	return adh.domainCache.GetDomainID(domain)
+}
+
+// getDomainSearchAttributes reads the search attributes registered in the domain
+func (adh *AdminHandler) getDomainSearchAttributes(
+	ctx context.Context,
+	domainName string,
+) (map[string]gen.IndexedValueType, error) {
+
+	resp, err := adh.domainHandler.DescribeDomain(ctx, &gen.DescribeDomainRequest{
+		Name: common.StringPtr(domainName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	domainAttr := make(map[string]gen.IndexedValueType, len(resp.Configuration.SearchAttributes))
+	for k, v := range resp.Configuration.SearchAttributes {
+		domainAttr[k] = v
+	}
+	return domainAttr, nil
+}
+
 // startRequestProfile initiates recording of request metrics
 func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
 	adh.startWG.Wait()
@@ -440,6 +938,10 @@ func (adh *AdminHandler) error(err error, scope int) error {
 		return err
 	case *gen.EntityNotExistsError:
 		return err
+	case *gen.WorkflowExecutionAlreadyStartedError:
+		return err
+	case *gen.DomainNotActiveError:
+		return err
 	default:
 		adh.Service.GetLogger().Error("Uncategorized error", tag.Error(err))
 		return &gen.InternalServiceError{Message: err.Error()}
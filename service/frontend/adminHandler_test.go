@@ -21,12 +21,99 @@
 package frontend
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/service"
 )
 
+type fakeDomainHandler struct {
+	domain.Handler
+	searchAttributes map[string]shared.IndexedValueType
+}
+
+func (h *fakeDomainHandler) DescribeDomain(
+	ctx context.Context,
+	describeRequest *shared.DescribeDomainRequest,
+) (*shared.DescribeDomainResponse, error) {
+	if describeRequest.GetName() != "test-domain" {
+		return nil, &shared.EntityNotExistsError{Message: "domain does not exist"}
+	}
+	return &shared.DescribeDomainResponse{
+		Configuration: &shared.DomainConfiguration{SearchAttributes: h.searchAttributes},
+	}, nil
+}
+
+func (h *fakeDomainHandler) UpdateSearchAttributes(
+	ctx context.Context,
+	domainName string,
+	update func(searchAttributes map[string]shared.IndexedValueType) error,
+) error {
+	if domainName != "test-domain" {
+		return &shared.EntityNotExistsError{Message: "domain does not exist"}
+	}
+	searchAttributes := make(map[string]shared.IndexedValueType, len(h.searchAttributes))
+	for k, v := range h.searchAttributes {
+		searchAttributes[k] = v
+	}
+	if err := update(searchAttributes); err != nil {
+		return err
+	}
+	h.searchAttributes = searchAttributes
+	return nil
+}
+
+func newTestAdminHandlerWithDomainHandler(domainHandler domain.Handler) *AdminHandler {
+	return &AdminHandler{
+		Service:       service.NewTestService(nil, nil, nil, nil, nil, nil, nil),
+		domainHandler: domainHandler,
+	}
+}
+
+func Test_DomainSearchAttributes(t *testing.T) {
+	domainHandler := &fakeDomainHandler{
+		searchAttributes: map[string]shared.IndexedValueType{
+			"key1": shared.IndexedValueTypeKeyword,
+			"key2": shared.IndexedValueTypeInt,
+		},
+	}
+	adh := newTestAdminHandlerWithDomainHandler(domainHandler)
+	ctx := context.Background()
+
+	resp, err := adh.ListSearchAttributes(ctx, &admin.ListSearchAttributesRequest{Domain: common.StringPtr("test-domain")})
+	require.NoError(t, err)
+	require.Equal(t, domainHandler.searchAttributes, resp.Keys)
+
+	_, err = adh.ListSearchAttributes(ctx, &admin.ListSearchAttributesRequest{})
+	require.IsType(t, &shared.BadRequestError{}, err)
+	_, err = adh.ListSearchAttributes(ctx, &admin.ListSearchAttributesRequest{Domain: common.StringPtr("unknown-domain")})
+	require.IsType(t, &shared.EntityNotExistsError{}, err)
+
+	err = adh.RemoveSearchAttribute(ctx, &admin.RemoveSearchAttributeRequest{
+		Domain: common.StringPtr("test-domain"),
+		Keys:   []string{"key2", "key3"},
+	})
+	require.IsType(t, &shared.BadRequestError{}, err)
+	require.Len(t, domainHandler.searchAttributes, 2)
+	err = adh.RemoveSearchAttribute(ctx, &admin.RemoveSearchAttributeRequest{
+		Domain: common.StringPtr("unknown-domain"),
+		Keys:   []string{"key1"},
+	})
+	require.IsType(t, &shared.EntityNotExistsError{}, err)
+
+	err = adh.RemoveSearchAttribute(ctx, &admin.RemoveSearchAttributeRequest{
+		Domain: common.StringPtr("test-domain"),
+		Keys:   []string{"key1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]shared.IndexedValueType{"key2": shared.IndexedValueTypeInt}, domainHandler.searchAttributes)
+}
+
 func Test_ConvertIndexedValueTypeToESDataType(t *testing.T) {
 	tests := []struct {
 		input    shared.IndexedValueType
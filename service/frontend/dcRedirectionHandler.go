@@ -465,6 +465,7 @@ func (handler *DCRedirectionHandlerImpl) CountWorkflowExecutions(
 // GetSearchAttributes API call
 func (handler *DCRedirectionHandlerImpl) GetSearchAttributes(
 	ctx context.Context,
+	request *shared.GetSearchAttributesRequest,
 ) (resp *shared.GetSearchAttributesResponse, retError error) {
 
 	var cluster = handler.currentClusterName
@@ -474,7 +475,7 @@ func (handler *DCRedirectionHandlerImpl) GetSearchAttributes(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
-	return handler.frontendHandler.GetSearchAttributes(ctx)
+	return handler.frontendHandler.GetSearchAttributes(ctx, request)
 }
 
 // PollForActivityTask API call
@@ -567,6 +568,36 @@ func (handler *DCRedirectionHandlerImpl) QueryWorkflow(
 	return resp, err
 }
 
+// UpdateWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+) (resp *shared.UpdateWorkflowExecutionResponse, retError error) {
+
+	var apiName = "UpdateWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.UpdateWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // RecordActivityTaskHeartbeat API call
 func (handler *DCRedirectionHandlerImpl) RecordActivityTaskHeartbeat(
 	ctx context.Context,
@@ -1188,3 +1219,237 @@ func (handler *DCRedirectionHandlerImpl) afterCall(
 		scope.IncCounter(metrics.CadenceDcRedirectionClientFailures)
 	}
 }
+
+// CreateSchedule API call
+func (handler *DCRedirectionHandlerImpl) CreateSchedule(
+	ctx context.Context,
+	request *shared.CreateScheduleRequest,
+) (retError error) {
+
+	var apiName = "CreateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCreateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.CreateSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.CreateSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// DescribeSchedule API call
+func (handler *DCRedirectionHandlerImpl) DescribeSchedule(
+	ctx context.Context,
+	request *shared.DescribeScheduleRequest,
+) (resp *shared.DescribeScheduleResponse, retError error) {
+
+	var apiName = "DescribeSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.DescribeSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.DescribeSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
+// UpdateSchedule API call
+func (handler *DCRedirectionHandlerImpl) UpdateSchedule(
+	ctx context.Context,
+	request *shared.UpdateScheduleRequest,
+) (retError error) {
+
+	var apiName = "UpdateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UpdateSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UpdateSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// PauseSchedule API call
+func (handler *DCRedirectionHandlerImpl) PauseSchedule(
+	ctx context.Context,
+	request *shared.PauseScheduleRequest,
+) (retError error) {
+
+	var apiName = "PauseSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// UnpauseSchedule API call
+func (handler *DCRedirectionHandlerImpl) UnpauseSchedule(
+	ctx context.Context,
+	request *shared.UnpauseScheduleRequest,
+) (retError error) {
+
+	var apiName = "UnpauseSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// DeleteSchedule API call
+func (handler *DCRedirectionHandlerImpl) DeleteSchedule(
+	ctx context.Context,
+	request *shared.DeleteScheduleRequest,
+) (retError error) {
+
+	var apiName = "DeleteSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.DeleteSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.DeleteSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// BackfillSchedule API call
+func (handler *DCRedirectionHandlerImpl) BackfillSchedule(
+	ctx context.Context,
+	request *shared.BackfillScheduleRequest,
+) (retError error) {
+
+	var apiName = "BackfillSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionBackfillScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.BackfillSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.BackfillSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// ListSchedules API call
+func (handler *DCRedirectionHandlerImpl) ListSchedules(
+	ctx context.Context,
+	request *shared.ListSchedulesRequest,
+) (resp *shared.ListSchedulesResponse, retError error) {
+
+	var apiName = "ListSchedules"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListSchedulesScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.ListSchedules(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.ListSchedules(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
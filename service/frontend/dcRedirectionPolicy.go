@@ -75,6 +75,16 @@ var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}
 	"SignalWorkflowExecution":          {},
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
+	"UpdateWorkflowExecution":          {},
+	// schedules only exist in the active cluster of their domain
+	"CreateSchedule":   {},
+	"DescribeSchedule": {},
+	"UpdateSchedule":   {},
+	"PauseSchedule":    {},
+	"UnpauseSchedule":  {},
+	"DeleteSchedule":   {},
+	"BackfillSchedule": {},
+	"ListSchedules":    {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
@@ -23,6 +23,7 @@ package frontend
 import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/domain"
@@ -161,6 +162,8 @@ func (s *Service) Start() {
 		log.Fatal("failed to create metadata manager", tag.Error(err))
 	}
 
+	domainCache := cache.NewDomainCache(metadata, base.GetClusterMetadata(), base.GetMetricsClient(), base.GetLogger())
+
 	visibilityFromDB, err := pFactory.NewVisibilityManager()
 	if err != nil {
 		log.Fatal("failed to create visibility manager", tag.Error(err))
@@ -173,7 +176,7 @@ func (s *Service) Start() {
 			MaxQPS:                 s.config.PersistenceMaxQPS,
 			VisibilityListMaxQPS:   s.config.ESVisibilityListMaxQPS,
 			ESIndexMaxResultWindow: s.config.ESIndexMaxResultWindow,
-			ValidSearchAttributes:  s.config.ValidSearchAttributes,
+			ValidSearchAttributes:  cache.NewDomainSearchAttributesFn(domainCache, s.config.ValidSearchAttributes),
 		}
 		visibilityFromES = espersistence.NewESVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
 			nil, base.GetMetricsClient(), log)
@@ -194,8 +197,6 @@ func (s *Service) Start() {
 		log.Fatal("Creating historyV2 manager persistence failed", tag.Error(err))
 	}
 
-	domainCache := cache.NewDomainCache(metadata, base.GetClusterMetadata(), base.GetMetricsClient(), base.GetLogger())
-
 	historyArchiverBootstrapContainer := &archiver.HistoryBootstrapContainer{
 		HistoryManager:   history,
 		HistoryV2Manager: historyV2,
@@ -237,6 +238,15 @@ func (s *Service) Start() {
 		replicationMessageSink = &mocks.KafkaProducer{}
 	}
 
+	authenticator, err := authorization.NewAuthenticator(params.Authorization)
+	if err != nil {
+		log.Fatal("Creating authenticator failed", tag.Error(err))
+	}
+	authorizer, err := authorization.NewAuthorizer(params.Authorization)
+	if err != nil {
+		log.Fatal("Creating authorizer failed", tag.Error(err))
+	}
+
 	wfHandler := NewWorkflowHandler(
 		base,
 		s.config,
@@ -248,18 +258,20 @@ func (s *Service) Start() {
 		domainReplicationQueue,
 		domainCache)
 	dcRedirectionHandler := NewDCRedirectionHandler(wfHandler, params.DCRedirectionPolicy)
-	dcRedirectionHandler.RegisterHandler()
+	accessControlledWorkflowHandler := NewAccessControlledWorkflowHandler(dcRedirectionHandler, authenticator, authorizer)
+	accessControlledWorkflowHandler.RegisterHandler()
 
-	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2, s.params)
-	adminHandler.RegisterHandler()
+	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2, wfHandler.domainHandler, s.params)
+	accessControlledAdminHandler := NewAccessControlledAdminHandler(adminHandler, authenticator, authorizer)
+	accessControlledAdminHandler.RegisterHandler()
 
 	// must start base service first
 	base.Start()
-	err = dcRedirectionHandler.Start()
+	err = accessControlledWorkflowHandler.Start()
 	if err != nil {
 		log.Fatal("DC redirection handler failed to start", tag.Error(err))
 	}
-	err = adminHandler.Start()
+	err = accessControlledAdminHandler.Start()
 	if err != nil {
 		log.Fatal("Admin handler failed to start", tag.Error(err))
 	}
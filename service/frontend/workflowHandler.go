@@ -27,6 +27,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -56,6 +57,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/schedule"
 	"github.com/uber/cadence/common/service"
 )
 
@@ -123,16 +125,34 @@ var (
 	errNextPageTokenRunIDMismatch                 = &gen.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
 	errQueryNotSet                                = &gen.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	errQueryTypeNotSet                            = &gen.BadRequestError{Message: "QueryType is not set on request."}
+	errUpdateNameNotSet                           = &gen.BadRequestError{Message: "UpdateName is not set on request."}
+	errUpdateNameTooLong                          = &gen.BadRequestError{Message: "UpdateName exceeds length limit."}
 	errRequestNotSet                              = &gen.BadRequestError{Message: "Request is nil."}
 	errNoPermission                               = &gen.BadRequestError{Message: "No permission to do this operation."}
 	errRequestIDNotSet                            = &gen.BadRequestError{Message: "RequestId is not set on request."}
 	errWorkflowTypeNotSet                         = &gen.BadRequestError{Message: "WorkflowType is not set on request."}
 	errInvalidExecutionStartToCloseTimeoutSeconds = &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	errInvalidDelayStartSeconds                   = &gen.BadRequestError{Message: "DelayStartSeconds must not be negative."}
 	errClientVersionNotSet                        = &gen.BadRequestError{Message: "Client version is not set on request."}
+	errScheduleIDNotSet                           = &gen.BadRequestError{Message: "ScheduleId is not set on request."}
+	errScheduleSpecNotSet                         = &gen.BadRequestError{Message: "Schedule spec with a CronExpression is not set on request."}
+	errScheduleActionNotSet                       = &gen.BadRequestError{Message: "Schedule action with StartWorkflow is not set on request."}
+	errScheduleUpdateNotSet                       = &gen.BadRequestError{Message: "None of Spec, Action or Policies is set on request."}
+	errInvalidScheduleJitter                      = &gen.BadRequestError{Message: "JitterInSeconds must not be negative."}
+	errInvalidScheduleCatchupWindow               = &gen.BadRequestError{Message: "CatchupWindowInSeconds must not be negative."}
+	errTaskListLimitsNotSet                       = &gen.BadRequestError{Message: "Limits are not set on request."}
+	errInvalidTaskListLimits                      = &gen.BadRequestError{Message: "Limits must not be negative."}
+	errInvalidBackfillRange                       = &gen.BadRequestError{Message: "A valid backfill range is not set on request."}
+	errHistoryBatchesNotSet                       = &gen.BadRequestError{Message: "History batches are not set on request."}
+	errScheduleNotExists                          = &gen.EntityNotExistsError{Message: "Schedule does not exist."}
+	errScheduleAlreadyExists                      = &gen.WorkflowExecutionAlreadyStartedError{Message: common.StringPtr("Schedule already exists.")}
+	errUnauthorized                               = &gen.AccessDeniedError{Message: "Request unauthorized."}
 
 	// err for archival
 	errHistoryHasPassedRetentionPeriod = &gen.BadRequestError{Message: "Requested workflow history has passed retention period."}
+	errHistoryArchivalURINotSet        = &gen.BadRequestError{Message: "History archival URI is not set for the domain."}
+	errWorkflowNotClosed               = &gen.BadRequestError{Message: "Workflow execution is not closed."}
 	// the following errors represents bad user input
 	errURIUpdate = &shared.BadRequestError{Message: "Cannot update existing archival URI"}
 
@@ -144,6 +164,7 @@ var (
 	errTaskListTooLong     = &gen.BadRequestError{Message: "TaskList length exceeds limit."}
 	errRequestIDTooLong    = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &gen.BadRequestError{Message: "Identity length exceeds limit."}
+	errFairnessKeyTooLong  = &gen.BadRequestError{Message: "FairnessKey length exceeds limit."}
 
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
@@ -189,16 +210,16 @@ func NewWorkflowHandler(
 			sVice.GetArchivalMetadata(),
 			sVice.GetArchiverProvider(),
 		),
-		visibilityQueryValidator: validator.NewQueryValidator(config.ValidSearchAttributes),
-		searchAttributesValidator: validator.NewSearchAttributesValidator(
-			sVice.GetLogger(),
-			config.ValidSearchAttributes,
-			config.SearchAttributesNumberOfKeysLimit,
-			config.SearchAttributesSizeOfValueLimit,
-			config.SearchAttributesTotalSizeLimit,
-		),
 		domainReplicationQueue: domainReplicationQueue,
 	}
+	handler.visibilityQueryValidator = validator.NewQueryValidator(handler.getValidSearchAttributes)
+	handler.searchAttributesValidator = validator.NewSearchAttributesValidator(
+		sVice.GetLogger(),
+		handler.getValidSearchAttributes,
+		config.SearchAttributesNumberOfKeysLimit,
+		config.SearchAttributesSizeOfValueLimit,
+		config.SearchAttributesTotalSizeLimit,
+	)
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
 	return handler
@@ -891,6 +912,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		}
 	}
 
+	wh.releaseTask(ctx, taskToken, persistence.TaskListTypeActivity)
 	return nil
 }
 
@@ -1075,6 +1097,8 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if err != nil {
 		return wh.error(err, scope)
 	}
+
+	wh.releaseTask(ctx, taskToken, persistence.TaskListTypeActivity)
 	return nil
 }
 
@@ -1259,6 +1283,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		}
 	}
 
+	wh.releaseTask(ctx, taskToken, persistence.TaskListTypeActivity)
 	return nil
 }
 
@@ -1420,6 +1445,7 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	wh.releaseTask(ctx, taskToken, persistence.TaskListTypeDecision)
 
 	if len(completeRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return nil, wh.error(errIdentityTooLong, scope)
@@ -1523,6 +1549,8 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 	if err != nil {
 		return wh.error(err, scope)
 	}
+
+	wh.releaseTask(ctx, taskToken, persistence.TaskListTypeDecision)
 	return nil
 }
 
@@ -1654,6 +1682,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope)
 	}
 
+	if startRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	if startRequest.GetRequestId() == "" {
 		return nil, wh.error(errRequestIDNotSet, scope)
 	}
@@ -1662,6 +1694,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errRequestIDTooLong, scope)
 	}
 
+	if len(startRequest.GetFairnessKey()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errFairnessKeyTooLong, scope)
+	}
+
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(startRequest.SearchAttributes, domainName); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2002,6 +2038,82 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 	return nil
 }
 
+// UpdateWorkflowExecution is used to send an update to a running workflow execution and wait for its result.
+// The update is delivered to the worker with the next decision task. If the worker accepts the update,
+// a WorkflowExecutionUpdateAccepted event is recorded in the history and the result is returned to the caller,
+// otherwise the call fails with WorkflowUpdateRejectedError.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *gen.UpdateWorkflowExecutionRequest,
+) (resp *gen.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, updateRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(updateRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if updateRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if len(updateRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errDomainTooLong, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(updateRequest.WorkflowExecution, scope); err != nil {
+		return nil, err
+	}
+
+	if updateRequest.GetUpdateName() == "" {
+		return nil, wh.error(errUpdateNameNotSet, scope)
+	}
+
+	if len(updateRequest.GetUpdateName()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errUpdateNameTooLong, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(updateRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	sizeLimitError := wh.config.BlobSizeLimitError(updateRequest.GetDomain())
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(updateRequest.GetDomain())
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.Input),
+		sizeLimitWarn,
+		sizeLimitError,
+		domainID,
+		updateRequest.GetWorkflowExecution().GetWorkflowId(),
+		updateRequest.GetWorkflowExecution().GetRunId(),
+		scope,
+		wh.GetThrottledLogger(),
+	); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err = wh.history.UpdateWorkflowExecution(ctx, &h.UpdateWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -2069,6 +2181,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(errRequestIDTooLong, scope)
 	}
 
+	if len(signalWithStartRequest.GetFairnessKey()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errFairnessKeyTooLong, scope)
+	}
+
 	if signalWithStartRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
 		return nil, wh.error(&gen.BadRequestError{
 			Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}, scope)
@@ -2079,6 +2195,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	if signalWithStartRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2778,24 +2898,39 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 	return resp, nil
 }
 
-// GetSearchAttributes return valid indexed keys
-func (wh *WorkflowHandler) GetSearchAttributes(ctx context.Context) (resp *gen.GetSearchAttributesResponse, retError error) {
+// GetSearchAttributes return valid indexed keys, including the ones registered in the given domain
+func (wh *WorkflowHandler) GetSearchAttributes(
+	ctx context.Context,
+	getRequest *gen.GetSearchAttributesRequest,
+) (resp *gen.GetSearchAttributesResponse, retError error) {
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	scope, sw := wh.startRequestProfile(metrics.FrontendGetSearchAttributesScope)
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendGetSearchAttributesScope, getRequest)
 	defer sw.Stop()
 
 	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
 		return nil, wh.error(err, scope)
 	}
 
-	keys := wh.config.ValidSearchAttributes()
+	domainName := getRequest.GetDomain()
+	if domainName != "" {
+		if _, err := wh.domainCache.GetDomain(domainName); err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
+
+	keys := wh.getValidSearchAttributes(domainName)
 	resp = &gen.GetSearchAttributesResponse{
 		Keys: wh.convertIndexedKeyToThrift(keys),
 	}
 	return resp, nil
 }
 
+// getValidSearchAttributes returns the cluster wide search attributes plus the ones registered in the domain
+func (wh *WorkflowHandler) getValidSearchAttributes(domain string) map[string]interface{} {
+	return cache.GetDomainSearchAttributes(wh.domainCache, wh.config.ValidSearchAttributes(), domain)
+}
+
 // ResetStickyTaskList reset the volatile information in mutable state of a given workflow.
 func (wh *WorkflowHandler) ResetStickyTaskList(
 	ctx context.Context,
@@ -2909,7 +3044,12 @@ func (wh *WorkflowHandler) QueryWorkflow(
 		response.GetClientImpl(),
 	)
 	queryRequest.Execution.RunId = response.Execution.RunId
-	if clientFeature.SupportConsistentQuery() {
+	// strongly consistent queries on a running workflow are buffered by history until all
+	// events which came before the query are reflected in the workflow state of the worker,
+	// a closed workflow cannot change so the query can be dispatched directly
+	strongConsistency := queryRequest.GetQueryConsistencyLevel() == gen.QueryConsistencyLevelStrong &&
+		workflowCloseStatus == persistence.WorkflowCloseStatusNone
+	if strongConsistency || clientFeature.SupportConsistentQuery() {
 		req := &h.QueryWorkflowRequest{
 			DomainUUID: common.StringPtr(domainID),
 			Execution:  queryRequest.GetExecution(),
@@ -2917,7 +3057,7 @@ func (wh *WorkflowHandler) QueryWorkflow(
 		}
 		resp, err := wh.history.QueryWorkflow(ctx, req)
 		if err != nil {
-			return nil, err
+			return nil, wh.error(err, scope)
 		}
 		return &gen.QueryWorkflowResponse{
 			QueryResult:   resp.GetQueryResult(),
@@ -3031,6 +3171,17 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(
 		Request:    request,
 	})
 
+	if _, ok := err.(*gen.EntityNotExistsError); ok && wh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled() {
+		// the workflow run may have been deleted after retention, in which case it's described from the archive
+		archivedResponse, archivedErr := wh.getArchivedMutableStateSummary(ctx, request, domainID)
+		if archivedErr == nil {
+			return archivedResponse, nil
+		}
+		if _, ok := archivedErr.(*gen.EntityNotExistsError); !ok {
+			return nil, wh.error(archivedErr, scope)
+		}
+	}
+
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -3189,6 +3340,31 @@ func (wh *WorkflowHandler) startRequestProfileWithDomain(scope int, d domainGett
 	return metricsScope, sw
 }
 
+// releaseTask frees the slot a task holds on a task list partition capping its outstanding tasks,
+// a failure is only logged as the slot expires on its own
+func (wh *WorkflowHandler) releaseTask(ctx context.Context, taskToken *common.TaskToken, taskListType int) {
+	if taskToken.TaskList == "" {
+		return
+	}
+	if err := wh.matching.ReleaseTask(ctx, &m.ReleaseTaskRequest{
+		DomainUUID:   common.StringPtr(taskToken.DomainID),
+		TaskList:     &gen.TaskList{Name: common.StringPtr(taskToken.TaskList)},
+		TaskListType: common.Int32Ptr(int32(taskListType)),
+		WorkflowExecution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(taskToken.WorkflowID),
+			RunId:      common.StringPtr(taskToken.RunID),
+		},
+		ScheduleId: common.Int64Ptr(taskToken.ScheduleID),
+	}); err != nil {
+		wh.GetLogger().Warn("Failed to release outstanding task.",
+			tag.WorkflowDomainID(taskToken.DomainID),
+			tag.WorkflowID(taskToken.WorkflowID),
+			tag.WorkflowRunID(taskToken.RunID),
+			tag.WorkflowTaskListName(taskToken.TaskList),
+			tag.Error(err))
+	}
+}
+
 // getDefaultScope returns a default scope to use for request metrics
 func (wh *WorkflowHandler) getDefaultScope(scope int) metrics.Scope {
 	return wh.metricsClient.Scope(scope).Tagged(metrics.DomainUnknownTag())
@@ -3226,6 +3402,9 @@ func (wh *WorkflowHandler) error(err error, scope metrics.Scope) error {
 	case *gen.QueryFailedError:
 		scope.IncCounter(metrics.CadenceErrQueryFailedCounter)
 		return err
+	case *gen.WorkflowUpdateRejectedError:
+		scope.IncCounter(metrics.CadenceErrUpdateRejectedCounter)
+		return err
 	case *gen.LimitExceededError:
 		scope.IncCounter(metrics.CadenceErrLimitExceededCounter)
 		return err
@@ -3372,6 +3551,8 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 		WorkflowExecutionTaskList: matchingResp.WorkflowExecutionTaskList,
 		ScheduledTimestamp:        matchingResp.ScheduledTimestamp,
 		StartedTimestamp:          matchingResp.StartedTimestamp,
+		Updates:                   matchingResp.Updates,
+		QueriesByID:               matchingResp.Queries,
 	}
 
 	return resp, nil
@@ -3470,6 +3651,100 @@ func (wh *WorkflowHandler) getArchivedHistory(
 	}, nil
 }
 
+// getArchivedMutableStateSummary returns the final mutable state summary archived along with the history
+// of a workflow run, an EntityNotExistsError is returned if the run or its summary is not archived.
+// When no RunID is given the latest closed run of the workflow is looked up in archived visibility
+func (wh *WorkflowHandler) getArchivedMutableStateSummary(
+	ctx context.Context,
+	request *gen.DescribeWorkflowExecutionRequest,
+	domainID string,
+) (*gen.DescribeWorkflowExecutionResponse, error) {
+	entry, err := wh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	runID := request.GetExecution().GetRunId()
+	if runID == "" {
+		runID, err = wh.getLatestArchivedRunID(ctx, entry, request.GetExecution().GetWorkflowId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	URIString := entry.GetConfig().HistoryArchivalURI
+	if URIString == "" {
+		return nil, &gen.EntityNotExistsError{Message: archiver.ErrMutableStateSummaryNotExist.Error()}
+	}
+
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+
+	historyArchiver, err := wh.GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), common.FrontendServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return historyArchiver.GetMutableStateSummary(ctx, URI, &archiver.GetMutableStateSummaryRequest{
+		DomainID:   domainID,
+		WorkflowID: request.GetExecution().GetWorkflowId(),
+		RunID:      runID,
+	})
+}
+
+// getLatestArchivedRunID returns the run of the workflow with the latest close time in archived visibility
+func (wh *WorkflowHandler) getLatestArchivedRunID(
+	ctx context.Context,
+	entry *cache.DomainCacheEntry,
+	workflowID string,
+) (string, error) {
+
+	if !wh.GetArchivalMetadata().GetVisibilityConfig().ReadEnabled() ||
+		entry.GetConfig().VisibilityArchivalStatus != gen.ArchivalStatusEnabled {
+		return "", &gen.EntityNotExistsError{
+			Message: "RunID is required to describe an archived workflow when visibility archival is not enabled.",
+		}
+	}
+
+	URI, err := archiver.NewURI(entry.GetConfig().VisibilityArchivalURI)
+	if err != nil {
+		return "", err
+	}
+	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), common.FrontendServiceName)
+	if err != nil {
+		return "", err
+	}
+
+	var latest *gen.WorkflowExecutionInfo
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: entry.GetInfo().ID,
+		PageSize: wh.config.VisibilityMaxPageSize(entry.GetInfo().Name),
+		Query:    fmt.Sprintf("WorkflowID = '%v'", strings.Replace(workflowID, "'", "\\'", -1)),
+	}
+	for {
+		response, err := visibilityArchiver.Query(ctx, URI, request)
+		if err != nil {
+			return "", err
+		}
+		for _, execution := range response.Executions {
+			if latest == nil || execution.GetCloseTime() > latest.GetCloseTime() {
+				latest = execution
+			}
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+
+	if latest == nil {
+		return "", &gen.EntityNotExistsError{Message: archiver.ErrMutableStateSummaryNotExist.Error()}
+	}
+	return latest.Execution.GetRunId(), nil
+}
+
 func (wh *WorkflowHandler) convertIndexedKeyToThrift(keys map[string]interface{}) map[string]gen.IndexedValueType {
 	converted := make(map[string]gen.IndexedValueType)
 	for k, v := range keys {
@@ -3602,6 +3877,575 @@ func (wh *WorkflowHandler) ReapplyEvents(
 	return nil
 }
 
+// CreateSchedule creates a schedule, the schedule is backed by a scheduler workflow running in the system domain
+func (wh *WorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	createRequest *gen.CreateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendCreateScheduleScope, createRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if createRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(createRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleRequest(createRequest.GetDomain(), createRequest.GetScheduleId()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if createRequest.Spec == nil {
+		return wh.error(errScheduleSpecNotSet, scope)
+	}
+	if err := validateScheduleSpec(createRequest.Spec); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if createRequest.Action == nil {
+		return wh.error(errScheduleActionNotSet, scope)
+	}
+	if err := wh.validateScheduleAction(createRequest.GetDomain(), createRequest.Action); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := validateSchedulePolicies(createRequest.Policies); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if len(createRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	if _, err := wh.domainCache.GetDomainID(createRequest.GetDomain()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	systemDomainID, err := wh.domainCache.GetDomainID(common.SystemLocalDomainName)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	input, err := json.Marshal(schedule.Params{
+		Domain:     createRequest.GetDomain(),
+		ScheduleID: createRequest.GetScheduleId(),
+		Spec:       createRequest.Spec,
+		Action:     createRequest.Action,
+		Policies:   createRequest.Policies,
+		State:      createRequest.State,
+		Memo:       createRequest.Memo,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	requestID := createRequest.GetRequestId()
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+	startRequest := &gen.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(common.SystemLocalDomainName),
+		WorkflowId:                          common.StringPtr(schedule.WorkflowID(createRequest.GetDomain(), createRequest.GetScheduleId())),
+		WorkflowType:                        &gen.WorkflowType{Name: common.StringPtr(schedule.WorkflowTypeName)},
+		TaskList:                            &gen.TaskList{Name: common.StringPtr(schedule.TaskListName)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(schedule.WorkflowStartToCloseTimeout / time.Second)),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(schedule.DecisionTaskStartToCloseTimeout / time.Second)),
+		Identity:                            createRequest.Identity,
+		RequestId:                           common.StringPtr(requestID),
+		WorkflowIdReusePolicy:               gen.WorkflowIdReusePolicyAllowDuplicate.Ptr(),
+	}
+	_, err = wh.history.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(systemDomainID, startRequest))
+	if err != nil {
+		if _, ok := err.(*gen.WorkflowExecutionAlreadyStartedError); ok {
+			return wh.error(errScheduleAlreadyExists, scope)
+		}
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeSchedule returns the spec, action, policies, state and info of a schedule
+func (wh *WorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	describeRequest *gen.DescribeScheduleRequest,
+) (resp *gen.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDescribeScheduleScope, describeRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if describeRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(describeRequest.GetDomain(), describeRequest.GetScheduleId()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	queryResponse, err := wh.QueryWorkflow(ctx, &gen.QueryWorkflowRequest{
+		Domain: common.StringPtr(common.SystemLocalDomainName),
+		Execution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(schedule.WorkflowID(describeRequest.GetDomain(), describeRequest.GetScheduleId())),
+		},
+		Query:                &gen.WorkflowQuery{QueryType: common.StringPtr(schedule.QueryTypeDescribe)},
+		QueryRejectCondition: gen.QueryRejectConditionNotOpen.Ptr(),
+	})
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); ok {
+			return nil, wh.error(errScheduleNotExists, scope)
+		}
+		return nil, wh.error(err, scope)
+	}
+	if queryResponse.QueryRejected != nil {
+		return nil, wh.error(errScheduleNotExists, scope)
+	}
+
+	resp = &gen.DescribeScheduleResponse{}
+	if err := json.Unmarshal(queryResponse.QueryResult, resp); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// UpdateSchedule replaces the spec, action and policies of a schedule which are set on the request
+func (wh *WorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	updateRequest *gen.UpdateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUpdateScheduleScope, updateRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(updateRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleRequest(updateRequest.GetDomain(), updateRequest.GetScheduleId()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if updateRequest.Spec == nil && updateRequest.Action == nil && updateRequest.Policies == nil {
+		return wh.error(errScheduleUpdateNotSet, scope)
+	}
+	if updateRequest.Spec != nil {
+		if err := validateScheduleSpec(updateRequest.Spec); err != nil {
+			return wh.error(err, scope)
+		}
+	}
+	if updateRequest.Action != nil {
+		if err := wh.validateScheduleAction(updateRequest.GetDomain(), updateRequest.Action); err != nil {
+			return wh.error(err, scope)
+		}
+	}
+	if err := validateSchedulePolicies(updateRequest.Policies); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.signalScheduler(
+		ctx,
+		updateRequest.GetDomain(),
+		updateRequest.GetScheduleId(),
+		schedule.SignalNameUpdate,
+		updateRequest,
+		updateRequest.GetIdentity(),
+	); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// PauseSchedule stops a schedule from taking actions until it is unpaused
+func (wh *WorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	pauseRequest *gen.PauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendPauseScheduleScope, pauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleRequest(pauseRequest.GetDomain(), pauseRequest.GetScheduleId()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.signalScheduler(
+		ctx,
+		pauseRequest.GetDomain(),
+		pauseRequest.GetScheduleId(),
+		schedule.SignalNamePause,
+		pauseRequest,
+		pauseRequest.GetIdentity(),
+	); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// UnpauseSchedule resumes a paused schedule, the actions due while the schedule was paused are not taken
+func (wh *WorkflowHandler) UnpauseSchedule(
+	ctx context.Context,
+	unpauseRequest *gen.UnpauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUnpauseScheduleScope, unpauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if unpauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(unpauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleRequest(unpauseRequest.GetDomain(), unpauseRequest.GetScheduleId()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.signalScheduler(
+		ctx,
+		unpauseRequest.GetDomain(),
+		unpauseRequest.GetScheduleId(),
+		schedule.SignalNameUnpause,
+		unpauseRequest,
+		unpauseRequest.GetIdentity(),
+	); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// DeleteSchedule deletes a schedule, the workflows already started by the schedule are not affected
+func (wh *WorkflowHandler) DeleteSchedule(
+	ctx context.Context,
+	deleteRequest *gen.DeleteScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDeleteScheduleScope, deleteRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if deleteRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(deleteRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleRequest(deleteRequest.GetDomain(), deleteRequest.GetScheduleId()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.signalScheduler(
+		ctx,
+		deleteRequest.GetDomain(),
+		deleteRequest.GetScheduleId(),
+		schedule.SignalNameDelete,
+		deleteRequest,
+		deleteRequest.GetIdentity(),
+	); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// BackfillSchedule takes the actions of a schedule which are scheduled in the given time range
+func (wh *WorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	backfillRequest *gen.BackfillScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendBackfillScheduleScope, backfillRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if backfillRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(backfillRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleRequest(backfillRequest.GetDomain(), backfillRequest.GetScheduleId()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if backfillRequest.StartTimestamp == nil || backfillRequest.EndTimestamp == nil ||
+		backfillRequest.GetStartTimestamp() > backfillRequest.GetEndTimestamp() {
+		return wh.error(errInvalidBackfillRange, scope)
+	}
+
+	if err := wh.signalScheduler(
+		ctx,
+		backfillRequest.GetDomain(),
+		backfillRequest.GetScheduleId(),
+		schedule.SignalNameBackfill,
+		backfillRequest,
+		backfillRequest.GetIdentity(),
+	); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// ListSchedules returns the IDs of the schedules in a domain
+func (wh *WorkflowHandler) ListSchedules(
+	ctx context.Context,
+	listRequest *gen.ListSchedulesRequest,
+) (resp *gen.ListSchedulesResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendListSchedulesScope, listRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(listRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	domain := listRequest.GetDomain()
+	if domain == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if listRequest.GetPageSize() <= 0 {
+		listRequest.PageSize = common.Int32Ptr(int32(wh.config.VisibilityMaxPageSize(domain)))
+	}
+
+	if wh.isListRequestPageSizeTooLarge(listRequest.GetPageSize(), domain) {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: fmt.Sprintf("Pagesize is larger than allow %d", wh.config.ESIndexMaxResultWindow())}, scope)
+	}
+
+	if _, err := wh.domainCache.GetDomainID(domain); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	systemDomainID, err := wh.domainCache.GetDomainID(common.SystemLocalDomainName)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// scheduler workflows of all domains run in the system domain and visibility cannot filter
+	// them by workflow ID prefix, so pages are read until the page size is reached. Each read asks
+	// for no more executions than the schedules still missing, so that every page read is
+	// consumed entirely and its next page token can be returned as is.
+	resp = &gen.ListSchedulesResponse{NextPageToken: listRequest.NextPageToken}
+	prefix := schedule.WorkflowID(domain, "")
+	latestStartTime := time.Now().UnixNano()
+	for {
+		persistenceResp, err := wh.visibilityMgr.ListOpenWorkflowExecutionsByType(&persistence.ListWorkflowExecutionsByTypeRequest{
+			ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+				DomainUUID:        systemDomainID,
+				Domain:            common.SystemLocalDomainName,
+				PageSize:          int(listRequest.GetPageSize()) - len(resp.Schedules),
+				NextPageToken:     resp.NextPageToken,
+				EarliestStartTime: 0,
+				LatestStartTime:   latestStartTime,
+			},
+			WorkflowTypeName: schedule.WorkflowTypeName,
+		})
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+
+		for _, execution := range persistenceResp.Executions {
+			workflowID := execution.Execution.GetWorkflowId()
+			if !strings.HasPrefix(workflowID, prefix) {
+				continue
+			}
+			resp.Schedules = append(resp.Schedules, &gen.ScheduleListEntry{
+				ScheduleId: common.StringPtr(strings.TrimPrefix(workflowID, prefix)),
+			})
+		}
+		resp.NextPageToken = persistenceResp.NextPageToken
+		if len(resp.NextPageToken) == 0 || len(resp.Schedules) >= int(listRequest.GetPageSize()) {
+			return resp, nil
+		}
+	}
+}
+
+func (wh *WorkflowHandler) validateScheduleRequest(
+	domain string,
+	scheduleID string,
+) error {
+
+	if domain == "" {
+		return errDomainNotSet
+	}
+	if len(domain) > wh.config.MaxIDLengthLimit() {
+		return errDomainTooLong
+	}
+	if scheduleID == "" {
+		return errScheduleIDNotSet
+	}
+	if len(schedule.WorkflowID(domain, scheduleID)) > wh.config.MaxIDLengthLimit() {
+		return errWorkflowIDTooLong
+	}
+	return nil
+}
+
+func validateScheduleSpec(
+	spec *gen.ScheduleSpec,
+) error {
+
+	if spec.GetCronExpression() == "" {
+		return errScheduleSpecNotSet
+	}
+	if err := backoff.ValidateSchedule(spec.GetCronExpression()); err != nil {
+		return err
+	}
+	if spec.GetJitterInSeconds() < 0 {
+		return errInvalidScheduleJitter
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) validateScheduleAction(
+	domain string,
+	action *gen.ScheduleAction,
+) error {
+
+	startRequest := action.StartWorkflow
+	if startRequest == nil {
+		return errScheduleActionNotSet
+	}
+	if startRequest.GetDomain() != "" && startRequest.GetDomain() != domain {
+		return &gen.BadRequestError{Message: "Schedule can only start workflows in its own domain."}
+	}
+	if startRequest.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	if startRequest.WorkflowType == nil || startRequest.WorkflowType.GetName() == "" {
+		return errWorkflowTypeNotSet
+	}
+	if startRequest.TaskList == nil || startRequest.TaskList.GetName() == "" {
+		return errTaskListNotSet
+	}
+	if startRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
+		return errInvalidExecutionStartToCloseTimeoutSeconds
+	}
+	if startRequest.GetTaskStartToCloseTimeoutSeconds() <= 0 {
+		return errInvalidTaskStartToCloseTimeoutSeconds
+	}
+	if startRequest.GetDelayStartSeconds() < 0 {
+		return errInvalidDelayStartSeconds
+	}
+	if startRequest.GetCronSchedule() != "" {
+		return &gen.BadRequestError{Message: "Workflows started by a schedule cannot have a CronSchedule."}
+	}
+	return nil
+}
+
+func validateSchedulePolicies(
+	policies *gen.SchedulePolicies,
+) error {
+
+	if policies.GetCatchupWindowInSeconds() < 0 {
+		return errInvalidScheduleCatchupWindow
+	}
+	return nil
+}
+
+// signalScheduler sends the schedule request as a signal to the scheduler workflow backing the schedule
+func (wh *WorkflowHandler) signalScheduler(
+	ctx context.Context,
+	domain string,
+	scheduleID string,
+	signalName string,
+	request interface{},
+	identity string,
+) error {
+
+	if _, err := wh.domainCache.GetDomainID(domain); err != nil {
+		return err
+	}
+
+	systemDomainID, err := wh.domainCache.GetDomainID(common.SystemLocalDomainName)
+	if err != nil {
+		return err
+	}
+
+	input, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	err = wh.history.SignalWorkflowExecution(ctx, &h.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(systemDomainID),
+		SignalRequest: &gen.SignalWorkflowExecutionRequest{
+			Domain: common.StringPtr(common.SystemLocalDomainName),
+			WorkflowExecution: &gen.WorkflowExecution{
+				WorkflowId: common.StringPtr(schedule.WorkflowID(domain, scheduleID)),
+			},
+			SignalName: common.StringPtr(signalName),
+			Input:      input,
+			Identity:   common.StringPtr(identity),
+			RequestId:  common.StringPtr(uuid.New()),
+		},
+	})
+	if _, ok := err.(*gen.EntityNotExistsError); ok {
+		return errScheduleNotExists
+	}
+	return err
+}
+
 func (wh *WorkflowHandler) checkPermission(
 	securityToken *string,
 ) error {
@@ -164,18 +164,18 @@ func (mr *MockWorkflowHandlerMockRecorder) GetReplicationMessages(ctx, Request i
 }
 
 // GetSearchAttributes mocks base method
-func (m *MockWorkflowHandler) GetSearchAttributes(ctx context.Context) (*shared.GetSearchAttributesResponse, error) {
+func (m *MockWorkflowHandler) GetSearchAttributes(ctx context.Context, GetRequest *shared.GetSearchAttributesRequest) (*shared.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchAttributes", ctx)
+	ret := m.ctrl.Call(m, "GetSearchAttributes", ctx, GetRequest)
 	ret0, _ := ret[0].(*shared.GetSearchAttributesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSearchAttributes indicates an expected call of GetSearchAttributes
-func (mr *MockWorkflowHandlerMockRecorder) GetSearchAttributes(ctx interface{}) *gomock.Call {
+func (mr *MockWorkflowHandlerMockRecorder) GetSearchAttributes(ctx, GetRequest interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockWorkflowHandler)(nil).GetSearchAttributes), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockWorkflowHandler)(nil).GetSearchAttributes), ctx, GetRequest)
 }
 
 // GetWorkflowExecutionHistory mocks base method
@@ -298,6 +298,21 @@ func (mr *MockWorkflowHandlerMockRecorder) PollForDecisionTask(ctx, PollRequest
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollForDecisionTask", reflect.TypeOf((*MockWorkflowHandler)(nil).PollForDecisionTask), ctx, PollRequest)
 }
 
+// UpdateWorkflowExecution mocks base method
+func (m *MockWorkflowHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", ctx, UpdateRequest)
+	ret0, _ := ret[0].(*shared.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution
+func (mr *MockWorkflowHandlerMockRecorder) UpdateWorkflowExecution(ctx, UpdateRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockWorkflowHandler)(nil).UpdateWorkflowExecution), ctx, UpdateRequest)
+}
+
 // QueryWorkflow mocks base method
 func (m *MockWorkflowHandler) QueryWorkflow(ctx context.Context, QueryRequest *shared.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -623,3 +638,117 @@ func (m *MockWorkflowHandler) ReapplyEvents(ctx context.Context, ReapplyEventsRe
 	ret0, _ := ret[0].(error)
 	return ret0
 }
+
+// CreateSchedule mocks base method
+func (m *MockWorkflowHandler) CreateSchedule(ctx context.Context, CreateRequest *shared.CreateScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, CreateRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule
+func (mr *MockWorkflowHandlerMockRecorder) CreateSchedule(ctx, CreateRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).CreateSchedule), ctx, CreateRequest)
+}
+
+// DescribeSchedule mocks base method
+func (m *MockWorkflowHandler) DescribeSchedule(ctx context.Context, DescribeRequest *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedule", ctx, DescribeRequest)
+	ret0, _ := ret[0].(*shared.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule
+func (mr *MockWorkflowHandlerMockRecorder) DescribeSchedule(ctx, DescribeRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).DescribeSchedule), ctx, DescribeRequest)
+}
+
+// UpdateSchedule mocks base method
+func (m *MockWorkflowHandler) UpdateSchedule(ctx context.Context, UpdateRequest *shared.UpdateScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", ctx, UpdateRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule
+func (mr *MockWorkflowHandlerMockRecorder) UpdateSchedule(ctx, UpdateRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).UpdateSchedule), ctx, UpdateRequest)
+}
+
+// PauseSchedule mocks base method
+func (m *MockWorkflowHandler) PauseSchedule(ctx context.Context, PauseRequest *shared.PauseScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSchedule", ctx, PauseRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseSchedule indicates an expected call of PauseSchedule
+func (mr *MockWorkflowHandlerMockRecorder) PauseSchedule(ctx, PauseRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).PauseSchedule), ctx, PauseRequest)
+}
+
+// UnpauseSchedule mocks base method
+func (m *MockWorkflowHandler) UnpauseSchedule(ctx context.Context, UnpauseRequest *shared.UnpauseScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseSchedule", ctx, UnpauseRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseSchedule indicates an expected call of UnpauseSchedule
+func (mr *MockWorkflowHandlerMockRecorder) UnpauseSchedule(ctx, UnpauseRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).UnpauseSchedule), ctx, UnpauseRequest)
+}
+
+// DeleteSchedule mocks base method
+func (m *MockWorkflowHandler) DeleteSchedule(ctx context.Context, DeleteRequest *shared.DeleteScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, DeleteRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule
+func (mr *MockWorkflowHandlerMockRecorder) DeleteSchedule(ctx, DeleteRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).DeleteSchedule), ctx, DeleteRequest)
+}
+
+// BackfillSchedule mocks base method
+func (m *MockWorkflowHandler) BackfillSchedule(ctx context.Context, BackfillRequest *shared.BackfillScheduleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillSchedule", ctx, BackfillRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillSchedule indicates an expected call of BackfillSchedule
+func (mr *MockWorkflowHandlerMockRecorder) BackfillSchedule(ctx, BackfillRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillSchedule", reflect.TypeOf((*MockWorkflowHandler)(nil).BackfillSchedule), ctx, BackfillRequest)
+}
+
+// ListSchedules mocks base method
+func (m *MockWorkflowHandler) ListSchedules(ctx context.Context, ListRequest *shared.ListSchedulesRequest) (*shared.ListSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", ctx, ListRequest)
+	ret0, _ := ret[0].(*shared.ListSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules
+func (mr *MockWorkflowHandlerMockRecorder) ListSchedules(ctx, ListRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockWorkflowHandler)(nil).ListSchedules), ctx, ListRequest)
+}
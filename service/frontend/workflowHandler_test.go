@@ -43,6 +43,7 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -50,6 +51,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/schedule"
 	cs "github.com/uber/cadence/common/service"
 	dc "github.com/uber/cadence/common/service/dynamicconfig"
 )
@@ -492,6 +494,152 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	assert.Equal(s.T(), errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidDelayStartSeconds() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		DelayStartSeconds:                   common.Int32Ptr(-1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errInvalidDelayStartSeconds, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_InvalidRequest() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	newRequest := func() *shared.CreateScheduleRequest {
+		return &shared.CreateScheduleRequest{
+			Domain:     common.StringPtr("test-domain"),
+			ScheduleId: common.StringPtr("schedule-id"),
+			Spec: &shared.ScheduleSpec{
+				CronExpression: common.StringPtr("* * * * *"),
+			},
+			Action: &shared.ScheduleAction{
+				StartWorkflow: &shared.StartWorkflowExecutionRequest{
+					WorkflowId: common.StringPtr("workflow-id"),
+					WorkflowType: &shared.WorkflowType{
+						Name: common.StringPtr("workflow-type"),
+					},
+					TaskList: &shared.TaskList{
+						Name: common.StringPtr("task-list"),
+					},
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+				},
+			},
+		}
+	}
+
+	request := newRequest()
+	request.ScheduleId = nil
+	err := wh.CreateSchedule(context.Background(), request)
+	assert.Equal(s.T(), errScheduleIDNotSet, err)
+
+	request = newRequest()
+	request.Spec.CronExpression = common.StringPtr("invalid")
+	err = wh.CreateSchedule(context.Background(), request)
+	assert.IsType(s.T(), &shared.BadRequestError{}, err)
+
+	request = newRequest()
+	request.Spec.JitterInSeconds = common.Int32Ptr(-1)
+	err = wh.CreateSchedule(context.Background(), request)
+	assert.Equal(s.T(), errInvalidScheduleJitter, err)
+
+	request = newRequest()
+	request.Action = nil
+	err = wh.CreateSchedule(context.Background(), request)
+	assert.Equal(s.T(), errScheduleActionNotSet, err)
+
+	request = newRequest()
+	request.Action.StartWorkflow.TaskList = nil
+	err = wh.CreateSchedule(context.Background(), request)
+	assert.Equal(s.T(), errTaskListNotSet, err)
+
+	request = newRequest()
+	request.Policies = &shared.SchedulePolicies{CatchupWindowInSeconds: common.Int32Ptr(-1)}
+	err = wh.CreateSchedule(context.Background(), request)
+	assert.Equal(s.T(), errInvalidScheduleCatchupWindow, err)
+}
+
+func (s *workflowHandlerSuite) TestBackfillSchedule_Failed_InvalidRange() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	err := wh.BackfillSchedule(context.Background(), &shared.BackfillScheduleRequest{
+		Domain:         common.StringPtr("test-domain"),
+		ScheduleId:     common.StringPtr("schedule-id"),
+		StartTimestamp: common.Int64Ptr(2),
+		EndTimestamp:   common.Int64Ptr(1),
+	})
+	assert.Equal(s.T(), errInvalidBackfillRange, err)
+}
+
+func (s *workflowHandlerSuite) TestListSchedules_ReadsPagesUntilFull() {
+	wh := s.getWorkflowHandlerHelper()
+	s.mockDomainCache.On("GetDomainID", mock.Anything).Return(s.testDomainID, nil)
+
+	executions := func(workflowIDs ...string) []*shared.WorkflowExecutionInfo {
+		var infos []*shared.WorkflowExecutionInfo
+		for _, workflowID := range workflowIDs {
+			infos = append(infos, &shared.WorkflowExecutionInfo{
+				Execution: &shared.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+			})
+		}
+		return infos
+	}
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByType", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByTypeRequest) bool {
+		return request.PageSize == 3 && request.NextPageToken == nil
+	})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: executions(
+			schedule.WorkflowID(s.testDomain, "schedule-1"),
+			schedule.WorkflowID("other-domain", "schedule-2"),
+			schedule.WorkflowID(s.testDomain+":schedule", "3"),
+		),
+		NextPageToken: []byte("token-1"),
+	}, nil).Once()
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByType", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByTypeRequest) bool {
+		return request.PageSize == 2 && string(request.NextPageToken) == "token-1"
+	})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions:    executions(schedule.WorkflowID(s.testDomain, "schedule:4"), schedule.WorkflowID(s.testDomain, "schedule-5")),
+		NextPageToken: []byte("token-2"),
+	}, nil).Once()
+
+	resp, err := wh.ListSchedules(context.Background(), &shared.ListSchedulesRequest{
+		Domain:   common.StringPtr(s.testDomain),
+		PageSize: common.Int32Ptr(3),
+	})
+	s.NoError(err)
+	s.Equal([]*shared.ScheduleListEntry{
+		{ScheduleId: common.StringPtr("schedule-1")},
+		{ScheduleId: common.StringPtr("schedule:4")},
+		{ScheduleId: common.StringPtr("schedule-5")},
+	}, resp.Schedules)
+	s.Equal([]byte("token-2"), resp.NextPageToken)
+}
+
 func (s *workflowHandlerSuite) getWorkflowHandlerWithParams(mService cs.Service, config *Config,
 	mMetadataManager persistence.MetadataManager, mockDomainCache *cache.DomainCacheMock) *WorkflowHandler {
 	return NewWorkflowHandler(mService, config, mMetadataManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
@@ -1147,6 +1295,148 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_GetFirstPage() {
 	s.True(resp.GetArchived())
 }
 
+func (s *workflowHandlerSuite) TestGetArchivedMutableStateSummary_Failure_ArchivalURIEmpty() {
+	config := s.newConfig()
+	mockDomainCache := &cache.DomainCacheMock{}
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: "test-domain"},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus:    shared.ArchivalStatusDisabled,
+			HistoryArchivalURI:       "",
+			VisibilityArchivalStatus: shared.ArchivalStatusDisabled,
+			VisibilityArchivalURI:    "",
+		},
+		"",
+		nil)
+	mockDomainCache.On("GetDomainByID", mock.Anything).Return(domainEntry, nil)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	mService := cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.mockArchivalMetadata, s.mockArchiverProvider, nil)
+	wh := s.getWorkflowHandlerWithParams(mService, config, nil, mockDomainCache)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+	resp, err := wh.getArchivedMutableStateSummary(context.Background(), describeWorkflowExecutionRequest(), s.testDomainID)
+	s.Nil(resp)
+	s.IsType(&gen.EntityNotExistsError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestGetArchivedMutableStateSummary_Success() {
+	config := s.newConfig()
+	mockDomainCache := &cache.DomainCacheMock{}
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: "test-domain"},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus:    shared.ArchivalStatusEnabled,
+			HistoryArchivalURI:       testHistoryArchivalURI,
+			VisibilityArchivalStatus: shared.ArchivalStatusDisabled,
+			VisibilityArchivalURI:    "",
+		},
+		"",
+		nil)
+	mockDomainCache.On("GetDomainByID", mock.Anything).Return(domainEntry, nil)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	mService := cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.mockArchivalMetadata, s.mockArchiverProvider, nil)
+	mHistoryArchiver := &archiver.HistoryArchiverMock{}
+	summary := &gen.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &gen.WorkflowExecutionInfo{
+			Execution:   describeWorkflowExecutionRequest().Execution,
+			CloseStatus: gen.WorkflowExecutionCloseStatusFailed.Ptr(),
+		},
+		PendingChildren: []*gen.PendingChildExecutionInfo{
+			{WorkflowID: common.StringPtr("child-workflow-id")},
+		},
+	}
+	mHistoryArchiver.On("GetMutableStateSummary", mock.Anything, mock.Anything, &archiver.GetMutableStateSummaryRequest{
+		DomainID:   s.testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}).Return(summary, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(mHistoryArchiver, nil)
+	wh := s.getWorkflowHandlerWithParams(mService, config, nil, mockDomainCache)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+	resp, err := wh.getArchivedMutableStateSummary(context.Background(), describeWorkflowExecutionRequest(), s.testDomainID)
+	s.NoError(err)
+	s.Equal(summary, resp)
+
+	// the run can't be looked up without visibility archival
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewDisabledArchvialConfig())
+	request := describeWorkflowExecutionRequest()
+	request.Execution.RunId = nil
+	resp, err = wh.getArchivedMutableStateSummary(context.Background(), request, s.testDomainID)
+	s.Nil(resp)
+	s.IsType(&gen.EntityNotExistsError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestGetArchivedMutableStateSummary_Success_EmptyRunID() {
+	config := s.newConfig()
+	mockDomainCache := &cache.DomainCacheMock{}
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.testDomainID, Name: "test-domain"},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus:    shared.ArchivalStatusEnabled,
+			HistoryArchivalURI:       testHistoryArchivalURI,
+			VisibilityArchivalStatus: shared.ArchivalStatusEnabled,
+			VisibilityArchivalURI:    testVisibilityArchivalURI,
+		},
+		"",
+		nil)
+	mockDomainCache.On("GetDomainByID", mock.Anything).Return(domainEntry, nil)
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	mService := cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.mockArchivalMetadata, s.mockArchiverProvider, nil)
+	mVisibilityArchiver := &archiver.VisibilityArchiverMock{}
+	archivedExecution := func(runID string, closeTime int64) *gen.WorkflowExecutionInfo {
+		return &gen.WorkflowExecutionInfo{
+			Execution: &gen.WorkflowExecution{
+				WorkflowId: common.StringPtr(testWorkflowID),
+				RunId:      common.StringPtr(runID),
+			},
+			CloseTime: common.Int64Ptr(closeTime),
+		}
+	}
+	mVisibilityArchiver.On("Query", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.QueryVisibilityRequest) bool {
+		return request.DomainID == s.testDomainID && request.Query == "WorkflowID = '"+testWorkflowID+"'" && request.NextPageToken == nil
+	})).Return(&archiver.QueryVisibilityResponse{
+		Executions:    []*gen.WorkflowExecutionInfo{archivedExecution("old-run-id", 100)},
+		NextPageToken: []byte("next-page"),
+	}, nil).Once()
+	mVisibilityArchiver.On("Query", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.QueryVisibilityRequest) bool {
+		return string(request.NextPageToken) == "next-page"
+	})).Return(&archiver.QueryVisibilityResponse{
+		Executions: []*gen.WorkflowExecutionInfo{archivedExecution(testRunID, 300), archivedExecution("other-run-id", 200)},
+	}, nil).Once()
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(mVisibilityArchiver, nil)
+	mHistoryArchiver := &archiver.HistoryArchiverMock{}
+	summary := &gen.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &gen.WorkflowExecutionInfo{
+			Execution:   describeWorkflowExecutionRequest().Execution,
+			CloseStatus: gen.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		},
+	}
+	mHistoryArchiver.On("GetMutableStateSummary", mock.Anything, mock.Anything, &archiver.GetMutableStateSummaryRequest{
+		DomainID:   s.testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}).Return(summary, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(mHistoryArchiver, nil)
+	wh := s.getWorkflowHandlerWithParams(mService, config, nil, mockDomainCache)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	request := describeWorkflowExecutionRequest()
+	request.Execution.RunId = nil
+	resp, err := wh.getArchivedMutableStateSummary(context.Background(), request, s.testDomainID)
+	s.NoError(err)
+	s.Equal(summary, resp)
+	mVisibilityArchiver.AssertExpectations(s.T())
+}
+
 func (s *workflowHandlerSuite) TestGetHistory() {
 	config := s.newConfig()
 	domainID := uuid.New()
@@ -1300,15 +1590,51 @@ func (s *workflowHandlerSuite) TestGetSearchAttributes() {
 	wh := s.getWorkflowHandlerHelper()
 
 	ctx := context.Background()
-	resp, err := wh.GetSearchAttributes(ctx)
+	resp, err := wh.GetSearchAttributes(ctx, &shared.GetSearchAttributesRequest{})
 	s.NoError(err)
 	s.NotNil(resp)
 }
 
+func (s *workflowHandlerSuite) TestGetSearchAttributes_DomainRegistered() {
+	wh := s.getWorkflowHandlerHelper()
+
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain},
+		&persistence.DomainConfig{SearchAttributes: map[string]shared.IndexedValueType{
+			"DomainKeywordField": shared.IndexedValueTypeKeyword,
+		}},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockDomainCache.On("GetDomain", s.testDomain).Return(domainEntry, nil)
+
+	ctx := context.Background()
+	resp, err := wh.GetSearchAttributes(ctx, &shared.GetSearchAttributesRequest{
+		Domain: common.StringPtr(s.testDomain),
+	})
+	s.NoError(err)
+	s.Equal(shared.IndexedValueTypeKeyword, resp.Keys["DomainKeywordField"])
+	s.Contains(resp.Keys, definition.CustomKeywordField)
+}
+
+func (s *workflowHandlerSuite) TestGetSearchAttributes_DomainNotExists() {
+	wh := s.getWorkflowHandlerHelper()
+
+	s.mockDomainCache.On("GetDomain", "unknown-domain").Return(nil, &shared.EntityNotExistsError{})
+
+	ctx := context.Background()
+	_, err := wh.GetSearchAttributes(ctx, &shared.GetSearchAttributesRequest{
+		Domain: common.StringPtr("unknown-domain"),
+	})
+	s.IsType(&shared.EntityNotExistsError{}, err)
+}
+
 func (s *workflowHandlerSuite) TestListWorkflowExecutions() {
 	wh := s.getWorkflowHandlerHelper()
 
 	s.mockDomainCache.On("GetDomainID", mock.Anything).Return(s.testDomainID, nil)
+	s.mockDomainCache.On("GetDomain", s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain}, &persistence.DomainConfig{}, cluster.TestCurrentClusterName, nil), nil)
 	s.mockVisibilityMgr.On("ListWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
 
 	listRequest := &shared.ListWorkflowExecutionsRequest{
@@ -1337,6 +1663,8 @@ func (s *workflowHandlerSuite) TestScantWorkflowExecutions() {
 	wh := s.getWorkflowHandlerHelper()
 
 	s.mockDomainCache.On("GetDomainID", mock.Anything).Return(s.testDomainID, nil)
+	s.mockDomainCache.On("GetDomain", s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain}, &persistence.DomainConfig{}, cluster.TestCurrentClusterName, nil), nil)
 	s.mockVisibilityMgr.On("ScanWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
 
 	listRequest := &shared.ListWorkflowExecutionsRequest{
@@ -1365,6 +1693,8 @@ func (s *workflowHandlerSuite) TestCountWorkflowExecutions() {
 	wh := s.getWorkflowHandlerHelper()
 
 	s.mockDomainCache.On("GetDomainID", mock.Anything).Return(s.testDomainID, nil)
+	s.mockDomainCache.On("GetDomain", s.testDomain).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomain}, &persistence.DomainConfig{}, cluster.TestCurrentClusterName, nil), nil)
 	s.mockVisibilityMgr.On("CountWorkflowExecutions", mock.Anything).Return(&persistence.CountWorkflowExecutionsResponse{}, nil).Once()
 
 	countRequest := &shared.CountWorkflowExecutionsRequest{
@@ -1525,6 +1855,16 @@ func getHistoryRequest(nextPageToken []byte) *shared.GetWorkflowExecutionHistory
 	}
 }
 
+func describeWorkflowExecutionRequest() *shared.DescribeWorkflowExecutionRequest {
+	return &shared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr("test-domain"),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+			RunId:      common.StringPtr(testRunID),
+		},
+	}
+}
+
 func listArchivedWorkflowExecutionsTestRequest() *shared.ListArchivedWorkflowExecutionsRequest {
 	return &shared.ListArchivedWorkflowExecutionsRequest{
 		Domain:   common.StringPtr("some random domain name"),
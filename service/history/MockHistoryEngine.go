@@ -116,6 +116,29 @@ func (_m *MockHistoryEngine) QueryWorkflow(ctx context.Context, request *gohisto
 	return r0, r1
 }
 
+// UpdateWorkflowExecution is mock implementation for UpdateWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) UpdateWorkflowExecution(ctx context.Context, request *gohistory.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMutableState is mock implementation for GetMutableState of HistoryEngine
 func (_m *MockHistoryEngine) GetMutableState(ctx context.Context, request *gohistory.GetMutableStateRequest) (*gohistory.GetMutableStateResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -613,3 +636,101 @@ func (_m *MockHistoryEngine) ReapplyEvents(ctx context.Context, domainUUID strin
 
 	return r0
 }
+
+// PauseActivity is mock implementation for PauseActivity of HistoryEngine
+func (_m *MockHistoryEngine) PauseActivity(ctx context.Context, request *gohistory.PauseActivityRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.PauseActivityRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnpauseActivity is mock implementation for UnpauseActivity of HistoryEngine
+func (_m *MockHistoryEngine) UnpauseActivity(ctx context.Context, request *gohistory.UnpauseActivityRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.UnpauseActivityRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetActivity is mock implementation for ResetActivity of HistoryEngine
+func (_m *MockHistoryEngine) ResetActivity(ctx context.Context, request *gohistory.ResetActivityRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ResetActivityRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateActivityTimeouts is mock implementation for UpdateActivityTimeouts of HistoryEngine
+func (_m *MockHistoryEngine) UpdateActivityTimeouts(ctx context.Context, request *gohistory.UpdateActivityTimeoutsRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.UpdateActivityTimeoutsRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateWorkflowSearchAttributes is mock implementation for UpdateWorkflowSearchAttributes of HistoryEngine
+func (_m *MockHistoryEngine) UpdateWorkflowSearchAttributes(ctx context.Context, request *gohistory.UpdateWorkflowSearchAttributesRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.UpdateWorkflowSearchAttributesRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWorkflowExecution is mock implementation for DeleteWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) DeleteWorkflowExecution(ctx context.Context, request *gohistory.DeleteWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.DeleteWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ImportWorkflowExecution is mock implementation for ImportWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ImportWorkflowExecution(ctx context.Context, request *gohistory.ImportWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.ImportWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
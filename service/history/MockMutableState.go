@@ -1197,6 +1197,62 @@ func (_m *mockMutableState) RetryActivity(ai *persistence.ActivityInfo, failureR
 	return r0, r1
 }
 
+// PauseActivity provides a mock function with given fields: ai
+func (_m *mockMutableState) PauseActivity(ai *persistence.ActivityInfo) error {
+	ret := _m.Called(ai)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.ActivityInfo) error); ok {
+		r0 = rf(ai)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnpauseActivity provides a mock function with given fields: ai
+func (_m *mockMutableState) UnpauseActivity(ai *persistence.ActivityInfo) error {
+	ret := _m.Called(ai)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.ActivityInfo) error); ok {
+		r0 = rf(ai)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetActivity provides a mock function with given fields: ai, resetHeartbeatDetails
+func (_m *mockMutableState) ResetActivity(ai *persistence.ActivityInfo, resetHeartbeatDetails bool) error {
+	ret := _m.Called(ai, resetHeartbeatDetails)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.ActivityInfo, bool) error); ok {
+		r0 = rf(ai, resetHeartbeatDetails)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateActivityTimeouts provides a mock function with given fields: ai, scheduleToStartTimeout, scheduleToCloseTimeout, startToCloseTimeout, heartbeatTimeout
+func (_m *mockMutableState) UpdateActivityTimeouts(ai *persistence.ActivityInfo, scheduleToStartTimeout int32, scheduleToCloseTimeout int32, startToCloseTimeout int32, heartbeatTimeout int32) error {
+	ret := _m.Called(ai, scheduleToStartTimeout, scheduleToCloseTimeout, startToCloseTimeout, heartbeatTimeout)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.ActivityInfo, int32, int32, int32, int32) error); ok {
+		r0 = rf(ai, scheduleToStartTimeout, scheduleToCloseTimeout, startToCloseTimeout, heartbeatTimeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateNewHistoryEvent provides a mock function with given fields: eventType
 func (_m *mockMutableState) CreateNewHistoryEvent(eventType shared.EventType) *shared.HistoryEvent {
 	ret := _m.Called(eventType)
@@ -2002,22 +2058,6 @@ func (_m *mockMutableState) GetWorkflowStateCloseStatus() (int, int) {
 	return r0, r1
 }
 
-// GetQueryRegistry provides a mock function with given fields:
-func (_m *mockMutableState) GetQueryRegistry() queryRegistry {
-	ret := _m.Called()
-
-	var r0 queryRegistry
-	if rf, ok := ret.Get(0).(func() queryRegistry); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(queryRegistry)
-		}
-	}
-
-	return r0
-}
-
 // HasBufferedEvents provides a mock function with given fields:
 func (_m *mockMutableState) HasBufferedEvents() bool {
 	ret := _m.Called()
@@ -2676,6 +2716,29 @@ func (_m *mockMutableState) ReplicateTransientDecisionTaskScheduled() (*decision
 	return r0, r1
 }
 
+// AddWorkflowExecutionUpdateAcceptedEvent provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *mockMutableState) AddWorkflowExecutionUpdateAcceptedEvent(_a0 int64, _a1 *shared.WorkflowUpdate, _a2 *shared.WorkflowUpdateResult, _a3 string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(int64, *shared.WorkflowUpdate, *shared.WorkflowUpdateResult, string) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *shared.WorkflowUpdate, *shared.WorkflowUpdateResult, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReplicateUpsertWorkflowSearchAttributesEvent provides a mock function with given fields: _a0
 func (_m *mockMutableState) ReplicateUpsertWorkflowSearchAttributesEvent(_a0 *shared.HistoryEvent) {
 	_m.Called(_a0)
@@ -75,7 +75,7 @@ func newDecisionAttrValidator(
 		maxIDLengthLimit: config.MaxIDLengthLimit(),
 		searchAttributesValidator: validator.NewSearchAttributesValidator(
 			logger,
-			config.ValidSearchAttributes,
+			cache.NewDomainSearchAttributesFn(domainCache, config.ValidSearchAttributes),
 			config.SearchAttributesNumberOfKeysLimit,
 			config.SearchAttributesSizeOfValueLimit,
 			config.SearchAttributesTotalSizeLimit,
@@ -228,6 +228,10 @@ func (v *decisionAttrValidator) validateActivityScheduleAttributes(
 		return &workflow.BadRequestError{Message: "Domain exceeds length limit."}
 	}
 
+	if len(attributes.GetFairnessKey()) > v.maxIDLengthLimit {
+		return &workflow.BadRequestError{Message: "FairnessKey exceeds length limit."}
+	}
+
 	// Only attempt to deduce and fill in unspecified timeouts only when all timeouts are non-negative.
 	if attributes.GetScheduleToCloseTimeoutSeconds() < 0 || attributes.GetScheduleToStartTimeoutSeconds() < 0 ||
 		attributes.GetStartToCloseTimeoutSeconds() < 0 || attributes.GetHeartbeatTimeoutSeconds() < 0 {
@@ -482,6 +486,18 @@ func (v *decisionAttrValidator) validateUpsertWorkflowSearchAttributes(
 	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
 }
 
+func (v *decisionAttrValidator) validateUpdateWorkflowSearchAttributes(
+	domainName string,
+	upsertSearchAttributes *workflow.SearchAttributes,
+) error {
+
+	if len(upsertSearchAttributes.GetIndexedFields()) == 0 {
+		return &workflow.BadRequestError{Message: "UpsertSearchAttributes is not set on request."}
+	}
+
+	return v.searchAttributesValidator.ValidateSearchAttributes(upsertSearchAttributes, domainName)
+}
+
 func (v *decisionAttrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes,
 	executionInfo *persistence.WorkflowExecutionInfo,
@@ -128,6 +128,15 @@ func (s *decisionAttrValidatorSuite) TestValidateSignalExternalWorkflowExecution
 
 func (s *decisionAttrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
 	domainName := "testDomain"
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: domainName},
+		&persistence.DomainConfig{SearchAttributes: map[string]workflow.IndexedValueType{
+			"DomainKeywordField": workflow.IndexedValueTypeKeyword,
+		}},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockDomainCache.On("GetDomain", domainName).Return(domainEntry, nil)
 	var attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes
 
 	err := s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
@@ -144,6 +153,10 @@ func (s *decisionAttrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes(
 	attributes.SearchAttributes.IndexedFields = map[string][]byte{"CustomKeywordField": []byte(`bytes`)}
 	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
 	s.Nil(err)
+
+	attributes.SearchAttributes.IndexedFields = map[string][]byte{"DomainKeywordField": []byte(`bytes`)}
+	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
+	s.Nil(err)
 }
 
 func (s *decisionAttrValidatorSuite) TestValidateCrossDomainCall_LocalToLocal() {
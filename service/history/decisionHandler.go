@@ -136,7 +136,7 @@ func (handler *decisionHandlerImpl) handleDecisionTaskScheduled(
 func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 	ctx ctx.Context,
 	req *h.RecordDecisionTaskStartedRequest,
-) (*h.RecordDecisionTaskStartedResponse, error) {
+) (retResp *h.RecordDecisionTaskStartedResponse, retError error) {
 
 	domainEntry, err := handler.historyEngine.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -152,8 +152,14 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 	scheduleID := req.GetScheduleId()
 	requestID := req.GetRequestId()
 
+	context, release, err := handler.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
 	var resp *h.RecordDecisionTaskStartedResponse
-	err = handler.historyEngine.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+	err = handler.historyEngine.updateWorkflowExecutionWithContext(context,
 		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -212,6 +218,10 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 	if err != nil {
 		return nil, err
 	}
+
+	// deliver pending workflow queries and updates with the started decision task
+	resp.Queries = handler.startQueries(context.getQueryRegistry())
+	resp.Updates = context.getUpdateRegistry().startUpdates()
 	return resp, nil
 }
 
@@ -291,6 +301,8 @@ func (handler *decisionHandlerImpl) handleDecisionTaskCompleted(
 		return nil, err
 	}
 	defer func() { release(retError) }()
+	queryRegistry := context.getQueryRegistry()
+	updateRegistry := context.getUpdateRegistry()
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
@@ -423,11 +435,22 @@ Update_History_Loop:
 				handler.config,
 			)
 
-			if err := decisionTaskHandler.handleDecisions(
-				request.ExecutionContext,
-				request.Decisions,
-			); err != nil {
-				return nil, err
+			if !decisionHeartbeatTimeout {
+				if err := decisionTaskHandler.handleUpdateResults(
+					request.UpdateResults,
+					updateRegistry,
+				); err != nil {
+					return nil, err
+				}
+			}
+
+			if !decisionTaskHandler.stopProcessing {
+				if err := decisionTaskHandler.handleDecisions(
+					request.ExecutionContext,
+					request.Decisions,
+				); err != nil {
+					return nil, err
+				}
 			}
 
 			// set the vars used by following logic
@@ -461,9 +484,10 @@ Update_History_Loop:
 			continueAsNewBuilder = nil
 		}
 
-		// Schedule another decision task if new events came in during this decision or if request forced to
+		// Schedule another decision task if new events or updates came in during this decision or if request forced to
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() &&
-			(hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
+			(hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled ||
+				updateRegistry.hasBuffered())
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
 			var newDecision *decisionInfo
@@ -553,6 +577,17 @@ Update_History_Loop:
 			return nil, updateErr
 		}
 
+		if !decisionHeartbeatTimeout {
+			queryResults := request.QueryResultsByID
+			if failDecision {
+				queryResults = nil
+			}
+			handler.completeQueries(queryRegistry, msBuilder, queryResults, createNewDecisionTask, decisionHeartbeating)
+		}
+		if !failDecision && !decisionHeartbeatTimeout {
+			handler.completeUpdates(updateRegistry, msBuilder, request.UpdateResults, decisionHeartbeating)
+		}
+
 		if decisionHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
 			return nil, &workflow.EntityNotExistsError{
@@ -569,6 +604,8 @@ Update_History_Loop:
 			}
 			// sticky is always enabled when worker request for new decision task from RespondDecisionTaskCompleted
 			resp.StartedResponse.StickyExecutionEnabled = common.BoolPtr(true)
+			resp.StartedResponse.Queries = handler.startQueries(queryRegistry)
+			resp.StartedResponse.Updates = updateRegistry.startUpdates()
 		}
 
 		return resp, nil
@@ -577,6 +614,89 @@ Update_History_Loop:
 	return nil, ErrMaxAttemptsExceeded
 }
 
+// startQueries moves all buffered queries to started and returns every started query,
+// including the ones delivered to earlier decision tasks which did not complete.
+func (handler *decisionHandlerImpl) startQueries(
+	queryRegistry queryRegistry,
+) map[string]*workflow.WorkflowQuery {
+
+	for _, id := range queryRegistry.getBufferedSnapshot() {
+		// the query may have been removed concurrently by a caller which timed out
+		_, _ = queryRegistry.recordEvent(id, queryEventStart, nil)
+	}
+	started := queryRegistry.getStartedSnapshot()
+	if len(started) == 0 {
+		return nil
+	}
+	queries := make(map[string]*workflow.WorkflowQuery, len(started))
+	for _, id := range started {
+		querySnapshot, err := queryRegistry.getQuerySnapshot(id)
+		if err != nil {
+			continue
+		}
+		queries[id] = querySnapshot.queryInput
+	}
+	return queries
+}
+
+// completeQueries records the query results of a persisted decision task completion, so the results reflect
+// all events which came before the queries. Queries delivered to the decision task without a result are buffered
+// again. If no new decision task was scheduled, the worker has seen all events and the buffered queries are
+// unblocked to be dispatched directly to the worker.
+func (handler *decisionHandlerImpl) completeQueries(
+	queryRegistry queryRegistry,
+	msBuilder mutableState,
+	results map[string]*workflow.WorkflowQueryResult,
+	createNewDecisionTask bool,
+	decisionHeartbeating bool,
+) {
+
+	for id, result := range results {
+		// results for queries which are no longer started, e.g. because the caller timed out, are dropped
+		if _, err := queryRegistry.recordEvent(id, queryEventRecordResult, result); err != nil {
+			continue
+		}
+		_, _ = queryRegistry.recordEvent(id, queryEventPersistenceConditionSatisfied, nil)
+	}
+
+	// local activities started by events which came before the queries may still be running on the worker,
+	// so unanswered queries are delivered again with the next decision task
+	if decisionHeartbeating && msBuilder.IsWorkflowExecutionRunning() {
+		return
+	}
+
+	for _, id := range queryRegistry.getStartedSnapshot() {
+		_, _ = queryRegistry.recordEvent(id, queryEventRebuffer, nil)
+	}
+	if !createNewDecisionTask {
+		for _, id := range queryRegistry.getBufferedSnapshot() {
+			_, _ = queryRegistry.recordEvent(id, queryEventUnblock, nil)
+		}
+	}
+}
+
+// completeUpdates unblocks the callers of the updates handled by a persisted decision task completion.
+// Updates delivered to the decision task without a result are rejected, unless the decision task was
+// only heartbeating, in which case they are delivered again with the next decision task.
+func (handler *decisionHandlerImpl) completeUpdates(
+	updateRegistry updateRegistry,
+	msBuilder mutableState,
+	results []*workflow.WorkflowUpdateResult,
+	decisionHeartbeating bool,
+) {
+
+	for _, result := range results {
+		// results for updates which are no longer pending, e.g. because the caller timed out, are dropped
+		_ = updateRegistry.completeUpdate(result.GetUpdateId(), result)
+	}
+
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		updateRegistry.rejectPendingUpdates("workflow execution already completed")
+	} else if !decisionHeartbeating {
+		updateRegistry.rejectStartedUpdates("update was not handled by the decision task")
+	}
+}
+
 func (handler *decisionHandlerImpl) createRecordDecisionTaskStartedResponse(
 	domainID string,
 	msBuilder mutableState,
@@ -135,6 +135,56 @@ func (handler *decisionTaskHandlerImpl) handleDecisions(
 	return nil
 }
 
+// handleUpdateResults records the updates accepted by the worker, rejected updates leave no trace in history.
+// Accepting an update which is not pending fails the decision, since the worker would otherwise diverge
+// from the history on replay.
+func (handler *decisionTaskHandlerImpl) handleUpdateResults(
+	results []*workflow.WorkflowUpdateResult,
+	updateRegistry updateRegistry,
+) error {
+
+	seen := make(map[string]struct{}, len(results))
+	for _, result := range results {
+		updateID := result.GetUpdateId()
+		if _, ok := seen[updateID]; ok {
+			return handler.handlerFailDecision(
+				workflow.DecisionTaskFailedCauseBadUpdateResult,
+				fmt.Sprintf("duplicate result for update %v", updateID),
+			)
+		}
+		seen[updateID] = struct{}{}
+
+		if result.ResultType == nil {
+			return handler.handlerFailDecision(
+				workflow.DecisionTaskFailedCauseBadUpdateResult,
+				fmt.Sprintf("result type is not set for update %v", updateID),
+			)
+		}
+		if result.GetResultType() != workflow.WorkflowUpdateResultTypeAccepted {
+			continue
+		}
+
+		update, err := updateRegistry.getUpdate(updateID)
+		if err != nil {
+			return handler.handlerFailDecision(
+				workflow.DecisionTaskFailedCauseBadUpdateResult,
+				fmt.Sprintf("accepted update %v is not pending: %v", updateID, err),
+			)
+		}
+
+		handler.metricsClient.IncCounter(
+			metrics.HistoryRespondDecisionTaskCompletedScope,
+			metrics.WorkflowUpdateAcceptedCounter,
+		)
+		if _, err := handler.mutableState.AddWorkflowExecutionUpdateAcceptedEvent(
+			handler.decisionTaskCompletedID, update, result, handler.identity,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (handler *decisionTaskHandlerImpl) handleDecision(decision *workflow.Decision) error {
 	switch decision.GetDecisionType() {
 	case workflow.DecisionTypeScheduleActivityTask:
@@ -352,6 +352,16 @@ func (b *historyBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	decisionTaskCompletedEventID int64,
+	update *workflow.WorkflowUpdate,
+	result *workflow.WorkflowUpdateResult,
+	identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateAcceptedEvent(decisionTaskCompletedEventID, update, result, identity)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
 	event := b.newSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID,
@@ -493,6 +503,9 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.ContinuedFailureDetails = startRequest.ContinuedFailureDetails
 	attributes.Initiator = startRequest.ContinueAsNewInitiator
 	attributes.FirstDecisionTaskBackoffSeconds = startRequest.FirstDecisionTaskBackoffSeconds
+	attributes.DelayStartSeconds = request.DelayStartSeconds
+	attributes.Priority = request.Priority
+	attributes.FairnessKey = request.FairnessKey
 	attributes.FirstExecutionRunId = common.StringPtr(firstRunID)
 	attributes.OriginalExecutionRunId = common.StringPtr(originalRunID)
 	attributes.Memo = request.Memo
@@ -583,6 +596,8 @@ func (b *historyBuilder) newActivityTaskScheduledEvent(decisionTaskCompletedEven
 	attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(common.Int32Default(scheduleAttributes.HeartbeatTimeoutSeconds))
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
 	attributes.RetryPolicy = scheduleAttributes.RetryPolicy
+	attributes.Priority = scheduleAttributes.Priority
+	attributes.FairnessKey = scheduleAttributes.FairnessKey
 	historyEvent.ActivityTaskScheduledEventAttributes = attributes
 
 	return historyEvent
@@ -817,6 +832,21 @@ func (b *historyBuilder) newUpsertWorkflowSearchAttributesEvent(decisionTaskComp
 	return event
 }
 
+func (b *historyBuilder) newWorkflowExecutionUpdateAcceptedEvent(decisionTaskCompletedEventID int64,
+	update *workflow.WorkflowUpdate, result *workflow.WorkflowUpdateResult, identity string) *workflow.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUpdateAccepted)
+	attributes := &workflow.WorkflowExecutionUpdateAcceptedEventAttributes{}
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.UpdateId = common.StringPtr(update.GetUpdateId())
+	attributes.UpdateName = common.StringPtr(update.GetUpdateName())
+	attributes.Input = update.Input
+	attributes.Result = result.Result
+	attributes.Identity = common.StringPtr(identity)
+	event.WorkflowExecutionUpdateAcceptedEventAttributes = attributes
+
+	return event
+}
+
 func (b *historyBuilder) newSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeSignalExternalWorkflowExecutionFailed)
@@ -63,6 +63,13 @@ func newHistoryCache(shard ShardContext) *historyCache {
 	opts.InitialCapacity = config.HistoryCacheInitialSize()
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
+	opts.RemovedFunc = func(value interface{}) {
+		// the update registry only lives in memory, callers waiting on updates
+		// of an evicted workflow execution would otherwise wait until timeout
+		if context, ok := value.(workflowExecutionContext); ok {
+			context.getUpdateRegistry().abortPendingUpdates(ErrUpdateAborted)
+		}
+	}
 
 	return &historyCache{
 		Cache:            cache.New(config.HistoryCacheMaxSize(), opts),
@@ -74,6 +81,19 @@ func newHistoryCache(shard ShardContext) *historyCache {
 	}
 }
 
+// abortPendingUpdates fails the pending updates of all cached workflow executions,
+// used when the shard is closed and the cache is dropped
+func (c *historyCache) abortPendingUpdates() {
+	it := c.Iterator()
+	defer it.Close()
+
+	for it.HasNext() {
+		if context, ok := it.Next().Value().(workflowExecutionContext); ok {
+			context.getUpdateRegistry().abortPendingUpdates(ErrUpdateAborted)
+		}
+	}
+}
+
 func (c *historyCache) getOrCreateCurrentWorkflowExecution(
 	ctx context.Context,
 	domainID string,
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
@@ -180,6 +181,41 @@ func (s *historyCacheSuite) TestHistoryCachePinning() {
 	release(err4)
 }
 
+func (s *historyCacheSuite) TestHistoryCacheEvictionAbortsPendingUpdates() {
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(2)
+	domainID := "test_domain_id"
+	s.cache = newHistoryCache(s.mockShard)
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wf-cache-test-eviction"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	context, release, err := s.cache.getOrCreateWorkflowExecutionForBackground(domainID, we)
+	s.Nil(err)
+	updateRegistry := context.getUpdateRegistry()
+	updateID, updateTermCh := updateRegistry.bufferUpdate(&workflow.WorkflowUpdate{})
+	release(nil)
+
+	// Loading other executions evicts the first one from the cache
+	for i := 0; i < 2; i++ {
+		we2 := workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("wf-cache-test-eviction"),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		_, release2, err2 := s.cache.getOrCreateWorkflowExecutionForBackground(domainID, we2)
+		s.Nil(err2)
+		release2(nil)
+	}
+
+	select {
+	case <-updateTermCh:
+	case <-time.After(time.Second):
+		s.Fail("expected pending update to be aborted")
+	}
+	_, err = updateRegistry.getUpdateResult(updateID)
+	s.Equal(ErrUpdateAborted, err)
+}
+
 func (s *historyCacheSuite) TestHistoryCacheClear() {
 	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(20)
 	domainID := "test_domain_id"
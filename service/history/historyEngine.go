@@ -30,14 +30,17 @@ import (
 
 	"github.com/pborman/uuid"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/yarpc/yarpcerrors"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	r "github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/definition"
@@ -87,6 +90,7 @@ type (
 		resetor                   workflowResetor
 		replicationTaskProcessors []*ReplicationTaskProcessor
 		publicClient              workflowserviceclient.Interface
+		matchingClient            matching.Client
 		eventsReapplier           nDCEventsReapplier
 	}
 )
@@ -112,6 +116,8 @@ var (
 	ErrWorkflowCompleted = &workflow.EntityNotExistsError{Message: "workflow execution already completed"}
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
 	ErrWorkflowParent = &workflow.EntityNotExistsError{Message: "workflow parent does not match"}
+	// ErrWorkflowRunning is the error to indicate workflow execution is not closed yet
+	ErrWorkflowRunning = &workflow.BadRequestError{Message: "workflow execution is still running, only closed workflow executions can be deleted"}
 	// ErrDeserializingToken is the error to indicate task token is invalid
 	ErrDeserializingToken = &workflow.BadRequestError{Message: "error deserializing task token"}
 	// ErrSignalOverSize is the error to indicate signal input size is > 256K
@@ -123,7 +129,19 @@ var (
 	// ErrEventsAterWorkflowFinish is the error indicating server error trying to write events after workflow finish event
 	ErrEventsAterWorkflowFinish = &workflow.InternalServiceError{Message: "error validating last event being workflow finish event"}
 	// ErrQueryTimeout is the error indicating query timed out before being answered
-	ErrQueryTimeout = errors.New("query timed out")
+	ErrQueryTimeout = yarpcerrors.DeadlineExceededErrorf("query timed out before a decision task answered it")
+	// ErrConsistentQueryBufferExceeded is the error indicating too many strongly consistent queries are buffered for the workflow
+	ErrConsistentQueryBufferExceeded = &workflow.InternalServiceError{Message: "consistent query buffer is full, cannot accept new consistent queries"}
+	// ErrActivityIDNotSet is the error indicating activity ID is not set on the request
+	ErrActivityIDNotSet = &workflow.BadRequestError{Message: "ActivityID is not set on request."}
+	// ErrActivityTaskRunning is the error indicating an operation is not allowed while the activity attempt is running
+	ErrActivityTaskRunning = &workflow.BadRequestError{Message: "activity task is running, retry state can only be reset between attempts"}
+	// ErrUpdateNameNotSet is the error indicating update name is not set on the request
+	ErrUpdateNameNotSet = &workflow.BadRequestError{Message: "UpdateName is not set on request."}
+	// ErrUpdateTimeout is the error indicating update timed out before being accepted or rejected
+	ErrUpdateTimeout = yarpcerrors.DeadlineExceededErrorf("update timed out before a decision task accepted or rejected it")
+	// ErrUpdateAborted is the error indicating the pending update was dropped before being handled, e.g. when the workflow execution is unloaded from the shard
+	ErrUpdateAborted = &workflow.InternalServiceError{Message: "update was aborted before being handled, please retry"}
 
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -179,7 +197,8 @@ func NewEngineWithShardContext(
 			shard.GetConfig().ArchiveRequestRPS,
 			shard.GetService().GetArchiverProvider(),
 		),
-		publicClient: publicClient,
+		publicClient:   publicClient,
+		matchingClient: matching,
 	}
 
 	historyEngImpl.txProcessor = newTransferQueueProcessor(shard, historyEngImpl, visibilityMgr, matching, historyClient, logger)
@@ -267,6 +286,9 @@ func (e *historyEngineImpl) Stop() {
 
 	// unset the failover callback
 	e.shard.GetDomainCache().UnregisterDomainChangeCallback(e.shard.GetShardID())
+
+	// pending updates are not persisted and cannot be handled by the next owner of the shard
+	e.historyCache.abortPendingUpdates()
 }
 
 func (e *historyEngineImpl) registerDomainFailoverCallback() {
@@ -676,11 +698,15 @@ func (e *historyEngineImpl) getMutableStateOrPolling(
 	return response, nil
 }
 
+// QueryWorkflow answers a strongly consistent query, i.e. the result of the query reflects all events
+// which were recorded before the query arrived.
 func (e *historyEngineImpl) QueryWorkflow(
 	ctx ctx.Context,
 	request *h.QueryWorkflowRequest,
-) (retResp *h.QueryWorkflowResponse, retErr error) {
-	domainCache, err := e.shard.GetDomainCache().GetDomainByID(request.GetDomainUUID())
+) (*h.QueryWorkflowResponse, error) {
+
+	scope := e.metricsClient.Scope(metrics.HistoryQueryWorkflowScope)
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(request.GetDomainUUID())
 	if err != nil {
 		return nil, err
 	}
@@ -693,93 +719,221 @@ func (e *historyEngineImpl) QueryWorkflow(
 		release(err)
 		return nil, err
 	}
-	queryRegistry := msBuilder.GetQueryRegistry()
-	release(nil)
-	queryID, _, queryTermCh := queryRegistry.bufferQuery(request.GetQuery())
+	execution := *context.getExecution()
+	executionInfo := msBuilder.GetExecutionInfo()
+	taskList := executionInfo.TaskList
+	stickyTaskList := ""
+	stickyScheduleToStartTimeout := executionInfo.StickyScheduleToStartTimeout
+	clientFeature := client.NewFeatureImpl(
+		executionInfo.ClientLibraryVersion,
+		executionInfo.ClientFeatureVersion,
+		executionInfo.ClientImpl,
+	)
+	if clientFeature.SupportStickyQuery() {
+		stickyTaskList = executionInfo.StickyTaskList
+	}
 
-	ttl := e.shard.GetConfig().LongPollExpirationInterval(domainCache.GetInfo().Name)
-	timer := time.NewTimer(ttl)
+	// A query can be dispatched directly to the worker without violating strong consistency when:
+	// 1. the domain is not active, in which case history cannot change
+	// 2. the workflow is not running, in which case history cannot change
+	// 3. there is no scheduled or started decision task, in which case the worker has already seen all events
+	// Otherwise the query is buffered and dispatched on the next decision task.
+	if !domainEntry.IsDomainActive() || !msBuilder.IsWorkflowExecutionRunning() || !msBuilder.HasPendingDecision() {
+		release(nil)
+		return e.queryDirectlyThroughMatching(ctx, domainEntry, execution, taskList, stickyTaskList, stickyScheduleToStartTimeout, request.GetQuery())
+	}
 
-	// after query is finished removed query from query registry and remove any potentially created in memory decision task
-	defer func() {
-		timer.Stop()
-		queryRegistry.removeQuery(queryID)
-		context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, request.GetDomainUUID(), *request.GetExecution())
-		if err != nil {
-			retResp = nil
-			retErr = err
-			return
-		}
-		msBuilder, err := context.loadWorkflowExecution()
-		if err != nil {
-			release(err)
-			retResp = nil
-			retErr = err
-			return
-		}
-		msBuilder.DeleteInMemoryDecisionTask()
+	queryRegistry := context.getQueryRegistry()
+	if len(queryRegistry.getBufferedSnapshot()) >= e.config.MaxBufferedQueryCount() {
 		release(nil)
-	}()
+		scope.IncCounter(metrics.QueryBufferExceededCounter)
+		return nil, ErrConsistentQueryBufferExceeded
+	}
+	// the query is buffered while holding the workflow lock so that the
+	// completion of the pending decision task is guaranteed to observe it
+	queryID, _, queryTermCh := queryRegistry.bufferQuery(request.GetQuery())
+	release(nil)
+	defer queryRegistry.removeQuery(queryID)
+
+	timer := time.NewTimer(e.shard.GetConfig().LongPollExpirationInterval(domainEntry.GetInfo().Name))
+	defer timer.Stop()
 
-	// ensure decision task exists to dispatch query on
-retryLoop:
-	for i := 0; i < conditionalRetryCount; i++ {
-		context, release, err = e.historyCache.getOrCreateWorkflowExecution(ctx, request.GetDomainUUID(), *request.GetExecution())
+	select {
+	case <-queryTermCh:
+		querySnapshot, err := queryRegistry.getQuerySnapshot(queryID)
 		if err != nil {
 			return nil, err
 		}
-		msBuilder, err := context.loadWorkflowExecution()
-		if err != nil {
-			release(err)
-			return nil, err
+		switch querySnapshot.state {
+		case queryStateCompleted:
+			result := querySnapshot.queryResult
+			switch result.GetResultType() {
+			case workflow.QueryResultTypeAnswered:
+				return &h.QueryWorkflowResponse{
+					QueryResult: result.GetAnswer(),
+				}, nil
+			case workflow.QueryResultTypeFailed:
+				return nil, &workflow.QueryFailedError{Message: fmt.Sprintf("%v: %v", result.GetErrorReason(), result.GetErrorDetails())}
+			}
+		case queryStateUnblocked:
+			// the decision task completed without answering the query and no new decision task
+			// was scheduled, so the worker has seen all events which came before the query
+			return e.queryDirectlyThroughMatching(ctx, domainEntry, execution, taskList, stickyTaskList, stickyScheduleToStartTimeout, request.GetQuery())
 		}
-		// a scheduled decision task already exists - no need to schedule an in memory decision task
-		if (msBuilder.HasPendingDecision() && !msBuilder.HasInFlightDecision()) || msBuilder.HasScheduledInMemoryDecisionTask() {
-			release(nil)
-			break retryLoop
+	case <-timer.C:
+		scope.IncCounter(metrics.ConsistentQueryTimeoutCounter)
+		return nil, ErrQueryTimeout
+	case <-ctx.Done():
+		scope.IncCounter(metrics.ConsistentQueryTimeoutCounter)
+		return nil, ctx.Err()
+	}
+	return nil, &workflow.InternalServiceError{Message: "query entered unexpected state, this should be impossible"}
+}
+
+func (e *historyEngineImpl) queryDirectlyThroughMatching(
+	requestCtx ctx.Context,
+	domainEntry *cache.DomainCacheEntry,
+	execution workflow.WorkflowExecution,
+	taskList string,
+	stickyTaskList string,
+	stickyScheduleToStartTimeout int32,
+	query *workflow.WorkflowQuery,
+) (*h.QueryWorkflowResponse, error) {
+
+	e.metricsClient.IncCounter(metrics.HistoryQueryWorkflowScope, metrics.DirectQueryDispatchCounter)
+	matchingRequest := &m.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(domainEntry.GetInfo().ID),
+		QueryRequest: &workflow.QueryWorkflowRequest{
+			Domain:    common.StringPtr(domainEntry.GetInfo().Name),
+			Execution: &execution,
+			Query:     query,
+		},
+	}
+	if len(stickyTaskList) != 0 {
+		matchingRequest.TaskList = &workflow.TaskList{Name: common.StringPtr(stickyTaskList)}
+		// using a clean new context in case customer provide a context which has
+		// a really short deadline, causing we clear the stickyness
+		stickyContext, cancel := ctx.WithTimeout(ctx.Background(), time.Duration(stickyScheduleToStartTimeout)*time.Second)
+		matchingResp, err := e.matchingClient.QueryWorkflow(stickyContext, matchingRequest)
+		cancel()
+		if err == nil {
+			return &h.QueryWorkflowResponse{
+				QueryResult: matchingResp.GetQueryResult(),
+			}, nil
 		}
-		// there exists an inflight decision task - try again to schedule decision task
-		if msBuilder.HasInFlightDecision() {
-			release(nil)
-			// wait for currently inflight decision task to complete
-			select {
-			case <-timer.C:
-				return nil, ErrQueryTimeout
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(100 * time.Millisecond):
-				continue retryLoop
-			}
+		if yarpcError, ok := err.(*yarpcerrors.Status); (!ok || yarpcError.Code() != yarpcerrors.CodeDeadlineExceeded) && err != ctx.DeadlineExceeded {
+			return nil, err
 		}
-		// there is no scheduled or started decision task - schedule in memory decision task
-		if err := msBuilder.AddInMemoryDecisionTaskScheduled(ttl); err != nil {
-			release(err)
+		// this means sticky timeout, should try using the normal tasklist
+		// we should clear the stickyness of this workflow
+		e.logger.Info("QueryWorkflowTimeouted with stickyTaskList, will try nonSticky one",
+			tag.WorkflowDomainName(domainEntry.GetInfo().Name),
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.WorkflowQueryType(query.GetQueryType()),
+			tag.WorkflowTaskListName(stickyTaskList))
+		resetContext, cancel := ctx.WithTimeout(ctx.Background(), 5*time.Second)
+		_, err = e.ResetStickyTaskList(resetContext, &h.ResetStickyTaskListRequest{
+			DomainUUID: common.StringPtr(domainEntry.GetInfo().ID),
+			Execution:  &execution,
+		})
+		cancel()
+		if err != nil && err != ErrWorkflowCompleted {
 			return nil, err
 		}
 	}
 
-	// at this point query has been buffered and there is a pending decision task to dispatch the query on
+	matchingRequest.TaskList = &workflow.TaskList{Name: common.StringPtr(taskList)}
+	matchingResp, err := e.matchingClient.QueryWorkflow(requestCtx, matchingRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &h.QueryWorkflowResponse{
+		QueryResult: matchingResp.GetQueryResult(),
+	}, nil
+}
+
+// UpdateWorkflowExecution buffers the update, makes sure there is a decision task to deliver it on
+// and blocks until the worker accepts or rejects the update.
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx ctx.Context,
+	wrappedRequest *h.UpdateWorkflowExecutionRequest,
+) (*workflow.UpdateWorkflowExecutionResponse, error) {
+
+	domainEntry, err := e.getActiveDomainEntry(wrappedRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := wrappedRequest.UpdateRequest
+	if request.GetUpdateName() == "" {
+		return nil, ErrUpdateNameNotSet
+	}
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	updateRegistry := context.getUpdateRegistry()
+	// pin the run, the update must not leak into a new run after continue as new
+	execution = *context.getExecution()
+	release(nil)
+
+	updateID, updateTermCh := updateRegistry.bufferUpdate(&workflow.WorkflowUpdate{
+		UpdateName: request.UpdateName,
+		Input:      request.Input,
+	})
+	defer updateRegistry.removeUpdate(updateID)
+
+	// a decision task which is already in flight schedules a new decision task
+	// for the buffered update when it completes
+	if err := e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if msBuilder.HasPendingDecision() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+			return &updateWorkflowAction{createDecision: true}, nil
+		}); err != nil {
+		return nil, err
+	}
+
+	ttl := e.shard.GetConfig().LongPollExpirationInterval(domainEntry.GetInfo().Name)
+	timer := time.NewTimer(ttl)
+	defer timer.Stop()
+
 	select {
-	case <-queryTermCh:
-		querySnapshot, err := queryRegistry.getQuerySnapshot(queryID)
+	case <-updateTermCh:
+		result, err := updateRegistry.getUpdateResult(updateID)
 		if err != nil {
 			return nil, err
 		}
-		result := querySnapshot.queryResult
-		switch result.GetResultType() {
-		case workflow.QueryResultTypeAnswered:
-			return &h.QueryWorkflowResponse{
-				QueryResult: result.GetAnswer(),
+		if result.GetResultType() == workflow.WorkflowUpdateResultTypeAccepted {
+			return &workflow.UpdateWorkflowExecutionResponse{
+				Result: result.Result,
 			}, nil
-		case workflow.QueryResultTypeFailed:
-			return nil, &workflow.QueryFailedError{Message: fmt.Sprintf("%v: %v", result.GetErrorReason(), result.GetErrorDetails())}
+		}
+		e.metricsClient.IncCounter(metrics.HistoryUpdateWorkflowExecutionScope, metrics.WorkflowUpdateRejectedCounter)
+		message := result.GetRejectionReason()
+		if message == "" {
+			message = "update rejected by workflow"
+		}
+		return nil, &workflow.WorkflowUpdateRejectedError{
+			Message: message,
+			Details: result.RejectionDetails,
 		}
 	case <-timer.C:
-		return nil, ErrQueryTimeout
+		return nil, ErrUpdateTimeout
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	}
-	return nil, &workflow.InternalServiceError{Message: "query entered unexpected state, this should be impossible"}
 }
 
 func (e *historyEngineImpl) getMutableState(
@@ -941,6 +1095,16 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 	if err1 != nil {
 		return nil, err1
 	}
+
+	return describeMutableState(msBuilder)
+}
+
+// describeMutableState builds the describe response of a workflow execution from its mutable state,
+// it's also archived along with the history as the final state of closed workflow runs
+func describeMutableState(
+	msBuilder mutableState,
+) (*workflow.DescribeWorkflowExecutionResponse, error) {
+
 	executionInfo := msBuilder.GetExecutionInfo()
 
 	result := &workflow.DescribeWorkflowExecutionResponse{
@@ -1121,6 +1285,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &h.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if ai.Paused {
+				// Activity is paused, drop the task. It is dispatched again once the activity is unpaused.
+				return ErrActivityTaskNotFound
+			}
+
 			if _, err := msBuilder.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -1199,7 +1368,8 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
+	var releaseRequest *m.ReleaseTaskRequest
+	err = e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
 		func(msBuilder mutableState, tBuilder *timerBuilder) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
@@ -1230,8 +1400,14 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 				// Unable to add ActivityTaskCompleted event to history
 				return &workflow.InternalServiceError{Message: "Unable to add ActivityTaskCompleted event to history."}
 			}
+			releaseRequest = newReleaseActivityTaskRequest(domainID, token, msBuilder, ai)
 			return nil
 		})
+	if err != nil {
+		return err
+	}
+	e.releaseActivityTask(ctx, releaseRequest)
+	return nil
 }
 
 // RespondActivityTaskFailed completes an activity task failure.
@@ -1257,7 +1433,8 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecutionWithAction(ctx, domainID, workflowExecution,
+	var releaseRequest *m.ReleaseTaskRequest
+	err = e.updateWorkflowExecutionWithAction(ctx, domainID, workflowExecution,
 		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1297,9 +1474,15 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 				}
 				postActions.createDecision = true
 			}
+			releaseRequest = newReleaseActivityTaskRequest(domainID, token, msBuilder, ai)
 
 			return postActions, nil
 		})
+	if err != nil {
+		return err
+	}
+	e.releaseActivityTask(ctx, releaseRequest)
+	return nil
 }
 
 // RespondActivityTaskCanceled completes an activity task failure.
@@ -1325,7 +1508,8 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
+	var releaseRequest *m.ReleaseTaskRequest
+	err = e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
 		func(msBuilder mutableState, tBuilder *timerBuilder) error {
 			if !msBuilder.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
@@ -1361,10 +1545,57 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 				// Unable to add ActivityTaskCanceled event to history
 				return &workflow.InternalServiceError{Message: "Unable to add ActivityTaskCanceled event to history."}
 			}
+			releaseRequest = newReleaseActivityTaskRequest(domainID, token, msBuilder, ai)
 
 			return nil
 		})
+	if err != nil {
+		return err
+	}
+	e.releaseActivityTask(ctx, releaseRequest)
+	return nil
+}
+
+// newReleaseActivityTaskRequest returns the request freeing the outstanding task slot held by an activity
+// completed by ID. Task tokens tell the task list partition holding the slot and the frontend releases it,
+// the worker completing an activity by ID has no task token so the slot is released on all partitions
+func newReleaseActivityTaskRequest(
+	domainID string,
+	token *common.TaskToken,
+	msBuilder mutableState,
+	ai *persistence.ActivityInfo,
+) *m.ReleaseTaskRequest {
+
+	if token.ScheduleID != common.EmptyEventID {
+		return nil
+	}
+	return &m.ReleaseTaskRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		TaskList:     &workflow.TaskList{Name: common.StringPtr(ai.TaskList)},
+		TaskListType: common.Int32Ptr(persistence.TaskListTypeActivity),
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(msBuilder.GetExecutionInfo().WorkflowID),
+			RunId:      common.StringPtr(msBuilder.GetExecutionInfo().RunID),
+		},
+		ScheduleId:    common.Int64Ptr(ai.ScheduleID),
+		AllPartitions: common.BoolPtr(true),
+	}
+}
 
+// releaseActivityTask frees the outstanding task slot, failing to do so is not fatal
+// as the slot is freed anyway once the start to close timeout of the activity expires
+func (e *historyEngineImpl) releaseActivityTask(ctx ctx.Context, request *m.ReleaseTaskRequest) {
+	if request == nil {
+		return
+	}
+	if err := e.matchingClient.ReleaseTask(ctx, request); err != nil {
+		e.logger.Warn("Failed to release outstanding activity task.",
+			tag.WorkflowDomainID(request.GetDomainUUID()),
+			tag.WorkflowID(request.WorkflowExecution.GetWorkflowId()),
+			tag.WorkflowRunID(request.WorkflowExecution.GetRunId()),
+			tag.WorkflowTaskListName(request.TaskList.GetName()),
+			tag.Error(err))
+	}
 }
 
 // RecordActivityTaskHeartbeat records an hearbeat for a task.
@@ -1520,8 +1751,8 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 		executionInfo := msBuilder.GetExecutionInfo()
 		createDecisionTask := true
-		// Do not create decision task when the workflow is cron and the cron has not been started yet
-		if msBuilder.GetExecutionInfo().CronSchedule != "" && !msBuilder.HasProcessedOrPendingDecision() {
+		// Do not create decision task when the workflow is cron or delayed and has not been started yet
+		if isFirstDecisionTaskBackoffPending(msBuilder) {
 			createDecisionTask = false
 		}
 		postActions := &updateWorkflowAction{
@@ -1625,8 +1856,8 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				return nil, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
 
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecision() {
+			// Create a transfer task to schedule a decision task, unless the workflow has not been started yet
+			if !msBuilder.HasPendingDecision() && !isFirstDecisionTaskBackoffPending(msBuilder) {
 				_, err := msBuilder.AddDecisionTaskScheduledEvent(false)
 				if err != nil {
 					return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
@@ -2072,6 +2303,16 @@ func (e *historyEngineImpl) updateWorkflowExecutionWithAction(
 	}
 	defer func() { release(retError) }()
 
+	return e.updateWorkflowExecutionWithContext(context, action)
+}
+
+// updateWorkflowExecutionWithContext runs the action against the workflow execution of the
+// context and persists the result, the caller must hold the lock of the context
+func (e *historyEngineImpl) updateWorkflowExecutionWithContext(
+	context workflowExecutionContext,
+	action func(builder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error),
+) error {
+
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
 		msBuilder, err1 := context.loadWorkflowExecution()
@@ -2382,12 +2623,28 @@ func getStartRequest(
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
+		DelayStartSeconds:                   request.DelayStartSeconds,
+		Priority:                            request.Priority,
+		FairnessKey:                         request.FairnessKey,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
 	return startRequest
 }
 
+// isFirstDecisionTaskBackoffPending returns true if the first decision of the workflow
+// is still waiting on the cron or delayed start backoff timer
+func isFirstDecisionTaskBackoffPending(
+	msBuilder mutableState,
+) bool {
+
+	if msBuilder.HasProcessedOrPendingDecision() {
+		return false
+	}
+	executionInfo := msBuilder.GetExecutionInfo()
+	return executionInfo.CronSchedule != "" || executionInfo.DelayStartSeconds > 0
+}
+
 func setTaskInfo(
 	version int64,
 	timestamp time.Time,
@@ -2520,8 +2777,8 @@ func (e *historyEngineImpl) ReapplyEvents(
 			postActions := &updateWorkflowAction{
 				createDecision: true,
 			}
-			// Do not create decision task when the workflow is cron and the cron has not been started yet
-			if msBuilder.GetExecutionInfo().CronSchedule != "" && !msBuilder.HasProcessedOrPendingDecision() {
+			// Do not create decision task when the workflow is cron or delayed and has not been started yet
+			if isFirstDecisionTaskBackoffPending(msBuilder) {
 				postActions.createDecision = false
 			}
 			// TODO when https://github.com/uber/cadence/issues/2420 is finished
@@ -2549,3 +2806,313 @@ func (e *historyEngineImpl) ReapplyEvents(
 			return postActions, nil
 		})
 }
+
+// PauseActivity stops dispatching further attempts of a pending activity
+func (e *historyEngineImpl) PauseActivity(
+	ctx ctx.Context,
+	request *h.PauseActivityRequest,
+) error {
+
+	pauseRequest := request.PauseRequest
+	return e.updatePendingActivity(
+		ctx,
+		request.DomainUUID,
+		pauseRequest.WorkflowExecution,
+		pauseRequest.GetActivityID(),
+		func(msBuilder mutableState, ai *persistence.ActivityInfo) error {
+			return msBuilder.PauseActivity(ai)
+		})
+}
+
+// UnpauseActivity resumes dispatching of a paused activity
+func (e *historyEngineImpl) UnpauseActivity(
+	ctx ctx.Context,
+	request *h.UnpauseActivityRequest,
+) error {
+
+	unpauseRequest := request.UnpauseRequest
+	return e.updatePendingActivity(
+		ctx,
+		request.DomainUUID,
+		unpauseRequest.WorkflowExecution,
+		unpauseRequest.GetActivityID(),
+		func(msBuilder mutableState, ai *persistence.ActivityInfo) error {
+			return msBuilder.UnpauseActivity(ai)
+		})
+}
+
+// ResetActivity resets the attempt counter and retry state of a pending activity
+func (e *historyEngineImpl) ResetActivity(
+	ctx ctx.Context,
+	request *h.ResetActivityRequest,
+) error {
+
+	resetRequest := request.ResetRequest
+	return e.updatePendingActivity(
+		ctx,
+		request.DomainUUID,
+		resetRequest.WorkflowExecution,
+		resetRequest.GetActivityID(),
+		func(msBuilder mutableState, ai *persistence.ActivityInfo) error {
+			// the attempt of a running activity is part of its task token,
+			// resetting it would fail the completion of the running attempt
+			if ai.StartedID != common.EmptyEventID {
+				return ErrActivityTaskRunning
+			}
+			return msBuilder.ResetActivity(ai, resetRequest.GetResetHeartbeatDetails())
+		})
+}
+
+// UpdateActivityTimeouts updates the timeouts of a pending activity
+func (e *historyEngineImpl) UpdateActivityTimeouts(
+	ctx ctx.Context,
+	request *h.UpdateActivityTimeoutsRequest,
+) error {
+
+	updateRequest := request.UpdateRequest
+	if updateRequest.GetScheduleToStartTimeoutSeconds() < 0 ||
+		updateRequest.GetScheduleToCloseTimeoutSeconds() < 0 ||
+		updateRequest.GetStartToCloseTimeoutSeconds() < 0 ||
+		updateRequest.GetHeartbeatTimeoutSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "A valid timeout may not be negative."}
+	}
+
+	return e.updatePendingActivity(
+		ctx,
+		request.DomainUUID,
+		updateRequest.WorkflowExecution,
+		updateRequest.GetActivityID(),
+		func(msBuilder mutableState, ai *persistence.ActivityInfo) error {
+			return msBuilder.UpdateActivityTimeouts(
+				ai,
+				updateRequest.GetScheduleToStartTimeoutSeconds(),
+				updateRequest.GetScheduleToCloseTimeoutSeconds(),
+				updateRequest.GetStartToCloseTimeoutSeconds(),
+				updateRequest.GetHeartbeatTimeoutSeconds(),
+			)
+		})
+}
+
+// ImportWorkflowExecution creates a workflow execution from history batches exported from another cluster
+func (e *historyEngineImpl) ImportWorkflowExecution(
+	ctx ctx.Context,
+	request *h.ImportWorkflowExecutionRequest,
+) (retError error) {
+
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	if workflowID == "" {
+		return &workflow.BadRequestError{Message: "WorkflowId is not set on request."}
+	}
+	runID := request.GetExecution().GetRunId()
+	if uuid.Parse(runID) == nil {
+		return &workflow.BadRequestError{Message: "Invalid RunId."}
+	}
+
+	// grab the current context as a lock, nothing more
+	_, currentRelease, err := e.historyCache.getOrCreateCurrentWorkflowExecution(
+		ctx,
+		domainID,
+		workflowID,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { currentRelease(retError) }()
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+	context := newWorkflowExecutionContext(domainID, execution, e.shard, e.executionManager, e.logger)
+	return newWorkflowImporter(e.shard, e.logger).importWorkflow(
+		ctx,
+		e.timeSource.Now(),
+		domainEntry,
+		context,
+		request.HistoryBatches,
+	)
+}
+
+// UpdateWorkflowSearchAttributes upserts search attributes of a running workflow execution
+func (e *historyEngineImpl) UpdateWorkflowSearchAttributes(
+	ctx ctx.Context,
+	request *h.UpdateWorkflowSearchAttributesRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	if request.GetWorkflowExecution().GetWorkflowId() == "" {
+		return &workflow.BadRequestError{Message: "WorkflowId is not set on request."}
+	}
+
+	attrValidator := newDecisionAttrValidator(e.shard.GetDomainCache(), e.config, e.logger)
+	if err := attrValidator.validateUpdateWorkflowSearchAttributes(
+		domainEntry.GetInfo().Name,
+		request.UpsertSearchAttributes,
+	); err != nil {
+		return err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, false,
+		func(msBuilder mutableState, tBuilder *timerBuilder) error {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return ErrWorkflowCompleted
+			}
+
+			// the event is not generated by a decision, so it is buffered while a decision is in flight
+			_, err := msBuilder.AddUpsertWorkflowSearchAttributesEvent(
+				common.EmptyEventID,
+				&workflow.UpsertWorkflowSearchAttributesDecisionAttributes{
+					SearchAttributes: request.UpsertSearchAttributes,
+				},
+			)
+			return err
+		})
+}
+
+// DeleteWorkflowExecution deletes a closed workflow execution along with its history and visibility record
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx ctx.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	if request.GetWorkflowExecution().GetWorkflowId() == "" {
+		return &workflow.BadRequestError{Message: "WorkflowId is not set on request."}
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		return err
+	}
+	if msBuilder.IsWorkflowExecutionRunning() {
+		return ErrWorkflowRunning
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	if err := e.executionManager.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: executionInfo.WorkflowID,
+		RunID:      executionInfo.RunID,
+	}); err != nil {
+		return err
+	}
+	if err := e.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: executionInfo.WorkflowID,
+		RunID:      executionInfo.RunID,
+	}); err != nil {
+		return err
+	}
+
+	if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
+		branchToken, err := msBuilder.GetCurrentBranchToken()
+		if err != nil {
+			return err
+		}
+		if err := persistence.DeleteWorkflowExecutionHistoryV2(e.historyV2Mgr, branchToken, common.IntPtr(e.shard.GetShardID()), e.logger); err != nil {
+			return err
+		}
+	} else if err := e.historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+		DomainID: domainID,
+		Execution: workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+			RunId:      common.StringPtr(executionInfo.RunID),
+		},
+	}); err != nil {
+		return err
+	}
+
+	// the task ID orders the deletion after the visibility updates of the workflow
+	taskID, err := e.shard.GenerateTransferTaskID()
+	if err != nil {
+		return err
+	}
+	if err := e.visibilityMgr.DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: executionInfo.WorkflowID,
+		RunID:      executionInfo.RunID,
+		TaskID:     taskID,
+	}); err != nil {
+		return err
+	}
+
+	// calling clear here to force accesses of mutable state to read database
+	context.clear()
+	return nil
+}
+
+func (e *historyEngineImpl) updatePendingActivity(
+	ctx ctx.Context,
+	domainUUID *string,
+	workflowExecution *workflow.WorkflowExecution,
+	activityID string,
+	action func(msBuilder mutableState, ai *persistence.ActivityInfo) error,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(domainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	if workflowExecution.GetWorkflowId() == "" {
+		return &workflow.BadRequestError{Message: "WorkflowId is not set on request."}
+	}
+	if activityID == "" {
+		return ErrActivityIDNotSet
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: workflowExecution.WorkflowId,
+		RunId:      workflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecution(ctx, domainID, execution, false,
+		func(msBuilder mutableState, tBuilder *timerBuilder) error {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return ErrWorkflowCompleted
+			}
+
+			scheduleID, err := msBuilder.GetScheduleIDByActivityID(activityID)
+			if err != nil {
+				return ErrActivityTaskNotFound
+			}
+			ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
+			if !isRunning {
+				return ErrActivityTaskNotFound
+			}
+
+			return action(msBuilder, ai)
+		})
+}
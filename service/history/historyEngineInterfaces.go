@@ -77,7 +77,15 @@ type (
 		SyncActivity(ctx context.Context, request *h.SyncActivityRequest) error
 		GetReplicationMessages(ctx context.Context, taskID int64) (*replicator.ReplicationMessages, error)
 		QueryWorkflow(ctx context.Context, request *h.QueryWorkflowRequest) (*h.QueryWorkflowResponse, error)
+		UpdateWorkflowExecution(ctx context.Context, request *h.UpdateWorkflowExecutionRequest) (*workflow.UpdateWorkflowExecutionResponse, error)
 		ReapplyEvents(ctx context.Context, domainUUID string, workflowID string, events []*workflow.HistoryEvent) error
+		PauseActivity(ctx context.Context, request *h.PauseActivityRequest) error
+		UnpauseActivity(ctx context.Context, request *h.UnpauseActivityRequest) error
+		ResetActivity(ctx context.Context, request *h.ResetActivityRequest) error
+		UpdateActivityTimeouts(ctx context.Context, request *h.UpdateActivityTimeoutsRequest) error
+		UpdateWorkflowSearchAttributes(ctx context.Context, request *h.UpdateWorkflowSearchAttributesRequest) error
+		DeleteWorkflowExecution(ctx context.Context, request *h.DeleteWorkflowExecutionRequest) error
+		ImportWorkflowExecution(ctx context.Context, request *h.ImportWorkflowExecutionRequest) error
 
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -34,9 +34,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	"github.com/uber/cadence/.gen/go/matching"
 	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
@@ -284,6 +287,7 @@ func (s *engineSuite) SetupTest() {
 		executionManager:     s.mockExecutionMgr,
 		historyMgr:           s.mockHistoryMgr,
 		historyV2Mgr:         s.mockHistoryV2Mgr,
+		visibilityMgr:        s.mockVisibilityMgr,
 		historyCache:         historyCache,
 		logger:               s.logger,
 		metricsClient:        metricsClient,
@@ -294,6 +298,7 @@ func (s *engineSuite) SetupTest() {
 		txProcessor:          s.mockTxProcessor,
 		replicatorProcessor:  s.mockReplicationProcessor,
 		timerProcessor:       s.mockTimerProcessor,
+		matchingClient:       s.mockMatchingClient,
 	}
 	mockShard.SetEngine(h)
 	h.decisionHandler = newDecisionHandler(h)
@@ -562,9 +567,8 @@ func (s *engineSuite) TestQueryWorkflow() {
 	waitGroup.Add(1)
 	asyncQueryUpdate := func(delay time.Duration, answer []byte) {
 		<-time.After(delay)
-		builder := s.getBuilder(testDomainID, execution)
-		s.NotNil(builder)
-		queryRegistry := builder.GetQueryRegistry()
+		queryRegistry := s.getQueryRegistry(testDomainID, execution)
+		s.NotNil(queryRegistry)
 		buffered := queryRegistry.getBufferedSnapshot()
 		for _, id := range buffered {
 			changed, err := queryRegistry.recordEvent(id, queryEventStart, nil)
@@ -609,6 +613,177 @@ func (s *engineSuite) TestQueryWorkflow() {
 	waitGroup.Wait()
 }
 
+func (s *engineSuite) TestQueryWorkflow_DirectlyThroughMatching() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-query-workflow-directly-through-matching"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+
+	// there is no pending decision task, so the query can be dispatched directly
+	s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matching.QueryWorkflowRequest, _ ...yarpc.CallOption) (*workflow.QueryWorkflowResponse, error) {
+			s.Equal(testDomainID, request.GetDomainUUID())
+			s.Equal(tasklist, request.TaskList.GetName())
+			s.Equal(testDomainName, request.QueryRequest.GetDomain())
+			s.Equal(execution.GetRunId(), request.QueryRequest.Execution.GetRunId())
+			return &workflow.QueryWorkflowResponse{QueryResult: []byte{1, 2, 3}}, nil
+		})
+
+	resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), &history.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		Execution:  &execution,
+		Query:      &workflow.WorkflowQuery{},
+	})
+	s.NoError(err)
+	s.Equal([]byte{1, 2, 3}, resp.GetQueryResult())
+}
+
+func (s *engineSuite) TestQueryWorkflow_DirectlyThroughMatching_StickyTimeout() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-query-workflow-sticky-timeout"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tasklist := "testTaskList"
+	stickyTasklist := "testStickyTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	executionInfo := msBuilder.GetExecutionInfo()
+	executionInfo.StickyTaskList = stickyTasklist
+	executionInfo.StickyScheduleToStartTimeout = 1
+	executionInfo.ClientFeatureVersion = "1.0.0"
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+	// the stickyness is cleared once the sticky task list times out
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return request.UpdateWorkflowMutation.ExecutionInfo.StickyTaskList == ""
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	gomock.InOrder(
+		s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *matching.QueryWorkflowRequest, _ ...yarpc.CallOption) (*workflow.QueryWorkflowResponse, error) {
+				s.Equal(stickyTasklist, request.TaskList.GetName())
+				_, ok := ctx.Deadline()
+				s.True(ok)
+				return nil, yarpcerrors.DeadlineExceededErrorf("sticky worker did not respond")
+			}),
+		s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *matching.QueryWorkflowRequest, _ ...yarpc.CallOption) (*workflow.QueryWorkflowResponse, error) {
+				s.Equal(tasklist, request.TaskList.GetName())
+				return &workflow.QueryWorkflowResponse{QueryResult: []byte{1, 2, 3}}, nil
+			}),
+	)
+
+	resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), &history.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		Execution:  &execution,
+		Query:      &workflow.WorkflowQuery{},
+	})
+	s.NoError(err)
+	s.Equal([]byte{1, 2, 3}, resp.GetQueryResult())
+}
+
+func (s *engineSuite) TestQueryWorkflow_BufferExceeded() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-query-workflow-buffer-exceeded"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+
+	queryRegistry := s.getQueryRegistry(testDomainID, execution)
+	for i := 0; i < s.mockHistoryEngine.config.MaxBufferedQueryCount(); i++ {
+		queryRegistry.bufferQuery(&workflow.WorkflowQuery{})
+	}
+
+	resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), &history.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		Execution:  &execution,
+		Query:      &workflow.WorkflowQuery{},
+	})
+	s.Nil(resp)
+	s.Equal(ErrConsistentQueryBufferExceeded, err)
+}
+
+func (s *engineSuite) TestQueryWorkflow_UnblockedByDecisionTaskCompletion() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-query-workflow-unblocked"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		ScheduleID: 2,
+	})
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache, loggerimpl.NewDevelopmentForTest(s.Suite), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	ms := createMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMatchingClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any()).Return(&workflow.QueryWorkflowResponse{QueryResult: []byte{1, 2, 3}}, nil)
+
+	type queryResult struct {
+		resp *history.QueryWorkflowResponse
+		err  error
+	}
+	resultCh := make(chan queryResult, 1)
+	go func() {
+		resp, err := s.mockHistoryEngine.QueryWorkflow(context.Background(), &history.QueryWorkflowRequest{
+			DomainUUID: common.StringPtr(testDomainID),
+			Execution:  &execution,
+			Query:      &workflow.WorkflowQuery{},
+		})
+		resultCh <- queryResult{resp: resp, err: err}
+	}()
+
+	// the decision task is in flight, so the query is buffered until it completes
+	queryRegistry := s.getQueryRegistry(testDomainID, execution)
+	for !queryRegistry.hasBuffered() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the decision task completes without scheduling a new one, which unblocks the query
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+		},
+	})
+	s.NoError(err)
+
+	result := <-resultCh
+	s.NoError(result.err)
+	s.Equal([]byte{1, 2, 3}, result.resp.GetQueryResult())
+	s.False(queryRegistry.hasBuffered())
+	s.False(queryRegistry.hasCompleted())
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedInvalidToken() {
 
 	invalidToken, _ := json.Marshal("bad token")
@@ -2528,6 +2703,14 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMatchingClient.EXPECT().ReleaseTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matching.ReleaseTaskRequest, _ ...yarpc.CallOption) error {
+			s.True(request.GetAllPartitions())
+			s.Equal(tl, request.TaskList.GetName())
+			s.Equal(*activityScheduledEvent.EventId, request.GetScheduleId())
+			s.Equal(we.GetRunId(), request.WorkflowExecution.GetRunId())
+			return nil
+		})
 
 	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(testDomainID),
@@ -3068,6 +3251,14 @@ func (s *engineSuite) TestRespondActivityTaskFailedByIDSuccess() {
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMatchingClient.EXPECT().ReleaseTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matching.ReleaseTaskRequest, _ ...yarpc.CallOption) error {
+			s.True(request.GetAllPartitions())
+			s.Equal(tl, request.TaskList.GetName())
+			s.Equal(*activityScheduledEvent.EventId, request.GetScheduleId())
+			s.Equal(we.GetRunId(), request.WorkflowExecution.GetRunId())
+			return nil
+		})
 
 	err := s.mockHistoryEngine.RespondActivityTaskFailed(context.Background(), &history.RespondActivityTaskFailedRequest{
 		DomainUUID: common.StringPtr(testDomainID),
@@ -3381,6 +3572,14 @@ func (s *engineSuite) TestRespondActivityTaskCanceledByID_Started() {
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMatchingClient.EXPECT().ReleaseTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matching.ReleaseTaskRequest, _ ...yarpc.CallOption) error {
+			s.True(request.GetAllPartitions())
+			s.Equal(tl, request.TaskList.GetName())
+			s.Equal(*activityScheduledEvent.EventId, request.GetScheduleId())
+			s.Equal(we.GetRunId(), request.WorkflowExecution.GetRunId())
+			return nil
+		})
 
 	err = s.mockHistoryEngine.RespondActivityTaskCanceled(context.Background(), &history.RespondActivityTaskCanceledRequest{
 		DomainUUID: common.StringPtr(testDomainID),
@@ -3480,6 +3679,422 @@ func (s *engineSuite) TestRespondActivityTaskCanceledIfNotFound() {
 	s.Error(err)
 }
 
+func (s *engineSuite) TestPauseActivity_Success() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.PauseActivity(context.Background(), &history.PauseActivityRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		PauseRequest: &workflow.PauseActivityRequest{
+			WorkflowExecution: &we,
+			ActivityID:        common.StringPtr(activityID),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(testDomainID, we)
+	ai, ok := executionBuilder.GetActivityInfo(activityScheduledEvent.GetEventId())
+	s.True(ok)
+	s.True(ai.Paused)
+}
+
+func (s *engineSuite) TestPauseActivity_NotFound() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.PauseActivity(context.Background(), &history.PauseActivityRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		PauseRequest: &workflow.PauseActivityRequest{
+			WorkflowExecution: &we,
+			ActivityID:        common.StringPtr("unknown_activity"),
+		},
+	})
+	s.Equal(ErrActivityTaskNotFound, err)
+}
+
+func (s *engineSuite) TestUpdateWorkflowSearchAttributes_Success() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	msBuilder.GetExecutionInfo().SearchAttributes = map[string][]byte{
+		"CustomKeywordField": []byte(`"old"`),
+		"CustomIntField":     []byte("1"),
+	}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UpdateWorkflowSearchAttributes(context.Background(), &history.UpdateWorkflowSearchAttributesRequest{
+		DomainUUID:        common.StringPtr(testDomainID),
+		WorkflowExecution: &we,
+		UpsertSearchAttributes: &workflow.SearchAttributes{
+			IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"new"`)},
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(testDomainID, we)
+	s.Equal(map[string][]byte{
+		"CustomKeywordField": []byte(`"new"`),
+		"CustomIntField":     []byte("1"),
+	}, executionBuilder.GetExecutionInfo().SearchAttributes)
+	// the upsert is recorded as an event
+	s.Equal(int64(4), executionBuilder.GetExecutionInfo().NextEventID)
+	s.False(executionBuilder.HasBufferedEvents())
+}
+
+func (s *engineSuite) TestUpdateWorkflowSearchAttributes_DecisionInFlight() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UpdateWorkflowSearchAttributes(context.Background(), &history.UpdateWorkflowSearchAttributesRequest{
+		DomainUUID:        common.StringPtr(testDomainID),
+		WorkflowExecution: &we,
+		UpsertSearchAttributes: &workflow.SearchAttributes{
+			IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"new"`)},
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(testDomainID, we)
+	s.Equal(map[string][]byte{"CustomKeywordField": []byte(`"new"`)}, executionBuilder.GetExecutionInfo().SearchAttributes)
+	// the event waits in the buffer until the decision completes
+	s.Equal(int64(4), executionBuilder.GetExecutionInfo().NextEventID)
+	s.True(executionBuilder.HasBufferedEvents())
+}
+
+func (s *engineSuite) TestUpdateWorkflowSearchAttributes_InvalidRequest() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+
+	err := s.mockHistoryEngine.UpdateWorkflowSearchAttributes(context.Background(), &history.UpdateWorkflowSearchAttributesRequest{
+		DomainUUID:        common.StringPtr(testDomainID),
+		WorkflowExecution: &we,
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	err = s.mockHistoryEngine.UpdateWorkflowSearchAttributes(context.Background(), &history.UpdateWorkflowSearchAttributesRequest{
+		DomainUUID:        common.StringPtr(testDomainID),
+		WorkflowExecution: &we,
+		UpsertSearchAttributes: &workflow.SearchAttributes{
+			IndexedFields: map[string][]byte{"UnknownField": []byte(`"value"`)},
+		},
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Closed() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, decisionStartedEvent.GetEventId(), nil, identity)
+	addCompleteWorkflowEvent(msBuilder, decisionCompletedEvent.GetEventId(), nil)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   testDomainID,
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", &persistence.DeleteWorkflowExecutionRequest{
+		DomainID:   testDomainID,
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+	}).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything).Return(nil).Once()
+	s.mockVisibilityMgr.On("DeleteWorkflowExecution", mock.MatchedBy(func(request *persistence.VisibilityDeleteWorkflowExecutionRequest) bool {
+		return request.DomainID == testDomainID && request.WorkflowID == we.GetWorkflowId() && request.RunID == we.GetRunId()
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(testDomainID),
+		WorkflowExecution: &we,
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID:        common.StringPtr(testDomainID),
+		WorkflowExecution: &we,
+	})
+	s.Equal(ErrWorkflowRunning, err)
+}
+
+func (s *engineSuite) TestUnpauseActivity_Redispatch() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, ai := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	ai.Paused = true
+	ai.Attempt = 2
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
+		// activity dropped by matching while paused is dispatched again
+		for _, task := range input.UpdateWorkflowMutation.TimerTasks {
+			if retryTask, ok := task.(*persistence.ActivityRetryTimerTask); ok {
+				s.Equal(activityScheduledEvent.GetEventId(), retryTask.EventID)
+				s.Equal(int32(2), retryTask.Attempt)
+				return true
+			}
+		}
+		return false
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseActivity(context.Background(), &history.UnpauseActivityRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		UnpauseRequest: &workflow.UnpauseActivityRequest{
+			WorkflowExecution: &we,
+			ActivityID:        common.StringPtr(activityID),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(testDomainID, we)
+	ai, ok := executionBuilder.GetActivityInfo(activityScheduledEvent.GetEventId())
+	s.True(ok)
+	s.False(ai.Paused)
+}
+
+func (s *engineSuite) TestResetActivity_Success() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, ai := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	// activity is waiting for its next attempt after a few failures
+	ai.Attempt = 5
+	ai.ScheduledTime = time.Now().Add(time.Hour)
+	ai.LastFailureReason = "some random error"
+	ai.LastFailureDetails = []byte("failure details")
+	ai.Details = []byte("heartbeat details")
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.ResetActivity(context.Background(), &history.ResetActivityRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		ResetRequest: &workflow.ResetActivityRequest{
+			WorkflowExecution:     &we,
+			ActivityID:            common.StringPtr(activityID),
+			ResetHeartbeatDetails: common.BoolPtr(true),
+			Identity:              common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(testDomainID, we)
+	ai, ok := executionBuilder.GetActivityInfo(activityScheduledEvent.GetEventId())
+	s.True(ok)
+	s.Equal(int32(0), ai.Attempt)
+	s.Equal("", ai.LastFailureReason)
+	s.Nil(ai.LastFailureDetails)
+	s.Nil(ai.Details)
+	s.False(ai.ScheduledTime.After(time.Now()))
+}
+
+func (s *engineSuite) TestResetActivity_Started() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.ResetActivity(context.Background(), &history.ResetActivityRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		ResetRequest: &workflow.ResetActivityRequest{
+			WorkflowExecution: &we,
+			ActivityID:        common.StringPtr(activityID),
+		},
+	})
+	s.Equal(ErrActivityTaskRunning, err)
+}
+
+func (s *engineSuite) TestUpdateActivityTimeouts_Success() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UpdateActivityTimeouts(context.Background(), &history.UpdateActivityTimeoutsRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		UpdateRequest: &workflow.UpdateActivityTimeoutsRequest{
+			WorkflowExecution:          &we,
+			ActivityID:                 common.StringPtr(activityID),
+			StartToCloseTimeoutSeconds: common.Int32Ptr(50),
+			HeartbeatTimeoutSeconds:    common.Int32Ptr(20),
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(testDomainID, we)
+	ai, ok := executionBuilder.GetActivityInfo(activityScheduledEvent.GetEventId())
+	s.True(ok)
+	s.Equal(int32(10), ai.ScheduleToStartTimeout)
+	s.Equal(int32(100), ai.ScheduleToCloseTimeout)
+	s.Equal(int32(50), ai.StartToCloseTimeout)
+	s.Equal(int32(20), ai.HeartbeatTimeout)
+}
+
 func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NotScheduled() {
 
 	we := workflow.WorkflowExecution{
@@ -4377,6 +4992,16 @@ func (s *engineSuite) getBuilder(testDomainID string, we workflow.WorkflowExecut
 	return context.(*workflowExecutionContextImpl).msBuilder
 }
 
+func (s *engineSuite) getQueryRegistry(testDomainID string, we workflow.WorkflowExecution) queryRegistry {
+	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecutionForBackground(testDomainID, we)
+	if err != nil {
+		return nil
+	}
+	defer release(nil)
+
+	return context.getQueryRegistry()
+}
+
 func (s *engineSuite) getActivityScheduledEvent(msBuilder mutableState,
 	scheduleID int64) *workflow.HistoryEvent {
 	event, _ := msBuilder.GetActivityScheduledEvent(scheduleID)
@@ -4711,6 +5336,9 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		CancelRequested:                    sourceInfo.CancelRequested,
 		CancelRequestID:                    sourceInfo.CancelRequestID,
 		CronSchedule:                       sourceInfo.CronSchedule,
+		DelayStartSeconds:                  sourceInfo.DelayStartSeconds,
+		Priority:                           sourceInfo.Priority,
+		FairnessKey:                        sourceInfo.FairnessKey,
 		ClientLibraryVersion:               sourceInfo.ClientLibraryVersion,
 		ClientFeatureVersion:               sourceInfo.ClientFeatureVersion,
 		ClientImpl:                         sourceInfo.ClientImpl,
@@ -4794,6 +5422,9 @@ func copyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.Activit
 		LastFailureReason:        sourceInfo.LastFailureReason,
 		LastWorkerIdentity:       sourceInfo.LastWorkerIdentity,
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
+		Paused:                   sourceInfo.Paused,
+		Priority:                 sourceInfo.Priority,
+		FairnessKey:              sourceInfo.FairnessKey,
 		//// Not written to database - This is used only for deduping heartbeat timer creation
 		// LastHeartbeatTimeoutVisibility: sourceInfo.LastHeartbeatTimeoutVisibility,
 	}
@@ -134,7 +134,7 @@ func newHistoryReplicator(
 		historyEngine:     historyEngine,
 		historyCache:      historyCache,
 		domainCache:       domainCache,
-		historySerializer: persistence.NewPayloadSerializer(),
+		historySerializer: shard.GetService().GetPayloadSerializer(),
 		historyMgr:        historyMgr,
 		clusterMetadata:   shard.GetService().GetClusterMetadata(),
 		metricsClient:     shard.GetMetricsClient(),
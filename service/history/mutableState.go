@@ -100,6 +100,7 @@ type (
 		AddTimerFiredEvent(int64, string) (*workflow.HistoryEvent, error)
 		AddTimerStartedEvent(int64, *workflow.StartTimerDecisionAttributes) (*workflow.HistoryEvent, *persistence.TimerInfo, error)
 		AddUpsertWorkflowSearchAttributesEvent(int64, *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionUpdateAcceptedEvent(int64, *workflow.WorkflowUpdate, *workflow.WorkflowUpdateResult, string) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *h.RequestCancelWorkflowExecutionRequest) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *workflow.CancelWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*workflow.HistoryEvent, error)
@@ -109,6 +110,10 @@ type (
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
 		RetryActivity(ai *persistence.ActivityInfo, failureReason string, failureDetails []byte) (bool, error)
+		PauseActivity(ai *persistence.ActivityInfo) error
+		UnpauseActivity(ai *persistence.ActivityInfo) error
+		ResetActivity(ai *persistence.ActivityInfo, resetHeartbeatDetails bool) error
+		UpdateActivityTimeouts(ai *persistence.ActivityInfo, scheduleToStartTimeout int32, scheduleToCloseTimeout int32, startToCloseTimeout int32, heartbeatTimeout int32) error
 		CreateNewHistoryEvent(eventType workflow.EventType) *workflow.HistoryEvent
 		CreateNewHistoryEventWithTimestamp(eventType workflow.EventType, timestamp int64) *workflow.HistoryEvent
 		CreateTransientDecisionEvents(di *decisionInfo, identity string) (*workflow.HistoryEvent, *workflow.HistoryEvent)
@@ -157,7 +162,6 @@ type (
 		GetUserTimer(string) (*persistence.TimerInfo, bool)
 		GetWorkflowType() *workflow.WorkflowType
 		GetWorkflowStateCloseStatus() (int, int)
-		GetQueryRegistry() queryRegistry
 		HasBufferedEvents() bool
 		HasInFlightDecision() bool
 		HasParentExecution() bool
@@ -121,7 +121,6 @@ type (
 
 		taskGenerator       mutableStateTaskGenerator
 		decisionTaskManager mutableStateDecisionTaskManager
-		queryRegistry       queryRegistry
 
 		shard           ShardContext
 		clusterMetadata cluster.Metadata
@@ -173,8 +172,6 @@ func newMutableStateBuilder(
 		nextEventIDInDB:       0,
 		domainEntry:           domainEntry,
 
-		queryRegistry: newQueryRegistry(),
-
 		shard:           shard,
 		clusterMetadata: shard.GetClusterMetadata(),
 		eventsCache:     eventsCache,
@@ -883,7 +880,8 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		workflow.EventTypeMarkerRecorded,
 		workflow.EventTypeStartChildWorkflowExecutionInitiated,
 		workflow.EventTypeSignalExternalWorkflowExecutionInitiated,
-		workflow.EventTypeUpsertWorkflowSearchAttributes:
+		// update accepted events are generated from update results carried by the decision completion
+		workflow.EventTypeWorkflowExecutionUpdateAccepted:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -893,6 +891,10 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 			panic(msg)
 		}
 		return false
+	case // search attributes can also be upserted outside of a decision, e.g. by a batch operation,
+		// such an event is buffered like a signal while a decision is in flight
+		workflow.EventTypeUpsertWorkflowSearchAttributes:
+		return e.HasInFlightDecision()
 	default:
 		return true
 	}
@@ -905,10 +907,6 @@ func (e *mutableStateBuilder) GetWorkflowType() *workflow.WorkflowType {
 	return wType
 }
 
-func (e *mutableStateBuilder) GetQueryRegistry() queryRegistry {
-	return e.queryRegistry
-}
-
 func (e *mutableStateBuilder) GetActivityScheduledEvent(
 	scheduleEventID int64,
 ) (*workflow.HistoryEvent, error) {
@@ -1230,6 +1228,7 @@ func (e *mutableStateBuilder) ReplicateActivityInfo(
 	ai.LastFailureReason = request.GetLastFailureReason()
 	ai.LastWorkerIdentity = request.GetLastWorkerIdentity()
 	ai.LastFailureDetails = request.GetLastFailureDetails()
+	ai.Paused = request.GetPaused()
 
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = TimerTaskStatusNone
@@ -1526,6 +1525,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		CronSchedule:                        attributes.CronSchedule,
 		Memo:                                attributes.Memo,
 		SearchAttributes:                    attributes.SearchAttributes,
+		Priority:                            common.Int32Ptr(previousExecutionInfo.Priority),
+		FairnessKey:                         common.StringPtr(previousExecutionInfo.FairnessKey),
 	}
 
 	req := &h.StartWorkflowExecutionRequest{
@@ -1681,6 +1682,9 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	e.executionInfo.DecisionTimeout = 0
 
 	e.executionInfo.CronSchedule = event.GetCronSchedule()
+	e.executionInfo.DelayStartSeconds = event.GetDelayStartSeconds()
+	e.executionInfo.Priority = event.GetPriority()
+	e.executionInfo.FairnessKey = event.GetFairnessKey()
 
 	if parentDomainID != nil {
 		e.executionInfo.ParentDomainID = *parentDomainID
@@ -2024,6 +2028,8 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		TimerTaskStatus:          TimerTaskStatusNone,
 		TaskList:                 attributes.TaskList.GetName(),
 		HasRetryPolicy:           attributes.RetryPolicy != nil,
+		Priority:                 attributes.GetPriority(),
+		FairnessKey:              attributes.GetFairnessKey(),
 	}
 	ai.ExpirationTime = ai.ScheduledTime.Add(time.Duration(scheduleToCloseTimeout) * time.Second)
 	if ai.HasRetryPolicy {
@@ -2770,6 +2776,22 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	e.executionInfo.SearchAttributes = mergeMapOfByteArray(currentSearchAttr, upsertSearchAttr)
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	decisionCompletedEventID int64,
+	update *workflow.WorkflowUpdate,
+	result *workflow.WorkflowUpdateResult,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateAccepted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	// no mutable state change, the event only records the accepted update for replay
+	return e.hBuilder.AddWorkflowExecutionUpdateAcceptedEvent(decisionCompletedEventID, update, result, identity), nil
+}
+
 func mergeMapOfByteArray(
 	current map[string][]byte,
 	upsert map[string][]byte,
@@ -3676,6 +3698,142 @@ func (e *mutableStateBuilder) RetryActivity(
 	return true, nil
 }
 
+// PauseActivity stops dispatching further attempts of the activity until it is unpaused
+func (e *mutableStateBuilder) PauseActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskPause
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = true
+
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// UnpauseActivity resumes dispatching of a paused activity
+func (e *mutableStateBuilder) UnpauseActivity(
+	ai *persistence.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskUnpause
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Paused = false
+
+	// any attempt dispatched while the activity was paused has been dropped,
+	// so an attempt which is already due needs to be dispatched again
+	if err := e.redispatchActivityIfDue(ai); err != nil {
+		return err
+	}
+
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// ResetActivity resets the attempt counter and retry state of the activity
+func (e *mutableStateBuilder) ResetActivity(
+	ai *persistence.ActivityInfo,
+	resetHeartbeatDetails bool,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskReset
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	now := e.timeSource.Now()
+
+	ai.Version = e.GetCurrentVersion()
+	ai.Attempt = 0
+	ai.LastFailureReason = ""
+	ai.LastWorkerIdentity = ""
+	ai.LastFailureDetails = nil
+	if resetHeartbeatDetails {
+		ai.Details = nil
+	}
+
+	if ai.HasRetryPolicy {
+		scheduledEvent, err := e.GetActivityScheduledEvent(ai.ScheduleID)
+		if err != nil {
+			return err
+		}
+		attributes := scheduledEvent.ActivityTaskScheduledEventAttributes
+		expirationInterval := attributes.GetScheduleToCloseTimeoutSeconds()
+		if attributes.RetryPolicy.GetExpirationIntervalInSeconds() > expirationInterval {
+			expirationInterval = attributes.RetryPolicy.GetExpirationIntervalInSeconds()
+		}
+		ai.ExpirationTime = now.Add(time.Duration(expirationInterval) * time.Second)
+	}
+
+	// activity waiting for the next retry is dispatched right away
+	if ai.StartedID == common.EmptyEventID && ai.ScheduledTime.After(now) {
+		ai.ScheduledTime = now
+		ai.TimerTaskStatus = TimerTaskStatusNone
+	}
+	if err := e.redispatchActivityIfDue(ai); err != nil {
+		return err
+	}
+
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// UpdateActivityTimeouts updates the timeouts of the activity, zero value leaves the timeout unchanged
+func (e *mutableStateBuilder) UpdateActivityTimeouts(
+	ai *persistence.ActivityInfo,
+	scheduleToStartTimeout int32,
+	scheduleToCloseTimeout int32,
+	startToCloseTimeout int32,
+	heartbeatTimeout int32,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskUpdateTimeouts
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	if scheduleToStartTimeout > 0 {
+		ai.ScheduleToStartTimeout = scheduleToStartTimeout
+	}
+	if scheduleToCloseTimeout > 0 {
+		ai.ScheduleToCloseTimeout = scheduleToCloseTimeout
+	}
+	if startToCloseTimeout > 0 {
+		ai.StartToCloseTimeout = startToCloseTimeout
+	}
+	if heartbeatTimeout > 0 {
+		ai.HeartbeatTimeout = heartbeatTimeout
+	}
+	// timer tasks are regenerated based on the new timeouts when the transaction is closed
+	ai.TimerTaskStatus = TimerTaskStatusNone
+
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+func (e *mutableStateBuilder) redispatchActivityIfDue(
+	ai *persistence.ActivityInfo,
+) error {
+
+	if ai.Paused || ai.StartedID != common.EmptyEventID || ai.ScheduledTime.After(e.timeSource.Now()) {
+		return nil
+	}
+	return e.taskGenerator.generateActivityRetryTasks(ai.ScheduleID)
+}
+
 // TODO mutable state should generate corresponding transfer / timer tasks according to
 //  updates accumulated, while currently all transfer / timer tasks are managed manually
 
@@ -295,6 +295,11 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		workflow.EventTypeUpsertWorkflowSearchAttributes:                  true,
 	}
 
+	// events corresponding to update results returned with the decision task completion
+	updateResultEvents := map[workflow.EventType]bool{
+		workflow.EventTypeWorkflowExecutionUpdateAccepted: true,
+	}
+
 	// other events will not be assign event ID immediately
 	otherEvents := map[workflow.EventType]bool{}
 OtherEventsLoop:
@@ -308,6 +313,9 @@ OtherEventsLoop:
 		if _, ok := decisionEvents[eventType]; ok {
 			continue OtherEventsLoop
 		}
+		if _, ok := updateResultEvents[eventType]; ok {
+			continue OtherEventsLoop
+		}
 		otherEvents[eventType] = true
 	}
 
@@ -321,6 +329,9 @@ OtherEventsLoop:
 	for eventType := range decisionEvents {
 		s.False(s.msBuilder.shouldBufferEvent(eventType))
 	}
+	for eventType := range updateResultEvents {
+		s.False(s.msBuilder.shouldBufferEvent(eventType))
+	}
 	// other events will return false
 	for eventType := range otherEvents {
 		s.True(s.msBuilder.shouldBufferEvent(eventType))
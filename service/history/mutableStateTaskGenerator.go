@@ -192,6 +192,9 @@ func (r *mutableStateTaskGeneratorImpl) generateDelayedDecisionTasks(
 
 	// noParentWorkflow case
 	firstDecisionDelayType := persistence.WorkflowBackoffTimeoutTypeCron
+	if startAttr.GetDelayStartSeconds() > 0 {
+		firstDecisionDelayType = persistence.WorkflowBackoffTimeoutTypeDelayStart
+	}
 	// continue as new case
 	if startAttr.Initiator != nil {
 		switch startAttr.GetInitiator() {
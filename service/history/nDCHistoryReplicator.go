@@ -101,7 +101,7 @@ func newNDCHistoryReplicator(
 		shard:             shard,
 		clusterMetadata:   shard.GetService().GetClusterMetadata(),
 		historyV2Mgr:      shard.GetHistoryV2Manager(),
-		historySerializer: persistence.NewPayloadSerializer(),
+		historySerializer: shard.GetService().GetPayloadSerializer(),
 		metricsClient:     shard.GetMetricsClient(),
 		domainCache:       shard.GetDomainCache(),
 		historyCache:      historyCache,
@@ -162,7 +162,7 @@ func (r *queryRegistryImpl) recordEvent(id string, event queryEvent, queryResult
 		r.buffered[id] = qsm
 	case queryStateStarted:
 		r.started[id] = qsm
-	case queryStateCompleted:
+	case queryStateCompleted, queryStateUnblocked:
 		r.completed[id] = qsm
 	default:
 		panic("unknown query state")
@@ -40,6 +40,7 @@ const (
 	queryStateBuffered queryState = iota
 	queryStateStarted
 	queryStateCompleted
+	queryStateUnblocked
 )
 
 const (
@@ -47,6 +48,7 @@ const (
 	queryEventStart
 	queryEventRecordResult
 	queryEventPersistenceConditionSatisfied
+	queryEventUnblock
 )
 
 type (
@@ -112,7 +114,7 @@ func (q *queryStateMachineImpl) recordEvent(event queryEvent, queryResult *share
 	q.Lock()
 	defer q.Unlock()
 
-	if q.state == queryStateCompleted {
+	if q.state == queryStateCompleted || q.state == queryStateUnblocked {
 		return false, errAlreadyCompleted
 	}
 
@@ -151,6 +153,15 @@ func (q *queryStateMachineImpl) recordEvent(event queryEvent, queryResult *share
 	case queryEventPersistenceConditionSatisfied:
 		q.persistenceConditionSatisfied = true
 		return q.handleComplete(), nil
+	case queryEventUnblock:
+		// a buffered query can be unblocked once no decision task is outstanding,
+		// at which point it is safe to dispatch it directly to the worker
+		if q.state != queryStateBuffered {
+			return false, errInvalidEvent
+		}
+		q.state = queryStateUnblocked
+		close(q.termCh)
+		return true, nil
 	default:
 		panic("invalid event")
 	}
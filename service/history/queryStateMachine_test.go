@@ -191,6 +191,68 @@ func (s *QuerySuite) TestCompletedTerminalState() {
 	}
 }
 
+func (s *QuerySuite) TestUnblockedTerminalState() {
+	testCases := []struct {
+		event queryEvent
+
+		expectChanged      bool
+		expectErr          error
+		expectState        queryState
+		expectTermChClosed bool
+	}{
+		{
+			event: queryEventStart,
+
+			expectChanged:      true,
+			expectErr:          nil,
+			expectState:        queryStateStarted,
+			expectTermChClosed: false,
+		},
+		{
+			event: queryEventUnblock,
+
+			expectChanged:      false,
+			expectErr:          errInvalidEvent,
+			expectState:        queryStateStarted,
+			expectTermChClosed: false,
+		},
+		{
+			event: queryEventRebuffer,
+
+			expectChanged:      true,
+			expectErr:          nil,
+			expectState:        queryStateBuffered,
+			expectTermChClosed: false,
+		},
+		{
+			event: queryEventUnblock,
+
+			expectChanged:      true,
+			expectErr:          nil,
+			expectState:        queryStateUnblocked,
+			expectTermChClosed: true,
+		},
+		{
+			event: queryEventStart,
+
+			expectChanged:      false,
+			expectErr:          errAlreadyCompleted,
+			expectState:        queryStateUnblocked,
+			expectTermChClosed: true,
+		},
+	}
+
+	qsm := newQueryStateMachine(&shared.WorkflowQuery{})
+	for _, tc := range testCases {
+		changed, err := qsm.recordEvent(tc.event, nil)
+		s.Equal(tc.expectChanged, changed)
+		s.Equal(tc.expectErr, err)
+		s.Equal(tc.expectState, qsm.getQuerySnapshot().state)
+		s.Equal(tc.expectTermChClosed, s.chanClosed(qsm.getQueryTermCh()))
+		s.Nil(qsm.getQuerySnapshot().queryResult)
+	}
+}
+
 func (s *QuerySuite) chanClosed(ch <-chan struct{}) bool {
 	select {
 	case <-ch:
@@ -311,6 +311,7 @@ func (p *ReplicationTaskProcessor) handleActivityTask(task *r.ReplicationTask) e
 		Attempt:            attr.Attempt,
 		LastFailureReason:  attr.LastFailureReason,
 		LastWorkerIdentity: attr.LastWorkerIdentity,
+		Paused:             attr.Paused,
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
 	defer cancel()
@@ -637,6 +637,7 @@ func (p *replicatorQueueProcessorImpl) generateSyncActivityTask(
 					LastFailureReason:  common.StringPtr(activityInfo.LastFailureReason),
 					LastWorkerIdentity: common.StringPtr(activityInfo.LastWorkerIdentity),
 					LastFailureDetails: activityInfo.LastFailureDetails,
+					Paused:             common.BoolPtr(activityInfo.Paused),
 				},
 			}, nil
 		},
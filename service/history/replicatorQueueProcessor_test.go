@@ -348,6 +348,7 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRetry() {
 			LastFailureReason:  common.StringPtr(activityLastFailureReason),
 			LastWorkerIdentity: common.StringPtr(activityLastWorkerIdentity),
 			LastFailureDetails: activityLastFailureDetails,
+			Paused:             common.BoolPtr(false),
 		},
 	}).Return(nil).Once()
 
@@ -440,6 +441,7 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 			LastFailureReason:  common.StringPtr(activityLastFailureReason),
 			LastWorkerIdentity: common.StringPtr(activityLastWorkerIdentity),
 			LastFailureDetails: activityLastFailureDetails,
+			Paused:             common.BoolPtr(false),
 		},
 	}).Return(nil).Once()
 
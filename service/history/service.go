@@ -174,6 +174,10 @@ type Config struct {
 	DecisionHeartbeatTimeout dynamicconfig.DurationPropertyFnWithDomainFilter
 	// MaxDecisionStartToCloseSeconds is the StartToCloseSeconds for decision
 	MaxDecisionStartToCloseSeconds dynamicconfig.IntPropertyFnWithDomainFilter
+
+	// Query settings
+	// MaxBufferedQueryCount is the maximum number of strongly consistent queries buffered for a workflow
+	MaxBufferedQueryCount dynamicconfig.IntPropertyFn
 }
 
 const (
@@ -275,6 +279,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		SearchAttributesTotalSizeLimit:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
 		StickyTTL:                         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.StickyTTL, time.Hour*24*365),
 		DecisionHeartbeatTimeout:          dc.GetDurationPropertyFilteredByDomain(dynamicconfig.DecisionHeartbeatTimeout, time.Minute*30),
+		MaxBufferedQueryCount:             dc.GetIntProperty(dynamicconfig.MaxBufferedQueryCount, 1),
 	}
 
 	return cfg
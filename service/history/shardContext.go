@@ -789,6 +789,7 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 	request.Encoding = s.getDefaultEncoding(domainEntry)
 	request.ShardID = common.IntPtr(s.shardID)
 	request.TransactionID = transactionID
+	request.DomainID = domainID
 
 	size := 0
 	defer func() {
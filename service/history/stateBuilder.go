@@ -475,6 +475,9 @@ func (b *stateBuilderImpl) applyEvents(
 		case shared.EventTypeMarkerRecorded:
 			// No mutable state action is needed
 
+		case shared.EventTypeWorkflowExecutionUpdateAccepted:
+			// No mutable state action is needed
+
 		case shared.EventTypeWorkflowExecutionSignaled:
 			if err := b.msBuilder.ReplicateWorkflowExecutionSignaled(event); err != nil {
 				return nil, nil, nil, err
@@ -752,6 +755,8 @@ func (b *stateBuilderImpl) scheduleWorkflowTimerTask(
 		timeoutType := persistence.WorkflowBackoffTimeoutTypeRetry
 		if startWorkflowAttribute.GetInitiator().Equals(shared.ContinueAsNewInitiatorCronSchedule) {
 			timeoutType = persistence.WorkflowBackoffTimeoutTypeCron
+		} else if startWorkflowAttribute.GetDelayStartSeconds() > 0 {
+			timeoutType = persistence.WorkflowBackoffTimeoutTypeDelayStart
 		}
 		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
 			VisibilityTimestamp: now.Add(firstDecisionTaskBackoffDuration),
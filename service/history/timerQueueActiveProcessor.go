@@ -585,9 +585,12 @@ func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(
 	}
 	defer func() { release(retError) }()
 
-	if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeRetry {
+	switch task.TimeoutType {
+	case persistence.WorkflowBackoffTimeoutTypeRetry:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
-	} else {
+	case persistence.WorkflowBackoffTimeoutTypeDelayStart:
+		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowDelayStartBackoffTimerCount)
+	default:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowCronBackoffTimerCount)
 	}
 
@@ -643,6 +646,10 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(
 		}
 		return nil
 	}
+	if ai.Paused {
+		// paused activity is dispatched again once it is unpaused
+		return nil
+	}
 	ok, err = verifyTaskVersion(t.shard, t.logger, task.DomainID, ai.Version, task.Version, task)
 	if err != nil {
 		return err
@@ -681,6 +688,8 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(
 		TaskList:                      taskList,
 		ScheduleId:                    &scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+		Priority:                      common.Int32Ptr(ai.Priority),
+		FairnessKey:                   common.StringPtr(ai.FairnessKey),
 	})
 }
 
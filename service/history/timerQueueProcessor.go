@@ -88,7 +88,7 @@ func newTimerQueueProcessor(
 				func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
 					return historyService.ReplicateRawEvents(ctx, request)
 				},
-				persistence.NewPayloadSerializer(),
+				shard.GetService().GetPayloadSerializer(),
 				historyRereplicationTimeout,
 				logger,
 			)
@@ -504,6 +504,10 @@ func (t *timerQueueProcessorBase) archiveWorkflow(
 			return err
 		}
 		req.ArchiveRequest.URI = domainCacheEntry.GetConfig().HistoryArchivalURI
+		req.ArchiveRequest.MutableStateSummary, err = describeMutableState(msBuilder)
+		if err != nil {
+			return err
+		}
 		req.ArchiveRequest.Targets = append(req.ArchiveRequest.Targets, archiver.ArchiveTargetHistory)
 	}
 
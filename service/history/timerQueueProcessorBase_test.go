@@ -178,6 +178,7 @@ func (s *timerQueueProcessorBaseSuite) TestArchiveHistory_NoErr_InlineArchivalFa
 	mockMutableState.On("GetEventStoreVersion").Return(int32(persistence.EventStoreVersionV2)).Once()
 	mockMutableState.On("GetCurrentBranchToken").Return([]byte{1, 2, 3}, nil).Once()
 	mockMutableState.On("GetLastWriteVersion").Return(int64(1234), nil).Once()
+	s.expectDescribeMutableState(mockMutableState)
 	mockMutableState.On("GetNextEventID").Return(int64(101)).Once()
 
 	s.mockExecutionManager.On("DeleteCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
@@ -185,7 +186,8 @@ func (s *timerQueueProcessorBaseSuite) TestArchiveHistory_NoErr_InlineArchivalFa
 	s.mockVisibilityManager.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
 
 	s.mockArchivalClient.On("Archive", mock.Anything, mock.MatchedBy(func(req *archiver.ClientRequest) bool {
-		return req.CallerService == common.HistoryServiceName && req.AttemptArchiveInline && req.ArchiveRequest.Targets[0] == archiver.ArchiveTargetHistory
+		return req.CallerService == common.HistoryServiceName && req.AttemptArchiveInline && req.ArchiveRequest.Targets[0] == archiver.ArchiveTargetHistory &&
+			req.ArchiveRequest.MutableStateSummary.WorkflowExecutionInfo.GetCloseStatus() == workflow.WorkflowExecutionCloseStatusFailed
 	})).Return(&archiver.ClientResponse{
 		HistoryArchivedInline: false,
 	}, nil)
@@ -205,6 +207,7 @@ func (s *timerQueueProcessorBaseSuite) TestArchiveHistory_SendSignalErr() {
 	mockMutableState.On("GetEventStoreVersion").Return(int32(persistence.EventStoreVersionV2)).Once()
 	mockMutableState.On("GetCurrentBranchToken").Return([]byte{1, 2, 3}, nil).Once()
 	mockMutableState.On("GetLastWriteVersion").Return(int64(1234), nil).Once()
+	s.expectDescribeMutableState(mockMutableState)
 	mockMutableState.On("GetNextEventID").Return(int64(101)).Once()
 
 	s.mockArchivalClient.On("Archive", mock.Anything, mock.MatchedBy(func(req *archiver.ClientRequest) bool {
@@ -262,6 +265,7 @@ func (s *timerQueueProcessorBaseSuite) TestArchiveBoth_SendSignalErr() {
 	mockMutableState.On("GetEventStoreVersion").Return(int32(persistence.EventStoreVersionV2)).Once()
 	mockMutableState.On("GetCurrentBranchToken").Return([]byte{1, 2, 3}, nil).Once()
 	mockMutableState.On("GetLastWriteVersion").Return(int64(1234), nil).Once()
+	s.expectDescribeMutableState(mockMutableState)
 	mockMutableState.On("GetNextEventID").Return(int64(101)).Twice()
 	mockMutableState.On("GetStartEvent").Return(&workflow.HistoryEvent{
 		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
@@ -283,3 +287,21 @@ func (s *timerQueueProcessorBaseSuite) TestArchiveBoth_SendSignalErr() {
 	err := s.timerQueueProcessor.archiveWorkflow(&persistence.TimerTaskInfo{}, mockWorkflowExecutionContext, mockMutableState, domainCacheEntry, true, true)
 	s.Error(err)
 }
+
+func (s *timerQueueProcessorBaseSuite) expectDescribeMutableState(mockMutableState *mockMutableState) {
+	mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{
+		WorkflowTypeName: "some random workflow type name",
+		StartTimestamp:   time.Now().Add(-time.Hour),
+		State:            persistence.WorkflowStateCompleted,
+		CloseStatus:      persistence.WorkflowCloseStatusFailed,
+	}).Once()
+	mockMutableState.On("GetNextEventID").Return(int64(101)).Once()
+	mockMutableState.On("GetStartEvent").Return(&workflow.HistoryEvent{
+		WorkflowExecutionStartedEventAttributes: &workflow.WorkflowExecutionStartedEventAttributes{},
+	}, nil).Once()
+	mockMutableState.On("GetCompletionEvent").Return(&workflow.HistoryEvent{
+		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+	}, nil).Once()
+	mockMutableState.On("GetPendingActivityInfos").Return(map[int64]*persistence.ActivityInfo{})
+	mockMutableState.On("GetPendingChildExecutionInfos").Return(map[int64]*persistence.ChildExecutionInfo{})
+}
@@ -345,7 +345,7 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, timeout)
+	return t.pushActivity(task, timeout, ai.Priority, ai.FairnessKey)
 }
 
 func (t *transferQueueActiveProcessorImpl) processDecisionTask(
@@ -407,7 +407,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushDecision(task, tasklist, decisionTimeout)
+	return t.pushDecision(task, tasklist, decisionTimeout, executionInfo.Priority, executionInfo.FairnessKey)
 }
 
 func (t *transferQueueActiveProcessorImpl) processCloseExecution(
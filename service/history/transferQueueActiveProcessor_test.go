@@ -258,6 +258,8 @@ func (s *transferQueueActiveProcessorSuite) TestProcessActivityTask_Success() {
 	activityID := "activity-1"
 	activityType := "some random activity type"
 	event, ai := addActivityTaskScheduledEvent(msBuilder, event.GetEventId(), activityID, activityType, taskListName, []byte{}, 1, 1, 1)
+	ai.Priority = 3
+	ai.FairnessKey = "some random fairness key"
 	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(s.mockClusterMetadata.GetCurrentClusterName())
 	msBuilder.UpdateReplicationStateLastEventID(s.version, event.GetEventId())
 
@@ -359,6 +361,8 @@ func (s *transferQueueActiveProcessorSuite) TestProcessDecisionTask_FirstDecisio
 				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
 				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
 				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+				Priority:                            common.Int32Ptr(5),
+				FairnessKey:                         common.StringPtr("some random fairness key"),
 			},
 		},
 	)
@@ -1792,6 +1796,8 @@ func (s *transferQueueActiveProcessorSuite) createAddActivityTaskRequest(task *p
 		TaskList:                      taskList,
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(ai.ScheduleToStartTimeout),
+		Priority:                      common.Int32Ptr(ai.Priority),
+		FairnessKey:                   common.StringPtr(ai.FairnessKey),
 	}
 }
 
@@ -1815,6 +1821,8 @@ func (s *transferQueueActiveProcessorSuite) createAddDecisionTaskRequest(task *p
 		TaskList:                      taskList,
 		ScheduleId:                    common.Int64Ptr(task.ScheduleID),
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(timeout),
+		Priority:                      common.Int32Ptr(executionInfo.Priority),
+		FairnessKey:                   common.StringPtr(executionInfo.FairnessKey),
 	}
 }
 
@@ -91,7 +91,7 @@ func newTransferQueueProcessor(
 				func(ctx context.Context, request *h.ReplicateRawEventsRequest) error {
 					return historyService.ReplicateRawEvents(ctx, request)
 				},
-				persistence.NewPayloadSerializer(),
+				shard.GetService().GetPayloadSerializer(),
 				historyRereplicationTimeout,
 				logger,
 			)
@@ -117,6 +117,8 @@ func (t *transferQueueProcessorBase) queueShutdown() error {
 func (t *transferQueueProcessorBase) pushActivity(
 	task *persistence.TransferTaskInfo,
 	activityScheduleToStartTimeout int32,
+	priority int32,
+	fairnessKey string,
 ) error {
 
 	if task.TaskType != persistence.TransferTaskTypeActivityTask {
@@ -133,6 +135,8 @@ func (t *transferQueueProcessorBase) pushActivity(
 		TaskList:                      &workflow.TaskList{Name: &task.TaskList},
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
+		Priority:                      common.Int32Ptr(priority),
+		FairnessKey:                   common.StringPtr(fairnessKey),
 	})
 
 	return err
@@ -142,6 +146,8 @@ func (t *transferQueueProcessorBase) pushDecision(
 	task *persistence.TransferTaskInfo,
 	tasklist *workflow.TaskList,
 	decisionScheduleToStartTimeout int32,
+	priority int32,
+	fairnessKey string,
 ) error {
 
 	if task.TaskType != persistence.TransferTaskTypeDecisionTask {
@@ -157,6 +163,8 @@ func (t *transferQueueProcessorBase) pushDecision(
 		TaskList:                      tasklist,
 		ScheduleId:                    common.Int64Ptr(task.ScheduleID),
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
+		Priority:                      common.Int32Ptr(priority),
+		FairnessKey:                   common.StringPtr(fairnessKey),
 	})
 	return err
 }
@@ -208,6 +208,8 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 ) error {
 
 	var activityScheduleToStartTimeout *int32
+	var priority int32
+	var fairnessKey string
 	processTaskIfClosed := false
 	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		activityInfo, ok := msBuilder.GetActivityInfo(transferTask.ScheduleID)
@@ -229,6 +231,8 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 				return ErrTaskRetry
 			}
 
+			priority = activityInfo.Priority
+			fairnessKey = activityInfo.FairnessKey
 			activityScheduleToStartTimeout = common.Int32Ptr(common.MinInt32(activityInfo.ScheduleToStartTimeout, common.MaxTaskTimeout))
 			return nil
 		}
@@ -240,7 +244,7 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 		}
 
 		timeout := common.MinInt32(*activityScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushActivity(transferTask, timeout)
+		err := t.pushActivity(transferTask, timeout, priority, fairnessKey)
 		return err
 	})
 }
@@ -251,6 +255,8 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(
 
 	var decisionScheduleToStartTimeout *int32
 	var tasklist *workflow.TaskList
+	var priority int32
+	var fairnessKey string
 	processTaskIfClosed := false
 
 	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
@@ -277,6 +283,8 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(
 				return ErrTaskRetry
 			}
 
+			priority = executionInfo.Priority
+			fairnessKey = executionInfo.FairnessKey
 			decisionScheduleToStartTimeout = common.Int32Ptr(decisionTimeout)
 			tasklist = &workflow.TaskList{Name: &transferTask.TaskList}
 			return nil
@@ -289,7 +297,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(
 		}
 
 		timeout := common.MinInt32(*decisionScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushDecision(transferTask, tasklist, timeout)
+		err := t.pushDecision(transferTask, tasklist, timeout, priority, fairnessKey)
 		return err
 	})
 }
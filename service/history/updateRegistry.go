@@ -0,0 +1,245 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+var (
+	errUpdateNotFound         = errors.New("update does not exist")
+	errUpdateAlreadyCompleted = errors.New("update has already been completed")
+)
+
+type (
+	// updateRegistry keeps track of workflow updates which are waiting to be handled by a decision task.
+	// An update is buffered until a decision task is started, at which point it is delivered to the worker
+	// and moves to started. Started updates are redelivered on every decision task until the worker returns
+	// a result for them, which completes the update and unblocks the caller.
+	updateRegistry interface {
+		hasBuffered() bool
+		hasStarted() bool
+
+		bufferUpdate(*shared.WorkflowUpdate) (string, <-chan struct{})
+		startUpdates() []*shared.WorkflowUpdate
+		getUpdate(string) (*shared.WorkflowUpdate, error)
+		getUpdateResult(string) (*shared.WorkflowUpdateResult, error)
+		completeUpdate(string, *shared.WorkflowUpdateResult) error
+		rejectStartedUpdates(string)
+		rejectPendingUpdates(string)
+		abortPendingUpdates(error)
+		removeUpdate(string)
+	}
+
+	updateRegistryImpl struct {
+		sync.RWMutex
+
+		buffered  map[string]*updateEntry
+		started   map[string]*updateEntry
+		completed map[string]*updateEntry
+	}
+
+	updateEntry struct {
+		update *shared.WorkflowUpdate
+		result *shared.WorkflowUpdateResult
+		err    error
+		termCh chan struct{}
+	}
+)
+
+func newUpdateRegistry() updateRegistry {
+	return &updateRegistryImpl{
+		buffered:  make(map[string]*updateEntry),
+		started:   make(map[string]*updateEntry),
+		completed: make(map[string]*updateEntry),
+	}
+}
+
+func (r *updateRegistryImpl) hasBuffered() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return len(r.buffered) > 0
+}
+
+func (r *updateRegistryImpl) hasStarted() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return len(r.started) > 0
+}
+
+func (r *updateRegistryImpl) bufferUpdate(update *shared.WorkflowUpdate) (string, <-chan struct{}) {
+	r.Lock()
+	defer r.Unlock()
+
+	id := uuid.New()
+	entry := &updateEntry{
+		update: &shared.WorkflowUpdate{
+			UpdateId:   &id,
+			UpdateName: update.UpdateName,
+			Input:      update.Input,
+		},
+		termCh: make(chan struct{}),
+	}
+	r.buffered[id] = entry
+	return id, entry.termCh
+}
+
+// startUpdates moves all buffered updates to started and returns every started update,
+// including the ones delivered to earlier decision tasks which did not complete.
+func (r *updateRegistryImpl) startUpdates() []*shared.WorkflowUpdate {
+	r.Lock()
+	defer r.Unlock()
+
+	for id, entry := range r.buffered {
+		r.started[id] = entry
+		delete(r.buffered, id)
+	}
+	if len(r.started) == 0 {
+		return nil
+	}
+	result := make([]*shared.WorkflowUpdate, 0, len(r.started))
+	for _, entry := range r.started {
+		result = append(result, entry.update)
+	}
+	return result
+}
+
+func (r *updateRegistryImpl) getUpdate(id string) (*shared.WorkflowUpdate, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	if _, ok := r.completed[id]; ok {
+		return nil, errUpdateAlreadyCompleted
+	}
+	if entry, ok := r.started[id]; ok {
+		return entry.update, nil
+	}
+	if entry, ok := r.buffered[id]; ok {
+		return entry.update, nil
+	}
+	return nil, errUpdateNotFound
+}
+
+func (r *updateRegistryImpl) getUpdateResult(id string) (*shared.WorkflowUpdateResult, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	entry, ok := r.completed[id]
+	if !ok {
+		return nil, errUpdateNotFound
+	}
+	if entry.err != nil {
+		return nil, entry.err
+	}
+	return entry.result, nil
+}
+
+func (r *updateRegistryImpl) completeUpdate(id string, result *shared.WorkflowUpdateResult) error {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.completeUpdateLocked(id, result)
+}
+
+// rejectStartedUpdates completes all started updates with a rejection,
+// used when a decision task completes without returning a result for them.
+func (r *updateRegistryImpl) rejectStartedUpdates(reason string) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.rejectUpdatesLocked(r.started, reason)
+}
+
+// rejectPendingUpdates completes all buffered and started updates with a rejection,
+// used when the workflow execution is closed and the updates can no longer be handled.
+func (r *updateRegistryImpl) rejectPendingUpdates(reason string) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.rejectUpdatesLocked(r.buffered, reason)
+	r.rejectUpdatesLocked(r.started, reason)
+}
+
+// abortPendingUpdates completes all buffered and started updates with the given error,
+// used when the registry is dropped together with the cached workflow execution and
+// the updates would otherwise never be handled.
+func (r *updateRegistryImpl) abortPendingUpdates(err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, updates := range []map[string]*updateEntry{r.buffered, r.started} {
+		for id, entry := range updates {
+			delete(updates, id)
+			entry.err = err
+			r.completed[id] = entry
+			close(entry.termCh)
+		}
+	}
+}
+
+func (r *updateRegistryImpl) removeUpdate(id string) {
+	r.Lock()
+	defer r.Unlock()
+
+	delete(r.buffered, id)
+	delete(r.started, id)
+	delete(r.completed, id)
+}
+
+func (r *updateRegistryImpl) rejectUpdatesLocked(updates map[string]*updateEntry, reason string) {
+	for id := range updates {
+		// the update is known to be pending, completion cannot fail
+		_ = r.completeUpdateLocked(id, &shared.WorkflowUpdateResult{
+			UpdateId:        common.StringPtr(id),
+			ResultType:      shared.WorkflowUpdateResultTypeRejected.Ptr(),
+			RejectionReason: common.StringPtr(reason),
+		})
+	}
+}
+
+func (r *updateRegistryImpl) completeUpdateLocked(id string, result *shared.WorkflowUpdateResult) error {
+	if _, ok := r.completed[id]; ok {
+		return errUpdateAlreadyCompleted
+	}
+	entry, ok := r.started[id]
+	if !ok {
+		entry, ok = r.buffered[id]
+	}
+	if !ok {
+		return errUpdateNotFound
+	}
+
+	delete(r.buffered, id)
+	delete(r.started, id)
+	entry.result = result
+	r.completed[id] = entry
+	close(entry.termCh)
+	return nil
+}
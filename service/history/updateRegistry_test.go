@@ -0,0 +1,166 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type UpdateRegistrySuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestUpdateRegistrySuite(t *testing.T) {
+	suite.Run(t, new(UpdateRegistrySuite))
+}
+
+func (s *UpdateRegistrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UpdateRegistrySuite) TestUpdateRegistry() {
+	ur := newUpdateRegistry()
+	s.False(ur.hasBuffered())
+	s.False(ur.hasStarted())
+	s.Nil(ur.startUpdates())
+
+	var ids []string
+	var termChs []<-chan struct{}
+	for i := 0; i < 3; i++ {
+		id, termCh := ur.bufferUpdate(&shared.WorkflowUpdate{UpdateName: common.StringPtr("update")})
+		ids = append(ids, id)
+		termChs = append(termChs, termCh)
+	}
+	s.True(ur.hasBuffered())
+	s.False(ur.hasStarted())
+
+	update, err := ur.getUpdate(ids[0])
+	s.NoError(err)
+	s.Equal(ids[0], update.GetUpdateId())
+	s.Equal("update", update.GetUpdateName())
+	_, err = ur.getUpdate("not_exists")
+	s.Equal(errUpdateNotFound, err)
+
+	started := ur.startUpdates()
+	s.Len(started, 3)
+	s.False(ur.hasBuffered())
+	s.True(ur.hasStarted())
+
+	result := &shared.WorkflowUpdateResult{
+		UpdateId:   common.StringPtr(ids[0]),
+		ResultType: shared.WorkflowUpdateResultTypeAccepted.Ptr(),
+		Result:     []byte("result"),
+	}
+	s.NoError(ur.completeUpdate(ids[0], result))
+	s.assertClosed(termChs[0])
+	s.assertNotClosed(termChs[1])
+	s.Equal(errUpdateAlreadyCompleted, ur.completeUpdate(ids[0], result))
+	s.Equal(errUpdateNotFound, ur.completeUpdate("not_exists", result))
+	_, err = ur.getUpdate(ids[0])
+	s.Equal(errUpdateAlreadyCompleted, err)
+	actualResult, err := ur.getUpdateResult(ids[0])
+	s.NoError(err)
+	s.Equal(result, actualResult)
+
+	// started updates which did not complete are redelivered together with newly buffered ones
+	id, termCh := ur.bufferUpdate(&shared.WorkflowUpdate{UpdateName: common.StringPtr("update")})
+	ids = append(ids, id)
+	termChs = append(termChs, termCh)
+	s.Len(ur.startUpdates(), 3)
+
+	ur.rejectStartedUpdates("not handled")
+	s.False(ur.hasStarted())
+	for i := 1; i < 4; i++ {
+		s.assertClosed(termChs[i])
+		result, err := ur.getUpdateResult(ids[i])
+		s.NoError(err)
+		s.Equal(shared.WorkflowUpdateResultTypeRejected, result.GetResultType())
+		s.Equal("not handled", result.GetRejectionReason())
+	}
+
+	for _, id := range ids {
+		ur.removeUpdate(id)
+		_, err := ur.getUpdateResult(id)
+		s.Equal(errUpdateNotFound, err)
+	}
+}
+
+func (s *UpdateRegistrySuite) TestRejectPendingUpdates() {
+	ur := newUpdateRegistry()
+	startedID, startedTermCh := ur.bufferUpdate(&shared.WorkflowUpdate{})
+	ur.startUpdates()
+	bufferedID, bufferedTermCh := ur.bufferUpdate(&shared.WorkflowUpdate{})
+
+	ur.rejectPendingUpdates("workflow closed")
+	s.False(ur.hasBuffered())
+	s.False(ur.hasStarted())
+	s.assertClosed(bufferedTermCh)
+	s.assertClosed(startedTermCh)
+	for _, id := range []string{bufferedID, startedID} {
+		result, err := ur.getUpdateResult(id)
+		s.NoError(err)
+		s.Equal(shared.WorkflowUpdateResultTypeRejected, result.GetResultType())
+		s.Equal(id, result.GetUpdateId())
+	}
+}
+
+func (s *UpdateRegistrySuite) TestAbortPendingUpdates() {
+	ur := newUpdateRegistry()
+	startedID, startedTermCh := ur.bufferUpdate(&shared.WorkflowUpdate{})
+	ur.startUpdates()
+	bufferedID, bufferedTermCh := ur.bufferUpdate(&shared.WorkflowUpdate{})
+
+	ur.abortPendingUpdates(ErrUpdateAborted)
+	s.False(ur.hasBuffered())
+	s.False(ur.hasStarted())
+	s.assertClosed(bufferedTermCh)
+	s.assertClosed(startedTermCh)
+	for _, id := range []string{bufferedID, startedID} {
+		result, err := ur.getUpdateResult(id)
+		s.Equal(ErrUpdateAborted, err)
+		s.Nil(result)
+		s.Equal(errUpdateAlreadyCompleted, ur.completeUpdate(id, &shared.WorkflowUpdateResult{}))
+	}
+}
+
+func (s *UpdateRegistrySuite) assertClosed(termCh <-chan struct{}) {
+	select {
+	case <-termCh:
+	default:
+		s.Fail("expected update to be completed")
+	}
+}
+
+func (s *UpdateRegistrySuite) assertNotClosed(termCh <-chan struct{}) {
+	select {
+	case <-termCh:
+		s.Fail("expected update to be pending")
+	default:
+	}
+}
@@ -46,6 +46,8 @@ type (
 		getDomainID() string
 		getExecution() *workflow.WorkflowExecution
 		getLogger() log.Logger
+		getQueryRegistry() queryRegistry
+		getUpdateRegistry() updateRegistry
 
 		loadWorkflowExecution() (mutableState, error)
 		loadExecutionStats() (*persistence.ExecutionStats, error)
@@ -144,6 +146,10 @@ type (
 		msBuilder       mutableState
 		stats           *persistence.ExecutionStats
 		updateCondition int64
+		// queries and updates are kept on the context rather than the mutable state
+		// so that pending queries and updates survive reloading of the mutable state
+		queryRegistry  queryRegistry
+		updateRegistry updateRegistry
 	}
 )
 
@@ -179,6 +185,8 @@ func newWorkflowExecutionContext(
 		stats: &persistence.ExecutionStats{
 			HistorySize: 0,
 		},
+		queryRegistry:  newQueryRegistry(),
+		updateRegistry: newUpdateRegistry(),
 	}
 }
 
@@ -208,6 +216,14 @@ func (c *workflowExecutionContextImpl) getLogger() log.Logger {
 	return c.logger
 }
 
+func (c *workflowExecutionContextImpl) getQueryRegistry() queryRegistry {
+	return c.queryRegistry
+}
+
+func (c *workflowExecutionContextImpl) getUpdateRegistry() updateRegistry {
+	return c.updateRegistry
+}
+
 func (c *workflowExecutionContextImpl) getDomainName() string {
 	domainEntry, err := c.shard.GetDomainCache().GetDomainByID(c.domainID)
 	if err != nil {
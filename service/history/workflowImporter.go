@@ -0,0 +1,270 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	ctx "context"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	workflowImporter interface {
+		importWorkflow(
+			ctx ctx.Context,
+			now time.Time,
+			domainEntry *cache.DomainCacheEntry,
+			context workflowExecutionContext,
+			historyBatches []*shared.DataBlob,
+		) error
+	}
+
+	workflowImporterImpl struct {
+		shard             ShardContext
+		executionMgr      persistence.ExecutionManager
+		historyV2Mgr      persistence.HistoryV2Manager
+		historySerializer persistence.PayloadSerializer
+		stateRebuilder    nDCStateRebuilder
+
+		logger log.Logger
+	}
+)
+
+var _ workflowImporter = (*workflowImporterImpl)(nil)
+
+func newWorkflowImporter(
+	shard ShardContext,
+	logger log.Logger,
+) *workflowImporterImpl {
+
+	return &workflowImporterImpl{
+		shard:             shard,
+		executionMgr:      shard.GetExecutionManager(),
+		historyV2Mgr:      shard.GetHistoryV2Manager(),
+		historySerializer: shard.GetService().GetPayloadSerializer(),
+		stateRebuilder:    newNDCStateRebuilder(shard, logger),
+		logger:            logger,
+	}
+}
+
+// importWorkflow persists the supplied history on a new branch, rebuilds the mutable state from it
+// and creates the workflow execution, caller is expected to hold the current workflow lock
+func (r *workflowImporterImpl) importWorkflow(
+	ctx ctx.Context,
+	now time.Time,
+	domainEntry *cache.DomainCacheEntry,
+	context workflowExecutionContext,
+	historyBatches []*shared.DataBlob,
+) (retError error) {
+
+	domainID := context.getDomainID()
+	workflowID := context.getExecution().GetWorkflowId()
+	runID := context.getExecution().GetRunId()
+
+	eventBatches, err := r.deserializeHistoryBatches(historyBatches)
+	if err != nil {
+		return err
+	}
+	if err := r.prepareEvents(domainEntry, eventBatches); err != nil {
+		return err
+	}
+
+	createMode, prevRunID, prevLastWriteVersion, err := r.getCreateMode(domainID, workflowID, runID)
+	if err != nil {
+		return err
+	}
+
+	branchToken, err := persistence.NewHistoryBranchToken(runID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retError == nil {
+			return
+		}
+		if errDelete := r.historyV2Mgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+			BranchToken: branchToken,
+			ShardID:     common.IntPtr(r.shard.GetShardID()),
+		}); errDelete != nil {
+			r.logger.WithTags(
+				tag.Error(errDelete),
+			).Error("workflowImporter unable to delete history branch of failed import.")
+		}
+	}()
+
+	for i, events := range eventBatches {
+		workflowEvents := &persistence.WorkflowEvents{
+			DomainID:    domainID,
+			WorkflowID:  workflowID,
+			RunID:       runID,
+			BranchToken: branchToken,
+			Events:      events,
+		}
+		if i == 0 {
+			_, err = context.persistFirstWorkflowEvents(workflowEvents)
+		} else {
+			_, err = context.persistNonFirstWorkflowEvents(workflowEvents)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	lastEvents := eventBatches[len(eventBatches)-1]
+	workflowIdentifier := definition.NewWorkflowIdentifier(domainID, workflowID, runID)
+	rebuiltMutableState, rebuiltHistorySize, err := r.stateRebuilder.rebuild(
+		ctx,
+		now,
+		workflowIdentifier,
+		branchToken,
+		lastEvents[len(lastEvents)-1].GetEventId()+1,
+		workflowIdentifier,
+		branchToken,
+		uuid.New(),
+	)
+	if err != nil {
+		return err
+	}
+
+	// the rebuilt mutable state only carries the tasks generated by the task refresher
+	workflowSnapshot, _, err := rebuiltMutableState.CloseTransactionAsSnapshot(
+		now,
+		transactionPolicyPassive,
+	)
+	if err != nil {
+		return err
+	}
+
+	return context.createWorkflowExecution(
+		workflowSnapshot,
+		rebuiltHistorySize,
+		now,
+		createMode,
+		prevRunID,
+		prevLastWriteVersion,
+	)
+}
+
+func (r *workflowImporterImpl) deserializeHistoryBatches(
+	historyBatches []*shared.DataBlob,
+) ([][]*shared.HistoryEvent, error) {
+
+	if len(historyBatches) == 0 {
+		return nil, &shared.BadRequestError{Message: "History batches are not set on request."}
+	}
+
+	eventBatches := make([][]*shared.HistoryEvent, 0, len(historyBatches))
+	nextEventID := common.FirstEventID
+	for _, blob := range historyBatches {
+		if blob == nil {
+			return nil, &shared.BadRequestError{Message: "History batch is not set."}
+		}
+		events, err := deserializeBlob(r.historySerializer, blob)
+		if err != nil {
+			return nil, &shared.BadRequestError{
+				Message: fmt.Sprintf("Unable to deserialize history batch: %v", err),
+			}
+		}
+		if len(events) == 0 {
+			return nil, &shared.BadRequestError{Message: "History batch has no events."}
+		}
+		for _, event := range events {
+			if event.GetEventId() != nextEventID {
+				return nil, &shared.BadRequestError{
+					Message: fmt.Sprintf("Unexpected event ID %v in history, expecting %v.", event.GetEventId(), nextEventID),
+				}
+			}
+			nextEventID++
+		}
+		eventBatches = append(eventBatches, events)
+	}
+
+	if eventBatches[0][0].GetEventType() != shared.EventTypeWorkflowExecutionStarted {
+		return nil, &shared.BadRequestError{Message: "History does not start with a workflow execution started event."}
+	}
+	return eventBatches, nil
+}
+
+// prepareEvents moves the imported events to the failover version of the target domain,
+// since the versions of the source cluster have no meaning in this cluster,
+// and assigns task IDs of this shard to them
+func (r *workflowImporterImpl) prepareEvents(
+	domainEntry *cache.DomainCacheEntry,
+	eventBatches [][]*shared.HistoryEvent,
+) error {
+
+	numEvents := 0
+	for _, events := range eventBatches {
+		numEvents += len(events)
+	}
+	taskIDs, err := r.shard.GenerateTransferTaskIDs(numEvents)
+	if err != nil {
+		return err
+	}
+
+	version := domainEntry.GetFailoverVersion()
+	index := 0
+	for _, events := range eventBatches {
+		for _, event := range events {
+			event.Version = common.Int64Ptr(version)
+			event.TaskId = common.Int64Ptr(taskIDs[index])
+			index++
+		}
+	}
+	return nil
+}
+
+func (r *workflowImporterImpl) getCreateMode(
+	domainID string,
+	workflowID string,
+	runID string,
+) (persistence.CreateWorkflowMode, string, int64, error) {
+
+	resp, err := r.executionMgr.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			// current record does not exists
+			return persistence.CreateWorkflowModeBrandNew, "", 0, nil
+		}
+		return 0, "", 0, err
+	}
+
+	if resp.RunID == runID || resp.State != persistence.WorkflowStateCompleted {
+		return 0, "", 0, &shared.WorkflowExecutionAlreadyStartedError{
+			Message:        common.StringPtr(fmt.Sprintf("Workflow execution already exists with run ID %v.", resp.RunID)),
+			StartRequestId: common.StringPtr(resp.StartRequestID),
+			RunId:          common.StringPtr(resp.RunID),
+		}
+	}
+	return persistence.CreateWorkflowModeWorkflowIDReuse, resp.RunID, resp.LastWriteVersion, nil
+}
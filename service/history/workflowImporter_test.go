@@ -0,0 +1,330 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	ctx "context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	workflowImporterSuite struct {
+		suite.Suite
+
+		controller         *gomock.Controller
+		mockStateRebuilder *MocknDCStateRebuilder
+
+		mockService      service.Service
+		mockShard        *shardContextImpl
+		mockExecutionMgr *mocks.ExecutionManager
+		mockHistoryV2Mgr *mocks.HistoryV2Manager
+		mockContext      *mockWorkflowExecutionContext
+		logger           log.Logger
+
+		domainID   string
+		workflowID string
+		runID      string
+
+		workflowImporter *workflowImporterImpl
+	}
+)
+
+func TestWorkflowImporterSuite(t *testing.T) {
+	s := new(workflowImporterSuite)
+	suite.Run(t, s)
+}
+
+func (s *workflowImporterSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockStateRebuilder = NewMocknDCStateRebuilder(s.controller)
+
+	s.logger = loggerimpl.NewDevelopmentForTest(s.Suite)
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	s.mockService = service.NewTestService(nil, nil, metricsClient, nil, nil, nil, nil)
+
+	s.mockShard = &shardContextImpl{
+		service:                   s.mockService,
+		shardInfo:                 &persistence.ShardInfo{ShardID: 10, RangeID: 1, TransferAckLevel: 0},
+		transferSequenceNumber:    1,
+		executionManager:          s.mockExecutionMgr,
+		historyV2Mgr:              s.mockHistoryV2Mgr,
+		maxTransferSequenceNumber: 100000,
+		closeCh:                   make(chan int, 100),
+		config:                    NewDynamicConfigForTest(),
+		logger:                    s.logger,
+		metricsClient:             metricsClient,
+		timeSource:                clock.NewRealTimeSource(),
+	}
+
+	s.domainID = testLocalDomainEntry.GetInfo().ID
+	s.workflowID = "some random workflow ID"
+	s.runID = uuid.New()
+	s.mockContext = &mockWorkflowExecutionContext{}
+	s.mockContext.On("getDomainID").Return(s.domainID).Maybe()
+	s.mockContext.On("getExecution").Return(&shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(s.workflowID),
+		RunId:      common.StringPtr(s.runID),
+	}).Maybe()
+
+	s.workflowImporter = newWorkflowImporter(s.mockShard, s.logger)
+	s.workflowImporter.stateRebuilder = s.mockStateRebuilder
+}
+
+func (s *workflowImporterSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
+	s.mockContext.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *workflowImporterSuite) TestImportWorkflow_BrandNew() {
+	ctx := ctx.Background()
+	now := time.Now()
+	historyBatches := s.newHistoryBatches([]int64{1, 2}, []int64{3, 4, 5})
+
+	s.mockExecutionMgr.On("GetCurrentExecution", &persistence.GetCurrentExecutionRequest{
+		DomainID:   s.domainID,
+		WorkflowID: s.workflowID,
+	}).Return(nil, &shared.EntityNotExistsError{}).Once()
+
+	var branchToken []byte
+	s.mockContext.On("persistFirstWorkflowEvents", mock.MatchedBy(func(workflowEvents *persistence.WorkflowEvents) bool {
+		branchToken = workflowEvents.BranchToken
+		return s.isImportedEvents(workflowEvents, 1, 2)
+	})).Return(int64(100), nil).Once()
+	s.mockContext.On("persistNonFirstWorkflowEvents", mock.MatchedBy(func(workflowEvents *persistence.WorkflowEvents) bool {
+		return s.isImportedEvents(workflowEvents, 3, 5) && string(workflowEvents.BranchToken) == string(branchToken)
+	})).Return(int64(200), nil).Once()
+
+	workflowIdentifier := definition.NewWorkflowIdentifier(s.domainID, s.workflowID, s.runID)
+	mutableState := &mockMutableState{}
+	defer mutableState.AssertExpectations(s.T())
+	s.mockStateRebuilder.EXPECT().rebuild(
+		ctx,
+		now,
+		workflowIdentifier,
+		gomock.Any(),
+		int64(6),
+		workflowIdentifier,
+		gomock.Any(),
+		gomock.Any(),
+	).Return(mutableState, int64(300), nil).Times(1)
+
+	workflowSnapshot := &persistence.WorkflowSnapshot{}
+	mutableState.On("CloseTransactionAsSnapshot", now, transactionPolicyPassive).Return(
+		workflowSnapshot, []*persistence.WorkflowEvents{}, nil,
+	).Once()
+	s.mockContext.On(
+		"createWorkflowExecution",
+		workflowSnapshot,
+		int64(300),
+		now,
+		persistence.CreateWorkflowModeBrandNew,
+		"",
+		int64(0),
+	).Return(nil).Once()
+
+	err := s.workflowImporter.importWorkflow(ctx, now, testLocalDomainEntry, s.mockContext, historyBatches)
+	s.NoError(err)
+}
+
+func (s *workflowImporterSuite) TestImportWorkflow_WorkflowIDReuse() {
+	ctx := ctx.Background()
+	now := time.Now()
+	historyBatches := s.newHistoryBatches([]int64{1, 2})
+	prevRunID := uuid.New()
+	prevLastWriteVersion := int64(123)
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID:            prevRunID,
+		State:            persistence.WorkflowStateCompleted,
+		CloseStatus:      persistence.WorkflowCloseStatusCompleted,
+		LastWriteVersion: prevLastWriteVersion,
+	}, nil).Once()
+	s.mockContext.On("persistFirstWorkflowEvents", mock.Anything).Return(int64(100), nil).Once()
+
+	mutableState := &mockMutableState{}
+	defer mutableState.AssertExpectations(s.T())
+	s.mockStateRebuilder.EXPECT().rebuild(
+		ctx, now, gomock.Any(), gomock.Any(), int64(3), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(mutableState, int64(100), nil).Times(1)
+
+	workflowSnapshot := &persistence.WorkflowSnapshot{}
+	mutableState.On("CloseTransactionAsSnapshot", now, transactionPolicyPassive).Return(
+		workflowSnapshot, []*persistence.WorkflowEvents{}, nil,
+	).Once()
+	s.mockContext.On(
+		"createWorkflowExecution",
+		workflowSnapshot,
+		int64(100),
+		now,
+		persistence.CreateWorkflowModeWorkflowIDReuse,
+		prevRunID,
+		prevLastWriteVersion,
+	).Return(nil).Once()
+
+	err := s.workflowImporter.importWorkflow(ctx, now, testLocalDomainEntry, s.mockContext, historyBatches)
+	s.NoError(err)
+}
+
+func (s *workflowImporterSuite) TestImportWorkflow_CurrentWorkflowRunning() {
+	historyBatches := s.newHistoryBatches([]int64{1, 2})
+	currentRunID := uuid.New()
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: currentRunID,
+		State: persistence.WorkflowStateRunning,
+	}, nil).Once()
+
+	err := s.workflowImporter.importWorkflow(ctx.Background(), time.Now(), testLocalDomainEntry, s.mockContext, historyBatches)
+	s.IsType(&shared.WorkflowExecutionAlreadyStartedError{}, err)
+	s.Equal(currentRunID, err.(*shared.WorkflowExecutionAlreadyStartedError).GetRunId())
+}
+
+func (s *workflowImporterSuite) TestImportWorkflow_RebuildFailed_DeleteBranch() {
+	ctx := ctx.Background()
+	now := time.Now()
+	historyBatches := s.newHistoryBatches([]int64{1, 2})
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, &shared.EntityNotExistsError{}).Once()
+	var branchToken []byte
+	s.mockContext.On("persistFirstWorkflowEvents", mock.MatchedBy(func(workflowEvents *persistence.WorkflowEvents) bool {
+		branchToken = workflowEvents.BranchToken
+		return true
+	})).Return(int64(100), nil).Once()
+	s.mockStateRebuilder.EXPECT().rebuild(
+		ctx, now, gomock.Any(), gomock.Any(), int64(3), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(nil, int64(0), &shared.InternalServiceError{}).Times(1)
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.MatchedBy(func(request *persistence.DeleteHistoryBranchRequest) bool {
+		return string(request.BranchToken) == string(branchToken)
+	})).Return(nil).Once()
+
+	err := s.workflowImporter.importWorkflow(ctx, now, testLocalDomainEntry, s.mockContext, historyBatches)
+	s.IsType(&shared.InternalServiceError{}, err)
+}
+
+func (s *workflowImporterSuite) TestDeserializeHistoryBatches_Invalid() {
+	_, err := s.workflowImporter.deserializeHistoryBatches(nil)
+	s.IsType(&shared.BadRequestError{}, err)
+
+	_, err = s.workflowImporter.deserializeHistoryBatches([]*shared.DataBlob{{Data: []byte("some random data")}})
+	s.IsType(&shared.BadRequestError{}, err)
+
+	// event IDs must be contiguous
+	_, err = s.workflowImporter.deserializeHistoryBatches(s.newHistoryBatches([]int64{1, 2}, []int64{4}))
+	s.IsType(&shared.BadRequestError{}, err)
+
+	// history must start with the first event
+	_, err = s.workflowImporter.deserializeHistoryBatches(s.newHistoryBatches([]int64{2, 3}))
+	s.IsType(&shared.BadRequestError{}, err)
+
+	// first event must be workflow execution started
+	batches := s.newHistoryBatches([]int64{1, 2})
+	events, err := s.workflowImporter.historySerializer.DeserializeBatchEvents(&persistence.DataBlob{
+		Encoding: common.EncodingTypeThriftRW,
+		Data:     batches[0].Data,
+	})
+	s.NoError(err)
+	events[0].EventType = shared.EventTypeWorkflowExecutionSignaled.Ptr()
+	_, err = s.workflowImporter.deserializeHistoryBatches([]*shared.DataBlob{s.serializeEvents(events)})
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *workflowImporterSuite) TestPrepareEvents() {
+	eventBatches, err := s.workflowImporter.deserializeHistoryBatches(s.newHistoryBatches([]int64{1, 2}, []int64{3}))
+	s.NoError(err)
+
+	err = s.workflowImporter.prepareEvents(testGlobalDomainEntry, eventBatches)
+	s.NoError(err)
+	lastTaskID := int64(0)
+	for _, events := range eventBatches {
+		for _, event := range events {
+			s.Equal(testGlobalDomainEntry.GetFailoverVersion(), event.GetVersion())
+			s.True(event.GetTaskId() > lastTaskID)
+			lastTaskID = event.GetTaskId()
+		}
+	}
+}
+
+func (s *workflowImporterSuite) isImportedEvents(
+	workflowEvents *persistence.WorkflowEvents,
+	firstEventID int64,
+	lastEventID int64,
+) bool {
+
+	events := workflowEvents.Events
+	return workflowEvents.DomainID == s.domainID &&
+		workflowEvents.WorkflowID == s.workflowID &&
+		workflowEvents.RunID == s.runID &&
+		len(workflowEvents.BranchToken) > 0 &&
+		events[0].GetEventId() == firstEventID &&
+		events[len(events)-1].GetEventId() == lastEventID &&
+		events[0].GetVersion() == testLocalDomainEntry.GetFailoverVersion()
+}
+
+func (s *workflowImporterSuite) newHistoryBatches(eventIDBatches ...[]int64) []*shared.DataBlob {
+	var blobs []*shared.DataBlob
+	for _, eventIDs := range eventIDBatches {
+		var events []*shared.HistoryEvent
+		for _, eventID := range eventIDs {
+			event := &shared.HistoryEvent{
+				EventId:   common.Int64Ptr(eventID),
+				Version:   common.Int64Ptr(1001),
+				TaskId:    common.Int64Ptr(eventID * 100),
+				EventType: shared.EventTypeWorkflowExecutionSignaled.Ptr(),
+			}
+			if eventID == common.FirstEventID {
+				event.EventType = shared.EventTypeWorkflowExecutionStarted.Ptr()
+			}
+			events = append(events, event)
+		}
+		blobs = append(blobs, s.serializeEvents(events))
+	}
+	return blobs
+}
+
+func (s *workflowImporterSuite) serializeEvents(events []*shared.HistoryEvent) *shared.DataBlob {
+	blob, err := s.workflowImporter.historySerializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	return &shared.DataBlob{
+		EncodingType: shared.EncodingTypeThriftRW.Ptr(),
+		Data:         blob.Data,
+	}
+}
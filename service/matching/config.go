@@ -48,6 +48,19 @@ type (
 		ForwarderMaxRatePerSecond    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
+		// partition auto scale configuration
+		EnablePartitionAutoScale        dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		MaxTasklistPartitions           dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionUpscaleRPS             dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		PartitionDownscaleFactor        dynamicconfig.FloatPropertyFn
+		PartitionUpscaleSustainedTime   dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionDownscaleSustainedTime dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionScaleInterval          dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		PartitionConfigRefreshInterval  dynamicconfig.DurationPropertyFn
+
+		// max time a decision task holds its slot on a task list capping outstanding tasks
+		OutstandingDecisionTaskTimeout dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
@@ -67,8 +80,20 @@ type (
 		ForwarderMaxChildrenPerNode  func() int
 	}
 
+	partitionScalerConfig struct {
+		EnablePartitionAutoScale        func() bool
+		MaxTasklistPartitions           func() int
+		PartitionUpscaleRPS             func() int
+		PartitionDownscaleFactor        func() float64
+		PartitionUpscaleSustainedTime   func() time.Duration
+		PartitionDownscaleSustainedTime func() time.Duration
+		PartitionScaleInterval          func() time.Duration
+		PartitionConfigRefreshInterval  func() time.Duration
+	}
+
 	taskListConfig struct {
 		forwarderConfig
+		partitionScalerConfig
 		EnableSyncMatch func() bool
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval func() time.Duration
@@ -110,6 +135,15 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
 		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
+		EnablePartitionAutoScale:        dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnablePartitionAutoScale, false),
+		MaxTasklistPartitions:           dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTasklistPartitions, 20),
+		PartitionUpscaleRPS:             dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleRPS, 200),
+		PartitionDownscaleFactor:        dc.GetFloat64Property(dynamicconfig.MatchingPartitionDownscaleFactor, 0.75),
+		PartitionUpscaleSustainedTime:   dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionUpscaleSustainedTime, time.Minute),
+		PartitionDownscaleSustainedTime: dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDownscaleSustainedTime, 2*time.Minute),
+		PartitionScaleInterval:          dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionScaleInterval, 15*time.Second),
+		PartitionConfigRefreshInterval:  dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval, 10*time.Second),
+		OutstandingDecisionTaskTimeout:  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingDecisionTaskTimeout, time.Minute),
 	}
 }
 
@@ -174,5 +208,31 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 				return common.MaxInt(1, config.ForwarderMaxChildrenPerNode(domain, taskListName, taskType))
 			},
 		},
+		partitionScalerConfig: partitionScalerConfig{
+			EnablePartitionAutoScale: func() bool {
+				return config.EnablePartitionAutoScale(domain, taskListName, taskType)
+			},
+			MaxTasklistPartitions: func() int {
+				return common.MaxInt(1, config.MaxTasklistPartitions(domain, taskListName, taskType))
+			},
+			PartitionUpscaleRPS: func() int {
+				return common.MaxInt(1, config.PartitionUpscaleRPS(domain, taskListName, taskType))
+			},
+			PartitionDownscaleFactor: func() float64 {
+				return config.PartitionDownscaleFactor()
+			},
+			PartitionUpscaleSustainedTime: func() time.Duration {
+				return config.PartitionUpscaleSustainedTime(domain, taskListName, taskType)
+			},
+			PartitionDownscaleSustainedTime: func() time.Duration {
+				return config.PartitionDownscaleSustainedTime(domain, taskListName, taskType)
+			},
+			PartitionScaleInterval: func() time.Duration {
+				return config.PartitionScaleInterval(domain, taskListName, taskType)
+			},
+			PartitionConfigRefreshInterval: func() time.Duration {
+				return config.PartitionConfigRefreshInterval()
+			},
+		},
 	}, nil
 }
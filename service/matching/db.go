@@ -38,12 +38,19 @@ type (
 		taskType     int
 		rangeID      int64
 		ackLevel     int64
-		store        persistence.TaskManager
-		logger       log.Logger
+		// partitionConfig is only maintained by root partitions whose
+		// partition count is scaled at runtime
+		partitionConfig *persistence.TaskListPartitionConfig
+		// limits are only set when pinned through the admin API
+		limits *persistence.TaskListLimits
+		store  persistence.TaskManager
+		logger log.Logger
 	}
 	taskListState struct {
-		rangeID  int64
-		ackLevel int64
+		rangeID         int64
+		ackLevel        int64
+		partitionConfig *persistence.TaskListPartitionConfig
+		limits          *persistence.TaskListLimits
 	}
 )
 
@@ -92,7 +99,14 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	}
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
-	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
+	db.partitionConfig = resp.TaskListInfo.PartitionConfig
+	db.limits = resp.TaskListInfo.Limits
+	return taskListState{
+		rangeID:         db.rangeID,
+		ackLevel:        db.ackLevel,
+		partitionConfig: db.partitionConfig,
+		limits:          db.limits,
+	}, nil
 }
 
 // UpdateState updates the taskList state with the given value
@@ -101,12 +115,14 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	defer db.Unlock()
 	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:        db.domainID,
+			Name:            db.taskListName,
+			TaskType:        db.taskType,
+			AckLevel:        ackLevel,
+			RangeID:         db.rangeID,
+			Kind:            db.taskListKind,
+			PartitionConfig: db.partitionConfig,
+			Limits:          db.limits,
 		},
 	})
 	if err == nil {
@@ -115,6 +131,50 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// UpdatePartitionConfig updates the partition config of the taskList with the given value
+func (db *taskListDB) UpdatePartitionConfig(partitionConfig *persistence.TaskListPartitionConfig) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:        db.domainID,
+			Name:            db.taskListName,
+			TaskType:        db.taskType,
+			AckLevel:        db.ackLevel,
+			RangeID:         db.rangeID,
+			Kind:            db.taskListKind,
+			PartitionConfig: partitionConfig,
+			Limits:          db.limits,
+		},
+	})
+	if err == nil {
+		db.partitionConfig = partitionConfig
+	}
+	return err
+}
+
+// UpdateLimits updates the limits of the taskList with the given value
+func (db *taskListDB) UpdateLimits(limits *persistence.TaskListLimits) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:        db.domainID,
+			Name:            db.taskListName,
+			TaskType:        db.taskType,
+			AckLevel:        db.ackLevel,
+			RangeID:         db.rangeID,
+			Kind:            db.taskListKind,
+			PartitionConfig: db.partitionConfig,
+			Limits:          limits,
+		},
+	})
+	if err == nil {
+		db.limits = limits
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
@@ -148,6 +148,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			ScheduleId:                    &task.event.ScheduleID,
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			ForwardedFrom:                 &fwdr.taskListID.name,
+			Priority:                      &task.event.Priority,
+			FairnessKey:                   &task.event.FairnessKey,
 		})
 	case persistence.TaskListTypeActivity:
 		err = fwdr.client.AddActivityTask(ctx, &gen.AddActivityTaskRequest{
@@ -161,6 +163,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			ScheduleId:                    &task.event.ScheduleID,
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			ForwardedFrom:                 &fwdr.taskListID.name,
+			Priority:                      &task.event.Priority,
+			FairnessKey:                   &task.event.FairnessKey,
 		})
 	default:
 		return errInvalidTaskListType
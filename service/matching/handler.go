@@ -30,6 +30,7 @@ import (
 	m "github.com/uber/cadence/.gen/go/matching"
 	"github.com/uber/cadence/.gen/go/matching/matchingserviceserver"
 	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
@@ -90,6 +91,18 @@ func (h *Handler) Start() error {
 	if err != nil {
 		return err
 	}
+	resolver, err := h.GetMembershipMonitor().GetResolver(common.MatchingServiceName)
+	if err != nil {
+		return err
+	}
+	partitionConfigProvider := matching.NewPartitionConfigProvider(
+		client,
+		h.domainCache.GetDomainName,
+		h.config.EnablePartitionAutoScale,
+		h.config.PartitionConfigRefreshInterval,
+		h.config.NumTasklistReadPartitions,
+		h.config.NumTasklistWritePartitions,
+	)
 	h.engine = NewEngine(
 		h.taskPersistence,
 		h.GetClientBean().GetHistoryClient(),
@@ -98,6 +111,8 @@ func (h *Handler) Start() error {
 		h.Service.GetLogger(),
 		h.Service.GetMetricsClient(),
 		h.domainCache,
+		partitionConfigProvider,
+		resolver,
 	)
 	h.startWG.Done()
 	return nil
@@ -299,6 +314,36 @@ func (h *Handler) DescribeTaskList(ctx context.Context, request *m.DescribeTaskL
 	return response, h.handleErr(err, scope)
 }
 
+// UpdateTaskListLimits persists the limits of the target tasklist, when the target is the root partition
+// the limits are propagated to all the partitions of the tasklist
+func (h *Handler) UpdateTaskListLimits(ctx context.Context, request *m.UpdateTaskListLimitsRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	scope := metrics.MatchingUpdateTaskListLimitsScope
+	sw := h.startRequestProfile("UpdateTaskListLimits", scope)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	err := h.engine.UpdateTaskListLimits(ctx, request)
+	return h.handleErr(err, scope)
+}
+
+// ReleaseTask frees the outstanding task slot held by a task once the worker responded to it
+func (h *Handler) ReleaseTask(ctx context.Context, request *m.ReleaseTaskRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	scope := metrics.MatchingReleaseTaskScope
+	sw := h.startRequestProfile("ReleaseTask", scope)
+	defer sw.Stop()
+
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	h.rateLimiter.Allow()
+
+	err := h.engine.ReleaseTask(ctx, request)
+	return h.handleErr(err, scope)
+}
+
 func (h *Handler) handleErr(err error, scope int) error {
 
 	if err == nil {
@@ -58,8 +58,14 @@ var errTasklistThrottled = errors.New("cannot add to tasklist, limit exceeded")
 
 // newTaskMatcher returns an task matcher instance. The returned instance can be
 // used by task producers and consumers to find a match. Both sync matches and non-sync
-// matches should use this implementation
-func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scopeFunc func() metrics.Scope) *TaskMatcher {
+// matches should use this implementation. numPartitions returns the current number of
+// read partitions of the task list, across which the dispatch rate is divided
+func newTaskMatcher(
+	config *taskListConfig,
+	fwdr *Forwarder,
+	scopeFunc func() metrics.Scope,
+	numPartitions func() int,
+) *TaskMatcher {
 	dPtr := _defaultTaskDispatchRPS
 	limiter := quotas.NewRateLimiter(&dPtr, _defaultTaskDispatchRPSTTL, config.MinTaskThrottlingBurstSize())
 	return &TaskMatcher{
@@ -68,7 +74,7 @@ func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scopeFunc func() me
 		fwdr:          fwdr,
 		taskC:         make(chan *internalTask),
 		queryTaskC:    make(chan *internalTask),
-		numPartitions: config.NumReadPartitions,
+		numPartitions: numPartitions,
 	}
 }
 
@@ -69,7 +69,7 @@ func (t *MatcherTestSuite) SetupTest() {
 	t.cfg = tlCfg
 	scope := func() metrics.Scope { return metrics.NoopScope(metrics.Matching) }
 	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskList, shared.TaskListKindNormal, t.client, scope)
-	t.matcher = newTaskMatcher(tlCfg, t.fwdr, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
+	t.matcher = newTaskMatcher(tlCfg, t.fwdr, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) }, tlCfg.NumReadPartitions)
 }
 
 func (t *MatcherTestSuite) TearDownTest() {
@@ -41,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -62,12 +63,15 @@ type matchingEngineImpl struct {
 	// map from query TaskID (which is a UUID generated in QueryWorkflow() call) to a channel that QueryWorkflow()
 	// will block and wait for. The RespondQueryTaskCompleted() call will send the data through that channel which will
 	// unblock QueryWorkflow() call.
-	queryTaskMap map[string]chan *queryResult
-	domainCache  cache.DomainCache
+	queryTaskMap            map[string]chan *queryResult
+	domainCache             cache.DomainCache
+	partitionConfigProvider matching.PartitionConfigProvider
+	membershipResolver      membership.ServiceResolver
 }
 
 type pollerIDCtxKey string
 type identityCtxKey string
+type forwardedFromCtxKey string
 
 var (
 	// EmptyPollForDecisionTaskResponse is the response when there are no decision tasks to hand out
@@ -81,8 +85,9 @@ var (
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
 
-	pollerIDKey pollerIDCtxKey = "pollerID"
-	identityKey identityCtxKey = "identity"
+	pollerIDKey      pollerIDCtxKey      = "pollerID"
+	identityKey      identityCtxKey      = "identity"
+	forwardedFromKey forwardedFromCtxKey = "forwardedFrom"
 )
 
 const (
@@ -99,19 +104,23 @@ func NewEngine(taskManager persistence.TaskManager,
 	logger log.Logger,
 	metricsClient metrics.Client,
 	domainCache cache.DomainCache,
+	partitionConfigProvider matching.PartitionConfigProvider,
+	membershipResolver membership.ServiceResolver,
 ) Engine {
 
 	return &matchingEngineImpl{
-		taskManager:     taskManager,
-		historyService:  historyService,
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
-		taskLists:       make(map[taskListID]taskListManager),
-		logger:          logger.WithTags(tag.ComponentMatchingEngine),
-		metricsClient:   metricsClient,
-		matchingClient:  matchingClient,
-		config:          config,
-		queryTaskMap:    make(map[string]chan *queryResult),
-		domainCache:     domainCache,
+		taskManager:             taskManager,
+		historyService:          historyService,
+		tokenSerializer:         common.NewJSONTaskTokenSerializer(),
+		taskLists:               make(map[taskListID]taskListManager),
+		logger:                  logger.WithTags(tag.ComponentMatchingEngine),
+		metricsClient:           metricsClient,
+		matchingClient:          matchingClient,
+		config:                  config,
+		queryTaskMap:            make(map[string]chan *queryResult),
+		domainCache:             domainCache,
+		partitionConfigProvider: partitionConfigProvider,
+		membershipResolver:      membershipResolver,
 	}
 }
 
@@ -229,6 +238,8 @@ func (e *matchingEngineImpl) AddDecisionTask(ctx context.Context, addRequest *m.
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		Priority:               addRequest.GetPriority(),
+		FairnessKey:            addRequest.GetFairnessKey(),
 	}
 	return tlMgr.AddTask(ctx, addTaskParams{
 		execution:     addRequest.Execution,
@@ -267,6 +278,8 @@ func (e *matchingEngineImpl) AddActivityTask(ctx context.Context, addRequest *m.
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		Priority:               addRequest.GetPriority(),
+		FairnessKey:            addRequest.GetFairnessKey(),
 	}
 	return tlMgr.AddTask(ctx, addTaskParams{
 		execution:     addRequest.Execution,
@@ -295,6 +308,7 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, forwardedFromKey, req.GetForwardedFrom())
 		taskList, err := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
 		if err != nil {
 			return nil, err
@@ -313,11 +327,14 @@ pollLoop:
 
 		if task.isStarted() {
 			// tasks received from remote are already started. So, simply forward the response
-			return task.pollForDecisionResponse(), nil
+			response := task.pollForDecisionResponse()
+			response.TaskToken = e.trackOutstandingTask(task, response.TaskToken, e.outstandingDecisionTaskTimeout(task, taskList))
+			return response, nil
 		}
 
 		if task.isQuery() {
-			task.finish(nil) // this only means query task sync match succeed.
+			task.cancelOutstanding() // query tasks do not count against outstanding tasks
+			task.finish(nil)         // this only means query task sync match succeed.
 
 			// for query task, we don't need to update history to record decision task started. but we need to know
 			// the NextEventID so front end knows what are the history events to load for this decision task.
@@ -362,6 +379,7 @@ pollLoop:
 
 		resp, err := e.recordDecisionTaskStarted(ctx, request, task)
 		if err != nil {
+			task.cancelOutstanding()
 			switch err.(type) {
 			case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError:
 				e.logger.Debug(fmt.Sprintf("Duplicated decision task taskList=%v, taskID=%v",
@@ -374,7 +392,9 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
-		return e.createPollForDecisionTaskResponse(task, resp), nil
+		response := e.createPollForDecisionTaskResponse(task, resp)
+		response.TaskToken = e.trackOutstandingTask(task, response.TaskToken, e.outstandingDecisionTaskTimeout(task, taskList))
+		return response, nil
 	}
 }
 
@@ -408,6 +428,7 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, forwardedFromKey, req.GetForwardedFrom())
 		taskListKind := common.TaskListKindPtr(request.TaskList.GetKind())
 		task, err := e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
 		if err != nil {
@@ -422,11 +443,15 @@ pollLoop:
 
 		if task.isStarted() {
 			// tasks received from remote are already started. So, simply forward the response
-			return task.pollForActivityResponse(), nil
+			response := task.pollForActivityResponse()
+			timeout := time.Duration(response.GetStartToCloseTimeoutSeconds()) * time.Second
+			response.TaskToken = e.trackOutstandingTask(task, response.TaskToken, timeout)
+			return response, nil
 		}
 
 		resp, err := e.recordActivityTaskStarted(ctx, request, task)
 		if err != nil {
+			task.cancelOutstanding()
 			switch err.(type) {
 			case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError:
 				e.logger.Debug(fmt.Sprintf("Duplicated activity task taskList=%v, taskID=%v",
@@ -439,7 +464,10 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
-		return e.createPollForActivityTaskResponse(task, resp), nil
+		response := e.createPollForActivityTaskResponse(task, resp)
+		timeout := time.Duration(response.GetStartToCloseTimeoutSeconds()) * time.Second
+		response.TaskToken = e.trackOutstandingTask(task, response.TaskToken, timeout)
+		return response, nil
 	}
 }
 
@@ -581,6 +609,117 @@ func (e *matchingEngineImpl) CancelOutstandingPoll(ctx context.Context, request
 	return nil
 }
 
+// UpdateTaskListLimits persists the limits of a task list partition. The root partition propagates
+// the limits to all the partitions of the task list, each of them enforcing its share
+func (e *matchingEngineImpl) UpdateTaskListLimits(ctx context.Context, request *m.UpdateTaskListLimitsRequest) error {
+	domainID := request.GetDomainUUID()
+	updateRequest := request.UpdateRequest
+	taskListType := persistence.TaskListTypeDecision
+	if updateRequest.GetTaskListType() == workflow.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskListName := updateRequest.TaskList.GetName()
+	taskList, err := newTaskListID(domainID, taskListName, taskListType)
+	if err != nil {
+		return err
+	}
+	tlMgr, err := e.getTaskListManager(taskList, common.TaskListKindPtr(workflow.TaskListKindNormal))
+	if err != nil {
+		return err
+	}
+
+	var limits *persistence.TaskListLimits
+	if updateRequest.Limits.GetMaxTasksPerSecond() > 0 || updateRequest.Limits.GetMaxOutstandingTasks() > 0 {
+		limits = &persistence.TaskListLimits{
+			MaxTasksPerSecond:   updateRequest.Limits.GetMaxTasksPerSecond(),
+			MaxOutstandingTasks: int(updateRequest.Limits.GetMaxOutstandingTasks()),
+		}
+	}
+	if err := tlMgr.UpdateLimits(limits); err != nil {
+		return err
+	}
+	if !taskList.IsRoot() {
+		return nil
+	}
+
+	// propagate to every partition the task list can scale up to, so that partitions
+	// loaded later on already have the limits persisted
+	numPartitions, err := e.maxNumPartitions(domainID, taskListName, taskListType)
+	if err != nil {
+		return err
+	}
+	for i := 1; i < numPartitions; i++ {
+		partitionRequest := *updateRequest
+		partitionRequest.TaskList = &workflow.TaskList{
+			Name: common.StringPtr(taskList.mkName(i)),
+			Kind: common.TaskListKindPtr(workflow.TaskListKindNormal),
+		}
+		if err := e.matchingClient.UpdateTaskListLimits(ctx, &m.UpdateTaskListLimitsRequest{
+			DomainUUID:    request.DomainUUID,
+			UpdateRequest: &partitionRequest,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReleaseTask frees the slot held by an outstanding task once the worker responded to it. The
+// slots are kept in memory, so nothing needs to be done when the task list is not loaded.
+// When the partition the task was dispatched from is unknown, the root partition forwards
+// the release to every partition of the task list
+func (e *matchingEngineImpl) ReleaseTask(ctx context.Context, request *m.ReleaseTaskRequest) error {
+	taskListName := request.TaskList.GetName()
+	taskListType := int(request.GetTaskListType())
+	taskList, err := newTaskListID(request.GetDomainUUID(), taskListName, taskListType)
+	if err != nil {
+		return err
+	}
+	e.taskListsLock.RLock()
+	tlMgr, ok := e.taskLists[*taskList]
+	e.taskListsLock.RUnlock()
+	if ok {
+		tlMgr.ReleaseTask(
+			request.WorkflowExecution.GetWorkflowId(),
+			request.WorkflowExecution.GetRunId(),
+			request.GetScheduleId(),
+		)
+	}
+	if !request.GetAllPartitions() || !taskList.IsRoot() {
+		return nil
+	}
+
+	numPartitions, err := e.maxNumPartitions(request.GetDomainUUID(), taskListName, taskListType)
+	if err != nil {
+		return err
+	}
+	for i := 1; i < numPartitions; i++ {
+		partitionRequest := *request
+		partitionRequest.TaskList = &workflow.TaskList{
+			Name: common.StringPtr(taskList.mkName(i)),
+			Kind: common.TaskListKindPtr(workflow.TaskListKindNormal),
+		}
+		partitionRequest.AllPartitions = nil
+		if err := e.matchingClient.ReleaseTask(ctx, &partitionRequest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxNumPartitions returns the number of partitions the task list can scale up to
+func (e *matchingEngineImpl) maxNumPartitions(domainID string, taskListName string, taskListType int) (int, error) {
+	domainName, err := e.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return 0, err
+	}
+	numPartitions := e.config.NumTasklistReadPartitions(domainName, taskListName, taskListType)
+	if e.config.EnablePartitionAutoScale(domainName, taskListName, taskListType) {
+		numPartitions = common.MaxInt(numPartitions, e.config.MaxTasklistPartitions(domainName, taskListName, taskListType))
+	}
+	return numPartitions, nil
+}
+
 func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error) {
 	domainID := request.GetDomainUUID()
 	taskListType := persistence.TaskListTypeDecision
@@ -598,7 +737,37 @@ func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.De
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
+	response := tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus())
+	if request.DescRequest.GetIncludeTaskListPartitions() && response.PartitionConfig != nil {
+		response.Partitions = e.describeTaskListPartitions(taskList, response.PartitionConfig)
+	}
+	return response, nil
+}
+
+// describeTaskListPartitions lists every read partition of the task list along with the host owning it,
+// partitions at or above the write partition count are reported as draining
+func (e *matchingEngineImpl) describeTaskListPartitions(
+	taskList *taskListID,
+	partitionConfig *workflow.TaskListPartitionConfig,
+) []*workflow.TaskListPartitionMetadata {
+	numRead := int(partitionConfig.GetNumReadPartitions())
+	numWrite := int(partitionConfig.GetNumWritePartitions())
+	partitions := make([]*workflow.TaskListPartitionMetadata, 0, numRead)
+	for i := 0; i < numRead; i++ {
+		key := taskList.mkName(i)
+		ownerHostName := ""
+		if host, err := e.membershipResolver.Lookup(key); err == nil {
+			ownerHostName = host.Identity()
+		} else {
+			e.logger.Warn("Failed to lookup owner of task list partition", tag.WorkflowTaskListName(key), tag.Error(err))
+		}
+		partitions = append(partitions, &workflow.TaskListPartitionMetadata{
+			Key:           common.StringPtr(key),
+			OwnerHostName: common.StringPtr(ownerHostName),
+			Draining:      common.BoolPtr(i >= numWrite),
+		})
+	}
+	return partitions
 }
 
 // Loads a task from persistence and wraps it in a task context
@@ -711,6 +880,37 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(
 	return response
 }
 
+// trackOutstandingTask records the task as outstanding when its poller reserved a slot on a task list capping
+// outstanding tasks. The returned task token carries the task list so the slot is released when the worker responds
+func (e *matchingEngineImpl) trackOutstandingTask(task *internalTask, taskToken []byte, timeout time.Duration) []byte {
+	if task.outstanding == nil {
+		return taskToken
+	}
+	token, err := e.tokenSerializer.Deserialize(taskToken)
+	if err != nil {
+		task.cancelOutstanding()
+		return taskToken
+	}
+	token.TaskList = task.outstanding.taskListName
+	serialized, err := e.tokenSerializer.Serialize(token)
+	if err != nil {
+		task.cancelOutstanding()
+		return taskToken
+	}
+	task.startOutstanding(outstandingTaskKey{
+		workflowID: token.WorkflowID,
+		runID:      token.RunID,
+		scheduleID: token.ScheduleID,
+	}, timeout)
+	return serialized
+}
+
+// outstandingDecisionTaskTimeout returns how long a decision task holds its outstanding slot when the worker
+// never responds, the start to close timeout of decision tasks is not known to matching
+func (e *matchingEngineImpl) outstandingDecisionTaskTimeout(task *internalTask, taskList *taskListID) time.Duration {
+	return e.config.OutstandingDecisionTaskTimeout(task.domainName, taskList.name, taskList.taskType)
+}
+
 func (e *matchingEngineImpl) recordDecisionTaskStarted(
 	ctx context.Context,
 	pollReq *workflow.PollForDecisionTaskRequest,
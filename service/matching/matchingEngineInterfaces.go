@@ -39,5 +39,7 @@ type (
 		RespondQueryTaskCompleted(ctx context.Context, request *m.RespondQueryTaskCompletedRequest) error
 		CancelOutstandingPoll(ctx context.Context, request *m.CancelOutstandingPollRequest) error
 		DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error)
+		UpdateTaskListLimits(ctx context.Context, request *m.UpdateTaskListLimitsRequest) error
+		ReleaseTask(ctx context.Context, request *m.ReleaseTaskRequest) error
 	}
 )
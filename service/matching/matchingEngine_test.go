@@ -34,12 +34,15 @@ import (
 	gohistory "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyservicetest"
 	"github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	clientmatching "github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -142,6 +145,14 @@ func newMatchingEngine(
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		config:          config,
 		domainCache:     domainCache,
+		partitionConfigProvider: clientmatching.NewPartitionConfigProvider(
+			nil,
+			func(string) (string, error) { return "domainName", nil },
+			config.EnablePartitionAutoScale,
+			config.PartitionConfigRefreshInterval,
+			config.NumTasklistReadPartitions,
+			config.NumTasklistWritePartitions,
+		),
 	}
 }
 
@@ -742,6 +753,171 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	s.True(descResp.GetTaskListStatus().GetRatePerSecond() >= (_defaultTaskDispatchRPS - 1))
 }
 
+func (s *matchingEngineSuite) TestDescribeTaskListPartitions() {
+	s.matchingEngine.config.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	s.matchingEngine.config.NumTasklistWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(2)
+	resolver := &mocks.ServiceResolver{}
+	resolver.On("Lookup", mock.Anything).Return(membership.NewHostInfo("matching-host", nil), nil)
+	s.matchingEngine.membershipResolver = resolver
+
+	domainID := uuid.New()
+	tlName := "makeToast"
+	tlType := workflow.TaskListTypeDecision
+	descResp, err := s.matchingEngine.DescribeTaskList(s.callContext, &matching.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(domainID),
+		DescRequest: &workflow.DescribeTaskListRequest{
+			TaskList:                  &workflow.TaskList{Name: common.StringPtr(tlName)},
+			TaskListType:              &tlType,
+			IncludeTaskListPartitions: common.BoolPtr(true),
+		},
+	})
+	s.NoError(err)
+	s.Equal(int32(3), descResp.GetPartitionConfig().GetNumReadPartitions())
+	s.Equal(int32(2), descResp.GetPartitionConfig().GetNumWritePartitions())
+	s.Len(descResp.Partitions, 3)
+	root := newTestTaskListID(domainID, tlName, persistence.TaskListTypeDecision)
+	for i, partition := range descResp.Partitions {
+		s.Equal(root.mkName(i), partition.GetKey())
+		s.Equal("matching-host", partition.GetOwnerHostName())
+		s.Equal(i >= 2, partition.GetDraining())
+	}
+	resolver.AssertExpectations(s.T())
+}
+
+func (s *matchingEngineSuite) TestUpdateTaskListLimits() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.domainCache.On("GetDomainName", mock.Anything).Return("domainName", nil)
+
+	domainID := uuid.New()
+	tl := "makeToast"
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+	taskList := &workflow.TaskList{Name: common.StringPtr(tl)}
+	tlType := workflow.TaskListTypeActivity
+	s.setupRecordActivityTaskStartedMock(tl)
+
+	err := s.matchingEngine.UpdateTaskListLimits(s.callContext, &matching.UpdateTaskListLimitsRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateTaskListLimitsRequest{
+			TaskList:     taskList,
+			TaskListType: &tlType,
+			Limits: &workflow.TaskListLimits{
+				MaxTasksPerSecond:   common.Float64Ptr(1000),
+				MaxOutstandingTasks: common.Int32Ptr(1),
+			},
+		},
+	})
+	s.NoError(err)
+	s.Equal(&persistence.TaskListLimits{MaxTasksPerSecond: 1000, MaxOutstandingTasks: 1},
+		s.taskManager.getTaskListManager(tlID).limits)
+
+	workflowExecution := &workflow.WorkflowExecution{RunId: common.StringPtr("run1"), WorkflowId: common.StringPtr("workflow1")}
+	for i := int64(1); i <= 2; i++ {
+		_, err := s.matchingEngine.AddActivityTask(s.callContext, &matching.AddActivityTaskRequest{
+			SourceDomainUUID:              common.StringPtr(domainID),
+			DomainUUID:                    common.StringPtr(domainID),
+			Execution:                     workflowExecution,
+			ScheduleId:                    common.Int64Ptr(i),
+			TaskList:                      taskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+		})
+		s.NoError(err)
+	}
+
+	poll := func() *workflow.PollForActivityTaskResponse {
+		result, err := s.matchingEngine.PollForActivityTask(s.callContext, &matching.PollForActivityTaskRequest{
+			DomainUUID: common.StringPtr(domainID),
+			PollRequest: &workflow.PollForActivityTaskRequest{
+				TaskList: taskList,
+				Identity: common.StringPtr("nobody"),
+			},
+		})
+		s.NoError(err)
+		return result
+	}
+
+	var result *workflow.PollForActivityTaskResponse
+	s.True(s.awaitCondition(func() bool {
+		result = poll()
+		return len(result.TaskToken) > 0
+	}, time.Second))
+	token, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
+	s.NoError(err)
+	s.Equal(tl, token.TaskList)
+
+	// the only slot is held by the first task until it is released
+	s.Empty(poll().TaskToken)
+
+	descResp, err := s.matchingEngine.DescribeTaskList(s.callContext, &matching.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(domainID),
+		DescRequest: &workflow.DescribeTaskListRequest{
+			TaskList:              taskList,
+			TaskListType:          &tlType,
+			IncludeTaskListStatus: common.BoolPtr(true),
+		},
+	})
+	s.NoError(err)
+	s.Equal(1000.0, descResp.Limits.GetMaxTasksPerSecond())
+	s.Equal(int32(1), descResp.Limits.GetMaxOutstandingTasks())
+	s.Equal(int32(1), descResp.TaskListStatus.GetOutstandingTasks())
+
+	err = s.matchingEngine.ReleaseTask(s.callContext, &matching.ReleaseTaskRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		TaskList:          &workflow.TaskList{Name: common.StringPtr(token.TaskList)},
+		TaskListType:      common.Int32Ptr(persistence.TaskListTypeActivity),
+		WorkflowExecution: workflowExecution,
+		ScheduleId:        common.Int64Ptr(token.ScheduleID),
+	})
+	s.NoError(err)
+	s.True(s.awaitCondition(func() bool {
+		return len(poll().TaskToken) > 0
+	}, time.Second))
+}
+
+func (s *matchingEngineSuite) TestReleaseTaskAllPartitions() {
+	s.matchingEngine.config.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	s.domainCache.On("GetDomainName", mock.Anything).Return("domainName", nil)
+	matchingClient := matchingservicetest.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = matchingClient
+
+	domainID := uuid.New()
+	tl := "makeToast"
+	root := newTestTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+	workflowExecution := &workflow.WorkflowExecution{RunId: common.StringPtr("run1"), WorkflowId: common.StringPtr("workflow1")}
+	for i := 1; i < 3; i++ {
+		matchingClient.EXPECT().ReleaseTask(gomock.Any(), &matching.ReleaseTaskRequest{
+			DomainUUID: common.StringPtr(domainID),
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(root.mkName(i)),
+				Kind: common.TaskListKindPtr(workflow.TaskListKindNormal),
+			},
+			TaskListType:      common.Int32Ptr(persistence.TaskListTypeActivity),
+			WorkflowExecution: workflowExecution,
+			ScheduleId:        common.Int64Ptr(5),
+		}).Return(nil).Times(1)
+	}
+
+	// the partition the task was dispatched from is unknown, so the root forwards the release
+	err := s.matchingEngine.ReleaseTask(s.callContext, &matching.ReleaseTaskRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		TaskList:          &workflow.TaskList{Name: common.StringPtr(tl)},
+		TaskListType:      common.Int32Ptr(persistence.TaskListTypeActivity),
+		WorkflowExecution: workflowExecution,
+		ScheduleId:        common.Int64Ptr(5),
+		AllPartitions:     common.BoolPtr(true),
+	})
+	s.NoError(err)
+
+	// a release addressed to a single partition is never forwarded
+	err = s.matchingEngine.ReleaseTask(s.callContext, &matching.ReleaseTaskRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		TaskList:          &workflow.TaskList{Name: common.StringPtr(tl)},
+		TaskListType:      common.Int32Ptr(persistence.TaskListTypeActivity),
+		WorkflowExecution: workflowExecution,
+		ScheduleId:        common.Int64Ptr(5),
+	})
+	s.NoError(err)
+}
+
 func (s *matchingEngineSuite) TestConcurrentPublishConsumeActivities() {
 	dispatchLimitFn := func(int, int64) float64 {
 		return _defaultTaskDispatchRPS
@@ -1577,7 +1753,9 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 
 		// wait until all tasks are loaded by into in-memory buffers by task list manager
 		// the buffer size should be one less than expected because dispatcher will dequeue the head
-		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) >= (taskCount/2 - 1) }, time.Second))
+		s.True(s.awaitCondition(func() bool {
+			return len(tlMgr.taskReader.taskBuffer)+int(tlMgr.taskReader.queuedTasks.Load()) >= (taskCount/2 - 1)
+		}, time.Second))
 
 		maxTimeBetweenTaskDeletes = tc.maxTimeBtwnDeletes
 		s.matchingEngine.config.MaxTaskDeleteBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(tc.batchSize)
@@ -1719,6 +1897,7 @@ type testTaskListManager struct {
 	rangeID         int64
 	ackLevel        int64
 	createTaskCount int
+	limits          *persistence.TaskListLimits
 	tasks           *treemap.Map
 }
 
@@ -1763,6 +1942,7 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 			TaskType: request.TaskType,
 			RangeID:  tlm.rangeID,
 			Kind:     request.TaskListKind,
+			Limits:   tlm.limits,
 		},
 	}, nil
 }
@@ -1782,6 +1962,7 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.limits = tli.Limits
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -0,0 +1,166 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package matching
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	// outstandingTasks caps the number of tasks of a task list handed to workers
+	// and not completed yet. Pollers reserve a slot before waiting for a task, the
+	// reservation is either turned into an outstanding task once the task is started
+	// or given back. Outstanding tasks hold their slot until the worker responds or
+	// at the latest until their start to close timeout expires
+	outstandingTasks struct {
+		sync.Mutex
+		taskListName string
+		limit        func() int // zero means no limit
+		reserved     int
+		tasks        map[outstandingTaskKey]time.Time // expiry time of each outstanding task
+		notifyC      chan struct{}                    // closed and replaced every time a slot is freed
+	}
+
+	outstandingTaskKey struct {
+		workflowID string
+		runID      string
+		scheduleID int64
+	}
+)
+
+func newOutstandingTasks(taskListName string, limit func() int) *outstandingTasks {
+	return &outstandingTasks{
+		taskListName: taskListName,
+		limit:        limit,
+		tasks:        make(map[outstandingTaskKey]time.Time),
+		notifyC:      make(chan struct{}),
+	}
+}
+
+// reserve blocks until a slot is available and reserves it. When the
+// task list has no limit, returns false without reserving anything
+func (o *outstandingTasks) reserve(ctx context.Context) (bool, error) {
+	for {
+		o.Lock()
+		limit := o.limit()
+		if limit <= 0 {
+			o.Unlock()
+			return false, nil
+		}
+		nextExpiry := o.expireLocked(time.Now())
+		if o.reserved+len(o.tasks) < limit {
+			o.reserved++
+			o.Unlock()
+			return true, nil
+		}
+		notifyC := o.notifyC
+		o.Unlock()
+
+		if err := o.wait(ctx, notifyC, nextExpiry); err != nil {
+			return false, err
+		}
+	}
+}
+
+// wait blocks until a slot is freed or the earliest outstanding task times out
+func (o *outstandingTasks) wait(ctx context.Context, notifyC <-chan struct{}, nextExpiry time.Time) error {
+	var expiryC <-chan time.Time
+	if !nextExpiry.IsZero() {
+		timer := time.NewTimer(time.Until(nextExpiry))
+		defer timer.Stop()
+		expiryC = timer.C
+	}
+	select {
+	case <-notifyC:
+	case <-expiryC:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// start turns a reservation into an outstanding task
+func (o *outstandingTasks) start(key outstandingTaskKey, timeout time.Duration) {
+	o.Lock()
+	defer o.Unlock()
+	o.reserved--
+	o.tasks[key] = time.Now().Add(timeout)
+}
+
+// cancel gives back a reservation
+func (o *outstandingTasks) cancel() {
+	o.Lock()
+	defer o.Unlock()
+	o.reserved--
+	o.signalLocked()
+}
+
+// release frees the slot of an outstanding task, this is a no-op for unknown tasks
+func (o *outstandingTasks) release(key outstandingTaskKey) {
+	o.Lock()
+	defer o.Unlock()
+	if _, ok := o.tasks[key]; ok {
+		delete(o.tasks, key)
+		o.signalLocked()
+	}
+}
+
+// signal wakes up the pollers waiting for a slot, needed when the limit changes
+func (o *outstandingTasks) signal() {
+	o.Lock()
+	defer o.Unlock()
+	o.signalLocked()
+}
+
+// count returns the number of outstanding tasks
+func (o *outstandingTasks) count() int {
+	o.Lock()
+	defer o.Unlock()
+	o.expireLocked(time.Now())
+	return len(o.tasks)
+}
+
+// expireLocked removes the timed out tasks and returns the earliest expiry time
+// among the remaining ones, zero time when there is none
+func (o *outstandingTasks) expireLocked(now time.Time) time.Time {
+	var nextExpiry time.Time
+	expired := false
+	for key, expiry := range o.tasks {
+		if !expiry.After(now) {
+			delete(o.tasks, key)
+			expired = true
+			continue
+		}
+		if nextExpiry.IsZero() || expiry.Before(nextExpiry) {
+			nextExpiry = expiry
+		}
+	}
+	if expired {
+		o.signalLocked()
+	}
+	return nextExpiry
+}
+
+func (o *outstandingTasks) signalLocked() {
+	close(o.notifyC)
+	o.notifyC = make(chan struct{})
+}
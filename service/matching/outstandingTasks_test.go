@@ -0,0 +1,136 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package matching
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutstandingTasks_NoLimit(t *testing.T) {
+	o := newOutstandingTasks("tl", func() int { return 0 })
+	reserved, err := o.reserve(context.Background())
+	require.NoError(t, err)
+	require.False(t, reserved)
+	require.Equal(t, 0, o.count())
+}
+
+func TestOutstandingTasks_ReserveStartRelease(t *testing.T) {
+	o := newOutstandingTasks("tl", func() int { return 2 })
+	key1 := outstandingTaskKey{workflowID: "wid", runID: "rid", scheduleID: 1}
+	key2 := outstandingTaskKey{workflowID: "wid", runID: "rid", scheduleID: 2}
+
+	for _, key := range []outstandingTaskKey{key1, key2} {
+		reserved, err := o.reserve(context.Background())
+		require.NoError(t, err)
+		require.True(t, reserved)
+		o.start(key, time.Minute)
+	}
+	require.Equal(t, 2, o.count())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := o.reserve(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	o.release(key1)
+	o.release(key1) // releasing twice is a no-op
+	require.Equal(t, 1, o.count())
+	reserved, err := o.reserve(context.Background())
+	require.NoError(t, err)
+	require.True(t, reserved)
+	o.cancel()
+	require.Equal(t, 1, o.count())
+}
+
+func TestOutstandingTasks_WaiterWokenUpOnRelease(t *testing.T) {
+	o := newOutstandingTasks("tl", func() int { return 1 })
+	key := outstandingTaskKey{workflowID: "wid", runID: "rid", scheduleID: 1}
+	_, err := o.reserve(context.Background())
+	require.NoError(t, err)
+	o.start(key, time.Minute)
+
+	doneC := make(chan error, 1)
+	go func() {
+		_, err := o.reserve(context.Background())
+		doneC <- err
+	}()
+
+	select {
+	case <-doneC:
+		t.Fatal("reserve should block while the limit is reached")
+	case <-time.After(50 * time.Millisecond):
+	}
+	o.release(key)
+	select {
+	case err := <-doneC:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("reserve should return once a slot is freed")
+	}
+}
+
+func TestOutstandingTasks_Expiry(t *testing.T) {
+	o := newOutstandingTasks("tl", func() int { return 1 })
+	_, err := o.reserve(context.Background())
+	require.NoError(t, err)
+	o.start(outstandingTaskKey{workflowID: "wid", runID: "rid", scheduleID: 1}, 50*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	reserved, err := o.reserve(ctx)
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.Equal(t, 0, o.count())
+}
+
+func TestOutstandingTasks_LimitRemoved(t *testing.T) {
+	limit := 1
+	var lock sync.Mutex
+	o := newOutstandingTasks("tl", func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return limit
+	})
+	_, err := o.reserve(context.Background())
+	require.NoError(t, err)
+	o.start(outstandingTaskKey{workflowID: "wid", runID: "rid", scheduleID: 1}, time.Minute)
+
+	doneC := make(chan bool, 1)
+	go func() {
+		reserved, _ := o.reserve(context.Background())
+		doneC <- reserved
+	}()
+	time.Sleep(20 * time.Millisecond)
+	lock.Lock()
+	limit = 0
+	lock.Unlock()
+	o.signal()
+	select {
+	case reserved := <-doneC:
+		require.False(t, reserved)
+	case <-time.After(time.Second):
+		t.Fatal("reserve should return once the limit is removed")
+	}
+}
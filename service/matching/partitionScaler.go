@@ -0,0 +1,310 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"time"
+
+	m "github.com/uber/cadence/.gen/go/matching"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// partitionScaler adds and removes partitions of a task list at runtime based on
+	// the observed add and poll rates. It only runs on the root partition, which owns
+	// the partition config of the task list and persists it along with the task list.
+	//
+	// Scaling out raises both the read and the write partition count. Scaling in only
+	// lowers the write partition count, so the removed partitions stop receiving new
+	// tasks but are still polled. The removed partitions are drained: once clients had
+	// time to pick up the new write partition count and the removed partitions have no
+	// backlog left, the read partition count is lowered as well.
+	partitionScaler struct {
+		taskListID *taskListID
+		config     *taskListConfig
+		client     matching.Client
+		logger     log.Logger
+		scope      func() metrics.Scope
+		// updateFn persists the given partition config for the task list
+		updateFn func(*persistence.TaskListPartitionConfig) error
+
+		// non-forwarded adds and polls seen by the root partition since last evaluation
+		addCount  int64
+		pollCount int64
+
+		numReadPartitions  int32
+		numWritePartitions int32
+
+		// the fields below are only accessed by the evaluation loop
+		lastEvaluateTime time.Time
+		upscaleSince     time.Time
+		downscaleSince   time.Time
+		writeReducedTime time.Time
+
+		shutdownCh chan struct{}
+	}
+)
+
+const (
+	partitionDrainCheckTimeout = 5 * time.Second
+)
+
+func newPartitionScaler(
+	taskListID *taskListID,
+	config *taskListConfig,
+	client matching.Client,
+	logger log.Logger,
+	scopeFunc func() metrics.Scope,
+	updateFn func(*persistence.TaskListPartitionConfig) error,
+) *partitionScaler {
+	return &partitionScaler{
+		taskListID: taskListID,
+		config:     config,
+		client:     client,
+		logger:     logger,
+		scope:      scopeFunc,
+		updateFn:   updateFn,
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+// Start initializes the partition counts from the persisted partition config, if
+// any, and starts the evaluation loop
+func (ps *partitionScaler) Start(partitionConfig *persistence.TaskListPartitionConfig, now time.Time) {
+	numRead, numWrite := ps.config.NumReadPartitions(), ps.config.NumWritePartitions()
+	if partitionConfig != nil {
+		numRead, numWrite = partitionConfig.NumReadPartitions, partitionConfig.NumWritePartitions
+	}
+	ps.setPartitionCounts(common.MaxInt(numRead, numWrite), numWrite)
+	ps.lastEvaluateTime = now
+	if numRead > numWrite {
+		// a drain was in progress before the task list got loaded here, start the
+		// grace period over since there is no way to tell when it started
+		ps.writeReducedTime = now
+	}
+	go ps.evaluateLoop()
+}
+
+// Stop stops the evaluation loop
+func (ps *partitionScaler) Stop() {
+	close(ps.shutdownCh)
+}
+
+// RecordAdd records a task added to the root partition
+func (ps *partitionScaler) RecordAdd() {
+	atomic.AddInt64(&ps.addCount, 1)
+}
+
+// RecordPoll records a poll received by the root partition
+func (ps *partitionScaler) RecordPoll() {
+	atomic.AddInt64(&ps.pollCount, 1)
+}
+
+// NumReadPartitions returns the number of partitions pollers can be sent to
+func (ps *partitionScaler) NumReadPartitions() int {
+	if !ps.config.EnablePartitionAutoScale() {
+		return ps.config.NumReadPartitions()
+	}
+	return int(atomic.LoadInt32(&ps.numReadPartitions))
+}
+
+// NumWritePartitions returns the number of partitions tasks can be added to
+func (ps *partitionScaler) NumWritePartitions() int {
+	if !ps.config.EnablePartitionAutoScale() {
+		return ps.config.NumWritePartitions()
+	}
+	return int(atomic.LoadInt32(&ps.numWritePartitions))
+}
+
+func (ps *partitionScaler) evaluateLoop() {
+	timer := time.NewTimer(ps.config.PartitionScaleInterval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-ps.shutdownCh:
+			return
+		case now := <-timer.C:
+			ps.evaluate(now)
+			timer.Reset(ps.config.PartitionScaleInterval())
+		}
+	}
+}
+
+// evaluate decides whether partitions need to be added or removed based on the
+// load observed since the previous evaluation
+func (ps *partitionScaler) evaluate(now time.Time) {
+	elapsed := now.Sub(ps.lastEvaluateTime)
+	ps.lastEvaluateTime = now
+	addCount := atomic.SwapInt64(&ps.addCount, 0)
+	pollCount := atomic.SwapInt64(&ps.pollCount, 0)
+	if !ps.config.EnablePartitionAutoScale() || elapsed <= 0 {
+		ps.upscaleSince = time.Time{}
+		ps.downscaleSince = time.Time{}
+		return
+	}
+
+	numRead := int(atomic.LoadInt32(&ps.numReadPartitions))
+	numWrite := int(atomic.LoadInt32(&ps.numWritePartitions))
+
+	// the root partition only observes its own share of the load, which the
+	// load balancer spreads evenly across all the partitions
+	addRate := float64(addCount) / elapsed.Seconds() * float64(numWrite)
+	pollRate := float64(pollCount) / elapsed.Seconds() * float64(numRead)
+	rate := math.Max(addRate, pollRate)
+
+	upscaleRPS := float64(ps.config.PartitionUpscaleRPS())
+	downscaleFactor := ps.config.PartitionDownscaleFactor()
+	if downscaleFactor <= 0 || downscaleFactor > 1 {
+		downscaleFactor = 1
+	}
+	upscaleTarget := ps.targetPartitions(rate, upscaleRPS)
+	downscaleTarget := ps.targetPartitions(rate, upscaleRPS*downscaleFactor)
+
+	switch {
+	case upscaleTarget > numWrite:
+		ps.downscaleSince = time.Time{}
+		if ps.upscaleSince.IsZero() {
+			ps.upscaleSince = now
+		}
+		if now.Sub(ps.upscaleSince) >= ps.config.PartitionUpscaleSustainedTime() {
+			ps.upscaleSince = time.Time{}
+			// partitions still being drained are reused before new ones are added
+			ps.updatePartitionCounts(common.MaxInt(numRead, upscaleTarget), upscaleTarget, metrics.PartitionUpscaleCounter)
+		}
+	case downscaleTarget < numWrite:
+		ps.upscaleSince = time.Time{}
+		if ps.downscaleSince.IsZero() {
+			ps.downscaleSince = now
+		}
+		if now.Sub(ps.downscaleSince) >= ps.config.PartitionDownscaleSustainedTime() {
+			ps.downscaleSince = time.Time{}
+			if ps.updatePartitionCounts(numRead, downscaleTarget, metrics.PartitionDownscaleCounter) {
+				ps.writeReducedTime = now
+			}
+		}
+	default:
+		ps.upscaleSince = time.Time{}
+		ps.downscaleSince = time.Time{}
+	}
+
+	if atomic.LoadInt32(&ps.numReadPartitions) > atomic.LoadInt32(&ps.numWritePartitions) {
+		ps.drain(now)
+	}
+}
+
+// targetPartitions returns the number of partitions needed so that none of the
+// partitions exceeds the given rate
+func (ps *partitionScaler) targetPartitions(rate float64, rpsPerPartition float64) int {
+	minPartitions := ps.config.NumWritePartitions()
+	maxPartitions := common.MaxInt(minPartitions, ps.config.MaxTasklistPartitions())
+	target := int(math.Ceil(rate / rpsPerPartition))
+	return common.MinInt(maxPartitions, common.MaxInt(minPartitions, target))
+}
+
+// drain lowers the read partition count down to the highest partition that is
+// no longer written to but still has backlog
+func (ps *partitionScaler) drain(now time.Time) {
+	// clients keep adding tasks to the removed partitions until they refresh their
+	// cached partition config, wait for them before looking at the backlogs
+	if now.Sub(ps.writeReducedTime) < 2*ps.config.PartitionConfigRefreshInterval() {
+		return
+	}
+
+	numRead := int(atomic.LoadInt32(&ps.numReadPartitions))
+	numWrite := int(atomic.LoadInt32(&ps.numWritePartitions))
+	newNumRead := numWrite
+	for partition := numRead - 1; partition >= numWrite; partition-- {
+		if !ps.isDrained(partition) {
+			newNumRead = partition + 1
+			break
+		}
+	}
+	if newNumRead < numRead {
+		ps.updatePartitionCounts(newNumRead, numWrite, metrics.PartitionDrainedCounter)
+	}
+}
+
+// isDrained returns true when all the tasks persisted in the given partition have
+// been dispatched. Describing the partition also loads it, which makes it forward
+// its backlog to the parent partition if nobody polls it anymore
+func (ps *partitionScaler) isDrained(partition int) bool {
+	taskListType := s.TaskListTypeDecision
+	if ps.taskListID.taskType == persistence.TaskListTypeActivity {
+		taskListType = s.TaskListTypeActivity
+	}
+	name := ps.taskListID.mkName(partition)
+	ctx, cancel := context.WithTimeout(context.Background(), partitionDrainCheckTimeout)
+	defer cancel()
+	resp, err := ps.client.DescribeTaskList(ctx, &m.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(ps.taskListID.domainID),
+		DescRequest: &s.DescribeTaskListRequest{
+			TaskList: &s.TaskList{
+				Name: common.StringPtr(name),
+				Kind: common.TaskListKindPtr(s.TaskListKindNormal),
+			},
+			TaskListType:          &taskListType,
+			IncludeTaskListStatus: common.BoolPtr(true),
+		},
+	})
+	if err != nil {
+		ps.logger.Warn("Failed to describe task list partition being drained",
+			tag.WorkflowTaskListName(name), tag.Error(err))
+		return false
+	}
+
+	// all the tasks written before the partition acquired its current lease must
+	// have been read, and all the tasks read must have been completed
+	status := resp.GetTaskListStatus()
+	return status.GetBacklogCountHint() == 0 &&
+		status.GetReadLevel() >= status.GetTaskIDBlock().GetStartID()-1
+}
+
+func (ps *partitionScaler) updatePartitionCounts(numRead int, numWrite int, counter int) bool {
+	err := ps.updateFn(&persistence.TaskListPartitionConfig{
+		NumReadPartitions:  numRead,
+		NumWritePartitions: numWrite,
+	})
+	if err != nil {
+		ps.logger.Error("Failed to update task list partition config",
+			tag.NumReadPartitions(numRead), tag.NumWritePartitions(numWrite), tag.Error(err))
+		return false
+	}
+
+	ps.setPartitionCounts(numRead, numWrite)
+	ps.scope().IncCounter(counter)
+	ps.logger.Info("Task list partition config updated",
+		tag.NumReadPartitions(numRead), tag.NumWritePartitions(numWrite))
+	return true
+}
+
+func (ps *partitionScaler) setPartitionCounts(numRead int, numWrite int) {
+	atomic.StoreInt32(&ps.numReadPartitions, int32(numRead))
+	atomic.StoreInt32(&ps.numWritePartitions, int32(numWrite))
+}
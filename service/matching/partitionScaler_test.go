@@ -0,0 +1,237 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type partitionScalerSuite struct {
+	suite.Suite
+	controller *gomock.Controller
+	client     *matchingservicetest.MockClient
+	cfg        *taskListConfig
+	taskList   *taskListID
+	updates    []persistence.TaskListPartitionConfig
+	scaler     *partitionScaler
+}
+
+func TestPartitionScalerSuite(t *testing.T) {
+	suite.Run(t, new(partitionScalerSuite))
+}
+
+func (s *partitionScalerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.client = matchingservicetest.NewMockClient(s.controller)
+	s.taskList = newTestTaskListID(uuid.New(), "tl0", persistence.TaskListTypeActivity)
+
+	dc := &cache.DomainCacheMock{}
+	dc.On("GetDomainByID", mock.Anything).Return(cache.CreateDomainCacheEntry("test-domain"), nil)
+	cfg, err := newTaskListConfig(s.taskList, NewConfig(dynamicconfig.NewNopCollection()), dc)
+	s.NoError(err)
+	cfg.NumReadPartitions = func() int { return 1 }
+	cfg.NumWritePartitions = func() int { return 1 }
+	cfg.partitionScalerConfig = partitionScalerConfig{
+		EnablePartitionAutoScale:        func() bool { return true },
+		MaxTasklistPartitions:           func() int { return 10 },
+		PartitionUpscaleRPS:             func() int { return 10 },
+		PartitionDownscaleFactor:        func() float64 { return 0.75 },
+		PartitionUpscaleSustainedTime:   func() time.Duration { return time.Minute },
+		PartitionDownscaleSustainedTime: func() time.Duration { return 2 * time.Minute },
+		PartitionScaleInterval:          func() time.Duration { return time.Hour },
+		PartitionConfigRefreshInterval:  func() time.Duration { return 10 * time.Second },
+	}
+	s.cfg = cfg
+
+	s.updates = nil
+	s.scaler = newPartitionScaler(
+		s.taskList,
+		s.cfg,
+		s.client,
+		loggerimpl.NewDevelopmentForTest(s.Suite),
+		func() metrics.Scope { return metrics.NoopScope(metrics.Matching) },
+		func(partitionConfig *persistence.TaskListPartitionConfig) error {
+			s.updates = append(s.updates, *partitionConfig)
+			return nil
+		},
+	)
+}
+
+func (s *partitionScalerSuite) TearDownTest() {
+	s.scaler.Stop()
+	s.controller.Finish()
+}
+
+func (s *partitionScalerSuite) TestStart_PersistedConfig() {
+	s.scaler.Start(&persistence.TaskListPartitionConfig{NumReadPartitions: 4, NumWritePartitions: 2}, time.Now())
+	s.Equal(4, s.scaler.NumReadPartitions())
+	s.Equal(2, s.scaler.NumWritePartitions())
+}
+
+func (s *partitionScalerSuite) TestAutoScaleDisabled() {
+	s.cfg.EnablePartitionAutoScale = func() bool { return false }
+	now := time.Now()
+	s.scaler.Start(&persistence.TaskListPartitionConfig{NumReadPartitions: 4, NumWritePartitions: 4}, now)
+	s.Equal(1, s.scaler.NumReadPartitions())
+	s.Equal(1, s.scaler.NumWritePartitions())
+
+	s.recordAdds(10000)
+	s.scaler.evaluate(now.Add(time.Minute))
+	s.scaler.evaluate(now.Add(5 * time.Minute))
+	s.Empty(s.updates)
+}
+
+func (s *partitionScalerSuite) TestUpscale_AfterSustainedTime() {
+	now := time.Now()
+	s.scaler.Start(nil, now)
+
+	// 30 tasks per second need 3 partitions
+	s.recordAdds(300)
+	s.scaler.evaluate(now.Add(10 * time.Second))
+	s.Empty(s.updates)
+
+	s.recordAdds(1800)
+	s.scaler.evaluate(now.Add(70 * time.Second))
+	s.Equal([]persistence.TaskListPartitionConfig{{NumReadPartitions: 3, NumWritePartitions: 3}}, s.updates)
+	s.Equal(3, s.scaler.NumReadPartitions())
+	s.Equal(3, s.scaler.NumWritePartitions())
+}
+
+func (s *partitionScalerSuite) TestUpscale_LoadNotSustained() {
+	now := time.Now()
+	s.scaler.Start(nil, now)
+
+	s.recordAdds(300)
+	s.scaler.evaluate(now.Add(10 * time.Second))
+	s.scaler.evaluate(now.Add(40 * time.Second))
+	s.recordAdds(1000)
+	s.scaler.evaluate(now.Add(100 * time.Second))
+	s.Empty(s.updates)
+	s.Equal(1, s.scaler.NumReadPartitions())
+}
+
+func (s *partitionScalerSuite) TestUpscale_CappedByMaxPartitions() {
+	now := time.Now()
+	s.scaler.Start(nil, now)
+
+	s.recordPolls(1000)
+	s.scaler.evaluate(now.Add(time.Second))
+	s.recordPolls(60000)
+	s.scaler.evaluate(now.Add(61 * time.Second))
+	s.Equal([]persistence.TaskListPartitionConfig{{NumReadPartitions: 10, NumWritePartitions: 10}}, s.updates)
+}
+
+func (s *partitionScalerSuite) TestDownscale_ThenDrain() {
+	now := time.Now()
+	s.scaler.Start(&persistence.TaskListPartitionConfig{NumReadPartitions: 4, NumWritePartitions: 4}, now)
+
+	s.scaler.evaluate(now.Add(time.Minute))
+	s.Empty(s.updates)
+
+	// only the write partitions are removed, the removed ones are still polled
+	s.scaler.evaluate(now.Add(3 * time.Minute))
+	s.Equal([]persistence.TaskListPartitionConfig{{NumReadPartitions: 4, NumWritePartitions: 1}}, s.updates)
+	s.Equal(4, s.scaler.NumReadPartitions())
+	s.Equal(1, s.scaler.NumWritePartitions())
+
+	// partition 3 is drained but partition 2 still has backlog
+	s.expectDescribe(3, 0, 10, 11)
+	s.expectDescribe(2, 5, 10, 11)
+	s.scaler.evaluate(now.Add(3*time.Minute + 30*time.Second))
+	s.Equal(persistence.TaskListPartitionConfig{NumReadPartitions: 3, NumWritePartitions: 1}, s.updates[1])
+	s.Equal(3, s.scaler.NumReadPartitions())
+
+	s.expectDescribe(2, 0, 20, 21)
+	s.expectDescribe(1, 0, 10, 1)
+	s.scaler.evaluate(now.Add(4 * time.Minute))
+	s.Equal(persistence.TaskListPartitionConfig{NumReadPartitions: 1, NumWritePartitions: 1}, s.updates[2])
+	s.Equal(1, s.scaler.NumReadPartitions())
+	s.Equal(1, s.scaler.NumWritePartitions())
+}
+
+func (s *partitionScalerSuite) TestDrain_WaitsForClientRefresh() {
+	now := time.Now()
+	s.scaler.Start(&persistence.TaskListPartitionConfig{NumReadPartitions: 2, NumWritePartitions: 1}, now)
+
+	// no DescribeTaskList calls are expected within the grace period
+	s.scaler.evaluate(now.Add(10 * time.Second))
+	s.Empty(s.updates)
+
+	s.expectDescribe(1, 0, 10, 11)
+	s.scaler.evaluate(now.Add(30 * time.Second))
+	s.Equal([]persistence.TaskListPartitionConfig{{NumReadPartitions: 1, NumWritePartitions: 1}}, s.updates)
+}
+
+func (s *partitionScalerSuite) TestUpscale_ReusesDrainingPartitions() {
+	now := time.Now()
+	s.scaler.Start(&persistence.TaskListPartitionConfig{NumReadPartitions: 4, NumWritePartitions: 1}, now)
+
+	s.recordAdds(200)
+	s.scaler.evaluate(now.Add(10 * time.Second))
+	s.recordAdds(1200)
+	s.expectDescribe(3, 5, 10, 11)
+	s.scaler.evaluate(now.Add(70 * time.Second))
+	s.Equal([]persistence.TaskListPartitionConfig{{NumReadPartitions: 4, NumWritePartitions: 2}}, s.updates)
+	s.Equal(4, s.scaler.NumReadPartitions())
+	s.Equal(2, s.scaler.NumWritePartitions())
+}
+
+func (s *partitionScalerSuite) expectDescribe(partition int, backlog int64, readLevel int64, startID int64) {
+	s.client.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *gen.DescribeTaskListRequest) (*shared.DescribeTaskListResponse, error) {
+			s.Equal(s.taskList.mkName(partition), request.DescRequest.TaskList.GetName())
+			s.Equal(shared.TaskListTypeActivity, request.DescRequest.GetTaskListType())
+			return &shared.DescribeTaskListResponse{
+				TaskListStatus: &shared.TaskListStatus{
+					BacklogCountHint: common.Int64Ptr(backlog),
+					ReadLevel:        common.Int64Ptr(readLevel),
+					TaskIDBlock:      &shared.TaskIDBlock{StartID: common.Int64Ptr(startID)},
+				},
+			}, nil
+		})
+}
+
+func (s *partitionScalerSuite) recordAdds(count int) {
+	for i := 0; i < count; i++ {
+		s.scaler.RecordAdd()
+	}
+}
+
+func (s *partitionScalerSuite) recordPolls(count int) {
+	for i := 0; i < count; i++ {
+		s.scaler.RecordPoll()
+	}
+}
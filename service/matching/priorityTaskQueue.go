@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package matching
+
+import (
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// priorityTaskQueue orders the tasks loaded from persistence for dispatch. Tasks
+	// with a higher priority are dispatched first, tasks of the same priority are
+	// dispatched round robin across their fairness keys and in the order they were
+	// added within a fairness key
+	priorityTaskQueue struct {
+		levels []*priorityLevel // sorted by descending priority
+		size   int
+	}
+
+	priorityLevel struct {
+		priority int32
+		keys     []string // fairness keys with pending tasks, in round robin order
+		next     int      // index in keys of the fairness key to dispatch from next
+		tasks    map[string][]*persistence.TaskInfo
+	}
+)
+
+func newPriorityTaskQueue() *priorityTaskQueue {
+	return &priorityTaskQueue{}
+}
+
+// Add adds a task to the queue
+func (q *priorityTaskQueue) Add(task *persistence.TaskInfo) {
+	level := q.getOrCreateLevel(task.Priority)
+	tasks, ok := level.tasks[task.FairnessKey]
+	if !ok {
+		level.keys = append(level.keys, task.FairnessKey)
+	}
+	level.tasks[task.FairnessKey] = append(tasks, task)
+	q.size++
+}
+
+// Pop removes and returns the next task to dispatch, or nil if the queue is empty
+func (q *priorityTaskQueue) Pop() *persistence.TaskInfo {
+	if q.size == 0 {
+		return nil
+	}
+
+	level := q.levels[0]
+	key := level.keys[level.next]
+	tasks := level.tasks[key]
+	task := tasks[0]
+	tasks[0] = nil
+	if len(tasks) > 1 {
+		level.tasks[key] = tasks[1:]
+		level.next++
+	} else {
+		delete(level.tasks, key)
+		level.keys = append(level.keys[:level.next], level.keys[level.next+1:]...)
+	}
+	if level.next >= len(level.keys) {
+		level.next = 0
+	}
+	if len(level.keys) == 0 {
+		q.levels = q.levels[1:]
+	}
+	q.size--
+	return task
+}
+
+// Len returns the number of tasks in the queue
+func (q *priorityTaskQueue) Len() int {
+	return q.size
+}
+
+func (q *priorityTaskQueue) getOrCreateLevel(priority int32) *priorityLevel {
+	i := 0
+	for ; i < len(q.levels); i++ {
+		if q.levels[i].priority == priority {
+			return q.levels[i]
+		}
+		if q.levels[i].priority < priority {
+			break
+		}
+	}
+
+	level := &priorityLevel{
+		priority: priority,
+		tasks:    make(map[string][]*persistence.TaskInfo),
+	}
+	q.levels = append(q.levels, nil)
+	copy(q.levels[i+1:], q.levels[i:])
+	q.levels[i] = level
+	return level
+}
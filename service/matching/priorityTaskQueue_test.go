@@ -0,0 +1,84 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestPriorityTaskQueue_Empty(t *testing.T) {
+	q := newPriorityTaskQueue()
+	require.Equal(t, 0, q.Len())
+	require.Nil(t, q.Pop())
+}
+
+func TestPriorityTaskQueue_DefaultsToFIFO(t *testing.T) {
+	q := newPriorityTaskQueue()
+	for i := int64(1); i <= 5; i++ {
+		q.Add(&persistence.TaskInfo{TaskID: i})
+	}
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, popTaskIDs(q))
+}
+
+func TestPriorityTaskQueue_HigherPriorityFirst(t *testing.T) {
+	q := newPriorityTaskQueue()
+	q.Add(&persistence.TaskInfo{TaskID: 1, Priority: 0})
+	q.Add(&persistence.TaskInfo{TaskID: 2, Priority: 5})
+	q.Add(&persistence.TaskInfo{TaskID: 3, Priority: -1})
+	q.Add(&persistence.TaskInfo{TaskID: 4, Priority: 5})
+	q.Add(&persistence.TaskInfo{TaskID: 5, Priority: 1})
+	require.Equal(t, 5, q.Len())
+	require.Equal(t, []int64{2, 4, 5, 1, 3}, popTaskIDs(q))
+}
+
+func TestPriorityTaskQueue_RoundRobinAcrossFairnessKeys(t *testing.T) {
+	q := newPriorityTaskQueue()
+	q.Add(&persistence.TaskInfo{TaskID: 1, FairnessKey: "a"})
+	q.Add(&persistence.TaskInfo{TaskID: 2, FairnessKey: "a"})
+	q.Add(&persistence.TaskInfo{TaskID: 3, FairnessKey: "a"})
+	q.Add(&persistence.TaskInfo{TaskID: 4, FairnessKey: "b"})
+	q.Add(&persistence.TaskInfo{TaskID: 5, FairnessKey: "c"})
+	q.Add(&persistence.TaskInfo{TaskID: 6, FairnessKey: "b"})
+	require.Equal(t, []int64{1, 4, 5, 2, 6, 3}, popTaskIDs(q))
+}
+
+func TestPriorityTaskQueue_AddWhilePopping(t *testing.T) {
+	q := newPriorityTaskQueue()
+	q.Add(&persistence.TaskInfo{TaskID: 1, FairnessKey: "a"})
+	q.Add(&persistence.TaskInfo{TaskID: 2, FairnessKey: "a"})
+	q.Add(&persistence.TaskInfo{TaskID: 3, FairnessKey: "b"})
+	require.Equal(t, int64(1), q.Pop().TaskID)
+
+	q.Add(&persistence.TaskInfo{TaskID: 4, Priority: 1, FairnessKey: "a"})
+	q.Add(&persistence.TaskInfo{TaskID: 5, FairnessKey: "c"})
+	require.Equal(t, []int64{4, 3, 5, 2}, popTaskIDs(q))
+	require.Equal(t, 0, q.Len())
+}
+
+func popTaskIDs(q *priorityTaskQueue) []int64 {
+	var taskIDs []int64
+	for q.Len() > 0 {
+		taskIDs = append(taskIDs, q.Pop().TaskID)
+	}
+	return taskIDs
+}
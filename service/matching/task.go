@@ -21,6 +21,8 @@
 package matching
 
 import (
+	"time"
+
 	m "github.com/uber/cadence/.gen/go/matching"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/persistence"
@@ -54,6 +56,8 @@ type (
 		forwardedFrom    string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		responseC        chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint int64
+		// non-nil when the poller of this task holds a slot reserved on a task list capping outstanding tasks
+		outstanding *outstandingTasks
 	}
 )
 
@@ -141,6 +145,23 @@ func (task *internalTask) pollForActivityResponse() *s.PollForActivityTaskRespon
 	return nil
 }
 
+// startOutstanding records the task as outstanding on the task list the poller reserved a slot on,
+// the slot is held until the worker responds or the timeout expires
+func (task *internalTask) startOutstanding(key outstandingTaskKey, timeout time.Duration) {
+	if task.outstanding != nil {
+		task.outstanding.start(key, timeout)
+		task.outstanding = nil
+	}
+}
+
+// cancelOutstanding gives back the slot reserved by the poller of this task, if any
+func (task *internalTask) cancelOutstanding() {
+	if task.outstanding != nil {
+		task.outstanding.cancel()
+		task.outstanding = nil
+	}
+}
+
 // finish marks a task as finished. Should be called after a poller picks up a task
 // and marks it as started. If the task is unable to marked as started, then this
 // method should be called with a non-nil error argument.
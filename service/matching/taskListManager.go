@@ -74,6 +74,10 @@ type (
 		GetAllPollerInfo() []*s.PollerInfo
 		// DescribeTaskList returns information about the target tasklist
 		DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse
+		// UpdateLimits persists and applies the limits pinned on the tasklist, nil removes them
+		UpdateLimits(limits *persistence.TaskListLimits) error
+		// ReleaseTask frees the slot held by an outstanding task once the worker responded to it
+		ReleaseTask(workflowID string, runID string, scheduleID int64)
 		String() string
 	}
 
@@ -87,8 +91,11 @@ type (
 		taskWriter       *taskWriter
 		taskReader       *taskReader // reads tasks from db and async matches it with poller
 		taskGC           *taskGC
-		taskAckManager   ackManager   // tracks ackLevel for delivered messages
-		matcher          *TaskMatcher // for matching a task producer with a poller
+		taskAckManager   ackManager        // tracks ackLevel for delivered messages
+		matcher          *TaskMatcher      // for matching a task producer with a poller
+		partitionScaler  *partitionScaler  // only set on the root partition of normal task lists
+		outstandingTasks *outstandingTasks // caps tasks handed to workers and not completed yet
+		limits           atomic.Value      // *persistence.TaskListLimits pinned through the admin API
 		domainCache      cache.DomainCache
 		logger           log.Logger
 		metricsClient    metrics.Client
@@ -159,7 +166,13 @@ func newTaskListManager(
 	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
 		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient, tlMgr.domainScope)
 	}
-	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.domainScope)
+	if taskList.IsRoot() && *taskListKind == s.TaskListKindNormal {
+		tlMgr.partitionScaler = newPartitionScaler(
+			taskList, taskListConfig, e.matchingClient, tlMgr.logger, tlMgr.domainScope, tlMgr.updatePartitionConfig)
+	}
+	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.domainScope, tlMgr.numReadPartitions)
+	tlMgr.outstandingTasks = newOutstandingTasks(taskList.name, tlMgr.maxOutstandingTasks)
+	tlMgr.limits.Store((*persistence.TaskListLimits)(nil))
 	tlMgr.startWG.Add(1)
 	return tlMgr, nil
 }
@@ -177,8 +190,12 @@ func (c *taskListManagerImpl) Start() error {
 	}
 
 	c.taskAckManager.setAckLevel(state.ackLevel)
+	c.applyLimits(state.limits)
 	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
 	c.taskReader.Start()
+	if c.partitionScaler != nil {
+		c.partitionScaler.Start(state.partitionConfig, time.Now())
+	}
 
 	return nil
 }
@@ -191,6 +208,9 @@ func (c *taskListManagerImpl) Stop() {
 	close(c.shutdownCh)
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
+	if c.partitionScaler != nil {
+		c.partitionScaler.Stop()
+	}
 	c.engine.removeTaskListManager(c.taskListID)
 	c.engine.removeTaskListManager(c.taskListID)
 	c.logger.Info("", tag.LifeCycleStopped)
@@ -201,6 +221,9 @@ func (c *taskListManagerImpl) Stop() {
 // be written to database and later asynchronously matched with a poller
 func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams) (bool, error) {
 	c.startWG.Wait()
+	if c.partitionScaler != nil && params.forwardedFrom == "" {
+		c.partitionScaler.RecordAdd()
+	}
 	var syncMatch bool
 	_, err := c.executeWithRetry(func() (interface{}, error) {
 
@@ -215,9 +238,12 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return r, err
 		}
 
-		syncMatch, err = c.trySyncMatch(ctx, params)
-		if syncMatch {
-			return &persistence.CreateTasksResponse{}, err
+		// a task is not allowed to jump ahead of higher priority tasks in the backlog
+		if !c.taskReader.hasHigherPriorityBacklog(params.taskInfo.Priority) {
+			syncMatch, err = c.trySyncMatch(ctx, params)
+			if syncMatch {
+				return &persistence.CreateTasksResponse{}, err
+			}
 		}
 
 		r, err := c.taskWriter.appendTask(params.execution, params.taskInfo)
@@ -305,6 +331,11 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
 	}
 
+	forwardedFrom, _ := ctx.Value(forwardedFromKey).(string)
+	if c.partitionScaler != nil && forwardedFrom == "" {
+		c.partitionScaler.RecordPoll()
+	}
+
 	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
 	if err != nil {
 		return nil, err
@@ -314,14 +345,35 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// poller, which lives inside the client side worker. There is
 	// one rateLimiter for this entire task list and as we get polls,
 	// we update the ratelimiter rps if it has changed from the last
-	// value. Last poller wins if different pollers provide different values
+	// value. Last poller wins if different pollers provide different values,
+	// unless the rate is pinned through the admin API
+	if limits := c.getLimits(); limits != nil && limits.MaxTasksPerSecond > 0 {
+		maxDispatchPerSecond = common.Float64Ptr(limits.MaxTasksPerSecond)
+	}
 	c.matcher.UpdateRatelimit(maxDispatchPerSecond)
 
 	if domainEntry.GetDomainNotActiveErr() != nil {
 		return c.matcher.PollForQuery(childCtx)
 	}
 
-	return c.matcher.Poll(childCtx)
+	// polls forwarded from child partitions hold a slot on the child partition
+	reserved := false
+	if forwardedFrom == "" {
+		if reserved, err = c.outstandingTasks.reserve(childCtx); err != nil {
+			return nil, ErrNoTasks
+		}
+	}
+	task, err := c.matcher.Poll(childCtx)
+	if err != nil {
+		if reserved {
+			c.outstandingTasks.cancel()
+		}
+		return nil, err
+	}
+	if reserved {
+		task.outstanding = c.outstandingTasks
+	}
+	return task, nil
 }
 
 // GetAllPollerInfo returns all pollers that polled from this tasklist in last few minutes
@@ -340,10 +392,23 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 }
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
-// pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// pollers which polled this tasklist in last few minutes, the partition config when this is
+// the root partition and status of tasklist's ackManager (readLevel, ackLevel, backlogCountHint
+// and taskIDBlock).
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse {
 	response := &s.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
+	if limits := c.getLimits(); limits != nil {
+		response.Limits = &s.TaskListLimits{
+			MaxTasksPerSecond:   common.Float64Ptr(limits.MaxTasksPerSecond),
+			MaxOutstandingTasks: common.Int32Ptr(int32(limits.MaxOutstandingTasks)),
+		}
+	}
+	if c.partitionScaler != nil {
+		response.PartitionConfig = &s.TaskListPartitionConfig{
+			NumReadPartitions:  common.Int32Ptr(int32(c.partitionScaler.NumReadPartitions())),
+			NumWritePartitions: common.Int32Ptr(int32(c.partitionScaler.NumWritePartitions())),
+		}
+	}
 	if !includeTaskListStatus {
 		return response
 	}
@@ -354,6 +419,7 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.De
 		AckLevel:         common.Int64Ptr(c.taskAckManager.getAckLevel()),
 		BacklogCountHint: common.Int64Ptr(c.taskAckManager.getBacklogCountHint()),
 		RatePerSecond:    common.Float64Ptr(c.matcher.Rate()),
+		OutstandingTasks: common.Int32Ptr(int32(c.outstandingTasks.count())),
 		TaskIDBlock: &s.TaskIDBlock{
 			StartID: common.Int64Ptr(taskIDBlock.start),
 			EndID:   common.Int64Ptr(taskIDBlock.end),
@@ -452,6 +518,74 @@ func (c *taskListManagerImpl) allocTaskIDBlock(prevBlockEnd int64) (taskIDBlock,
 	return c.rangeIDToTaskIDBlock(state.rangeID), nil
 }
 
+// numReadPartitions returns the current number of read partitions of the task list
+func (c *taskListManagerImpl) numReadPartitions() int {
+	if c.partitionScaler != nil {
+		return c.partitionScaler.NumReadPartitions()
+	}
+	if c.taskListKind == persistence.TaskListKindSticky {
+		return c.config.NumReadPartitions()
+	}
+	// the partition count of a task list is owned by its root partition
+	return c.engine.partitionConfigProvider.GetNumberOfReadPartitions(
+		c.taskListID.domainID,
+		s.TaskList{Name: common.StringPtr(c.taskListID.baseName)},
+		c.taskListID.taskType,
+	)
+}
+
+func (c *taskListManagerImpl) updatePartitionConfig(partitionConfig *persistence.TaskListPartitionConfig) error {
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.UpdatePartitionConfig(partitionConfig)
+	})
+	return err
+}
+
+// UpdateLimits persists and applies the limits pinned on the tasklist, nil removes them
+func (c *taskListManagerImpl) UpdateLimits(limits *persistence.TaskListLimits) error {
+	c.startWG.Wait()
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.UpdateLimits(limits)
+	})
+	if err != nil {
+		return err
+	}
+	c.applyLimits(limits)
+	return nil
+}
+
+// ReleaseTask frees the slot held by an outstanding task once the worker responded to it
+func (c *taskListManagerImpl) ReleaseTask(workflowID string, runID string, scheduleID int64) {
+	c.outstandingTasks.release(outstandingTaskKey{workflowID: workflowID, runID: runID, scheduleID: scheduleID})
+}
+
+func (c *taskListManagerImpl) applyLimits(limits *persistence.TaskListLimits) {
+	c.limits.Store(limits)
+	if limits != nil && limits.MaxTasksPerSecond > 0 {
+		c.matcher.UpdateRatelimit(common.Float64Ptr(limits.MaxTasksPerSecond))
+	} else {
+		// go back to the rate set by pollers, which is applied again on the next poll
+		c.matcher.UpdateRatelimit(common.Float64Ptr(_defaultTaskDispatchRPS))
+	}
+	// pollers waiting for a slot need to re-evaluate the cap
+	c.outstandingTasks.signal()
+}
+
+func (c *taskListManagerImpl) getLimits() *persistence.TaskListLimits {
+	return c.limits.Load().(*persistence.TaskListLimits)
+}
+
+// maxOutstandingTasks returns the share of the max outstanding tasks enforced by this partition,
+// the cap is divided equally across all partitions
+func (c *taskListManagerImpl) maxOutstandingTasks() int {
+	limits := c.getLimits()
+	if limits == nil || limits.MaxOutstandingTasks <= 0 {
+		return 0
+	}
+	nPartitions := c.numReadPartitions()
+	return (limits.MaxOutstandingTasks + nPartitions - 1) / nPartitions
+}
+
 func (c *taskListManagerImpl) getAckLevel() (ackLevel int64) {
 	return c.taskAckManager.getAckLevel()
 }
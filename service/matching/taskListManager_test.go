@@ -22,6 +22,7 @@ package matching
 
 import (
 	"context"
+	"math"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -78,6 +79,32 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	wg.Wait()
 }
 
+func TestDeliverBufferTasks_Priority(t *testing.T) {
+	tlm := createTestTaskListManager()
+	require.False(t, tlm.taskReader.hasHigherPriorityBacklog(math.MinInt32))
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{ScheduleID: 1, Priority: 0}
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{ScheduleID: 2, Priority: 5}
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{ScheduleID: 3, Priority: 0}
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{ScheduleID: 4, Priority: 5}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		tlm.taskReader.dispatchBufferedTasks()
+	}()
+
+	var scheduleIDs []int64
+	for i := 0; i < 4; i++ {
+		task, err := tlm.matcher.Poll(context.Background())
+		require.NoError(t, err)
+		scheduleIDs = append(scheduleIDs, task.event.ScheduleID)
+	}
+	require.Equal(t, []int64{2, 4, 1, 3}, scheduleIDs)
+
+	close(tlm.taskReader.dispatcherShutdownC)
+	wg.Wait()
+}
+
 func createTestTaskListManager() *taskListManagerImpl {
 	return createTestTaskListManagerWithConfig(defaultTestConfig())
 }
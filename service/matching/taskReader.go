@@ -22,6 +22,7 @@ package matching
 
 import (
 	"context"
+	"math"
 	"runtime"
 	"time"
 
@@ -29,10 +30,14 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"go.uber.org/atomic"
 )
 
 var epochStartTime = time.Unix(0, 0)
 
+// noBacklogPriority is the dispatch priority reported when no buffered task is being dispatched
+const noBacklogPriority = math.MinInt64
+
 type (
 	taskReader struct {
 		taskBuffer chan *persistence.TaskInfo // tasks loaded from persistence
@@ -48,12 +53,17 @@ type (
 		// separate shutdownC needed for dispatchTasks go routine to allow
 		// getTasksPump to be stopped without stopping dispatchTasks in unit tests
 		dispatcherShutdownC chan struct{}
+		// priority of the buffered task being dispatched, which is the highest priority
+		// among the buffered tasks
+		dispatchPriority atomic.Int64
+		// number of tasks taken off the buffer that are waiting to be dispatched
+		queuedTasks atomic.Int32
 	}
 )
 
 func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
-	return &taskReader{
+	tr := &taskReader{
 		tlMgr:               tlMgr,
 		cancelCtx:           ctx,
 		cancelFunc:          cancel,
@@ -63,6 +73,8 @@ func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
 		// so allocate one less than desired target buffer size
 		taskBuffer: make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1),
 	}
+	tr.dispatchPriority.Store(noBacklogPriority)
+	return tr
 }
 
 func (tr *taskReader) Start() {
@@ -84,35 +96,68 @@ func (tr *taskReader) Signal() {
 	}
 }
 
+// dispatchBufferedTasks dispatches the buffered tasks to pollers. Rather than dispatching
+// in the order tasks were loaded, up to a batch of buffered tasks is kept in a priority
+// queue so that higher priority tasks and less busy fairness keys are dispatched first.
 func (tr *taskReader) dispatchBufferedTasks() {
+	queue := newPriorityTaskQueue()
 dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task list getTasks pump is shutdown
+		if queue.Len() == 0 {
+			select {
+			case taskInfo, ok := <-tr.taskBuffer:
+				if !ok { // Task list getTasks pump is shutdown
+					break dispatchLoop
+				}
+				queue.Add(taskInfo)
+			case <-tr.dispatcherShutdownC:
 				break dispatchLoop
 			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
-				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+		}
+
+	fillQueueLoop:
+		for queue.Len() < tr.tlMgr.config.GetTasksBatchSize() {
+			select {
+			case taskInfo, ok := <-tr.taskBuffer:
+				if !ok { // Task list getTasks pump is shutdown
 					break dispatchLoop
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottleCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
+				queue.Add(taskInfo)
+			default:
+				break fillQueueLoop
 			}
-		case <-tr.dispatcherShutdownC:
-			break dispatchLoop
+		}
+
+		taskInfo := queue.Pop()
+		tr.queuedTasks.Store(int32(queue.Len()))
+		tr.dispatchPriority.Store(int64(taskInfo.Priority))
+		task := newInternalTask(taskInfo, tr.tlMgr.completeTask, "", false)
+		for {
+			err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+			if err == nil {
+				if queue.Len() == 0 {
+					tr.dispatchPriority.Store(noBacklogPriority)
+				}
+				break
+			}
+			if err == context.Canceled {
+				tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+				break dispatchLoop
+			}
+			// this should never happen unless there is a bug - don't drop the task
+			tr.scope().IncCounter(metrics.BufferThrottleCounter)
+			tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+			runtime.Gosched()
 		}
 	}
 }
 
+// hasHigherPriorityBacklog returns true if buffered tasks with a higher priority
+// than the given one are waiting to be dispatched
+func (tr *taskReader) hasHigherPriorityBacklog(priority int32) bool {
+	return tr.dispatchPriority.Load() > int64(priority)
+}
+
 func (tr *taskReader) getTasksPump() {
 	tr.tlMgr.startWG.Wait()
 	defer close(tr.taskBuffer)
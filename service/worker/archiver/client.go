@@ -67,6 +67,7 @@ type (
 		NextEventID          int64
 		CloseFailoverVersion int64
 		URI                  string // should be historyURI, but keep the existing name for backward compatibility
+		MutableStateSummary  *shared.DescribeWorkflowExecutionResponse
 
 		// visibility archival
 		WorkflowTypeName   string
@@ -209,6 +210,7 @@ func (c *client) archiveHistoryInline(ctx context.Context, request *ClientReques
 		BranchToken:          request.ArchiveRequest.BranchToken,
 		NextEventID:          request.ArchiveRequest.NextEventID,
 		CloseFailoverVersion: request.ArchiveRequest.CloseFailoverVersion,
+		MutableStateSummary:  request.ArchiveRequest.MutableStateSummary,
 	})
 }
 
@@ -0,0 +1,136 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/clock"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// multiplicative decrease of the rate when the backend rejects the request for being overloaded
+	throttledDecreaseFactor = 0.5
+	// multiplicative decrease of the rate when the latency of the request exceeds the target latency
+	latencyDecreaseFactor = 0.8
+	// the rate is decreased at most once per cooldown, requests in flight would otherwise
+	// collapse the rate to the floor for a single spike
+	rateDecreaseCooldown = time.Second
+)
+
+type (
+	// rateController adapts the processing rate of a batch operation to the pressure of the backend.
+	// The rate is increased additively by about one RPS per second while requests succeed within the
+	// target latency, and decreased multiplicatively on ServiceBusyError, LimitExceededError or slow requests.
+	rateController struct {
+		sync.Mutex
+
+		limiter       *rate.Limiter
+		timeSource    clock.TimeSource
+		minRPS        float64
+		maxRPS        float64
+		targetLatency time.Duration
+		rps           float64
+		lastDecrease  time.Time
+	}
+)
+
+func newRateController(
+	initialRPS float64,
+	minRPS float64,
+	maxRPS float64,
+	targetLatency time.Duration,
+	timeSource clock.TimeSource,
+) *rateController {
+
+	rps := math.Max(minRPS, math.Min(maxRPS, initialRPS))
+	return &rateController{
+		// burst of one keeps the processing smooth when the rate is adjusted
+		limiter:       rate.NewLimiter(rate.Limit(rps), 1),
+		timeSource:    timeSource,
+		minRPS:        minRPS,
+		maxRPS:        maxRPS,
+		targetLatency: targetLatency,
+		rps:           rps,
+	}
+}
+
+// Wait blocks until the next request is allowed by the current rate
+func (c *rateController) Wait(ctx context.Context) error {
+	return c.limiter.Wait(ctx)
+}
+
+// Record adjusts the rate based on the result and the latency of a request
+func (c *rateController) Record(err error, latency time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+
+	if isThrottledError(err) {
+		c.decreaseLocked(throttledDecreaseFactor)
+		return
+	}
+	if latency > c.targetLatency {
+		c.decreaseLocked(latencyDecreaseFactor)
+		return
+	}
+	if err == nil {
+		c.setRPSLocked(math.Min(c.maxRPS, c.rps+1/c.rps))
+	}
+}
+
+// RPS returns the current rate
+func (c *rateController) RPS() float64 {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.rps
+}
+
+func (c *rateController) decreaseLocked(factor float64) {
+	now := c.timeSource.Now()
+	if now.Sub(c.lastDecrease) < rateDecreaseCooldown {
+		return
+	}
+	c.lastDecrease = now
+	c.setRPSLocked(math.Max(c.minRPS, c.rps*factor))
+}
+
+func (c *rateController) setRPSLocked(rps float64) {
+	if rps == c.rps {
+		return
+	}
+	c.rps = rps
+	c.limiter.SetLimit(rate.Limit(rps))
+}
+
+func isThrottledError(err error) bool {
+	switch err.(type) {
+	case *shared.ServiceBusyError, *shared.LimitExceededError:
+		return true
+	default:
+		return false
+	}
+}
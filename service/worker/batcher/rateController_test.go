@@ -0,0 +1,91 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/clock"
+)
+
+type rateControllerSuite struct {
+	suite.Suite
+
+	timeSource *clock.EventTimeSource
+	controller *rateController
+}
+
+func TestRateControllerSuite(t *testing.T) {
+	suite.Run(t, new(rateControllerSuite))
+}
+
+func (s *rateControllerSuite) SetupTest() {
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.controller = newRateController(10, 2, 20, time.Second, s.timeSource)
+}
+
+func (s *rateControllerSuite) TestNewRateController_ClampInitialRPS() {
+	s.Equal(float64(20), newRateController(100, 2, 20, time.Second, s.timeSource).RPS())
+	s.Equal(float64(2), newRateController(1, 2, 20, time.Second, s.timeSource).RPS())
+}
+
+func (s *rateControllerSuite) TestRecord_IncreaseOnSuccess() {
+	for i := 0; i < 10; i++ {
+		s.controller.Record(nil, time.Millisecond)
+	}
+	s.InDelta(11, s.controller.RPS(), 0.1)
+
+	for i := 0; i < 1000; i++ {
+		s.controller.Record(nil, time.Millisecond)
+	}
+	s.Equal(float64(20), s.controller.RPS())
+}
+
+func (s *rateControllerSuite) TestRecord_NoIncreaseOnError() {
+	s.controller.Record(errors.New("some error"), time.Millisecond)
+	s.Equal(float64(10), s.controller.RPS())
+}
+
+func (s *rateControllerSuite) TestRecord_DecreaseOnThrottledError() {
+	s.controller.Record(&shared.ServiceBusyError{}, time.Millisecond)
+	s.Equal(float64(5), s.controller.RPS())
+
+	// decreased at most once per cooldown
+	s.controller.Record(&shared.LimitExceededError{}, time.Millisecond)
+	s.Equal(float64(5), s.controller.RPS())
+
+	s.timeSource.Update(s.timeSource.Now().Add(rateDecreaseCooldown))
+	s.controller.Record(&shared.LimitExceededError{}, time.Millisecond)
+	s.Equal(float64(2.5), s.controller.RPS())
+
+	s.timeSource.Update(s.timeSource.Now().Add(rateDecreaseCooldown))
+	s.controller.Record(&shared.ServiceBusyError{}, time.Millisecond)
+	s.Equal(float64(2), s.controller.RPS())
+}
+
+func (s *rateControllerSuite) TestRecord_DecreaseOnHighLatency() {
+	s.controller.Record(nil, 2*time.Second)
+	s.Equal(float64(8), s.controller.RPS())
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/service/history"
+	"go.uber.org/yarpc"
+)
+
+const (
+	// ResetTypeFirstDecisionCompleted resets to the first DecisionTaskCompleted event of the run
+	ResetTypeFirstDecisionCompleted = "FirstDecisionCompleted"
+	// ResetTypeLastDecisionCompleted resets to the last DecisionTaskCompleted event of the run
+	ResetTypeLastDecisionCompleted = "LastDecisionCompleted"
+	// ResetTypeLastContinuedAsNew resets to the last DecisionTaskCompleted event of the run that continued as new to this run
+	ResetTypeLastContinuedAsNew = "LastContinuedAsNew"
+	// ResetTypeBadBinary resets to the first DecisionTaskCompleted event completed by the bad binary
+	ResetTypeBadBinary = "BadBinary"
+
+	resetHistoryPageSize = 1000
+)
+
+// AllResetTypes is the reset types we supported
+var AllResetTypes = []string{ResetTypeFirstDecisionCompleted, ResetTypeLastDecisionCompleted, ResetTypeLastContinuedAsNew, ResetTypeBadBinary}
+
+func validateResetType(resetType string) bool {
+	for _, t := range AllResetTypes {
+		if t == resetType {
+			return true
+		}
+	}
+	return false
+}
+
+// resetWorkflow resets a single workflow run according to the reset params,
+// a run without any reset point fails with BadRequestError so that it is not retried
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	yarpcCallOptions ...yarpc.CallOption,
+) error {
+
+	resetParams := batchParams.ResetParams
+	resp, err := client.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(batchParams.DomainName),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if resetParams.SkipCurrentOpen && resp.WorkflowExecutionInfo.CloseStatus == nil {
+		getActivityLogger(ctx).Info(fmt.Sprintf("Skip resetting workflow %v because current run is open", workflowID))
+		return nil
+	}
+	if runID == "" {
+		runID = resp.WorkflowExecutionInfo.Execution.GetRunId()
+	}
+
+	resetBaseRunID, decisionFinishID, err := getResetEventIDByType(ctx, client, batchParams.DomainName, workflowID, runID, resetParams)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ResetWorkflowExecution(ctx, &shared.ResetWorkflowExecutionRequest{
+		Domain: common.StringPtr(batchParams.DomainName),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(resetBaseRunID),
+		},
+		Reason:                common.StringPtr(batchParams.Reason),
+		DecisionFinishEventId: common.Int64Ptr(decisionFinishID),
+		RequestId:             common.StringPtr(uuid.New().String()),
+	}, yarpcCallOptions...)
+	return err
+}
+
+func getResetEventIDByType(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	resetParams ResetParams,
+) (string, int64, error) {
+
+	switch resetParams.ResetType {
+	case ResetTypeFirstDecisionCompleted:
+		decisionFinishID, err := getDecisionCompletedID(ctx, client, domain, workflowID, runID, true)
+		return runID, decisionFinishID, err
+	case ResetTypeLastDecisionCompleted:
+		decisionFinishID, err := getDecisionCompletedID(ctx, client, domain, workflowID, runID, false)
+		return runID, decisionFinishID, err
+	case ResetTypeLastContinuedAsNew:
+		return getLastContinueAsNewID(ctx, client, domain, workflowID, runID)
+	case ResetTypeBadBinary:
+		decisionFinishID, err := getBadDecisionCompletedID(ctx, client, domain, workflowID, runID, resetParams.BadBinaryChecksum)
+		return runID, decisionFinishID, err
+	default:
+		return "", 0, &shared.BadRequestError{Message: fmt.Sprintf("not supported reset type: %v", resetParams.ResetType)}
+	}
+}
+
+func getDecisionCompletedID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	first bool,
+) (int64, error) {
+
+	req := &shared.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+		MaximumPageSize: common.Int32Ptr(resetHistoryPageSize),
+	}
+
+	decisionFinishID := int64(0)
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return 0, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if e.GetEventType() == shared.EventTypeDecisionTaskCompleted {
+				decisionFinishID = e.GetEventId()
+				if first {
+					return decisionFinishID, nil
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+	if decisionFinishID == 0 {
+		return 0, &shared.BadRequestError{Message: "no DecisionTaskCompleted event to reset to"}
+	}
+	return decisionFinishID, nil
+}
+
+func getLastContinueAsNewID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+) (string, int64, error) {
+
+	resp, err := client.GetWorkflowExecutionHistory(ctx, &shared.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+		MaximumPageSize: common.Int32Ptr(1),
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return "", 0, &shared.BadRequestError{Message: "workflow history is empty"}
+	}
+	resetBaseRunID := events[0].GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
+	if resetBaseRunID == "" {
+		return "", 0, &shared.BadRequestError{Message: "workflow run is not continued from another run"}
+	}
+
+	decisionFinishID, err := getDecisionCompletedID(ctx, client, domain, workflowID, resetBaseRunID, false)
+	return resetBaseRunID, decisionFinishID, err
+}
+
+func getBadDecisionCompletedID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	binaryChecksum string,
+) (int64, error) {
+
+	resp, err := client.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	_, p := history.FindAutoResetPoint(clock.NewRealTimeSource(), &shared.BadBinaries{
+		Binaries: map[string]*shared.BadBinaryInfo{
+			binaryChecksum: {},
+		},
+	}, resp.WorkflowExecutionInfo.AutoResetPoints)
+	if p == nil || p.GetFirstDecisionCompletedId() == 0 {
+		return 0, &shared.BadRequestError{Message: fmt.Sprintf("no reset point found for binary checksum %v", binaryChecksum)}
+	}
+	return p.GetFirstDecisionCompletedId(), nil
+}
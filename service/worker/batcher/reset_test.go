@@ -0,0 +1,316 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package batcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/zap"
+)
+
+const (
+	testResetDomain         = "test-domain"
+	testResetWorkflowID     = "test-workflow-id"
+	testResetRunID          = "test-run-id"
+	testResetBaseRunID      = "test-base-run-id"
+	testResetBinaryChecksum = "test-bad-binary"
+
+	testResetActivityName = "cadence-sys-batch-reset-test-activity"
+)
+
+type resetSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller *gomock.Controller
+	mockClient *workflowservicetest.MockClient
+}
+
+var testResetClient *workflowservicetest.MockClient
+
+func init() {
+	activity.RegisterWithOptions(func(ctx context.Context, params BatchParams) error {
+		return resetWorkflow(ctx, testResetClient, params, testResetWorkflowID, "")
+	}, activity.RegisterOptions{Name: testResetActivityName})
+}
+
+func TestResetSuite(t *testing.T) {
+	suite.Run(t, new(resetSuite))
+}
+
+func (s *resetSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockClient = workflowservicetest.NewMockClient(s.controller)
+}
+
+func (s *resetSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *resetSuite) newParams(resetType string) BatchParams {
+	return BatchParams{
+		DomainName: testResetDomain,
+		Reason:     "test-reason",
+		BatchType:  BatchTypeReset,
+		ResetParams: ResetParams{
+			ResetType:         resetType,
+			BadBinaryChecksum: testResetBinaryChecksum,
+		},
+	}
+}
+
+func (s *resetSuite) TestValidateResetType() {
+	for _, t := range AllResetTypes {
+		s.True(validateResetType(t))
+	}
+	s.False(validateResetType("invalid-reset-type"))
+	s.False(validateResetType(""))
+}
+
+func (s *resetSuite) TestResetWorkflow_FirstDecisionCompleted() {
+	s.expectDescribe(closedStatus(), nil)
+	s.expectHistoryPages(testResetRunID,
+		[]*shared.HistoryEvent{{EventId: common.Int64Ptr(common.FirstEventID), EventType: shared.EventTypeWorkflowExecutionStarted.Ptr()}},
+		[]*shared.HistoryEvent{decisionCompletedEvent(4), decisionCompletedEvent(8)},
+	)
+	s.expectReset(testResetRunID, 4)
+
+	err := resetWorkflow(context.Background(), s.mockClient, s.newParams(ResetTypeFirstDecisionCompleted), testResetWorkflowID, "")
+	s.NoError(err)
+}
+
+func (s *resetSuite) TestResetWorkflow_LastDecisionCompleted() {
+	s.expectDescribe(closedStatus(), nil)
+	s.expectHistoryPages(testResetRunID,
+		[]*shared.HistoryEvent{decisionCompletedEvent(4), decisionCompletedEvent(8)},
+		[]*shared.HistoryEvent{decisionCompletedEvent(12), {EventId: common.Int64Ptr(13), EventType: shared.EventTypeWorkflowExecutionCompleted.Ptr()}},
+	)
+	s.expectReset(testResetRunID, 12)
+
+	err := resetWorkflow(context.Background(), s.mockClient, s.newParams(ResetTypeLastDecisionCompleted), testResetWorkflowID, "")
+	s.NoError(err)
+}
+
+func (s *resetSuite) TestResetWorkflow_LastContinuedAsNew() {
+	s.expectDescribe(closedStatus(), nil)
+	s.mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *shared.GetWorkflowExecutionHistoryRequest) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+			s.Equal(testResetRunID, req.Execution.GetRunId())
+			s.Equal(int32(1), req.GetMaximumPageSize())
+			return &shared.GetWorkflowExecutionHistoryResponse{
+				History: &shared.History{Events: []*shared.HistoryEvent{{
+					EventId:   common.Int64Ptr(common.FirstEventID),
+					EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+					WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+						ContinuedExecutionRunId: common.StringPtr(testResetBaseRunID),
+					},
+				}}},
+			}, nil
+		})
+	s.expectHistoryPages(testResetBaseRunID,
+		[]*shared.HistoryEvent{decisionCompletedEvent(4), decisionCompletedEvent(10)},
+	)
+	s.expectReset(testResetBaseRunID, 10)
+
+	err := resetWorkflow(context.Background(), s.mockClient, s.newParams(ResetTypeLastContinuedAsNew), testResetWorkflowID, testResetRunID)
+	s.NoError(err)
+}
+
+func (s *resetSuite) TestResetWorkflow_LastContinuedAsNew_NotContinued() {
+	s.expectDescribe(closedStatus(), nil)
+	s.mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&shared.GetWorkflowExecutionHistoryResponse{
+		History: &shared.History{Events: []*shared.HistoryEvent{{
+			EventId:                                 common.Int64Ptr(common.FirstEventID),
+			EventType:                               shared.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{},
+		}}},
+	}, nil)
+
+	err := resetWorkflow(context.Background(), s.mockClient, s.newParams(ResetTypeLastContinuedAsNew), testResetWorkflowID, "")
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *resetSuite) TestResetWorkflow_NoDecisionCompleted() {
+	s.expectDescribe(closedStatus(), nil)
+	s.expectHistoryPages(testResetRunID,
+		[]*shared.HistoryEvent{{EventId: common.Int64Ptr(common.FirstEventID), EventType: shared.EventTypeWorkflowExecutionStarted.Ptr()}},
+	)
+
+	err := resetWorkflow(context.Background(), s.mockClient, s.newParams(ResetTypeLastDecisionCompleted), testResetWorkflowID, "")
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *resetSuite) TestResetWorkflow_BadBinary() {
+	resetPoints := &shared.ResetPoints{
+		Points: []*shared.ResetPointInfo{
+			{
+				BinaryChecksum:           common.StringPtr("test-good-binary"),
+				RunId:                    common.StringPtr(testResetRunID),
+				FirstDecisionCompletedId: common.Int64Ptr(4),
+				Resettable:               common.BoolPtr(true),
+			},
+			{
+				BinaryChecksum:           common.StringPtr(testResetBinaryChecksum),
+				RunId:                    common.StringPtr(testResetRunID),
+				FirstDecisionCompletedId: common.Int64Ptr(16),
+				Resettable:               common.BoolPtr(true),
+			},
+		},
+	}
+	s.expectDescribe(closedStatus(), resetPoints).Times(2)
+	s.expectReset(testResetRunID, 16)
+
+	err := resetWorkflow(context.Background(), s.mockClient, s.newParams(ResetTypeBadBinary), testResetWorkflowID, "")
+	s.NoError(err)
+}
+
+func (s *resetSuite) TestResetWorkflow_BadBinary_NoResetPoint() {
+	resetPoints := &shared.ResetPoints{
+		Points: []*shared.ResetPointInfo{{
+			BinaryChecksum:           common.StringPtr("test-good-binary"),
+			RunId:                    common.StringPtr(testResetRunID),
+			FirstDecisionCompletedId: common.Int64Ptr(4),
+			Resettable:               common.BoolPtr(true),
+		}},
+	}
+	s.expectDescribe(closedStatus(), resetPoints).Times(2)
+
+	err := resetWorkflow(context.Background(), s.mockClient, s.newParams(ResetTypeBadBinary), testResetWorkflowID, "")
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *resetSuite) TestResetWorkflow_InvalidResetType() {
+	s.expectDescribe(closedStatus(), nil)
+
+	err := resetWorkflow(context.Background(), s.mockClient, s.newParams("invalid-reset-type"), testResetWorkflowID, "")
+	s.IsType(&shared.BadRequestError{}, err)
+}
+
+func (s *resetSuite) TestResetWorkflow_SkipCurrentOpen_Closed() {
+	s.expectDescribe(closedStatus(), nil)
+	s.expectHistoryPages(testResetRunID,
+		[]*shared.HistoryEvent{decisionCompletedEvent(4)},
+	)
+	s.expectReset(testResetRunID, 4)
+
+	params := s.newParams(ResetTypeFirstDecisionCompleted)
+	params.ResetParams.SkipCurrentOpen = true
+	err := resetWorkflow(context.Background(), s.mockClient, params, testResetWorkflowID, "")
+	s.NoError(err)
+}
+
+func (s *resetSuite) TestResetWorkflow_SkipCurrentOpen_Open() {
+	// only DescribeWorkflowExecution is expected, the open current run must not be reset
+	s.expectDescribe(nil, nil)
+	testResetClient = s.mockClient
+
+	env := s.NewTestActivityEnvironment()
+	batcher := &Batcher{logger: loggerimpl.NewLogger(zap.NewNop())}
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), batcherContextKey, batcher),
+	})
+	params := s.newParams(ResetTypeFirstDecisionCompleted)
+	params.ResetParams.SkipCurrentOpen = true
+	_, err := env.ExecuteActivity(testResetActivityName, params)
+	s.NoError(err)
+}
+
+func (s *resetSuite) expectDescribe(
+	closeStatus *shared.WorkflowExecutionCloseStatus,
+	resetPoints *shared.ResetPoints,
+) *gomock.Call {
+	return s.mockClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *shared.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
+			s.Equal(testResetDomain, req.GetDomain())
+			s.Equal(testResetWorkflowID, req.Execution.GetWorkflowId())
+			return &shared.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{
+					Execution: &shared.WorkflowExecution{
+						WorkflowId: common.StringPtr(testResetWorkflowID),
+						RunId:      common.StringPtr(testResetRunID),
+					},
+					CloseStatus:     closeStatus,
+					AutoResetPoints: resetPoints,
+				},
+			}, nil
+		})
+}
+
+func (s *resetSuite) expectHistoryPages(
+	runID string,
+	pages ...[]*shared.HistoryEvent,
+) {
+	for i, events := range pages {
+		var pageToken, nextPageToken []byte
+		if i > 0 {
+			pageToken = []byte{byte(i)}
+		}
+		if i < len(pages)-1 {
+			nextPageToken = []byte{byte(i + 1)}
+		}
+		resp := &shared.GetWorkflowExecutionHistoryResponse{
+			History:       &shared.History{Events: events},
+			NextPageToken: nextPageToken,
+		}
+		s.mockClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *shared.GetWorkflowExecutionHistoryRequest) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+				s.Equal(runID, req.Execution.GetRunId())
+				s.Equal(pageToken, req.NextPageToken)
+				return resp, nil
+			})
+	}
+}
+
+func (s *resetSuite) expectReset(
+	runID string,
+	decisionFinishID int64,
+) {
+	s.mockClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *shared.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+			s.Equal(testResetDomain, req.GetDomain())
+			s.Equal(testResetWorkflowID, req.WorkflowExecution.GetWorkflowId())
+			s.Equal(runID, req.WorkflowExecution.GetRunId())
+			s.Equal(decisionFinishID, req.GetDecisionFinishEventId())
+			s.Equal("test-reason", req.GetReason())
+			s.NotEmpty(req.GetRequestId())
+			return &shared.ResetWorkflowExecutionResponse{RunId: common.StringPtr("test-new-run-id")}, nil
+		})
+}
+
+func closedStatus() *shared.WorkflowExecutionCloseStatus {
+	return shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+}
+
+func decisionCompletedEvent(eventID int64) *shared.HistoryEvent {
+	return &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(eventID),
+		EventType: shared.EventTypeDecisionTaskCompleted.Ptr(),
+	}
+}
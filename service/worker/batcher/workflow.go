@@ -23,12 +23,15 @@ package batcher
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -36,7 +39,7 @@ import (
 	"go.uber.org/cadence/activity"
 	"go.uber.org/cadence/workflow"
 	"go.uber.org/yarpc"
-	"golang.org/x/time/rate"
+	"go.uber.org/zap"
 )
 
 const (
@@ -50,14 +53,43 @@ const (
 	InfiniteDuration = 20 * 365 * 24 * time.Hour
 	pageSize         = 1000
 
+	// SignalNamePause is the signal to pause the processing of a running batch operation
+	SignalNamePause = "pause"
+	// SignalNameResume is the signal to resume the processing of a paused batch operation
+	SignalNameResume = "resume"
+	// QueryTypeProgress is the query type returning the ProgressQueryResult of a batch operation
+	QueryTypeProgress = "progress"
+	// ErrReasonScanExpired is the reason of the error the batch operation fails with when the scan of the
+	// target workflows expired during a pause. The scan cannot be resumed without processing the workflows
+	// of the completed pages again, the details of the error are the HeartBeatDetails of the progress made.
+	ErrReasonScanExpired = "BatchScanExpired"
+
 	// DefaultRPS is the default RPS
 	DefaultRPS = 50
+	// DefaultMinRPS is the default value for MinRPS
+	DefaultMinRPS = 1
+	// DefaultTargetLatency is the default value for TargetLatency
+	DefaultTargetLatency = time.Second
 	// DefaultConcurrency is the default concurrency
 	DefaultConcurrency = 5
 	// DefaultAttemptsOnRetryableError is the default value for AttemptsOnRetryableError
 	DefaultAttemptsOnRetryableError = 50
 	// DefaultActivityHeartBeatTimeout is the default value for ActivityHeartBeatTimeout
 	DefaultActivityHeartBeatTimeout = time.Second * 10
+
+	// the batch activity returns its progress to the workflow at this interval so that the progress query stays fresh
+	progressReportInterval = time.Minute
+	// bound the history size of the batch workflow
+	maxActivityRunsBeforeContinueAsNew = 500
+	// the page token of the scan is backed by an ElasticSearch scroll which expires after 5 minutes by default,
+	// the batch operation fails with ErrReasonScanExpired when the processing is paused longer than that
+	scanPageTokenExpiration = 5 * time.Minute
+	// number of matched workflows kept as a sample in dry run mode
+	dryRunSampleSize = 100
+	// bound the size of the failure report which is carried in the heartbeat details and the workflow result
+	maxFailureReportSize = 1000
+	// error messages longer than this are truncated in the failure report
+	maxFailureErrorLength = 256
 )
 
 const (
@@ -67,10 +99,17 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeUpdateSearchAttributes is batch type for upserting search attributes of workflows
+	BatchTypeUpdateSearchAttributes = "update_search_attributes"
+	// BatchTypeDelete is batch type for deleting closed workflows, running workflows are reported as failures.
+	// Like BatchTypeUpdateSearchAttributes it goes through the history service of the current cluster
+	BatchTypeDelete = "delete"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReset, BatchTypeUpdateSearchAttributes, BatchTypeDelete}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -95,6 +134,22 @@ type (
 		Input      string
 	}
 
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// where to reset, one of AllResetTypes
+		ResetType string
+		// binary checksum to find the reset point, required for ResetTypeBadBinary
+		BadBinaryChecksum string
+		// this indicates whether to skip the workflow if its current run is still open
+		SkipCurrentOpen bool
+	}
+
+	// UpdateSearchAttributesParams is the parameters for updating search attributes of workflow
+	UpdateSearchAttributesParams struct {
+		// search attributes to upsert, the values are JSON encoded
+		UpsertSearchAttributes map[string][]byte
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target domain to execute batch operation
@@ -103,19 +158,35 @@ type (
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: reset,terminate
+		// Supporting: terminate,cancel,signal,reset,update_search_attributes,delete
 		BatchType string
 
 		// Below are all optional
+		// Executions are the target workflows to process instead of the workflows matched by Query,
+		// e.g. the failures of a previous batch operation to retry
+		Executions []shared.WorkflowExecution
+		// DryRun pages through the target workflows and reports a sample and the count of them without processing
+		DryRun bool
 		// TerminateParams is params only for BatchTypeTerminate
 		TerminateParams TerminateParams
 		// CancelParams is params only for BatchTypeCancel
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
-		// RPS of processing. Default to DefaultRPS
-		// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
+		// UpdateSearchAttributesParams is params only for BatchTypeUpdateSearchAttributes.
+		// The search attributes are updated through the history service of the current cluster,
+		// so the target domain must be active in this cluster
+		UpdateSearchAttributesParams UpdateSearchAttributesParams
+		// Initial RPS of processing, the rate is adapted to the backend pressure between MinRPS and MaxRPS. Default to DefaultRPS
 		RPS int
+		// Floor of the adaptive RPS. Default to DefaultMinRPS
+		MinRPS int
+		// Ceiling of the adaptive RPS. Default to RPS
+		MaxRPS int
+		// The rate is decreased when the latency of processing a workflow exceeds it. Default to DefaultTargetLatency
+		TargetLatency time.Duration
 		// Number of goroutines running in parallel to process
 		Concurrency int
 		// Number of attempts for each workflow to process in case of retryable error before giving up
@@ -126,6 +197,14 @@ type (
 		NonRetryableErrors []string
 		// internal conversion for NonRetryableErrors
 		_nonRetryableErrors map[string]struct{}
+
+		// Below are the state of the workflow, they are carried over when the workflow continues as new
+		// Progress is the progress of processing reported by the batch activity
+		Progress HeartBeatDetails
+		// Paused indicates whether the processing is paused by SignalNamePause
+		Paused bool
+		// PauseTime is the time when the processing was paused
+		PauseTime time.Time
 	}
 
 	// HeartBeatDetails is the struct for heartbeat details
@@ -138,6 +217,36 @@ type (
 		SuccessCount int
 		// Number of workflows that give up due to errors.
 		ErrorCount int
+		// The adaptive RPS of processing
+		CurrentRPS float64
+		// Workflows that give up due to errors, at most maxFailureReportSize of them are recorded
+		Failures []FailedWorkflow
+		// Number of target workflows found in dry run mode
+		DryRunCount int
+		// Sample of the target workflows found in dry run mode, at most dryRunSampleSize of them are recorded
+		DryRunSample []shared.WorkflowExecution
+	}
+
+	// FailedWorkflow is a workflow that the batch operation gives up processing due to error
+	FailedWorkflow struct {
+		WorkflowID string
+		RunID      string
+		Error      string
+	}
+
+	// ProgressQueryResult is the result of QueryTypeProgress
+	ProgressQueryResult struct {
+		Paused bool
+		// Progress is updated every time the batch activity reports its progress to the workflow
+		Progress HeartBeatDetails
+	}
+
+	// batchWorkflow holds the state of one run of the batch workflow
+	batchWorkflow struct {
+		params BatchParams
+		logger *zap.Logger
+		// scanExpired indicates the page token of the scan expired during the pause
+		scanExpired bool
 	}
 
 	taskDetail struct {
@@ -146,6 +255,11 @@ type (
 		// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
 		hbd HeartBeatDetails
 	}
+
+	taskResult struct {
+		execution shared.WorkflowExecution
+		err       error
+	}
 )
 
 var (
@@ -175,19 +289,136 @@ func BatchWorkflow(ctx workflow.Context, batchParams BatchParams) (HeartBeatDeta
 	if err != nil {
 		return HeartBeatDetails{}, err
 	}
-	batchActivityOptions.HeartbeatTimeout = batchParams.ActivityHeartBeatTimeout
-	opt := workflow.WithActivityOptions(ctx, batchActivityOptions)
+	w := &batchWorkflow{
+		params: batchParams,
+		logger: workflow.GetLogger(ctx),
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (ProgressQueryResult, error) {
+		return ProgressQueryResult{
+			Paused:   w.params.Paused,
+			Progress: w.params.Progress,
+		}, nil
+	}); err != nil {
+		return HeartBeatDetails{}, err
+	}
+
+	for i := 0; i < maxActivityRunsBeforeContinueAsNew; i++ {
+		if w.scanExpired {
+			return HeartBeatDetails{}, cadence.NewCustomError(ErrReasonScanExpired, w.params.Progress)
+		}
+		if w.params.Paused {
+			w.waitForSignal(ctx)
+			continue
+		}
+		done, err := w.runActivity(ctx)
+		if err != nil {
+			return w.params.Progress, err
+		}
+		if done {
+			return w.params.Progress, nil
+		}
+	}
+	// signals received after the last selection would be lost on continue as new
+	w.drainSignals(ctx)
+	return HeartBeatDetails{}, workflow.NewContinueAsNewError(ctx, BatchWFTypeName, w.params)
+}
+
+// runActivity runs the batch activity until it reports its progress, the activity is canceled
+// when the workflow is paused and it returns the progress of the pages it has completed
+func (w *batchWorkflow) runActivity(ctx workflow.Context) (bool, error) {
+	batchActivityOptions.HeartbeatTimeout = w.params.ActivityHeartBeatTimeout
+	activityCtx, cancel := workflow.WithCancel(workflow.WithActivityOptions(ctx, batchActivityOptions))
+	activityCtx = workflow.WithWaitForCancellation(activityCtx, true)
+	future := workflow.ExecuteActivity(activityCtx, batchActivityName, w.params)
+
 	var result HeartBeatDetails
-	err = workflow.ExecuteActivity(opt, batchActivityName, batchParams).Get(ctx, &result)
-	return result, err
+	var err error
+	completed := false
+	canceled := false
+	for !completed {
+		selector := workflow.NewSelector(ctx)
+		selector.AddFuture(future, func(f workflow.Future) {
+			err = f.Get(ctx, &result)
+			completed = true
+		})
+		w.addSignalHandlers(ctx, selector)
+		selector.Select(ctx)
+		if w.params.Paused && !completed && !canceled {
+			cancel()
+			canceled = true
+		}
+	}
+
+	if canceledErr, ok := err.(*cadence.CanceledError); ok && canceled {
+		if canceledErr.HasDetails() {
+			if err := canceledErr.Details(&result); err != nil {
+				w.logger.Error("failed to decode the progress of the canceled batch activity", zap.Error(err))
+				return false, nil
+			}
+			w.params.Progress = result
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	w.params.Progress = result
+	// the activity always processes a page before reporting, no page token left means all pages are processed
+	return len(result.PageToken) == 0, nil
+}
+
+func (w *batchWorkflow) waitForSignal(ctx workflow.Context) {
+	selector := workflow.NewSelector(ctx)
+	w.addSignalHandlers(ctx, selector)
+	selector.Select(ctx)
+}
+
+func (w *batchWorkflow) addSignalHandlers(ctx workflow.Context, selector workflow.Selector) {
+	selector.AddReceive(workflow.GetSignalChannel(ctx, SignalNamePause), func(c workflow.Channel, more bool) {
+		var reason string
+		c.Receive(ctx, &reason)
+		w.logger.Info("batch operation is paused", zap.String("reason", reason))
+		if !w.params.Paused {
+			w.params.Paused = true
+			w.params.PauseTime = workflow.Now(ctx)
+		}
+	})
+	selector.AddReceive(workflow.GetSignalChannel(ctx, SignalNameResume), func(c workflow.Channel, more bool) {
+		var reason string
+		c.Receive(ctx, &reason)
+		w.logger.Info("batch operation is resumed", zap.String("reason", reason))
+		if !w.params.Paused {
+			return
+		}
+		w.params.Paused = false
+		if workflow.Now(ctx).Sub(w.params.PauseTime) > scanPageTokenExpiration &&
+			len(w.params.Progress.PageToken) > 0 &&
+			len(w.params.Executions) == 0 {
+			// restarting the scan would process the workflows of the completed pages again
+			w.logger.Error("page token of the scan has expired during the pause, fail the batch operation")
+			w.scanExpired = true
+		}
+	})
+}
+
+func (w *batchWorkflow) drainSignals(ctx workflow.Context) {
+	for drained := false; !drained; {
+		selector := workflow.NewSelector(ctx)
+		w.addSignalHandlers(ctx, selector)
+		selector.AddDefault(func() { drained = true })
+		selector.Select(ctx)
+	}
 }
 
 func validateParams(params BatchParams) error {
 	if params.BatchType == "" ||
 		params.Reason == "" ||
 		params.DomainName == "" ||
-		params.Query == "" {
-		return fmt.Errorf("must provide required parameters: BatchType/Reason/DomainName/Query")
+		(params.Query == "" && len(params.Executions) == 0) {
+		return fmt.Errorf("must provide required parameters: BatchType/Reason/DomainName/Query or Executions")
+	}
+	if params.MinRPS > params.MaxRPS {
+		return fmt.Errorf("MinRPS %v must not be greater than MaxRPS %v", params.MinRPS, params.MaxRPS)
 	}
 	switch params.BatchType {
 	case BatchTypeSignal:
@@ -195,6 +426,21 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
+	case BatchTypeReset:
+		if !validateResetType(params.ResetParams.ResetType) {
+			return fmt.Errorf("must provide valid reset type: %v", AllResetTypes)
+		}
+		if params.ResetParams.ResetType == ResetTypeBadBinary && params.ResetParams.BadBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum for reset type: %v", ResetTypeBadBinary)
+		}
+		return nil
+	case BatchTypeUpdateSearchAttributes:
+		if len(params.UpdateSearchAttributesParams.UpsertSearchAttributes) == 0 {
+			return fmt.Errorf("must provide search attributes to upsert")
+		}
+		return nil
+	case BatchTypeDelete:
+		fallthrough
 	case BatchTypeCancel:
 		fallthrough
 	case BatchTypeTerminate:
@@ -208,6 +454,15 @@ func setDefaultParams(params BatchParams) BatchParams {
 	if params.RPS <= 0 {
 		params.RPS = DefaultRPS
 	}
+	if params.MinRPS <= 0 {
+		params.MinRPS = DefaultMinRPS
+	}
+	if params.MaxRPS <= 0 {
+		params.MaxRPS = params.RPS
+	}
+	if params.TargetLatency <= 0 {
+		params.TargetLatency = DefaultTargetLatency
+	}
 	if params.Concurrency <= 0 {
 		params.Concurrency = DefaultConcurrency
 	}
@@ -234,57 +489,86 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
 	client := batcher.clientBean.GetFrontendClient()
 
-	hbd := HeartBeatDetails{}
-	startOver := true
+	// continue from the progress reported by the previous runs of the activity
+	hbd := batchParams.Progress
 	if activity.HasHeartbeatDetails(ctx) {
-		if err := activity.GetHeartbeatDetails(ctx, &hbd); err == nil {
-			startOver = false
+		var heartbeatDetails HeartBeatDetails
+		if err := activity.GetHeartbeatDetails(ctx, &heartbeatDetails); err == nil {
+			hbd = heartbeatDetails
 		} else {
 			batcher := ctx.Value(batcherContextKey).(*Batcher)
 			batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
-			getActivityLogger(ctx).Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			getActivityLogger(ctx).Error("Failed to recover from last heartbeat, start over from last reported progress", tag.Error(err))
 		}
 	}
 
-	if startOver {
-		resp, err := client.CountWorkflowExecutions(ctx, &shared.CountWorkflowExecutionsRequest{
-			Domain: common.StringPtr(batchParams.DomainName),
-			Query:  common.StringPtr(batchParams.Query),
+	if hbd.CurrentPage == 0 {
+		if len(batchParams.Executions) > 0 {
+			hbd.TotalEstimate = int64(len(batchParams.Executions))
+		} else {
+			resp, err := client.CountWorkflowExecutions(ctx, &shared.CountWorkflowExecutionsRequest{
+				Domain: common.StringPtr(batchParams.DomainName),
+				Query:  common.StringPtr(batchParams.Query),
+			})
+			if err != nil {
+				return HeartBeatDetails{}, err
+			}
+			hbd.TotalEstimate = resp.GetCount()
+		}
+	}
+
+	if batchParams.DryRun {
+		return dryRun(ctx, client, batchParams, hbd)
+	}
+
+	// search attributes are updated and workflows deleted through history service which is addressed by domain ID
+	var domainID string
+	if batchParams.BatchType == BatchTypeUpdateSearchAttributes || batchParams.BatchType == BatchTypeDelete {
+		resp, err := client.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+			Name: common.StringPtr(batchParams.DomainName),
 		})
 		if err != nil {
 			return HeartBeatDetails{}, err
 		}
-		hbd.TotalEstimate = resp.GetCount()
+		domainID = resp.GetDomainInfo().GetUUID()
 	}
-	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
+
+	initialRPS := hbd.CurrentRPS
+	if initialRPS <= 0 {
+		initialRPS = float64(batchParams.RPS)
+	}
+	controller := newRateController(
+		initialRPS,
+		float64(batchParams.MinRPS),
+		float64(batchParams.MaxRPS),
+		batchParams.TargetLatency,
+		clock.NewRealTimeSource(),
+	)
 	taskCh := make(chan taskDetail, pageSize)
-	respCh := make(chan error, pageSize)
+	respCh := make(chan taskResult, pageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, client)
+		go startTaskProcessor(ctx, batchParams, domainID, taskCh, respCh, controller, client)
 	}
 
+	startTime := time.Now()
 	for {
-		// TODO https://github.com/uber/cadence/issues/2154
-		//  Need to improve scan concurrency because it will hold an ES resource until the workflow finishes.
-		//  And we can't use list API because terminate / reset will mutate the result.
-		resp, err := client.ScanWorkflowExecutions(ctx, &shared.ListWorkflowExecutionsRequest{
-			Domain:        common.StringPtr(batchParams.DomainName),
-			PageSize:      common.Int32Ptr(int32(pageSize)),
-			NextPageToken: hbd.PageToken,
-			Query:         common.StringPtr(batchParams.Query),
-		})
+		executions, nextPageToken, err := getNextPage(ctx, client, batchParams, hbd.PageToken)
 		if err != nil {
+			if isDone(ctx) {
+				return HeartBeatDetails{}, cadence.NewCanceledError(hbd)
+			}
 			return HeartBeatDetails{}, err
 		}
-		batchCount := len(resp.Executions)
+		batchCount := len(executions)
 		if batchCount <= 0 {
+			hbd.PageToken = nil
 			break
 		}
 
 		// send all tasks
-		for _, wf := range resp.Executions {
+		for _, wf := range executions {
 			taskCh <- taskDetail{
-				execution: *wf.Execution,
+				execution: wf,
 				attempts:  0,
 				hbd:       hbd,
 			}
@@ -292,31 +576,37 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 
 		succCount := 0
 		errCount := 0
+		var failures []FailedWorkflow
 		// wait for counters indicate this batch is done
 	Loop:
 		for {
 			select {
-			case err := <-respCh:
-				if err == nil {
+			case result := <-respCh:
+				if result.err == nil {
 					succCount++
 				} else {
 					errCount++
+					failures = append(failures, newFailedWorkflow(result.execution, result.err))
 				}
 				if succCount+errCount == batchCount {
 					break Loop
 				}
 			case <-ctx.Done():
-				return HeartBeatDetails{}, ctx.Err()
+				// report the progress of the completed pages when the workflow is paused
+				return HeartBeatDetails{}, cadence.NewCanceledError(hbd)
 			}
 		}
 
 		hbd.CurrentPage++
-		hbd.PageToken = resp.NextPageToken
+		hbd.PageToken = nextPageToken
 		hbd.SuccessCount += succCount
 		hbd.ErrorCount += errCount
+		hbd.Failures = appendFailures(hbd.Failures, failures)
+		hbd.CurrentRPS = controller.RPS()
 		activity.RecordHeartbeat(ctx, hbd)
+		batcher.metricsClient.UpdateGauge(metrics.BatcherScope, metrics.BatcherProcessorRPS, hbd.CurrentRPS)
 
-		if len(hbd.PageToken) == 0 {
+		if len(hbd.PageToken) == 0 || time.Since(startTime) >= progressReportInterval {
 			break
 		}
 	}
@@ -324,12 +614,122 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	return hbd, nil
 }
 
+// dryRun pages through the target workflows and records the count and a sample of them without processing
+func dryRun(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	hbd HeartBeatDetails,
+) (HeartBeatDetails, error) {
+	startTime := time.Now()
+	for {
+		executions, nextPageToken, err := getNextPage(ctx, client, batchParams, hbd.PageToken)
+		if err != nil {
+			if isDone(ctx) {
+				return HeartBeatDetails{}, cadence.NewCanceledError(hbd)
+			}
+			return HeartBeatDetails{}, err
+		}
+		if len(executions) <= 0 {
+			hbd.PageToken = nil
+			break
+		}
+
+		for _, wf := range executions {
+			if len(hbd.DryRunSample) >= dryRunSampleSize {
+				break
+			}
+			hbd.DryRunSample = append(hbd.DryRunSample, wf)
+		}
+		hbd.CurrentPage++
+		hbd.PageToken = nextPageToken
+		hbd.DryRunCount += len(executions)
+		activity.RecordHeartbeat(ctx, hbd)
+
+		if len(hbd.PageToken) == 0 || time.Since(startTime) >= progressReportInterval {
+			break
+		}
+	}
+	return hbd, nil
+}
+
+// getNextPage returns the next page of the target workflows, either from the given executions or from the scan of the query.
+// No page token is returned for the last page
+func getNextPage(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	pageToken []byte,
+) ([]shared.WorkflowExecution, []byte, error) {
+	if len(batchParams.Executions) > 0 {
+		// the page token is the offset of the next page in the executions
+		offset := 0
+		if len(pageToken) > 0 {
+			var err error
+			if offset, err = strconv.Atoi(string(pageToken)); err != nil {
+				return nil, nil, err
+			}
+		}
+		if offset >= len(batchParams.Executions) {
+			return nil, nil, nil
+		}
+		end := offset + pageSize
+		if end >= len(batchParams.Executions) {
+			return batchParams.Executions[offset:], nil, nil
+		}
+		return batchParams.Executions[offset:end], []byte(strconv.Itoa(end)), nil
+	}
+
+	// TODO https://github.com/uber/cadence/issues/2154
+	//  Need to improve scan concurrency because it will hold an ES resource until the workflow finishes.
+	//  And we can't use list API because terminate / reset will mutate the result.
+	resp, err := client.ScanWorkflowExecutions(ctx, &shared.ListWorkflowExecutionsRequest{
+		Domain:        common.StringPtr(batchParams.DomainName),
+		PageSize:      common.Int32Ptr(int32(pageSize)),
+		NextPageToken: pageToken,
+		Query:         common.StringPtr(batchParams.Query),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]shared.WorkflowExecution, 0, len(resp.Executions))
+	for _, wf := range resp.Executions {
+		executions = append(executions, *wf.Execution)
+	}
+	return executions, resp.NextPageToken, nil
+}
+
+func newFailedWorkflow(execution shared.WorkflowExecution, err error) FailedWorkflow {
+	errMsg := err.Error()
+	if len(errMsg) > maxFailureErrorLength {
+		errMsg = errMsg[:maxFailureErrorLength]
+	}
+	return FailedWorkflow{
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		Error:      errMsg,
+	}
+}
+
+// appendFailures appends the failures to the report until it reaches maxFailureReportSize,
+// ErrorCount still counts the failures that are dropped from the report
+func appendFailures(report []FailedWorkflow, failures []FailedWorkflow) []FailedWorkflow {
+	for _, f := range failures {
+		if len(report) >= maxFailureReportSize {
+			break
+		}
+		report = append(report, f)
+	}
+	return report
+}
+
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	domainID string,
 	taskCh chan taskDetail,
-	respCh chan error,
-	limiter *rate.Limiter,
+	respCh chan taskResult,
+	controller *rateController,
 	client frontend.Client,
 ) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
@@ -349,7 +749,7 @@ func startTaskProcessor(
 
 			switch batchParams.BatchType {
 			case BatchTypeTerminate:
-				err = processTask(ctx, limiter, task, batchParams, client,
+				err = processTask(ctx, controller, task, batchParams, client,
 					batchParams.TerminateParams.TerminateChildren,
 					func(workflowID, runID string) error {
 						return client.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{
@@ -363,7 +763,7 @@ func startTaskProcessor(
 						}, yarpcCallOptions...)
 					})
 			case BatchTypeCancel:
-				err = processTask(ctx, limiter, task, batchParams, client,
+				err = processTask(ctx, controller, task, batchParams, client,
 					batchParams.CancelParams.CancelChildren,
 					func(workflowID, runID string) error {
 						return client.RequestCancelWorkflowExecution(ctx, &shared.RequestCancelWorkflowExecutionRequest{
@@ -377,7 +777,7 @@ func startTaskProcessor(
 						}, yarpcCallOptions...)
 					})
 			case BatchTypeSignal:
-				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+				err = processTask(ctx, controller, task, batchParams, client, common.BoolPtr(false),
 					func(workflowID, runID string) error {
 						return client.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{
 							Domain: common.StringPtr(batchParams.DomainName),
@@ -391,14 +791,46 @@ func startTaskProcessor(
 							Input:      []byte(batchParams.SignalParams.Input),
 						}, yarpcCallOptions...)
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, controller, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID, yarpcCallOptions...)
+					})
+			case BatchTypeUpdateSearchAttributes:
+				err = processTask(ctx, controller, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return batcher.clientBean.GetHistoryClient().UpdateWorkflowSearchAttributes(ctx, &h.UpdateWorkflowSearchAttributesRequest{
+							DomainUUID: common.StringPtr(domainID),
+							WorkflowExecution: &shared.WorkflowExecution{
+								WorkflowId: common.StringPtr(workflowID),
+								RunId:      common.StringPtr(runID),
+							},
+							UpsertSearchAttributes: &shared.SearchAttributes{
+								IndexedFields: batchParams.UpdateSearchAttributesParams.UpsertSearchAttributes,
+							},
+						})
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, controller, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return batcher.clientBean.GetHistoryClient().DeleteWorkflowExecution(ctx, &h.DeleteWorkflowExecutionRequest{
+							DomainUUID: common.StringPtr(domainID),
+							WorkflowExecution: &shared.WorkflowExecution{
+								WorkflowId: common.StringPtr(workflowID),
+								RunId:      common.StringPtr(runID),
+							},
+						})
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
+				// BadRequestError will not succeed on retry, e.g. a workflow without any reset point
+				_, isBadRequest := err.(*shared.BadRequestError)
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
-				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
-					respCh <- err
+				if ok || isBadRequest || task.attempts >= batchParams.AttemptsOnRetryableError {
+					respCh <- taskResult{execution: task.execution, err: err}
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
@@ -406,7 +838,7 @@ func startTaskProcessor(
 				}
 			} else {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorSuccess)
-				respCh <- nil
+				respCh <- taskResult{execution: task.execution}
 			}
 		}
 	}
@@ -414,7 +846,7 @@ func startTaskProcessor(
 
 func processTask(
 	ctx context.Context,
-	limiter *rate.Limiter,
+	controller *rateController,
 	task taskDetail,
 	batchParams BatchParams,
 	client frontend.Client,
@@ -425,13 +857,19 @@ func processTask(
 	for len(wfs) > 0 {
 		wf := wfs[0]
 
-		err := limiter.Wait(ctx)
+		err := controller.Wait(ctx)
 		if err != nil {
 			return err
 		}
 		activity.RecordHeartbeat(ctx, task.hbd)
 
+		startTime := time.Now()
 		err = procFn(wf.GetWorkflowId(), wf.GetRunId())
+		controller.Record(err, time.Since(startTime))
+		if isThrottledError(err) {
+			batcher := ctx.Value(batcherContextKey).(*Batcher)
+			batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorThrottled)
+		}
 		if err != nil {
 			// EntityNotExistsError means wf is not running or deleted
 			_, ok := err.(*shared.EntityNotExistsError)
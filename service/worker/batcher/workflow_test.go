@@ -0,0 +1,210 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"go.uber.org/cadence/testsuite"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) newParams() BatchParams {
+	return BatchParams{
+		DomainName: "test-domain",
+		Query:      "WorkflowType = 'test-workflow-type'",
+		Reason:     "test-reason",
+		BatchType:  BatchTypeTerminate,
+	}
+}
+
+func (s *workflowSuite) TestBatchWorkflow_ContinuesFromReportedProgress() {
+	env := s.NewTestWorkflowEnvironment()
+	var progresses []HeartBeatDetails
+	env.OnActivity(batchActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params BatchParams) (HeartBeatDetails, error) {
+			progresses = append(progresses, params.Progress)
+			hbd := params.Progress
+			hbd.CurrentPage++
+			hbd.SuccessCount += 10
+			hbd.CurrentRPS = 20
+			hbd.PageToken = []byte("next-page")
+			if hbd.CurrentPage == 3 {
+				hbd.PageToken = nil
+			}
+			return hbd, nil
+		})
+
+	env.ExecuteWorkflow(BatchWFTypeName, s.newParams())
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result HeartBeatDetails
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(HeartBeatDetails{CurrentPage: 3, SuccessCount: 30, CurrentRPS: 20}, result)
+	s.Len(progresses, 3)
+	s.Equal(0, progresses[0].CurrentPage)
+	s.Equal(2, progresses[2].CurrentPage)
+	s.Equal([]byte("next-page"), progresses[2].PageToken)
+}
+
+func (s *workflowSuite) TestBatchWorkflow_PauseAndResume() {
+	env, progresses := s.runPausedWorkflow(4 * time.Minute)
+	s.NoError(env.GetWorkflowError())
+	var result HeartBeatDetails
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(2, result.CurrentPage)
+	// the second run is canceled by the pause before it reports any progress
+	s.Len(*progresses, 3)
+	s.Equal(1, (*progresses)[2].CurrentPage)
+	s.Equal([]byte("next-page"), (*progresses)[2].PageToken)
+}
+
+func (s *workflowSuite) TestBatchWorkflow_ResumeAfterPageTokenExpired() {
+	env, progresses := s.runPausedWorkflow(10 * time.Minute)
+	// the scan is not restarted, the workflows of the completed page must not be processed again
+	s.Len(*progresses, 2)
+	err := env.GetWorkflowError()
+	s.Error(err)
+	s.Contains(err.Error(), ErrReasonScanExpired)
+}
+
+func (s *workflowSuite) runPausedWorkflow(resumeTime time.Duration) (*testsuite.TestWorkflowEnvironment, *[]HeartBeatDetails) {
+	env := s.NewTestWorkflowEnvironment()
+	var progresses []HeartBeatDetails
+	env.OnActivity(batchActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params BatchParams) (HeartBeatDetails, error) {
+			progresses = append(progresses, params.Progress)
+			s.False(params.Paused)
+			hbd := params.Progress
+			hbd.CurrentPage++
+			hbd.PageToken = []byte("next-page")
+			if hbd.CurrentPage == 2 {
+				hbd.PageToken = nil
+			}
+			return hbd, nil
+		}).After(time.Minute)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(SignalNamePause, "test-pause")
+	}, time.Minute+10*time.Second)
+	env.RegisterDelayedCallback(func() {
+		value, err := env.QueryWorkflow(QueryTypeProgress)
+		s.NoError(err)
+		var result ProgressQueryResult
+		s.NoError(value.Get(&result))
+		s.True(result.Paused)
+		s.Equal(1, result.Progress.CurrentPage)
+		env.SignalWorkflow(SignalNameResume, "test-resume")
+	}, resumeTime)
+
+	env.ExecuteWorkflow(BatchWFTypeName, s.newParams())
+
+	s.True(env.IsWorkflowCompleted())
+	return env, &progresses
+}
+
+func (s *workflowSuite) TestBatchWorkflow_InvalidParams() {
+	env := s.NewTestWorkflowEnvironment()
+	params := s.newParams()
+	params.BatchType = BatchTypeReset
+	params.ResetParams.ResetType = ResetTypeBadBinary
+
+	env.ExecuteWorkflow(BatchWFTypeName, params)
+
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *workflowSuite) TestBatchWorkflow_ReportsFailures() {
+	env := s.NewTestWorkflowEnvironment()
+	params := s.newParams()
+	params.Query = ""
+	params.Executions = []shared.WorkflowExecution{
+		{WorkflowId: common.StringPtr("wid1"), RunId: common.StringPtr("rid1")},
+	}
+	env.OnActivity(batchActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params BatchParams) (HeartBeatDetails, error) {
+			hbd := params.Progress
+			hbd.CurrentPage++
+			hbd.ErrorCount++
+			hbd.Failures = appendFailures(hbd.Failures, []FailedWorkflow{
+				newFailedWorkflow(params.Executions[0], errors.New("test-error")),
+			})
+			return hbd, nil
+		})
+
+	env.ExecuteWorkflow(BatchWFTypeName, params)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result HeartBeatDetails
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(1, result.ErrorCount)
+	s.Equal([]FailedWorkflow{{WorkflowID: "wid1", RunID: "rid1", Error: "test-error"}}, result.Failures)
+}
+
+func (s *workflowSuite) TestGetNextPage_Executions() {
+	params := s.newParams()
+	for i := 0; i < pageSize+1; i++ {
+		params.Executions = append(params.Executions, shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(strconv.Itoa(i)),
+		})
+	}
+
+	executions, pageToken, err := getNextPage(context.Background(), nil, params, nil)
+	s.NoError(err)
+	s.Len(executions, pageSize)
+	s.NotEmpty(pageToken)
+
+	executions, pageToken, err = getNextPage(context.Background(), nil, params, pageToken)
+	s.NoError(err)
+	s.Equal(params.Executions[pageSize:], executions)
+	s.Empty(pageToken)
+}
+
+func (s *workflowSuite) TestAppendFailures_Bounded() {
+	execution := shared.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}
+	failure := newFailedWorkflow(execution, errors.New(strings.Repeat("e", maxFailureErrorLength+1)))
+	s.Len(failure.Error, maxFailureErrorLength)
+
+	var report []FailedWorkflow
+	for i := 0; i < maxFailureReportSize+1; i++ {
+		report = appendFailures(report, []FailedWorkflow{failure})
+	}
+	s.Len(report, maxFailureReportSize)
+}
@@ -23,6 +23,7 @@ package indexer
 import (
 	"fmt"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -37,6 +38,7 @@ type (
 		config              *Config
 		kafkaClient         messaging.Client
 		esClient            es.Client
+		domainCache         cache.DomainCache
 		logger              log.Logger
 		metricsClient       metrics.Client
 		dynamicCollection   *dynamicconfig.Collection
@@ -61,13 +63,14 @@ const (
 
 // NewIndexer create a new Indexer
 func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esConfig *es.Config,
-	logger log.Logger, metricsClient metrics.Client) *Indexer {
+	domainCache cache.DomainCache, logger log.Logger, metricsClient metrics.Client) *Indexer {
 	logger = logger.WithTags(tag.ComponentIndexer)
 
 	return &Indexer{
 		config:              config,
 		kafkaClient:         client,
 		esClient:            esClient,
+		domainCache:         domainCache,
 		logger:              logger,
 		metricsClient:       metricsClient,
 		visibilityIndexName: esConfig.Indices[common.VisibilityAppName],
@@ -79,7 +82,7 @@ func (x Indexer) Start() error {
 	visibilityApp := common.VisibilityAppName
 	visConsumerName := getConsumerName(x.visibilityIndexName)
 	x.visibilityProcessor = newIndexProcessor(visibilityApp, visConsumerName, x.kafkaClient, x.esClient,
-		visibilityProcessorName, x.visibilityIndexName, x.config, x.logger, x.metricsClient)
+		visibilityProcessorName, x.visibilityIndexName, x.config, x.domainCache, x.logger, x.metricsClient)
 	return x.visibilityProcessor.Start()
 }
 
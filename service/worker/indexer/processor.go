@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/.gen/go/indexer"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/definition"
 	es "github.com/uber/cadence/common/elasticsearch"
@@ -49,6 +50,7 @@ type indexProcessor struct {
 	esProcessorName string
 	esIndexName     string
 	config          *Config
+	domainCache     cache.DomainCache
 	logger          log.Logger
 	metricsClient   metrics.Client
 	isStarted       int32
@@ -70,7 +72,8 @@ var (
 )
 
 func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Client, esClient es.Client,
-	esProcessorName, esIndexName string, config *Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
+	esProcessorName, esIndexName string, config *Config, domainCache cache.DomainCache, logger log.Logger,
+	metricsClient metrics.Client) *indexProcessor {
 	return &indexProcessor{
 		appName:         appName,
 		consumerName:    consumerName,
@@ -79,6 +82,7 @@ func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Clien
 		esProcessorName: esProcessorName,
 		esIndexName:     esIndexName,
 		config:          config,
+		domainCache:     domainCache,
 		logger:          logger.WithTags(tag.ComponentIndexerProcessor),
 		metricsClient:   metricsClient,
 		shutdownCh:      make(chan struct{}),
@@ -233,7 +237,7 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 }
 
 func (p *indexProcessor) generateESDoc(msg *indexer.Message, keyToKafkaMsg string) map[string]interface{} {
-	doc := p.dumpFieldsToMap(msg.Fields)
+	doc := p.dumpFieldsToMap(msg.Fields, msg.GetDomainID())
 	fulfillDoc(doc, msg, keyToKafkaMsg)
 	return doc
 }
@@ -248,11 +252,11 @@ func (p *indexProcessor) decodeSearchAttrBinary(bytes []byte, key string) interf
 	return val
 }
 
-func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field) map[string]interface{} {
+func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field, domainID string) map[string]interface{} {
 	doc := make(map[string]interface{})
 	attr := make(map[string]interface{})
 	for k, v := range fields {
-		if !p.isValidFieldToES(k) {
+		if !p.isValidFieldToES(k, domainID) {
 			p.logger.Error("Unregistered field.", tag.ESField(k))
 			p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
 			continue
@@ -280,13 +284,19 @@ func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field) map[s
 	return doc
 }
 
-func (p *indexProcessor) isValidFieldToES(field string) bool {
+func (p *indexProcessor) isValidFieldToES(field string, domainID string) bool {
 	if _, ok := p.config.ValidSearchAttributes()[field]; ok {
 		return true
 	}
 	if field == definition.Memo || field == definition.KafkaKey || field == definition.Encoding {
 		return true
 	}
+	// search attributes registered in the domain
+	if domainEntry, err := p.domainCache.GetDomainByID(domainID); err == nil {
+		if _, ok := domainEntry.GetConfig().SearchAttributes[field]; ok {
+			return true
+		}
+	}
 	return false
 }
 
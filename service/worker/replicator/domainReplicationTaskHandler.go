@@ -112,6 +112,7 @@ func (domainReplicator *domainReplicatorImpl) handleDomainCreationReplicationTas
 			HistoryArchivalURI:       task.Config.GetHistoryArchivalURI(),
 			VisibilityArchivalStatus: task.Config.GetVisibilityArchivalStatus(),
 			VisibilityArchivalURI:    task.Config.GetVisibilityArchivalURI(),
+			SearchAttributes:         task.Config.SearchAttributes,
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -228,6 +229,7 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 			HistoryArchivalURI:       task.Config.GetHistoryArchivalURI(),
 			VisibilityArchivalStatus: task.Config.GetVisibilityArchivalStatus(),
 			VisibilityArchivalURI:    task.Config.GetVisibilityArchivalURI(),
+			SearchAttributes:         task.Config.SearchAttributes,
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Config.BadBinaries = *task.Config.GetBadBinaries()
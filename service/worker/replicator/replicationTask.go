@@ -127,6 +127,7 @@ func newActivityReplicationTask(task *replicator.ReplicationTask, msg messaging.
 			LastFailureReason:  attr.LastFailureReason,
 			LastWorkerIdentity: attr.LastWorkerIdentity,
 			LastFailureDetails: attr.LastFailureDetails,
+			Paused:             attr.Paused,
 		},
 	}
 }
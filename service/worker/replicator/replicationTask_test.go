@@ -244,6 +244,7 @@ func (s *activityReplicationTaskSuite) TestNewActivityReplicationTask() {
 				LastFailureReason:  replicationAttr.LastFailureReason,
 				LastWorkerIdentity: replicationAttr.LastWorkerIdentity,
 				LastFailureDetails: replicationAttr.LastFailureDetails,
+				Paused:             replicationAttr.Paused,
 			},
 		},
 		task,
@@ -668,6 +669,7 @@ func (s *activityReplicationTaskSuite) getActivityReplicationTask() *replicator.
 		LastFailureReason:  common.StringPtr("some random failure reason"),
 		LastWorkerIdentity: common.StringPtr("some random worker identity"),
 		LastFailureDetails: []byte("some random failure details"),
+		Paused:             common.BoolPtr(true),
 	}
 	replicationTask := &replicator.ReplicationTask{
 		TaskType:                    replicator.ReplicationTaskTypeSyncActivity.Ptr(),
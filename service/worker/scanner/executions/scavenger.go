@@ -0,0 +1,539 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/history/historyserviceclient"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+)
+
+type (
+	// CorruptionType is the type of invariant violated by a corrupted execution
+	CorruptionType string
+
+	// ScavengerHeartbeatDetails is the heartbeat detail for ExecutionsScavengerActivity
+	ScavengerHeartbeatDetails struct {
+		ShardID        int
+		NextPageToken  []byte
+		ExecutionCount int
+		CorruptedCount int
+		FixedCount     int
+		ErrorCount     int
+		// Report lists the corrupted executions, at most maxReportSize of them are recorded
+		Report []CorruptedExecution
+	}
+
+	// CorruptedExecution is an execution that violates an invariant
+	CorruptedExecution struct {
+		ShardID    int
+		DomainID   string
+		WorkflowID string
+		RunID      string
+		Type       CorruptionType
+		Detail     string
+		Fixed      bool
+	}
+
+	// Scavenger is the type that holds the state for executions scavenger daemon
+	Scavenger struct {
+		executionDBFactory p.ExecutionManagerFactory
+		historyDB          p.HistoryV2Manager
+		client             historyserviceclient.Interface
+		numShards          int
+		fixEnabled         bool
+		hbd                ScavengerHeartbeatDetails
+		rps                int
+		limiter            *rate.Limiter
+		timeSource         clock.TimeSource
+		metrics            metrics.Client
+		logger             log.Logger
+		isInTest           bool
+	}
+
+	taskDetail struct {
+		shardID     int
+		executionDB p.ExecutionManager
+		info        *p.WorkflowExecutionInfo
+
+		// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
+		hbd ScavengerHeartbeatDetails
+	}
+
+	taskResult struct {
+		corruptions []CorruptedExecution
+		err         error
+	}
+)
+
+const (
+	// CorruptionTypeMissingHistory is for the execution whose history branch doesn't exist
+	CorruptionTypeMissingHistory CorruptionType = "missing_history"
+	// CorruptionTypeOpenNotCurrent is for the open execution which is not the current execution of its workflow
+	CorruptionTypeOpenNotCurrent CorruptionType = "open_execution_not_current"
+	// CorruptionTypeDanglingCurrent is for the current execution pointing to an execution which doesn't exist
+	CorruptionTypeDanglingCurrent CorruptionType = "dangling_current_execution"
+	// CorruptionTypeStuckOpen is for the open execution without pending decision or timer which outlives its workflow timeout,
+	// i.e. the workflow timeout timer is lost
+	CorruptionTypeStuckOpen CorruptionType = "stuck_open_execution"
+)
+
+const (
+	// used this to decide how many goroutines to process
+	rpsPerConcurrency = 50
+	pageSize          = 1000
+	// bound the size of the report which is carried in the heartbeat details and the activity result
+	maxReportSize = 1000
+	// an open execution is only considered stuck when its workflow timeout has passed by this long
+	stuckOpenExecutionGracePeriod = time.Hour * 24
+
+	scavengerIdentity    = "cadence-sys-executions-scanner"
+	stuckTerminateReason = "workflow execution is stuck open after its workflow timeout"
+)
+
+var corruptionMetrics = map[CorruptionType]int{
+	CorruptionTypeMissingHistory:  metrics.ExecutionsScavengerMissingHistoryCount,
+	CorruptionTypeOpenNotCurrent:  metrics.ExecutionsScavengerOpenNotCurrentCount,
+	CorruptionTypeDanglingCurrent: metrics.ExecutionsScavengerDanglingCurrentCount,
+	CorruptionTypeStuckOpen:       metrics.ExecutionsScavengerStuckOpenCount,
+}
+
+// NewScavenger returns an instance of executions scavenger daemon
+// The Scavenger can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over all of the executions of all shards. For
+// each execution, the scavenger will check
+//   - the history branch of the execution exists
+//   - an open execution is the current execution of its workflow
+//   - the current execution of the workflow exists
+//   - an open execution without pending decision or timer doesn't outlive its workflow timeout
+//
+// When fixEnabled is set, executions without history are deleted and
+// stuck open executions are terminated, other corruptions are only reported
+func NewScavenger(
+	executionDBFactory p.ExecutionManagerFactory,
+	historyDB p.HistoryV2Manager,
+	client historyserviceclient.Interface,
+	numShards int,
+	fixEnabled bool,
+	rps int,
+	hbd ScavengerHeartbeatDetails,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Scavenger {
+
+	rateLimiter := rate.NewLimiter(rate.Limit(rps), rps)
+
+	return &Scavenger{
+		executionDBFactory: executionDBFactory,
+		historyDB:          historyDB,
+		client:             client,
+		numShards:          numShards,
+		fixEnabled:         fixEnabled,
+		hbd:                hbd,
+		rps:                rps,
+		limiter:            rateLimiter,
+		timeSource:         clock.NewRealTimeSource(),
+		metrics:            metricsClient,
+		logger:             logger,
+	}
+}
+
+// Run runs the scavenger
+func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error) {
+	taskCh := make(chan taskDetail, pageSize)
+	respCh := make(chan taskResult, pageSize)
+	concurrency := s.rps/rpsPerConcurrency + 1
+
+	for i := 0; i < concurrency; i++ {
+		go s.startTaskProcessor(ctx, taskCh, respCh)
+	}
+
+	for ; s.hbd.ShardID < s.numShards; s.hbd.ShardID++ {
+		executionDB, err := s.executionDBFactory.NewExecutionManager(s.hbd.ShardID)
+		if err != nil {
+			return s.hbd, err
+		}
+
+		for {
+			if err := s.limiter.Wait(ctx); err != nil {
+				return s.hbd, err
+			}
+			resp, err := executionDB.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+				PageSize:  pageSize,
+				PageToken: s.hbd.NextPageToken,
+			})
+			if err != nil {
+				return s.hbd, err
+			}
+
+			// send all tasks
+			for _, info := range resp.ExecutionInfos {
+				taskCh <- taskDetail{
+					shardID:     s.hbd.ShardID,
+					executionDB: executionDB,
+					info:        info,
+
+					hbd: s.hbd,
+				}
+			}
+
+			batchCount := len(resp.ExecutionInfos)
+			var corruptions []CorruptedExecution
+			errCount := 0
+			// wait for counters indicate this batch is done
+			for i := 0; i < batchCount; i++ {
+				select {
+				case result := <-respCh:
+					s.metrics.IncCounter(metrics.ExecutionsScavengerScope, metrics.ExecutionsScavengerExecutionCount)
+					if result.err != nil {
+						s.metrics.IncCounter(metrics.ExecutionsScavengerScope, metrics.ExecutionsScavengerErrorCount)
+						errCount++
+					}
+					corruptions = append(corruptions, result.corruptions...)
+				case <-ctx.Done():
+					return s.hbd, ctx.Err()
+				}
+			}
+
+			s.hbd.NextPageToken = resp.PageToken
+			s.hbd.ExecutionCount += batchCount
+			s.hbd.ErrorCount += errCount
+			for _, corruption := range corruptions {
+				s.hbd.CorruptedCount++
+				if corruption.Fixed {
+					s.hbd.FixedCount++
+				}
+				if len(s.hbd.Report) < maxReportSize {
+					s.hbd.Report = append(s.hbd.Report, corruption)
+				}
+			}
+			if !s.isInTest {
+				activity.RecordHeartbeat(ctx, s.hbd)
+			}
+
+			if len(s.hbd.NextPageToken) == 0 {
+				break
+			}
+		}
+	}
+	return s.hbd, nil
+}
+
+func (s *Scavenger) startTaskProcessor(
+	ctx context.Context,
+	taskCh chan taskDetail,
+	respCh chan taskResult,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-taskCh:
+			if isDone(ctx) {
+				return
+			}
+
+			if !s.isInTest {
+				activity.RecordHeartbeat(ctx, task.hbd)
+			}
+
+			corruptions, err := s.checkExecution(ctx, task)
+			if err != nil {
+				s.logger.Error("encounter error when checking the execution", getTaskLoggingTags(err, task)...)
+			}
+			for _, corruption := range corruptions {
+				s.metrics.IncCounter(metrics.ExecutionsScavengerScope, corruptionMetrics[corruption.Type])
+				if corruption.Fixed {
+					s.metrics.IncCounter(metrics.ExecutionsScavengerScope, metrics.ExecutionsScavengerFixedCount)
+				}
+				msg := "found corrupted execution"
+				if corruption.Fixed {
+					msg = "fixed corrupted execution"
+				}
+				s.logger.Warn(msg,
+					tag.ShardID(corruption.ShardID),
+					tag.WorkflowDomainID(corruption.DomainID),
+					tag.WorkflowID(corruption.WorkflowID),
+					tag.WorkflowRunID(corruption.RunID),
+					tag.Value(corruption.Type),
+					tag.DetailInfo(corruption.Detail),
+				)
+			}
+			respCh <- taskResult{corruptions: corruptions, err: err}
+		}
+	}
+}
+
+// checkExecution checks the invariants of an execution and fixes the corruptions when it is enabled
+func (s *Scavenger) checkExecution(
+	ctx context.Context,
+	task taskDetail,
+) ([]CorruptedExecution, error) {
+
+	resp, err := s.getWorkflowExecution(ctx, task.executionDB, task.info.DomainID, task.info.WorkflowID, task.info.RunID)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			// the execution is deleted after it is listed
+			return nil, nil
+		}
+		return nil, err
+	}
+	state := resp.State
+	info := state.ExecutionInfo
+
+	startedEvent, err := s.getStartedEvent(ctx, task.shardID, state)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); !ok {
+			return nil, err
+		}
+		corruption := newCorruptedExecution(task.shardID, info.DomainID, info.WorkflowID, info.RunID, CorruptionTypeMissingHistory, err.Error())
+		if s.fixEnabled {
+			// the execution can't be loaded without its history, delete it
+			if err := s.deleteExecution(ctx, task.executionDB, info); err != nil {
+				return []CorruptedExecution{corruption}, err
+			}
+			corruption.Fixed = true
+		}
+		return []CorruptedExecution{corruption}, nil
+	}
+
+	if info.State != p.WorkflowStateCreated && info.State != p.WorkflowStateRunning {
+		return nil, nil
+	}
+
+	var corruptions []CorruptedExecution
+	if err := s.waitForLimiter(ctx); err != nil {
+		return nil, err
+	}
+	current, err := task.executionDB.GetCurrentExecution(&p.GetCurrentExecutionRequest{
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); !ok {
+			return nil, err
+		}
+		current = nil
+	}
+	if current == nil {
+		corruptions = append(corruptions, newCorruptedExecution(task.shardID, info.DomainID, info.WorkflowID, info.RunID,
+			CorruptionTypeOpenNotCurrent, "the workflow has no current execution"))
+	} else if current.RunID != info.RunID {
+		corruptions = append(corruptions, newCorruptedExecution(task.shardID, info.DomainID, info.WorkflowID, info.RunID,
+			CorruptionTypeOpenNotCurrent, fmt.Sprintf("the current execution is %v", current.RunID)))
+		_, err := s.getWorkflowExecution(ctx, task.executionDB, info.DomainID, info.WorkflowID, current.RunID)
+		if err != nil {
+			if _, ok := err.(*shared.EntityNotExistsError); !ok {
+				return corruptions, err
+			}
+			corruptions = append(corruptions, newCorruptedExecution(task.shardID, info.DomainID, info.WorkflowID, current.RunID,
+				CorruptionTypeDanglingCurrent, err.Error()))
+		}
+	}
+
+	if s.isStuckOpen(state, startedEvent) {
+		corruption := newCorruptedExecution(task.shardID, info.DomainID, info.WorkflowID, info.RunID, CorruptionTypeStuckOpen,
+			fmt.Sprintf("started at %v with workflow timeout %v seconds", info.StartTimestamp, info.WorkflowTimeout))
+		if s.fixEnabled {
+			err := s.client.TerminateWorkflowExecution(ctx, &history.TerminateWorkflowExecutionRequest{
+				DomainUUID: common.StringPtr(info.DomainID),
+				TerminateRequest: &shared.TerminateWorkflowExecutionRequest{
+					WorkflowExecution: &shared.WorkflowExecution{
+						WorkflowId: common.StringPtr(info.WorkflowID),
+						RunId:      common.StringPtr(info.RunID),
+					},
+					Reason:   common.StringPtr(stuckTerminateReason),
+					Identity: common.StringPtr(scavengerIdentity),
+				},
+			})
+			if err != nil {
+				return append(corruptions, corruption), err
+			}
+			corruption.Fixed = true
+		}
+		corruptions = append(corruptions, corruption)
+	}
+	return corruptions, nil
+}
+
+// isStuckOpen returns true if the running execution without pending decision or timer outlives its workflow timeout
+func (s *Scavenger) isStuckOpen(
+	state *p.WorkflowMutableState,
+	startedEvent *shared.HistoryEvent,
+) bool {
+
+	info := state.ExecutionInfo
+	if info.State != p.WorkflowStateRunning ||
+		info.DecisionScheduleID != common.EmptyEventID ||
+		len(state.TimerInfos) > 0 ||
+		startedEvent == nil ||
+		startedEvent.WorkflowExecutionStartedEventAttributes == nil {
+		return false
+	}
+
+	// the workflow timeout timer fires after the first decision backoff of cron and retry
+	backoff := time.Duration(startedEvent.WorkflowExecutionStartedEventAttributes.GetFirstDecisionTaskBackoffSeconds()) * time.Second
+	timeout := time.Duration(info.WorkflowTimeout) * time.Second
+	deadline := info.StartTimestamp.Add(backoff + timeout + stuckOpenExecutionGracePeriod)
+	return s.timeSource.Now().After(deadline)
+}
+
+// getStartedEvent reads the first event from the history branch of the execution,
+// nil is returned for the execution which doesn't use events V2
+func (s *Scavenger) getStartedEvent(
+	ctx context.Context,
+	shardID int,
+	state *p.WorkflowMutableState,
+) (*shared.HistoryEvent, error) {
+
+	if state.ExecutionInfo.EventStoreVersion != p.EventStoreVersionV2 {
+		return nil, nil
+	}
+	branchToken := state.ExecutionInfo.BranchToken
+	if state.VersionHistories != nil {
+		currentVersionHistory, err := state.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return nil, err
+		}
+		branchToken = currentVersionHistory.GetBranchToken()
+	}
+
+	if err := s.waitForLimiter(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := s.historyDB.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.FirstEventID + 1,
+		PageSize:    1,
+		ShardID:     common.IntPtr(shardID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.HistoryEvents) == 0 {
+		return nil, nil
+	}
+	return resp.HistoryEvents[0], nil
+}
+
+func (s *Scavenger) getWorkflowExecution(
+	ctx context.Context,
+	executionDB p.ExecutionManager,
+	domainID string,
+	workflowID string,
+	runID string,
+) (*p.GetWorkflowExecutionResponse, error) {
+
+	if err := s.waitForLimiter(ctx); err != nil {
+		return nil, err
+	}
+	return executionDB.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		DomainID: domainID,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+	})
+}
+
+func (s *Scavenger) deleteExecution(
+	ctx context.Context,
+	executionDB p.ExecutionManager,
+	info *p.WorkflowExecutionInfo,
+) error {
+
+	// the current execution is only deleted when it points to this execution,
+	// it is deleted first so that a failure in between won't leave a dangling current execution
+	if err := s.waitForLimiter(ctx); err != nil {
+		return err
+	}
+	if err := executionDB.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+		RunID:      info.RunID,
+	}); err != nil {
+		return err
+	}
+
+	if err := s.waitForLimiter(ctx); err != nil {
+		return err
+	}
+	return executionDB.DeleteWorkflowExecution(&p.DeleteWorkflowExecutionRequest{
+		DomainID:   info.DomainID,
+		WorkflowID: info.WorkflowID,
+		RunID:      info.RunID,
+	})
+}
+
+func (s *Scavenger) waitForLimiter(ctx context.Context) error {
+	return s.limiter.Wait(ctx)
+}
+
+func newCorruptedExecution(
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+	corruptionType CorruptionType,
+	detail string,
+) CorruptedExecution {
+	return CorruptedExecution{
+		ShardID:    shardID,
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+		Type:       corruptionType,
+		Detail:     detail,
+	}
+}
+
+func getTaskLoggingTags(err error, task taskDetail) []tag.Tag {
+	return []tag.Tag{
+		tag.Error(err),
+		tag.ShardID(task.shardID),
+		tag.WorkflowDomainID(task.info.DomainID),
+		tag.WorkflowID(task.info.WorkflowID),
+		tag.WorkflowRunID(task.info.RunID),
+	}
+}
+
+func isDone(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,295 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"go.uber.org/zap"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		logger log.Logger
+		metric metrics.Client
+
+		executionDBs []*mocks.ExecutionManager
+		historyDB    *mocks.HistoryV2Manager
+		client       *historyservicetest.MockClient
+		controller   *gomock.Controller
+		now          time.Time
+	}
+
+	testExecutionDBFactory struct {
+		executionDBs []*mocks.ExecutionManager
+	}
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testWorkflowID = "test-workflow-id"
+	testRunID      = "test-run-id"
+)
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	zapLogger, err := zap.NewDevelopment()
+	if err != nil {
+		s.Require().NoError(err)
+	}
+	s.logger = loggerimpl.NewLogger(zapLogger)
+	s.metric = metrics.NewClient(tally.NoopScope, metrics.Worker)
+	s.historyDB = &mocks.HistoryV2Manager{}
+	s.controller = gomock.NewController(s.T())
+	s.client = historyservicetest.NewMockClient(s.controller)
+	s.now = time.Now()
+}
+
+func (s *ScavengerTestSuite) TearDownTest() {
+	for _, db := range s.executionDBs {
+		db.AssertExpectations(s.T())
+	}
+	s.historyDB.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *ScavengerTestSuite) createTestScavenger(numShards int, fixEnabled bool) *Scavenger {
+	s.executionDBs = nil
+	for i := 0; i < numShards; i++ {
+		s.executionDBs = append(s.executionDBs, &mocks.ExecutionManager{})
+	}
+	factory := &testExecutionDBFactory{executionDBs: s.executionDBs}
+	scvgr := NewScavenger(factory, s.historyDB, s.client, numShards, fixEnabled, 100, ScavengerHeartbeatDetails{}, s.metric, s.logger)
+	scvgr.timeSource = clock.NewEventTimeSource().Update(s.now)
+	scvgr.isInTest = true
+	return scvgr
+}
+
+func (s *ScavengerTestSuite) TestNoCorruption() {
+	scvgr := s.createTestScavenger(2, true)
+	running := s.newExecutionInfo(testRunID, p.WorkflowStateRunning)
+	completed := s.newExecutionInfo("completed-run-id", p.WorkflowStateCompleted)
+	s.executionDBs[0].On("ListConcreteExecutions", &p.ListConcreteExecutionsRequest{
+		PageSize: pageSize,
+	}).Return(&p.ListConcreteExecutionsResponse{
+		ExecutionInfos: []*p.WorkflowExecutionInfo{running},
+		PageToken:      []byte("page1"),
+	}, nil).Once()
+	s.executionDBs[0].On("ListConcreteExecutions", &p.ListConcreteExecutionsRequest{
+		PageSize:  pageSize,
+		PageToken: []byte("page1"),
+	}).Return(&p.ListConcreteExecutionsResponse{
+		ExecutionInfos: []*p.WorkflowExecutionInfo{completed},
+	}, nil).Once()
+	s.executionDBs[1].On("ListConcreteExecutions", &p.ListConcreteExecutionsRequest{
+		PageSize: pageSize,
+	}).Return(&p.ListConcreteExecutionsResponse{}, nil).Once()
+	s.mockGetWorkflowExecution(s.executionDBs[0], running, nil)
+	s.mockGetWorkflowExecution(s.executionDBs[0], completed, nil)
+	s.mockReadStartedEvent(0, 2)
+	s.mockGetCurrentExecution(s.executionDBs[0], testRunID)
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(2, hbd.ShardID)
+	s.Equal(2, hbd.ExecutionCount)
+	s.Equal(0, hbd.CorruptedCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Empty(hbd.Report)
+}
+
+func (s *ScavengerTestSuite) TestMissingHistory_Fixed() {
+	scvgr := s.createTestScavenger(1, true)
+	info := s.newExecutionInfo(testRunID, p.WorkflowStateRunning)
+	s.mockListExecutions(info)
+	s.mockGetWorkflowExecution(s.executionDBs[0], info, nil)
+	s.historyDB.On("ReadHistoryBranch", mock.Anything).Return(nil, &shared.EntityNotExistsError{}).Once()
+	s.executionDBs[0].On("DeleteCurrentWorkflowExecution", &p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}).Return(nil).Once()
+	s.executionDBs[0].On("DeleteWorkflowExecution", &p.DeleteWorkflowExecutionRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}).Return(nil).Once()
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.CorruptedCount)
+	s.Equal(1, hbd.FixedCount)
+	s.Len(hbd.Report, 1)
+	s.Equal(CorruptionTypeMissingHistory, hbd.Report[0].Type)
+	s.True(hbd.Report[0].Fixed)
+}
+
+func (s *ScavengerTestSuite) TestOpenNotCurrent_DanglingCurrent() {
+	scvgr := s.createTestScavenger(1, true)
+	info := s.newExecutionInfo(testRunID, p.WorkflowStateRunning)
+	dangling := s.newExecutionInfo("dangling-run-id", p.WorkflowStateRunning)
+	s.mockListExecutions(info)
+	s.mockGetWorkflowExecution(s.executionDBs[0], info, nil)
+	s.mockGetWorkflowExecution(s.executionDBs[0], dangling, &shared.EntityNotExistsError{})
+	s.mockReadStartedEvent(0, 1)
+	s.mockGetCurrentExecution(s.executionDBs[0], dangling.RunID)
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(2, hbd.CorruptedCount)
+	s.Equal(0, hbd.FixedCount)
+	s.Len(hbd.Report, 2)
+	s.Equal(CorruptionTypeOpenNotCurrent, hbd.Report[0].Type)
+	s.Equal(testRunID, hbd.Report[0].RunID)
+	s.Equal(CorruptionTypeDanglingCurrent, hbd.Report[1].Type)
+	s.Equal(dangling.RunID, hbd.Report[1].RunID)
+}
+
+func (s *ScavengerTestSuite) TestStuckOpen_Fixed() {
+	scvgr := s.createTestScavenger(1, true)
+	info := s.newExecutionInfo(testRunID, p.WorkflowStateRunning)
+	info.StartTimestamp = s.now.Add(-stuckOpenExecutionGracePeriod - time.Hour)
+	s.mockListExecutions(info)
+	s.mockGetWorkflowExecution(s.executionDBs[0], info, nil)
+	s.mockReadStartedEvent(0, 1)
+	s.mockGetCurrentExecution(s.executionDBs[0], testRunID)
+	s.client.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.CorruptedCount)
+	s.Equal(1, hbd.FixedCount)
+	s.Equal(CorruptionTypeStuckOpen, hbd.Report[0].Type)
+}
+
+func (s *ScavengerTestSuite) TestStuckOpen_WaitingForFirstDecisionBackoff() {
+	scvgr := s.createTestScavenger(1, false)
+	info := s.newExecutionInfo(testRunID, p.WorkflowStateRunning)
+	info.StartTimestamp = s.now.Add(-stuckOpenExecutionGracePeriod - time.Hour)
+	s.mockListExecutions(info)
+	s.mockGetWorkflowExecution(s.executionDBs[0], info, nil)
+	s.mockReadStartedEvent(int32((time.Hour * 2).Seconds()), 1)
+	s.mockGetCurrentExecution(s.executionDBs[0], testRunID)
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(0, hbd.CorruptedCount)
+}
+
+func (s *ScavengerTestSuite) TestCheckError() {
+	scvgr := s.createTestScavenger(1, false)
+	info := s.newExecutionInfo(testRunID, p.WorkflowStateRunning)
+	s.mockListExecutions(info)
+	s.mockGetWorkflowExecution(s.executionDBs[0], info, &shared.InternalServiceError{})
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.ExecutionCount)
+	s.Equal(1, hbd.ErrorCount)
+	s.Equal(0, hbd.CorruptedCount)
+}
+
+func (s *ScavengerTestSuite) newExecutionInfo(runID string, state int) *p.WorkflowExecutionInfo {
+	return &p.WorkflowExecutionInfo{
+		DomainID:           testDomainID,
+		WorkflowID:         testWorkflowID,
+		RunID:              runID,
+		State:              state,
+		StartTimestamp:     s.now,
+		WorkflowTimeout:    10,
+		DecisionScheduleID: common.EmptyEventID,
+		EventStoreVersion:  p.EventStoreVersionV2,
+		BranchToken:        []byte("branch-token-" + runID),
+	}
+}
+
+func (s *ScavengerTestSuite) mockListExecutions(infos ...*p.WorkflowExecutionInfo) {
+	s.executionDBs[0].On("ListConcreteExecutions", &p.ListConcreteExecutionsRequest{
+		PageSize: pageSize,
+	}).Return(&p.ListConcreteExecutionsResponse{
+		ExecutionInfos: infos,
+	}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) mockGetWorkflowExecution(db *mocks.ExecutionManager, info *p.WorkflowExecutionInfo, err error) {
+	request := &p.GetWorkflowExecutionRequest{
+		DomainID: info.DomainID,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(info.WorkflowID),
+			RunId:      common.StringPtr(info.RunID),
+		},
+	}
+	if err != nil {
+		db.On("GetWorkflowExecution", request).Return(nil, err).Once()
+		return
+	}
+	db.On("GetWorkflowExecution", request).Return(&p.GetWorkflowExecutionResponse{
+		State: &p.WorkflowMutableState{ExecutionInfo: info},
+	}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) mockReadStartedEvent(firstDecisionBackoffSeconds int32, times int) {
+	s.historyDB.On("ReadHistoryBranch", mock.Anything).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*shared.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(common.FirstEventID),
+				EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+				WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+					FirstDecisionTaskBackoffSeconds: common.Int32Ptr(firstDecisionBackoffSeconds),
+				},
+			},
+		},
+	}, nil).Times(times)
+}
+
+func (s *ScavengerTestSuite) mockGetCurrentExecution(db *mocks.ExecutionManager, runID string) {
+	db.On("GetCurrentExecution", &p.GetCurrentExecutionRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+	}).Return(&p.GetCurrentExecutionResponse{
+		RunID: runID,
+	}, nil).Once()
+}
+
+func (f *testExecutionDBFactory) NewExecutionManager(shardID int) (p.ExecutionManager, error) {
+	return f.executionDBs[shardID], nil
+}
+
+func (f *testExecutionDBFactory) Close() {}
@@ -0,0 +1,461 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+)
+
+type (
+	// ScavengerHeartbeatDetails is the heartbeat detail for QueueScavengerActivity
+	ScavengerHeartbeatDetails struct {
+		ShardID               int
+		TransferTaskCount     int
+		TimerTaskCount        int
+		DeadTransferTaskCount int
+		DeadTimerTaskCount    int
+		DeletedTaskCount      int
+		ErrorCount            int
+		// Report lists the shards with dead tasks, at most maxReportSize of them are recorded
+		Report []ShardReport
+	}
+
+	// ShardReport is the summary of the dead tasks found in the queues of a shard
+	ShardReport struct {
+		ShardID          int
+		TransferAckLevel int64
+		TimerAckLevel    time.Time
+		// tasks at or below the ack level of the queue, they are already processed
+		TransferTasksBelowAckLevel int
+		TimerTasksBelowAckLevel    int
+		// tasks of executions which no longer exist
+		OrphanTransferTasks int
+		OrphanTimerTasks    int
+		// Deleted is false in dry run mode or when the deletion fails
+		Deleted bool
+		Error   string
+	}
+
+	// Scavenger is the type that holds the state for queue scavenger daemon
+	Scavenger struct {
+		shardDB            p.ShardManager
+		executionDBFactory p.ExecutionManagerFactory
+		numShards          int
+		dryRun             bool
+		hbd                ScavengerHeartbeatDetails
+		limiter            *rate.Limiter
+		timeSource         clock.TimeSource
+		metrics            metrics.Client
+		logger             log.Logger
+		isInTest           bool
+	}
+
+	executionKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	// shardScavenger holds the state for scavenging the queues of a single shard
+	shardScavenger struct {
+		*Scavenger
+		executionDB p.ExecutionManager
+		report      ShardReport
+		// caches the existence of the executions owning the tasks
+		executions          map[executionKey]bool
+		orphanTransferTasks []*p.TransferTaskInfo
+		orphanTimerTasks    []*p.TimerTaskInfo
+	}
+)
+
+const (
+	pageSize = 1000
+	// bound the size of the report which is carried in the heartbeat details and the activity result
+	maxReportSize = 1000
+	// tasks above the ack level are only checked against mutable state when they are older than this,
+	// so that the tasks created with their execution are not treated as orphans
+	orphanTaskAge = time.Hour * 24
+)
+
+var minTimerTaskTimestamp = time.Unix(0, 0)
+
+// NewScavenger returns an instance of queue scavenger daemon
+// The Scavenger can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over the transfer and timer queues of all shards.
+// For each queue, the scavenger will find
+//   - tasks at or below the ack level of the queue, which are already processed
+//     but left behind, e.g. when the range deletion of history service failed
+//   - tasks older than orphanTaskAge above the ack level whose execution doesn't exist
+//
+// Unless dryRun is set, the dead tasks are deleted, tasks below the ack
+// level are deleted by range and orphan tasks are deleted one by one
+func NewScavenger(
+	shardDB p.ShardManager,
+	executionDBFactory p.ExecutionManagerFactory,
+	numShards int,
+	dryRun bool,
+	rps int,
+	hbd ScavengerHeartbeatDetails,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Scavenger {
+
+	rateLimiter := rate.NewLimiter(rate.Limit(rps), rps)
+
+	return &Scavenger{
+		shardDB:            shardDB,
+		executionDBFactory: executionDBFactory,
+		numShards:          numShards,
+		dryRun:             dryRun,
+		hbd:                hbd,
+		limiter:            rateLimiter,
+		timeSource:         clock.NewRealTimeSource(),
+		metrics:            metricsClient,
+		logger:             logger,
+	}
+}
+
+// Run runs the scavenger
+func (s *Scavenger) Run(ctx context.Context) (ScavengerHeartbeatDetails, error) {
+	for ; s.hbd.ShardID < s.numShards; s.hbd.ShardID++ {
+		report, err := s.scavengeShard(ctx, s.hbd.ShardID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return s.hbd, ctx.Err()
+			}
+			s.metrics.IncCounter(metrics.QueueScavengerScope, metrics.QueueScavengerErrorCount)
+			s.logger.Error("encounter error when scavenging the queues of shard", tag.ShardID(s.hbd.ShardID), tag.Error(err))
+			s.hbd.ErrorCount++
+			report.Error = err.Error()
+		}
+
+		deadTransferTaskCount := report.TransferTasksBelowAckLevel + report.OrphanTransferTasks
+		deadTimerTaskCount := report.TimerTasksBelowAckLevel + report.OrphanTimerTasks
+		s.hbd.DeadTransferTaskCount += deadTransferTaskCount
+		s.hbd.DeadTimerTaskCount += deadTimerTaskCount
+		if report.Deleted {
+			s.hbd.DeletedTaskCount += deadTransferTaskCount + deadTimerTaskCount
+		}
+		if (deadTransferTaskCount > 0 || deadTimerTaskCount > 0 || err != nil) && len(s.hbd.Report) < maxReportSize {
+			s.hbd.Report = append(s.hbd.Report, report)
+		}
+		if !s.isInTest {
+			activity.RecordHeartbeat(ctx, s.hbd)
+		}
+	}
+	return s.hbd, nil
+}
+
+// scavengeShard finds the dead tasks in the transfer and timer queues of the shard and deletes them unless in dry run mode
+func (s *Scavenger) scavengeShard(
+	ctx context.Context,
+	shardID int,
+) (ShardReport, error) {
+
+	report := ShardReport{ShardID: shardID}
+	if err := s.waitForLimiter(ctx); err != nil {
+		return report, err
+	}
+	resp, err := s.shardDB.GetShard(&p.GetShardRequest{ShardID: shardID})
+	if err != nil {
+		return report, err
+	}
+	executionDB, err := s.executionDBFactory.NewExecutionManager(shardID)
+	if err != nil {
+		return report, err
+	}
+
+	report.TransferAckLevel = getTransferAckLevel(resp.ShardInfo)
+	report.TimerAckLevel = getTimerAckLevel(resp.ShardInfo)
+	shard := &shardScavenger{
+		Scavenger:   s,
+		executionDB: executionDB,
+		report:      report,
+		executions:  make(map[executionKey]bool),
+	}
+	if err := shard.scanTransferQueue(ctx); err != nil {
+		return shard.report, err
+	}
+	if err := shard.scanTimerQueue(ctx); err != nil {
+		return shard.report, err
+	}
+	if s.dryRun {
+		return shard.report, nil
+	}
+	if err := shard.deleteDeadTasks(ctx); err != nil {
+		return shard.report, err
+	}
+	shard.report.Deleted = true
+	return shard.report, nil
+}
+
+func (s *shardScavenger) scanTransferQueue(ctx context.Context) error {
+	ackLevel := s.report.TransferAckLevel
+	orphanTaskVisibility := s.timeSource.Now().Add(-orphanTaskAge)
+	var pageToken []byte
+	for {
+		if err := s.waitForLimiter(ctx); err != nil {
+			return err
+		}
+		resp, err := s.executionDB.GetTransferTasks(&p.GetTransferTasksRequest{
+			ReadLevel:     0,
+			MaxReadLevel:  math.MaxInt64,
+			BatchSize:     pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, task := range resp.Tasks {
+			s.hbd.TransferTaskCount++
+			s.metrics.IncCounter(metrics.QueueScavengerScope, metrics.QueueScavengerTransferTaskCount)
+			if task.TaskID <= ackLevel {
+				s.report.TransferTasksBelowAckLevel++
+				s.metrics.IncCounter(metrics.QueueScavengerScope, metrics.QueueScavengerDeadTransferTaskCount)
+				continue
+			}
+			if !task.VisibilityTimestamp.Before(orphanTaskVisibility) {
+				continue
+			}
+			exists, err := s.executionExists(ctx, task.DomainID, task.WorkflowID, task.RunID)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				s.report.OrphanTransferTasks++
+				s.orphanTransferTasks = append(s.orphanTransferTasks, task)
+				s.metrics.IncCounter(metrics.QueueScavengerScope, metrics.QueueScavengerDeadTransferTaskCount)
+			}
+		}
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (s *shardScavenger) scanTimerQueue(ctx context.Context) error {
+	ackLevel := s.report.TimerAckLevel
+	orphanTaskVisibility := s.timeSource.Now().Add(-orphanTaskAge)
+	if orphanTaskVisibility.Before(ackLevel) {
+		orphanTaskVisibility = ackLevel
+	}
+	var pageToken []byte
+	for {
+		if err := s.waitForLimiter(ctx); err != nil {
+			return err
+		}
+		// timers above orphanTaskVisibility are neither below the ack level nor old enough to be orphans
+		resp, err := s.executionDB.GetTimerIndexTasks(&p.GetTimerIndexTasksRequest{
+			MinTimestamp:  minTimerTaskTimestamp,
+			MaxTimestamp:  orphanTaskVisibility,
+			BatchSize:     pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, task := range resp.Timers {
+			s.hbd.TimerTaskCount++
+			s.metrics.IncCounter(metrics.QueueScavengerScope, metrics.QueueScavengerTimerTaskCount)
+			if task.VisibilityTimestamp.Before(ackLevel) {
+				s.report.TimerTasksBelowAckLevel++
+				s.metrics.IncCounter(metrics.QueueScavengerScope, metrics.QueueScavengerDeadTimerTaskCount)
+				continue
+			}
+			if !task.VisibilityTimestamp.Before(orphanTaskVisibility) {
+				continue
+			}
+			exists, err := s.executionExists(ctx, task.DomainID, task.WorkflowID, task.RunID)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				s.report.OrphanTimerTasks++
+				s.orphanTimerTasks = append(s.orphanTimerTasks, task)
+				s.metrics.IncCounter(metrics.QueueScavengerScope, metrics.QueueScavengerDeadTimerTaskCount)
+			}
+		}
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// deleteDeadTasks deletes the dead tasks found by the scan, it is done after the
+// scan so that the deletion won't invalidate the page tokens of the queues
+func (s *shardScavenger) deleteDeadTasks(ctx context.Context) error {
+	if s.report.TransferTasksBelowAckLevel > 0 {
+		if err := s.waitForLimiter(ctx); err != nil {
+			return err
+		}
+		if err := s.executionDB.RangeCompleteTransferTask(&p.RangeCompleteTransferTaskRequest{
+			ExclusiveBeginTaskID: 0,
+			InclusiveEndTaskID:   s.report.TransferAckLevel,
+		}); err != nil {
+			return err
+		}
+		s.metrics.AddCounter(metrics.QueueScavengerScope, metrics.QueueScavengerDeletedTaskCount, int64(s.report.TransferTasksBelowAckLevel))
+	}
+	if s.report.TimerTasksBelowAckLevel > 0 {
+		if err := s.waitForLimiter(ctx); err != nil {
+			return err
+		}
+		if err := s.executionDB.RangeCompleteTimerTask(&p.RangeCompleteTimerTaskRequest{
+			InclusiveBeginTimestamp: minTimerTaskTimestamp,
+			ExclusiveEndTimestamp:   s.report.TimerAckLevel,
+		}); err != nil {
+			return err
+		}
+		s.metrics.AddCounter(metrics.QueueScavengerScope, metrics.QueueScavengerDeletedTaskCount, int64(s.report.TimerTasksBelowAckLevel))
+	}
+
+	for _, task := range s.orphanTransferTasks {
+		if err := s.waitForLimiter(ctx); err != nil {
+			return err
+		}
+		if err := s.executionDB.CompleteTransferTask(&p.CompleteTransferTaskRequest{
+			TaskID: task.TaskID,
+		}); err != nil {
+			return err
+		}
+		s.metrics.IncCounter(metrics.QueueScavengerScope, metrics.QueueScavengerDeletedTaskCount)
+		s.logger.Info("deleted orphan transfer task", getTaskLoggingTags(s.report.ShardID, task.DomainID, task.WorkflowID, task.RunID, task.TaskID)...)
+	}
+	for _, task := range s.orphanTimerTasks {
+		if err := s.waitForLimiter(ctx); err != nil {
+			return err
+		}
+		if err := s.executionDB.CompleteTimerTask(&p.CompleteTimerTaskRequest{
+			VisibilityTimestamp: task.VisibilityTimestamp,
+			TaskID:              task.TaskID,
+		}); err != nil {
+			return err
+		}
+		s.metrics.IncCounter(metrics.QueueScavengerScope, metrics.QueueScavengerDeletedTaskCount)
+		s.logger.Info("deleted orphan timer task", getTaskLoggingTags(s.report.ShardID, task.DomainID, task.WorkflowID, task.RunID, task.TaskID)...)
+	}
+	return nil
+}
+
+// executionExists returns false if the execution owning a task doesn't exist
+func (s *shardScavenger) executionExists(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	runID string,
+) (bool, error) {
+
+	key := executionKey{domainID: domainID, workflowID: workflowID, runID: runID}
+	if exists, ok := s.executions[key]; ok {
+		return exists, nil
+	}
+
+	if err := s.waitForLimiter(ctx); err != nil {
+		return false, err
+	}
+	_, err := s.executionDB.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+		DomainID: domainID,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); !ok {
+			return false, err
+		}
+	}
+	s.executions[key] = err == nil
+	return err == nil, nil
+}
+
+func (s *Scavenger) waitForLimiter(ctx context.Context) error {
+	return s.limiter.Wait(ctx)
+}
+
+// getTransferAckLevel returns the level at or below which the transfer tasks are processed
+// by all the transfer queue processors of the shard, including the standby and failover ones
+func getTransferAckLevel(info *p.ShardInfo) int64 {
+	ackLevel := info.TransferAckLevel
+	for _, level := range info.ClusterTransferAckLevel {
+		if level < ackLevel {
+			ackLevel = level
+		}
+	}
+	for _, level := range info.TransferFailoverLevels {
+		if level.MinLevel < ackLevel {
+			ackLevel = level.MinLevel
+		}
+	}
+	return ackLevel
+}
+
+// getTimerAckLevel returns the level below which the timer tasks are processed
+// by all the timer queue processors of the shard, including the standby and failover ones
+func getTimerAckLevel(info *p.ShardInfo) time.Time {
+	ackLevel := info.TimerAckLevel
+	for _, level := range info.ClusterTimerAckLevel {
+		if level.Before(ackLevel) {
+			ackLevel = level
+		}
+	}
+	for _, level := range info.TimerFailoverLevels {
+		if level.MinLevel.Before(ackLevel) {
+			ackLevel = level.MinLevel
+		}
+	}
+	return ackLevel
+}
+
+func getTaskLoggingTags(
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+	taskID int64,
+) []tag.Tag {
+	return []tag.Tag{
+		tag.ShardID(shardID),
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(workflowID),
+		tag.WorkflowRunID(runID),
+		tag.TaskID(taskID),
+	}
+}
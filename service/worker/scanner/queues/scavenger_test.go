@@ -0,0 +1,298 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"go.uber.org/zap"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		logger log.Logger
+		metric metrics.Client
+	}
+)
+
+const (
+	liveRunID   = "live-run-id"
+	orphanRunID = "orphan-run-id"
+)
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	zapLogger, err := zap.NewDevelopment()
+	if err != nil {
+		s.Require().NoError(err)
+	}
+	s.logger = loggerimpl.NewLogger(zapLogger)
+	s.metric = metrics.NewClient(tally.NoopScope, metrics.Worker)
+}
+
+func (s *ScavengerTestSuite) createTestScavenger(numShards int, dryRun bool, now time.Time) (*mocks.ShardManager, []*mocks.ExecutionManager, *Scavenger) {
+	shardDB := &mocks.ShardManager{}
+	factory := &mocks.ExecutionManagerFactory{}
+	var executionDBs []*mocks.ExecutionManager
+	for i := 0; i < numShards; i++ {
+		executionDB := &mocks.ExecutionManager{}
+		factory.On("NewExecutionManager", i).Return(executionDB, nil).Maybe()
+		executionDBs = append(executionDBs, executionDB)
+	}
+	scvgr := NewScavenger(shardDB, factory, numShards, dryRun, 100, ScavengerHeartbeatDetails{}, s.metric, s.logger)
+	scvgr.timeSource = clock.NewEventTimeSource().Update(now)
+	scvgr.isInTest = true
+	return shardDB, executionDBs, scvgr
+}
+
+func (s *ScavengerTestSuite) assertExpectations(shardDB *mocks.ShardManager, executionDBs []*mocks.ExecutionManager) {
+	shardDB.AssertExpectations(s.T())
+	for _, db := range executionDBs {
+		db.AssertExpectations(s.T())
+	}
+}
+
+func (s *ScavengerTestSuite) TestNoDeadTasks() {
+	now := time.Now()
+	shardDB, executionDBs, scvgr := s.createTestScavenger(1, false, now)
+	defer s.assertExpectations(shardDB, executionDBs)
+	timerAckLevel := now.Add(-time.Hour)
+	s.mockGetShard(shardDB, 0, 10, timerAckLevel)
+	s.mockGetTransferTasks(executionDBs[0], s.newTransferTask(20, liveRunID, now))
+	s.mockGetTimerTasks(executionDBs[0], timerAckLevel, s.newTimerTask(1, liveRunID, timerAckLevel))
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, hbd.ShardID)
+	s.Equal(1, hbd.TransferTaskCount)
+	s.Equal(1, hbd.TimerTaskCount)
+	s.Equal(0, hbd.DeadTransferTaskCount)
+	s.Equal(0, hbd.DeadTimerTaskCount)
+	s.Equal(0, hbd.DeletedTaskCount)
+	s.Empty(hbd.Report)
+}
+
+func (s *ScavengerTestSuite) TestDryRun() {
+	now := time.Now()
+	shardDB, executionDBs, scvgr := s.createTestScavenger(1, true, now)
+	defer s.assertExpectations(shardDB, executionDBs)
+	timerAckLevel := now.Add(-time.Hour * 48)
+	orphanTaskVisibility := now.Add(-orphanTaskAge)
+	s.mockGetShard(shardDB, 0, 10, timerAckLevel)
+	s.mockGetTransferTasks(executionDBs[0],
+		s.newTransferTask(5, liveRunID, timerAckLevel),
+		s.newTransferTask(20, orphanRunID, timerAckLevel),
+		s.newTransferTask(30, liveRunID, timerAckLevel),
+		s.newTransferTask(40, orphanRunID, now),
+	)
+	s.mockGetTimerTasks(executionDBs[0], orphanTaskVisibility,
+		s.newTimerTask(1, liveRunID, timerAckLevel.Add(-time.Hour)),
+		s.newTimerTask(2, orphanRunID, timerAckLevel.Add(time.Hour)),
+	)
+	s.mockGetWorkflowExecution(executionDBs[0], liveRunID, nil)
+	s.mockGetWorkflowExecution(executionDBs[0], orphanRunID, &shared.EntityNotExistsError{})
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(4, hbd.TransferTaskCount)
+	s.Equal(2, hbd.TimerTaskCount)
+	s.Equal(2, hbd.DeadTransferTaskCount)
+	s.Equal(2, hbd.DeadTimerTaskCount)
+	s.Equal(0, hbd.DeletedTaskCount)
+	s.Equal([]ShardReport{{
+		ShardID:                    0,
+		TransferAckLevel:           10,
+		TimerAckLevel:              timerAckLevel,
+		TransferTasksBelowAckLevel: 1,
+		TimerTasksBelowAckLevel:    1,
+		OrphanTransferTasks:        1,
+		OrphanTimerTasks:           1,
+	}}, hbd.Report)
+	executionDBs[0].AssertNotCalled(s.T(), "RangeCompleteTransferTask", mock.Anything)
+	executionDBs[0].AssertNotCalled(s.T(), "RangeCompleteTimerTask", mock.Anything)
+	executionDBs[0].AssertNotCalled(s.T(), "CompleteTransferTask", mock.Anything)
+	executionDBs[0].AssertNotCalled(s.T(), "CompleteTimerTask", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestDeleteDeadTasks() {
+	now := time.Now()
+	shardDB, executionDBs, scvgr := s.createTestScavenger(2, false, now)
+	defer s.assertExpectations(shardDB, executionDBs)
+	timerAckLevel := now.Add(-time.Hour * 48)
+	orphanTaskVisibility := now.Add(-orphanTaskAge)
+	orphanTimer := s.newTimerTask(2, orphanRunID, timerAckLevel.Add(time.Hour))
+	s.mockGetShard(shardDB, 0, 10, timerAckLevel)
+	s.mockGetTransferTasks(executionDBs[0],
+		s.newTransferTask(5, liveRunID, timerAckLevel),
+		s.newTransferTask(20, orphanRunID, timerAckLevel),
+	)
+	s.mockGetTimerTasks(executionDBs[0], orphanTaskVisibility,
+		s.newTimerTask(1, liveRunID, timerAckLevel.Add(-time.Hour)),
+		orphanTimer,
+	)
+	s.mockGetWorkflowExecution(executionDBs[0], orphanRunID, &shared.EntityNotExistsError{})
+	executionDBs[0].On("RangeCompleteTransferTask", &p.RangeCompleteTransferTaskRequest{
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   10,
+	}).Return(nil).Once()
+	executionDBs[0].On("RangeCompleteTimerTask", &p.RangeCompleteTimerTaskRequest{
+		InclusiveBeginTimestamp: minTimerTaskTimestamp,
+		ExclusiveEndTimestamp:   timerAckLevel,
+	}).Return(nil).Once()
+	executionDBs[0].On("CompleteTransferTask", &p.CompleteTransferTaskRequest{
+		TaskID: 20,
+	}).Return(nil).Once()
+	executionDBs[0].On("CompleteTimerTask", &p.CompleteTimerTaskRequest{
+		VisibilityTimestamp: orphanTimer.VisibilityTimestamp,
+		TaskID:              2,
+	}).Return(nil).Once()
+
+	s.mockGetShard(shardDB, 1, 10, timerAckLevel)
+	s.mockGetTransferTasks(executionDBs[1])
+	s.mockGetTimerTasks(executionDBs[1], orphanTaskVisibility)
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(2, hbd.ShardID)
+	s.Equal(2, hbd.DeadTransferTaskCount)
+	s.Equal(2, hbd.DeadTimerTaskCount)
+	s.Equal(4, hbd.DeletedTaskCount)
+	s.Equal(0, hbd.ErrorCount)
+	s.Equal(1, len(hbd.Report))
+	s.True(hbd.Report[0].Deleted)
+}
+
+func (s *ScavengerTestSuite) TestShardError() {
+	now := time.Now()
+	shardDB, executionDBs, scvgr := s.createTestScavenger(2, false, now)
+	defer s.assertExpectations(shardDB, executionDBs)
+	timerAckLevel := now.Add(-time.Hour)
+	shardDB.On("GetShard", &p.GetShardRequest{ShardID: 0}).Return(nil, errors.New("some random error")).Once()
+	s.mockGetShard(shardDB, 1, 10, timerAckLevel)
+	s.mockGetTransferTasks(executionDBs[1], s.newTransferTask(5, liveRunID, timerAckLevel))
+	s.mockGetTimerTasks(executionDBs[1], timerAckLevel)
+	executionDBs[1].On("RangeCompleteTransferTask", mock.Anything).Return(errors.New("some random error")).Once()
+
+	hbd, err := scvgr.Run(context.Background())
+	s.NoError(err)
+	s.Equal(2, hbd.ErrorCount)
+	s.Equal(1, hbd.DeadTransferTaskCount)
+	s.Equal(0, hbd.DeletedTaskCount)
+	s.Equal(2, len(hbd.Report))
+	s.Equal(0, hbd.Report[0].ShardID)
+	s.Equal("some random error", hbd.Report[0].Error)
+	s.Equal(1, hbd.Report[1].ShardID)
+	s.False(hbd.Report[1].Deleted)
+}
+
+func (s *ScavengerTestSuite) TestGetAckLevel() {
+	now := time.Now()
+	info := &p.ShardInfo{
+		TransferAckLevel: 100,
+		TimerAckLevel:    now,
+	}
+	s.Equal(int64(100), getTransferAckLevel(info))
+	s.Equal(now, getTimerAckLevel(info))
+
+	info.ClusterTransferAckLevel = map[string]int64{"active": 100, "standby": 50}
+	info.ClusterTimerAckLevel = map[string]time.Time{"active": now, "standby": now.Add(-time.Minute)}
+	s.Equal(int64(50), getTransferAckLevel(info))
+	s.Equal(now.Add(-time.Minute), getTimerAckLevel(info))
+
+	info.TransferFailoverLevels = map[string]p.TransferFailoverLevel{"failover": {MinLevel: 20}}
+	info.TimerFailoverLevels = map[string]p.TimerFailoverLevel{"failover": {MinLevel: now.Add(-time.Hour)}}
+	s.Equal(int64(20), getTransferAckLevel(info))
+	s.Equal(now.Add(-time.Hour), getTimerAckLevel(info))
+}
+
+func (s *ScavengerTestSuite) mockGetShard(shardDB *mocks.ShardManager, shardID int, transferAckLevel int64, timerAckLevel time.Time) {
+	shardDB.On("GetShard", &p.GetShardRequest{ShardID: shardID}).Return(&p.GetShardResponse{
+		ShardInfo: &p.ShardInfo{
+			ShardID:          shardID,
+			TransferAckLevel: transferAckLevel,
+			TimerAckLevel:    timerAckLevel,
+		},
+	}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) mockGetTransferTasks(executionDB *mocks.ExecutionManager, tasks ...*p.TransferTaskInfo) {
+	executionDB.On("GetTransferTasks", &p.GetTransferTasksRequest{
+		ReadLevel:    0,
+		MaxReadLevel: math.MaxInt64,
+		BatchSize:    pageSize,
+	}).Return(&p.GetTransferTasksResponse{Tasks: tasks}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) mockGetTimerTasks(executionDB *mocks.ExecutionManager, maxTimestamp time.Time, tasks ...*p.TimerTaskInfo) {
+	executionDB.On("GetTimerIndexTasks", &p.GetTimerIndexTasksRequest{
+		MinTimestamp: minTimerTaskTimestamp,
+		MaxTimestamp: maxTimestamp,
+		BatchSize:    pageSize,
+	}).Return(&p.GetTimerIndexTasksResponse{Timers: tasks}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) mockGetWorkflowExecution(executionDB *mocks.ExecutionManager, runID string, err error) {
+	var resp *p.GetWorkflowExecutionResponse
+	if err == nil {
+		resp = &p.GetWorkflowExecutionResponse{}
+	}
+	executionDB.On("GetWorkflowExecution", mock.MatchedBy(func(req *p.GetWorkflowExecutionRequest) bool {
+		return req.Execution.GetRunId() == runID
+	})).Return(resp, err).Once()
+}
+
+func (s *ScavengerTestSuite) newTransferTask(taskID int64, runID string, visibility time.Time) *p.TransferTaskInfo {
+	return &p.TransferTaskInfo{
+		DomainID:            "test-domain-id",
+		WorkflowID:          "test-workflow-id",
+		RunID:               runID,
+		TaskID:              taskID,
+		VisibilityTimestamp: visibility,
+	}
+}
+
+func (s *ScavengerTestSuite) newTimerTask(taskID int64, runID string, visibility time.Time) *p.TimerTaskInfo {
+	return &p.TimerTaskInfo{
+		DomainID:            "test-domain-id",
+		WorkflowID:          "test-workflow-id",
+		RunID:               runID,
+		TaskID:              taskID,
+		VisibilityTimestamp: visibility,
+	}
+}
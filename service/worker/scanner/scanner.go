@@ -48,6 +48,14 @@ type (
 	Config struct {
 		// PersistenceMaxQPS the max rate of calls to persistence
 		PersistenceMaxQPS dynamicconfig.IntPropertyFn
+		// ExecutionsScannerEnabled indicates if executions scanner should be started as part of scanner
+		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// ExecutionsScannerFixEnabled indicates if executions scanner should fix the corrupted executions
+		ExecutionsScannerFixEnabled dynamicconfig.BoolPropertyFn
+		// QueueScannerEnabled indicates if transfer and timer queue scanner should be started as part of scanner
+		QueueScannerEnabled dynamicconfig.BoolPropertyFn
+		// QueueScannerDryRun indicates if queue scanner should only report the dead tasks without deleting them
+		QueueScannerDryRun dynamicconfig.BoolPropertyFn
 		// Persistence contains the persistence configuration
 		Persistence *config.Persistence
 		// ClusterMetadata contains the metadata for this cluster
@@ -73,16 +81,18 @@ type (
 	// scannerContext is the context object that get's
 	// passed around within the scanner workflows / activities
 	scannerContext struct {
-		taskDB        p.TaskManager
-		domainDB      p.MetadataManager
-		historyDB     p.HistoryV2Manager
-		cfg           Config
-		sdkClient     workflowserviceclient.Interface
-		clientBean    client.Bean
-		metricsClient metrics.Client
-		tallyScope    tally.Scope
-		logger        log.Logger
-		zapLogger     *zap.Logger
+		taskDB             p.TaskManager
+		domainDB           p.MetadataManager
+		historyDB          p.HistoryV2Manager
+		shardDB            p.ShardManager
+		executionDBFactory p.ExecutionManagerFactory
+		cfg                Config
+		sdkClient          workflowserviceclient.Interface
+		clientBean         client.Bean
+		metricsClient      metrics.Client
+		tallyScope         tally.Scope
+		logger             log.Logger
+		zapLogger          *zap.Logger
 	}
 
 	// Scanner is the background sub-system that does full scans
@@ -137,6 +147,22 @@ func (s *Scanner) Start() error {
 		go s.startWorkflowWithRetry(historyScannerWFStartOptions, historyScannerWFTypeName)
 	}
 
+	if s.context.cfg.ExecutionsScannerEnabled() {
+		go s.startWorkflowWithRetry(executionsScannerWFStartOptions, executionsScannerWFTypeName)
+		executionsWorker := worker.New(s.context.sdkClient, common.SystemLocalDomainName, executionsScannerTaskListName, workerOpts)
+		if err := executionsWorker.Start(); err != nil {
+			return err
+		}
+	}
+
+	if s.context.cfg.QueueScannerEnabled() {
+		go s.startWorkflowWithRetry(queueScannerWFStartOptions, queueScannerWFTypeName)
+		queueWorker := worker.New(s.context.sdkClient, common.SystemLocalDomainName, queueScannerTaskListName, workerOpts)
+		if err := queueWorker.Start(); err != nil {
+			return err
+		}
+	}
+
 	worker := worker.New(s.context.sdkClient, common.SystemLocalDomainName, tlScannerTaskListName, workerOpts)
 	return worker.Start()
 }
@@ -183,8 +209,14 @@ func (s *Scanner) buildContext() error {
 	if err != nil {
 		return err
 	}
+	shardDB, err := pFactory.NewShardManager()
+	if err != nil {
+		return err
+	}
 	s.context.taskDB = taskDB
 	s.context.domainDB = domainDB
 	s.context.historyDB = historyDB
+	s.context.shardDB = shardDB
+	s.context.executionDBFactory = pFactory
 	return nil
 }
@@ -25,7 +25,9 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/history"
+	"github.com/uber/cadence/service/worker/scanner/queues"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
@@ -53,6 +55,16 @@ const (
 	historyScannerWFTypeName     = "cadence-sys-history-scanner-workflow"
 	historyScannerTaskListName   = "cadence-sys-history-scanner-tasklist-0"
 	historyScavengerActivityName = "cadence-sys-history-scanner-scvg-activity"
+
+	executionsScannerWFID           = "cadence-sys-executions-scanner"
+	executionsScannerWFTypeName     = "cadence-sys-executions-scanner-workflow"
+	executionsScannerTaskListName   = "cadence-sys-executions-scanner-tasklist-0"
+	executionsScavengerActivityName = "cadence-sys-executions-scanner-scvg-activity"
+
+	queueScannerWFID           = "cadence-sys-queue-scanner"
+	queueScannerWFTypeName     = "cadence-sys-queue-scanner-workflow"
+	queueScannerTaskListName   = "cadence-sys-queue-scanner-tasklist-0"
+	queueScavengerActivityName = "cadence-sys-queue-scanner-scvg-activity"
 )
 
 var (
@@ -83,13 +95,31 @@ var (
 		ExecutionStartToCloseTimeout: infiniteDuration,
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
 	}
+	executionsScannerWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           executionsScannerWFID,
+		TaskList:                     executionsScannerTaskListName,
+		ExecutionStartToCloseTimeout: infiniteDuration,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 0 * * *",
+	}
+	queueScannerWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           queueScannerWFID,
+		TaskList:                     queueScannerTaskListName,
+		ExecutionStartToCloseTimeout: infiniteDuration,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 12 * * *",
+	}
 )
 
 func init() {
 	workflow.RegisterWithOptions(TaskListScannerWorkflow, workflow.RegisterOptions{Name: tlScannerWFTypeName})
 	workflow.RegisterWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
+	workflow.RegisterWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+	workflow.RegisterWithOptions(QueueScannerWorkflow, workflow.RegisterOptions{Name: queueScannerWFTypeName})
 	activity.RegisterWithOptions(TaskListScavengerActivity, activity.RegisterOptions{Name: taskListScavengerActivityName})
 	activity.RegisterWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
+	activity.RegisterWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+	activity.RegisterWithOptions(QueueScavengerActivity, activity.RegisterOptions{Name: queueScavengerActivityName})
 }
 
 // TaskListScannerWorkflow is the workflow that runs the task-list scanner background daemon
@@ -105,6 +135,24 @@ func HistoryScannerWorkflow(ctx workflow.Context) error {
 	return future.Get(ctx, nil)
 }
 
+// ExecutionsScannerWorkflow is the workflow that runs the executions scanner background daemon,
+// its result is the report of the corrupted executions
+func ExecutionsScannerWorkflow(ctx workflow.Context) (executions.ScavengerHeartbeatDetails, error) {
+	var result executions.ScavengerHeartbeatDetails
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), executionsScavengerActivityName)
+	err := future.Get(ctx, &result)
+	return result, err
+}
+
+// QueueScannerWorkflow is the workflow that runs the transfer and timer queue scanner background daemon,
+// its result is the report of the dead tasks
+func QueueScannerWorkflow(ctx workflow.Context) (queues.ScavengerHeartbeatDetails, error) {
+	var result queues.ScavengerHeartbeatDetails
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), queueScavengerActivityName)
+	err := future.Get(ctx, &result)
+	return result, err
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(aCtx context.Context) (history.ScavengerHeartbeatDetails, error) {
 	ctx := aCtx.Value(scannerContextKey).(scannerContext)
@@ -121,6 +169,57 @@ func HistoryScavengerActivity(aCtx context.Context) (history.ScavengerHeartbeatD
 	return scavenger.Run(aCtx)
 }
 
+// ExecutionsScavengerActivity is the activity that runs executions scavenger
+func ExecutionsScavengerActivity(aCtx context.Context) (executions.ScavengerHeartbeatDetails, error) {
+	ctx := aCtx.Value(scannerContextKey).(scannerContext)
+	rps := ctx.cfg.PersistenceMaxQPS()
+
+	hbd := executions.ScavengerHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(aCtx) {
+		if err := activity.GetHeartbeatDetails(aCtx, &hbd); err != nil {
+			ctx.logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	scavenger := executions.NewScavenger(
+		ctx.executionDBFactory,
+		ctx.historyDB,
+		ctx.clientBean.GetHistoryClient(),
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.cfg.ExecutionsScannerFixEnabled(),
+		rps,
+		hbd,
+		ctx.metricsClient,
+		ctx.logger,
+	)
+	return scavenger.Run(aCtx)
+}
+
+// QueueScavengerActivity is the activity that runs queue scavenger
+func QueueScavengerActivity(aCtx context.Context) (queues.ScavengerHeartbeatDetails, error) {
+	ctx := aCtx.Value(scannerContextKey).(scannerContext)
+	rps := ctx.cfg.PersistenceMaxQPS()
+
+	hbd := queues.ScavengerHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(aCtx) {
+		if err := activity.GetHeartbeatDetails(aCtx, &hbd); err != nil {
+			ctx.logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	scavenger := queues.NewScavenger(
+		ctx.shardDB,
+		ctx.executionDBFactory,
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.cfg.QueueScannerDryRun(),
+		rps,
+		hbd,
+		ctx.metricsClient,
+		ctx.logger,
+	)
+	return scavenger.Run(aCtx)
+}
+
 // TaskListScavengerActivity is the activity that runs task list scavenger
 func TaskListScavengerActivity(aCtx context.Context) error {
 	ctx := aCtx.Value(scannerContextKey).(scannerContext)
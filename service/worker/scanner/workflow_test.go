@@ -32,6 +32,8 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/scanner/executions"
+	"github.com/uber/cadence/service/worker/scanner/queues"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/zap"
@@ -53,6 +55,44 @@ func (s *scannerWorkflowTestSuite) TestWorkflow() {
 	s.True(env.IsWorkflowCompleted())
 }
 
+func (s *scannerWorkflowTestSuite) TestExecutionsScannerWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	report := executions.ScavengerHeartbeatDetails{
+		ShardID:        1,
+		ExecutionCount: 1,
+		CorruptedCount: 1,
+		Report: []executions.CorruptedExecution{
+			{WorkflowID: "test-workflow-id", Type: executions.CorruptionTypeMissingHistory},
+		},
+	}
+	env.OnActivity(executionsScavengerActivityName, mock.Anything).Return(report, nil)
+	env.ExecuteWorkflow(executionsScannerWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result executions.ScavengerHeartbeatDetails
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(report, result)
+}
+
+func (s *scannerWorkflowTestSuite) TestQueueScannerWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	report := queues.ScavengerHeartbeatDetails{
+		ShardID:               1,
+		TransferTaskCount:     2,
+		DeadTransferTaskCount: 1,
+		Report: []queues.ShardReport{
+			{ShardID: 0, TransferAckLevel: 10, TransferTasksBelowAckLevel: 1},
+		},
+	}
+	env.OnActivity(queueScavengerActivityName, mock.Anything).Return(report, nil)
+	env.ExecuteWorkflow(queueScannerWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result queues.ScavengerHeartbeatDetails
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(report, result)
+}
+
 func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	env := s.NewTestActivityEnvironment()
 	taskDB := &mocks.TaskManager{}
@@ -0,0 +1,156 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/schedule"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/yarpc"
+)
+
+// startWorkflowActivity takes the action of a schedule by starting its workflow, unless the overlap policy
+// prevents it. It also drops the workflows which are no longer running from the running workflows.
+func startWorkflowActivity(
+	ctx context.Context,
+	params startWorkflowActivityParams,
+) (startWorkflowActivityResult, error) {
+
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	client := scheduler.clientBean.GetFrontendClient()
+	logger := getActivityLogger(ctx).WithTags(tag.WorkflowDomainName(params.Domain))
+	yarpcCallOptions := []yarpc.CallOption{
+		yarpc.WithHeader(common.EnforceDCRedirection, "true"),
+	}
+
+	running, err := getRunningWorkflows(ctx, client, params.Domain, params.RunningWorkflows)
+	if err != nil {
+		scheduler.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerActionFailures)
+		return startWorkflowActivityResult{}, err
+	}
+
+	if len(running) > 0 {
+		switch params.OverlapPolicy {
+		case shared.ScheduleOverlapPolicyAllowAll:
+		case shared.ScheduleOverlapPolicyCancelOther:
+			for _, execution := range running {
+				err := client.RequestCancelWorkflowExecution(ctx, &shared.RequestCancelWorkflowExecutionRequest{
+					Domain:            common.StringPtr(params.Domain),
+					WorkflowExecution: execution,
+					Identity:          common.StringPtr(schedule.WorkflowTypeName),
+					RequestId:         common.StringPtr(uuid.New().String()),
+				}, yarpcCallOptions...)
+				if err != nil && !isWorkflowGone(err) {
+					if _, ok := err.(*shared.CancellationAlreadyRequestedError); !ok {
+						scheduler.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerActionFailures)
+						return startWorkflowActivityResult{}, err
+					}
+				}
+			}
+			running = nil
+		default:
+			scheduler.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerActionsSkipped)
+			return startWorkflowActivityResult{RunningWorkflows: running}, nil
+		}
+	}
+
+	request := *params.Action.StartWorkflow
+	workflowID := fmt.Sprintf("%v-%v", request.GetWorkflowId(), params.ScheduledTime.UTC().Format(time.RFC3339))
+	request.Domain = common.StringPtr(params.Domain)
+	request.WorkflowId = common.StringPtr(workflowID)
+	// the request ID only depends on the action so that retries of this activity do not start the workflow twice
+	request.RequestId = common.StringPtr(uuid.NewSHA1(uuid.NameSpaceURL, []byte(params.Domain+"/"+workflowID)).String())
+	if request.GetIdentity() == "" {
+		request.Identity = common.StringPtr(schedule.WorkflowTypeName)
+	}
+
+	resp, err := client.StartWorkflowExecution(ctx, &request, yarpcCallOptions...)
+	if err != nil {
+		if _, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
+			logger.Warn("Workflow ID of scheduled action is already in use", tag.WorkflowID(workflowID))
+			scheduler.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerActionsSkipped)
+			return startWorkflowActivityResult{RunningWorkflows: running}, nil
+		}
+		scheduler.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerActionFailures)
+		logger.Error("Failed to start workflow of scheduled action", tag.WorkflowID(workflowID), tag.Error(err))
+		return startWorkflowActivityResult{}, err
+	}
+
+	scheduler.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerActionsStarted)
+	started := &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      resp.RunId,
+	}
+	return startWorkflowActivityResult{
+		StartedWorkflow:  started,
+		RunningWorkflows: append(running, started),
+	}, nil
+}
+
+func getRunningWorkflows(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	executions []*shared.WorkflowExecution,
+) ([]*shared.WorkflowExecution, error) {
+
+	var running []*shared.WorkflowExecution
+	for _, execution := range executions {
+		resp, err := client.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+			Domain:    common.StringPtr(domain),
+			Execution: execution,
+		})
+		if err != nil {
+			if isWorkflowGone(err) {
+				continue
+			}
+			return nil, err
+		}
+		if resp.WorkflowExecutionInfo.CloseStatus == nil {
+			running = append(running, execution)
+		}
+	}
+	return running, nil
+}
+
+func isWorkflowGone(err error) bool {
+	_, ok := err.(*shared.EntityNotExistsError)
+	return ok
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	wfInfo := activity.GetInfo(ctx)
+	return scheduler.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+	)
+}
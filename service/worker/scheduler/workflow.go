@@ -0,0 +1,420 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"hash/fnv"
+	"time"
+
+	"github.com/robfig/cron"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/schedule"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	schedulerContextKey       = "schedulerContext"
+	startWorkflowActivityName = "cadence-sys-scheduler-start-workflow-activity"
+
+	// DefaultOverlapPolicy is the default value for SchedulePolicies.OverlapPolicy
+	DefaultOverlapPolicy = shared.ScheduleOverlapPolicySkipNew
+	// DefaultCatchupWindow is the default value for SchedulePolicies.CatchupWindowInSeconds
+	DefaultCatchupWindow = time.Minute
+	// MaxBackfillActions is the maximum number of actions taken by a single backfill
+	MaxBackfillActions = 1000
+
+	maxRecentActions        = 10
+	numNextActionTimestamps = 5
+	// interval to check whether the action buffered by BufferOne overlap policy can be taken
+	bufferedActionRecheckInterval = 10 * time.Second
+	// interval to retry a due action whose workflow could not be started
+	failedActionRetryInterval = time.Minute
+	// bound the history size of the scheduler workflow
+	maxIterationsBeforeContinueAsNew = 500
+)
+
+type (
+	startWorkflowActivityParams struct {
+		Domain           string
+		ScheduleID       string
+		Action           *shared.ScheduleAction
+		ScheduledTime    time.Time
+		OverlapPolicy    shared.ScheduleOverlapPolicy
+		RunningWorkflows []*shared.WorkflowExecution
+	}
+
+	startWorkflowActivityResult struct {
+		// StartedWorkflow is nil when the overlap policy prevented the action
+		StartedWorkflow  *shared.WorkflowExecution
+		RunningWorkflows []*shared.WorkflowExecution
+	}
+
+	// scheduleWorkflow holds the state of one run of the scheduler workflow
+	scheduleWorkflow struct {
+		params  schedule.Params
+		deleted bool
+		logger  *zap.Logger
+	}
+)
+
+var (
+	startWorkflowActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+		ExpirationInterval: 10 * time.Minute,
+	}
+
+	startWorkflowActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &startWorkflowActivityRetryPolicy,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(Workflow, workflow.RegisterOptions{Name: schedule.WorkflowTypeName})
+	activity.RegisterWithOptions(startWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+}
+
+// Workflow is the workflow backing a schedule, it takes the actions of the schedule
+// and handles the signals sent by the schedule APIs until the schedule is deleted
+func Workflow(ctx workflow.Context, params schedule.Params) error {
+	w := &scheduleWorkflow{
+		params: setDefaultParams(params, workflow.Now(ctx)),
+		logger: workflow.GetLogger(ctx).With(zap.String("schedule-id", params.ScheduleID)),
+	}
+	if err := workflow.SetQueryHandler(ctx, schedule.QueryTypeDescribe, func() (*shared.DescribeScheduleResponse, error) {
+		return w.describe(workflow.Now(ctx)), nil
+	}); err != nil {
+		return err
+	}
+
+	for i := 0; i < maxIterationsBeforeContinueAsNew && !w.deleted; i++ {
+		w.processBufferedAction(ctx)
+		w.processDueActions(ctx)
+		w.waitForNextEvent(ctx)
+	}
+	// signals received after the last selection would be lost on continue as new
+	w.drainSignals(ctx)
+	if w.deleted {
+		return nil
+	}
+	return workflow.NewContinueAsNewError(ctx, schedule.WorkflowTypeName, w.params)
+}
+
+func setDefaultParams(params schedule.Params, now time.Time) schedule.Params {
+	if params.Policies == nil {
+		params.Policies = &shared.SchedulePolicies{}
+	}
+	if params.Policies.OverlapPolicy == nil {
+		params.Policies.OverlapPolicy = shared.ScheduleOverlapPolicy(DefaultOverlapPolicy).Ptr()
+	}
+	if params.Policies.CatchupWindowInSeconds == nil {
+		params.Policies.CatchupWindowInSeconds = common.Int32Ptr(int32(DefaultCatchupWindow / time.Second))
+	}
+	if params.State == nil {
+		params.State = &shared.ScheduleState{Paused: common.BoolPtr(false)}
+	}
+	if params.Info == nil {
+		params.Info = &shared.ScheduleInfo{
+			NumActions:                    common.Int64Ptr(0),
+			NumActionsMissedCatchupWindow: common.Int64Ptr(0),
+			NumActionsSkippedOverlap:      common.Int64Ptr(0),
+			CreateTimestamp:               common.Int64Ptr(now.UnixNano()),
+			UpdateTimestamp:               common.Int64Ptr(now.UnixNano()),
+		}
+	}
+	if params.LastProcessedTime.IsZero() {
+		params.LastProcessedTime = now
+	}
+	return params
+}
+
+func (w *scheduleWorkflow) processDueActions(ctx workflow.Context) {
+	if w.params.State.GetPaused() {
+		return
+	}
+	now := workflow.Now(ctx)
+	catchupWindow := time.Duration(w.params.Policies.GetCatchupWindowInSeconds()) * time.Second
+	for _, scheduledTime := range w.getActionTimes(w.params.LastProcessedTime, now, -1) {
+		actionTime := scheduledTime.Add(w.getJitter(scheduledTime))
+		if actionTime.After(now) {
+			break
+		}
+		if now.Sub(actionTime) > catchupWindow {
+			w.params.LastProcessedTime = scheduledTime
+			w.params.Info.NumActionsMissedCatchupWindow = common.Int64Ptr(w.params.Info.GetNumActionsMissedCatchupWindow() + 1)
+			continue
+		}
+		if err := w.takeAction(ctx, scheduledTime, w.params.Policies.GetOverlapPolicy()); err != nil {
+			// the action stays due and is retried until it is taken or misses the catchup window
+			return
+		}
+		w.params.LastProcessedTime = scheduledTime
+	}
+}
+
+func (w *scheduleWorkflow) processBufferedAction(ctx workflow.Context) {
+	if w.params.BufferedActionTime == nil || w.params.State.GetPaused() {
+		return
+	}
+	result, err := w.startWorkflow(ctx, *w.params.BufferedActionTime, shared.ScheduleOverlapPolicySkipNew)
+	if err != nil || result.StartedWorkflow == nil {
+		return
+	}
+	w.recordAction(ctx, *w.params.BufferedActionTime, result.StartedWorkflow)
+	w.params.BufferedActionTime = nil
+}
+
+// takeAction starts the workflow of the action scheduled at the given time, or buffers or skips
+// the action according to the overlap policy. An error is returned when the workflow could not be started.
+func (w *scheduleWorkflow) takeAction(
+	ctx workflow.Context,
+	scheduledTime time.Time,
+	overlapPolicy shared.ScheduleOverlapPolicy,
+) error {
+	result, err := w.startWorkflow(ctx, scheduledTime, overlapPolicy)
+	if err != nil {
+		return err
+	}
+	if result.StartedWorkflow != nil {
+		w.recordAction(ctx, scheduledTime, result.StartedWorkflow)
+		return nil
+	}
+	if overlapPolicy == shared.ScheduleOverlapPolicyBufferOne && w.params.BufferedActionTime == nil {
+		w.params.BufferedActionTime = &scheduledTime
+		return nil
+	}
+	w.params.Info.NumActionsSkippedOverlap = common.Int64Ptr(w.params.Info.GetNumActionsSkippedOverlap() + 1)
+	return nil
+}
+
+func (w *scheduleWorkflow) startWorkflow(
+	ctx workflow.Context,
+	scheduledTime time.Time,
+	overlapPolicy shared.ScheduleOverlapPolicy,
+) (startWorkflowActivityResult, error) {
+	activityParams := startWorkflowActivityParams{
+		Domain:           w.params.Domain,
+		ScheduleID:       w.params.ScheduleID,
+		Action:           w.params.Action,
+		ScheduledTime:    scheduledTime,
+		OverlapPolicy:    overlapPolicy,
+		RunningWorkflows: w.params.Info.RunningWorkflows,
+	}
+	var result startWorkflowActivityResult
+	opt := workflow.WithActivityOptions(ctx, startWorkflowActivityOptions)
+	if err := workflow.ExecuteActivity(opt, startWorkflowActivityName, activityParams).Get(ctx, &result); err != nil {
+		w.logger.Error("failed to take scheduled action", zap.Time("scheduled-time", scheduledTime), zap.Error(err))
+		return startWorkflowActivityResult{}, err
+	}
+	w.params.Info.RunningWorkflows = result.RunningWorkflows
+	return result, nil
+}
+
+func (w *scheduleWorkflow) recordAction(
+	ctx workflow.Context,
+	scheduledTime time.Time,
+	startedWorkflow *shared.WorkflowExecution,
+) {
+	info := w.params.Info
+	info.NumActions = common.Int64Ptr(info.GetNumActions() + 1)
+	info.RecentActions = append(info.RecentActions, &shared.ScheduleActionResult{
+		ScheduledTimestamp: common.Int64Ptr(scheduledTime.UnixNano()),
+		ActualTimestamp:    common.Int64Ptr(workflow.Now(ctx).UnixNano()),
+		StartedWorkflow:    startedWorkflow,
+	})
+	if len(info.RecentActions) > maxRecentActions {
+		info.RecentActions = info.RecentActions[len(info.RecentActions)-maxRecentActions:]
+	}
+}
+
+func (w *scheduleWorkflow) waitForNextEvent(ctx workflow.Context) {
+	timerCtx, cancel := workflow.WithCancel(ctx)
+	defer cancel()
+
+	selector := workflow.NewSelector(ctx)
+	if !w.params.State.GetPaused() {
+		now := workflow.Now(ctx)
+		if nextActionTimes := w.getActionTimes(w.params.LastProcessedTime, time.Time{}, 1); len(nextActionTimes) > 0 {
+			actionTime := nextActionTimes[0].Add(w.getJitter(nextActionTimes[0]))
+			wait := actionTime.Sub(now)
+			if wait <= 0 {
+				// the action is already due, its workflow failed to start
+				wait = failedActionRetryInterval
+			}
+			selector.AddFuture(workflow.NewTimer(timerCtx, wait), func(workflow.Future) {})
+		}
+		if w.params.BufferedActionTime != nil {
+			selector.AddFuture(workflow.NewTimer(timerCtx, bufferedActionRecheckInterval), func(workflow.Future) {})
+		}
+	}
+	w.addSignalHandlers(ctx, selector)
+	selector.Select(ctx)
+}
+
+func (w *scheduleWorkflow) addSignalHandlers(ctx workflow.Context, selector workflow.Selector) {
+	selector.AddReceive(workflow.GetSignalChannel(ctx, schedule.SignalNameUpdate), func(c workflow.Channel, more bool) {
+		var request shared.UpdateScheduleRequest
+		c.Receive(ctx, &request)
+		w.handleUpdate(ctx, &request)
+	})
+	selector.AddReceive(workflow.GetSignalChannel(ctx, schedule.SignalNamePause), func(c workflow.Channel, more bool) {
+		var request shared.PauseScheduleRequest
+		c.Receive(ctx, &request)
+		w.handlePause(ctx, true, request.GetReason())
+	})
+	selector.AddReceive(workflow.GetSignalChannel(ctx, schedule.SignalNameUnpause), func(c workflow.Channel, more bool) {
+		var request shared.UnpauseScheduleRequest
+		c.Receive(ctx, &request)
+		w.handlePause(ctx, false, request.GetReason())
+	})
+	selector.AddReceive(workflow.GetSignalChannel(ctx, schedule.SignalNameBackfill), func(c workflow.Channel, more bool) {
+		var request shared.BackfillScheduleRequest
+		c.Receive(ctx, &request)
+		w.handleBackfill(ctx, &request)
+	})
+	selector.AddReceive(workflow.GetSignalChannel(ctx, schedule.SignalNameDelete), func(c workflow.Channel, more bool) {
+		var request shared.DeleteScheduleRequest
+		c.Receive(ctx, &request)
+		w.deleted = true
+	})
+}
+
+func (w *scheduleWorkflow) drainSignals(ctx workflow.Context) {
+	for drained := false; !drained && !w.deleted; {
+		selector := workflow.NewSelector(ctx)
+		w.addSignalHandlers(ctx, selector)
+		selector.AddDefault(func() { drained = true })
+		selector.Select(ctx)
+	}
+}
+
+func (w *scheduleWorkflow) handleUpdate(ctx workflow.Context, request *shared.UpdateScheduleRequest) {
+	if request.Spec != nil {
+		w.params.Spec = request.Spec
+	}
+	if request.Action != nil {
+		w.params.Action = request.Action
+	}
+	if request.Policies != nil {
+		w.params.Policies = request.Policies
+		w.params = setDefaultParams(w.params, workflow.Now(ctx))
+	}
+	// actions due before the update are not taken with the new spec
+	w.params.LastProcessedTime = workflow.Now(ctx)
+	w.params.Info.UpdateTimestamp = common.Int64Ptr(workflow.Now(ctx).UnixNano())
+}
+
+func (w *scheduleWorkflow) handlePause(ctx workflow.Context, paused bool, reason string) {
+	if !paused && w.params.State.GetPaused() {
+		// actions due while the schedule was paused are not taken
+		w.params.LastProcessedTime = workflow.Now(ctx)
+	}
+	w.params.State = &shared.ScheduleState{
+		Paused: common.BoolPtr(paused),
+		Notes:  common.StringPtr(reason),
+	}
+	w.params.Info.UpdateTimestamp = common.Int64Ptr(workflow.Now(ctx).UnixNano())
+}
+
+func (w *scheduleWorkflow) handleBackfill(ctx workflow.Context, request *shared.BackfillScheduleRequest) {
+	overlapPolicy := w.params.Policies.GetOverlapPolicy()
+	if request.OverlapPolicy != nil {
+		overlapPolicy = request.GetOverlapPolicy()
+	}
+	// the start of the backfill range is inclusive
+	startTime := time.Unix(0, request.GetStartTimestamp()-1)
+	endTime := time.Unix(0, request.GetEndTimestamp())
+	for _, scheduledTime := range w.getActionTimes(startTime, endTime, MaxBackfillActions) {
+		// failures are logged by startWorkflow, backfilled actions are not retried
+		w.takeAction(ctx, scheduledTime, overlapPolicy)
+	}
+}
+
+// getActionTimes returns up to limit scheduled times after the given time and not after the until time,
+// a negative limit or a zero until time means no bound
+func (w *scheduleWorkflow) getActionTimes(after time.Time, until time.Time, limit int) []time.Time {
+	schedule, err := cron.ParseStandard(w.params.Spec.GetCronExpression())
+	if err != nil {
+		w.logger.Error("invalid cron expression", zap.Error(err))
+		return nil
+	}
+	if w.params.Spec.StartTimestamp != nil {
+		if startTime := time.Unix(0, w.params.Spec.GetStartTimestamp()-1); after.Before(startTime) {
+			after = startTime
+		}
+	}
+	if w.params.Spec.EndTimestamp != nil {
+		if endTime := time.Unix(0, w.params.Spec.GetEndTimestamp()); until.IsZero() || endTime.Before(until) {
+			until = endTime
+		}
+	}
+
+	var actionTimes []time.Time
+	for next := schedule.Next(after.UTC()); !next.IsZero() && (limit < 0 || len(actionTimes) < limit); next = schedule.Next(next) {
+		if !until.IsZero() && next.After(until) {
+			break
+		}
+		actionTimes = append(actionTimes, next)
+	}
+	return actionTimes
+}
+
+// getJitter returns a deterministic delay for the action scheduled at the given time,
+// so that it stays the same across replays and continue as new
+func (w *scheduleWorkflow) getJitter(scheduledTime time.Time) time.Duration {
+	maxJitter := time.Duration(w.params.Spec.GetJitterInSeconds()) * time.Second
+	if maxJitter <= 0 {
+		return 0
+	}
+	hash := fnv.New64a()
+	hash.Write([]byte(w.params.ScheduleID))
+	hash.Write([]byte(scheduledTime.UTC().Format(time.RFC3339Nano)))
+	return time.Duration(hash.Sum64() % uint64(maxJitter))
+}
+
+func (w *scheduleWorkflow) describe(now time.Time) *shared.DescribeScheduleResponse {
+	info := *w.params.Info
+	info.NextActionTimestamps = nil
+	if !w.params.State.GetPaused() {
+		after := w.params.LastProcessedTime
+		if now.After(after) {
+			after = now
+		}
+		for _, actionTime := range w.getActionTimes(after, time.Time{}, numNextActionTimestamps) {
+			info.NextActionTimestamps = append(info.NextActionTimestamps, actionTime.UnixNano())
+		}
+	}
+	return &shared.DescribeScheduleResponse{
+		Spec:     w.params.Spec,
+		Action:   w.params.Action,
+		Policies: w.params.Policies,
+		State:    w.params.State,
+		Info:     &info,
+		Memo:     w.params.Memo,
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/schedule"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/zap"
+)
+
+type workflowSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	startTime time.Time
+}
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.startTime = time.Date(2019, 10, 1, 0, 0, 30, 0, time.UTC)
+}
+
+func (s *workflowSuite) newParams(cronExpression string) schedule.Params {
+	return schedule.Params{
+		Domain:     "test-domain",
+		ScheduleID: "test-schedule",
+		Spec:       &shared.ScheduleSpec{CronExpression: common.StringPtr(cronExpression)},
+		Action: &shared.ScheduleAction{
+			StartWorkflow: &shared.StartWorkflowExecutionRequest{
+				WorkflowId:   common.StringPtr("test-workflow"),
+				WorkflowType: &shared.WorkflowType{Name: common.StringPtr("test-workflow-type")},
+			},
+		},
+	}
+}
+
+func (s *workflowSuite) startedResult(params startWorkflowActivityParams) (startWorkflowActivityResult, error) {
+	started := &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(params.ScheduledTime.Format(time.RFC3339)),
+		RunId:      common.StringPtr("run-id"),
+	}
+	return startWorkflowActivityResult{StartedWorkflow: started}, nil
+}
+
+func (s *workflowSuite) TestWorkflow_TakesDueActions() {
+	env := s.NewTestWorkflowEnvironment()
+	env.SetStartTime(s.startTime)
+	var scheduledTimes []time.Time
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params startWorkflowActivityParams) (startWorkflowActivityResult, error) {
+			scheduledTimes = append(scheduledTimes, params.ScheduledTime)
+			return s.startedResult(params)
+		})
+	env.RegisterDelayedCallback(func() {
+		value, err := env.QueryWorkflow(schedule.QueryTypeDescribe)
+		s.NoError(err)
+		var resp shared.DescribeScheduleResponse
+		s.NoError(value.Get(&resp))
+		s.Equal(int64(3), resp.Info.GetNumActions())
+		s.Len(resp.Info.RecentActions, 3)
+		s.Len(resp.Info.NextActionTimestamps, numNextActionTimestamps)
+		env.SignalWorkflow(schedule.SignalNameDelete, &shared.DeleteScheduleRequest{})
+	}, 3*time.Minute+10*time.Second)
+
+	env.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams("* * * * *"))
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	s.Equal([]time.Time{
+		time.Date(2019, 10, 1, 0, 1, 0, 0, time.UTC),
+		time.Date(2019, 10, 1, 0, 2, 0, 0, time.UTC),
+		time.Date(2019, 10, 1, 0, 3, 0, 0, time.UTC),
+	}, scheduledTimes)
+}
+
+func (s *workflowSuite) TestWorkflow_RetriesFailedAction() {
+	env := s.NewTestWorkflowEnvironment()
+	env.SetStartTime(s.startTime)
+	var startedTimes []time.Time
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params startWorkflowActivityParams) (startWorkflowActivityResult, error) {
+			if env.Now().Before(time.Date(2019, 10, 1, 1, 20, 0, 0, time.UTC)) {
+				return startWorkflowActivityResult{}, errors.New("some random error")
+			}
+			startedTimes = append(startedTimes, params.ScheduledTime)
+			return s.startedResult(params)
+		})
+	env.RegisterDelayedCallback(func() {
+		value, err := env.QueryWorkflow(schedule.QueryTypeDescribe)
+		s.NoError(err)
+		var resp shared.DescribeScheduleResponse
+		s.NoError(value.Get(&resp))
+		s.Equal(int64(1), resp.Info.GetNumActions())
+		s.Equal(int64(0), resp.Info.GetNumActionsMissedCatchupWindow())
+		env.SignalWorkflow(schedule.SignalNameDelete, &shared.DeleteScheduleRequest{})
+	}, 90*time.Minute)
+
+	params := s.newParams("0 * * * *")
+	params.Policies = &shared.SchedulePolicies{CatchupWindowInSeconds: common.Int32Ptr(int32(time.Hour / time.Second))}
+	env.ExecuteWorkflow(schedule.WorkflowTypeName, params)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	// the action at 01:00 is retried until its workflow is started
+	s.Equal([]time.Time{time.Date(2019, 10, 1, 1, 0, 0, 0, time.UTC)}, startedTimes)
+}
+
+func (s *workflowSuite) TestWorkflow_Paused() {
+	env := s.NewTestWorkflowEnvironment()
+	env.SetStartTime(s.startTime)
+	numActions := 0
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params startWorkflowActivityParams) (startWorkflowActivityResult, error) {
+			numActions++
+			return s.startedResult(params)
+		})
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(schedule.SignalNamePause, &shared.PauseScheduleRequest{Reason: common.StringPtr("test")})
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		value, err := env.QueryWorkflow(schedule.QueryTypeDescribe)
+		s.NoError(err)
+		var resp shared.DescribeScheduleResponse
+		s.NoError(value.Get(&resp))
+		s.True(resp.State.GetPaused())
+		s.Equal("test", resp.State.GetNotes())
+		s.Empty(resp.Info.NextActionTimestamps)
+		env.SignalWorkflow(schedule.SignalNameUnpause, &shared.UnpauseScheduleRequest{})
+	}, 5*time.Minute+10*time.Second)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(schedule.SignalNameDelete, &shared.DeleteScheduleRequest{})
+	}, 6*time.Minute+10*time.Second)
+
+	env.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams("* * * * *"))
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	// the actions at 00:01 and 00:06, the ones due while paused are not taken
+	s.Equal(2, numActions)
+}
+
+func (s *workflowSuite) TestWorkflow_BufferOne() {
+	env := s.NewTestWorkflowEnvironment()
+	env.SetStartTime(s.startTime)
+	params := s.newParams("* * * * *")
+	params.Policies = &shared.SchedulePolicies{
+		OverlapPolicy:          shared.ScheduleOverlapPolicyBufferOne.Ptr(),
+		CatchupWindowInSeconds: common.Int32Ptr(60),
+	}
+	var running bool
+	var skippedOverlapPolicies []shared.ScheduleOverlapPolicy
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params startWorkflowActivityParams) (startWorkflowActivityResult, error) {
+			if running {
+				skippedOverlapPolicies = append(skippedOverlapPolicies, params.OverlapPolicy)
+				return startWorkflowActivityResult{}, nil
+			}
+			running = true
+			return s.startedResult(params)
+		})
+	env.RegisterDelayedCallback(func() {
+		running = false
+	}, 3*time.Minute+30*time.Second)
+	env.RegisterDelayedCallback(func() {
+		value, err := env.QueryWorkflow(schedule.QueryTypeDescribe)
+		s.NoError(err)
+		var resp shared.DescribeScheduleResponse
+		s.NoError(value.Get(&resp))
+		// 00:01 started, 00:02 buffered and started at 00:03:30, 00:03 skipped
+		s.Equal(int64(2), resp.Info.GetNumActions())
+		s.Equal(int64(1), resp.Info.GetNumActionsSkippedOverlap())
+		s.Equal(time.Date(2019, 10, 1, 0, 2, 0, 0, time.UTC).UnixNano(), resp.Info.RecentActions[1].GetScheduledTimestamp())
+		env.SignalWorkflow(schedule.SignalNameDelete, &shared.DeleteScheduleRequest{})
+	}, 3*time.Minute+50*time.Second)
+
+	env.ExecuteWorkflow(schedule.WorkflowTypeName, params)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	s.Contains(skippedOverlapPolicies, shared.ScheduleOverlapPolicyBufferOne)
+	s.Contains(skippedOverlapPolicies, shared.ScheduleOverlapPolicySkipNew)
+}
+
+func (s *workflowSuite) TestWorkflow_Backfill() {
+	env := s.NewTestWorkflowEnvironment()
+	env.SetStartTime(s.startTime)
+	var scheduledTimes []time.Time
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, params startWorkflowActivityParams) (startWorkflowActivityResult, error) {
+			scheduledTimes = append(scheduledTimes, params.ScheduledTime)
+			s.Equal(shared.ScheduleOverlapPolicyAllowAll, params.OverlapPolicy)
+			return s.startedResult(params)
+		})
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(schedule.SignalNameBackfill, &shared.BackfillScheduleRequest{
+			StartTimestamp: common.Int64Ptr(time.Date(2019, 9, 28, 0, 0, 0, 0, time.UTC).UnixNano()),
+			EndTimestamp:   common.Int64Ptr(time.Date(2019, 9, 30, 0, 0, 0, 0, time.UTC).UnixNano()),
+			OverlapPolicy:  shared.ScheduleOverlapPolicyAllowAll.Ptr(),
+		})
+	}, time.Second)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(schedule.SignalNameDelete, &shared.DeleteScheduleRequest{})
+	}, time.Minute)
+
+	env.ExecuteWorkflow(schedule.WorkflowTypeName, s.newParams("0 0 * * *"))
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	s.Equal([]time.Time{
+		time.Date(2019, 9, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2019, 9, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2019, 9, 30, 0, 0, 0, 0, time.UTC),
+	}, scheduledTimes)
+}
+
+func (s *workflowSuite) TestGetActionTimes_SpecBounds() {
+	w := &scheduleWorkflow{params: s.newParams("0 * * * *"), logger: zap.NewNop()}
+	w.params.Spec.StartTimestamp = common.Int64Ptr(time.Date(2019, 10, 1, 2, 0, 0, 0, time.UTC).UnixNano())
+	w.params.Spec.EndTimestamp = common.Int64Ptr(time.Date(2019, 10, 1, 4, 30, 0, 0, time.UTC).UnixNano())
+
+	s.Equal([]time.Time{
+		time.Date(2019, 10, 1, 2, 0, 0, 0, time.UTC),
+		time.Date(2019, 10, 1, 3, 0, 0, 0, time.UTC),
+		time.Date(2019, 10, 1, 4, 0, 0, 0, time.UTC),
+	}, w.getActionTimes(s.startTime, time.Time{}, -1))
+	s.Len(w.getActionTimes(s.startTime, time.Time{}, 2), 2)
+}
+
+func (s *workflowSuite) TestGetJitter() {
+	w := &scheduleWorkflow{params: s.newParams("* * * * *"), logger: zap.NewNop()}
+	s.Zero(w.getJitter(s.startTime))
+
+	w.params.Spec.JitterInSeconds = common.Int32Ptr(30)
+	jitter := w.getJitter(s.startTime)
+	s.True(jitter >= 0 && jitter < 30*time.Second)
+	s.Equal(jitter, w.getJitter(s.startTime))
+}
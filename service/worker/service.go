@@ -46,6 +46,7 @@ import (
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
+	"github.com/uber/cadence/service/worker/scheduler"
 	cshared "go.uber.org/cadence/.gen/go/shared"
 	cclient "go.uber.org/cadence/client"
 )
@@ -73,6 +74,7 @@ type (
 		BatcherCfg                    *batcher.Config
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
+		EnableScheduler               dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
 	}
 )
@@ -111,15 +113,20 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			TimeLimitPerArchivalIteration: dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
 		},
 		ScannerCfg: &scanner.Config{
-			PersistenceMaxQPS: dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
-			Persistence:       &params.PersistenceConfig,
-			ClusterMetadata:   params.ClusterMetadata,
+			PersistenceMaxQPS:           dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
+			ExecutionsScannerEnabled:    dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
+			ExecutionsScannerFixEnabled: dc.GetBoolProperty(dynamicconfig.ExecutionsScannerFixEnabled, false),
+			QueueScannerEnabled:         dc.GetBoolProperty(dynamicconfig.QueueScannerEnabled, false),
+			QueueScannerDryRun:          dc.GetBoolProperty(dynamicconfig.QueueScannerDryRun, true),
+			Persistence:                 &params.PersistenceConfig,
+			ClusterMetadata:             params.ClusterMetadata,
 		},
 		BatcherCfg: &batcher.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, false),
+		EnableScheduler:               dc.GetBoolProperty(dynamicconfig.EnableScheduler, true),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 	}
@@ -148,13 +155,10 @@ func (s *Service) Start() {
 	s.metricsClient = base.GetMetricsClient()
 	s.logger.Info("service starting", tag.ComponentWorker)
 
-	if s.config.IndexerCfg != nil {
-		s.startIndexer(base)
-	}
-
 	replicatorEnabled := base.GetClusterMetadata().IsGlobalDomainEnabled()
 	archiverEnabled := base.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
 	batcherEnabled := s.config.EnableBatcher()
+	schedulerEnabled := s.config.EnableScheduler()
 	parentClosePolicyEnabled := s.config.EnableParentClosePolicyWorker()
 
 	pConfig := s.params.PersistenceConfig
@@ -162,6 +166,9 @@ func (s *Service) Start() {
 	pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.logger)
 	s.ensureSystemDomainExists(pFactory, base.GetClusterMetadata().GetCurrentClusterName())
 
+	if s.config.IndexerCfg != nil {
+		s.startIndexer(base, pFactory)
+	}
 	s.startScanner(base)
 	if replicatorEnabled {
 		s.startReplicator(base, pFactory)
@@ -172,6 +179,9 @@ func (s *Service) Start() {
 	if batcherEnabled {
 		s.startBatcher(base)
 	}
+	if schedulerEnabled {
+		s.startScheduler(base)
+	}
 	if parentClosePolicyEnabled {
 		s.startParentClosePolicyProcessor(base)
 	}
@@ -219,6 +229,20 @@ func (s *Service) startBatcher(base service.Service) {
 	}
 }
 
+func (s *Service) startScheduler(base service.Service) {
+	params := &scheduler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.metricsClient,
+		Logger:        s.logger,
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    base.GetClientBean(),
+	}
+	scheduler := scheduler.New(params)
+	if err := scheduler.Start(); err != nil {
+		s.logger.Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner(base service.Service) {
 	params := &scanner.BootstrapParams{
 		Config:        *s.config.ScannerCfg,
@@ -257,12 +281,20 @@ func (s *Service) startReplicator(base service.Service, pFactory persistencefact
 	}
 }
 
-func (s *Service) startIndexer(base service.Service) {
+func (s *Service) startIndexer(base service.Service, pFactory persistencefactory.Factory) {
+	metadataMgr, err := pFactory.NewMetadataManager()
+	if err != nil {
+		s.logger.Fatal("failed to start indexer, could not create MetadataManager", tag.Error(err))
+	}
+	domainCache := cache.NewDomainCache(metadataMgr, base.GetClusterMetadata(), s.metricsClient, s.logger)
+	domainCache.Start()
+
 	indexer := indexer.NewIndexer(
 		s.config.IndexerCfg,
 		base.GetMessagingClient(),
 		s.params.ESClient,
 		s.params.ESConfig,
+		domainCache,
 		s.logger,
 		s.metricsClient)
 	if err := indexer.Start(); err != nil {
@@ -338,6 +370,7 @@ func (s *Service) ensureSystemDomainExists(pFactory persistencefactory.Factory,
 		if _, ok := err.(*shared.EntityNotExistsError); ok {
 			s.logger.Info("cadence-system domain does not exist, attempting to register domain")
 			s.registerSystemDomain(pFactory, clusterName)
+			return
 		}
 		s.logger.Fatal("failed to verify if cadence system domain exists", tag.Error(err))
 	}
@@ -151,6 +151,51 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:    "export",
+			Aliases: []string{"exp"},
+			Usage:   "Export the history and metadata of a workflow execution to a file, to be imported into another cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the current run is exported if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Output file",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Number of history batches fetched per page",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminExportWorkflow(c)
+			},
+		},
+		{
+			Name:    "import",
+			Aliases: []string{"imp"},
+			Usage:   "Import a workflow execution exported from another cluster into the domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Input file written by workflow export",
+				},
+				cli.BoolFlag{
+					Name:  FlagNewRunID,
+					Usage: "Import the workflow execution under a new run ID instead of the exported one",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminImportWorkflow(c)
+			},
+		},
 	}
 }
 
@@ -683,6 +728,52 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminDescribeTaskList(c)
 			},
 		},
+		{
+			Name:    "list_partitions",
+			Aliases: []string{"lp"},
+			Usage:   "List partitions of tasklist along with their owner hosts",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList description",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListTaskListPartitions(c)
+			},
+		},
+		{
+			Name:    "update-limits",
+			Aliases: []string{"ul"},
+			Usage:   "Pin the dispatch rate and the max number of outstanding tasks of tasklist, 0 removes a limit",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.Float64Flag{
+					Name:  FlagMaxTasksPerSecondWithAlias,
+					Usage: "Max number of tasks dispatched per second across all pollers of tasklist",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxOutstandingTasksWithAlias,
+					Usage: "Max number of tasks dispatched to workers and not yet completed",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateTaskListLimits(c)
+			},
+		},
 	}
 }
 
@@ -702,10 +793,88 @@ func newAdminClusterCommands() []cli.Command {
 					Value: -1,
 					Usage: "Search Attribute value type. [0:String, 1:Keyword, 2:Int, 3:Double, 4:Bool, 5:Datetime]",
 				},
+				cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "Register the search attribute in this domain only, instead of whitelisting it for the cluster",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminAddSearchAttribute(c)
 			},
 		},
+		{
+			Name:    "remove-search-attr",
+			Aliases: []string{"rsa"},
+			Usage:   "remove search attributes registered in a domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSearchAttributesKey,
+					Usage: "Search Attribute keys to be removed, separated by comma",
+				},
+				cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "DomainName",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRemoveSearchAttribute(c)
+			},
+		},
+		{
+			Name:    "list-search-attr",
+			Aliases: []string{"lsa"},
+			Usage:   "list search attributes registered in a domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomain,
+					Usage: "DomainName",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListSearchAttributes(c)
+			},
+		},
+	}
+}
+
+func newAdminArchivalCommands() []cli.Command {
+	flags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "WorkflowID, if not set all archived workflows matching the query are scanned",
+		},
+		cli.StringFlag{
+			Name:  FlagRunIDWithAlias,
+			Usage: "RunID, required if WorkflowID is set",
+		},
+		cli.StringFlag{
+			Name:  FlagListQueryWithAlias,
+			Usage: "SQL like archived visibility query selecting the workflows to scan. Please check the documentation of the visibility archiver used by your domain for detailed instructions",
+		},
+		cli.IntFlag{
+			Name:  FlagPageSizeWithAlias,
+			Value: 100,
+			Usage: "Number of archived workflows listed per page when scanning",
+		},
+	}
+	return []cli.Command{
+		{
+			Name:    "verify",
+			Aliases: []string{"v"},
+			Usage:   "Verify archived workflow histories against their checksums and report missing or corrupted ones",
+			Flags:   flags,
+			Action: func(c *cli.Context) {
+				AdminArchivalVerify(c)
+			},
+		},
+		{
+			Name:    "rearchive",
+			Aliases: []string{"re"},
+			Usage:   "Archive workflow histories again, when scanning only missing or corrupted ones are rearchived",
+			Flags:   flags,
+			Action: func(c *cli.Context) {
+				AdminArchivalRearchive(c)
+			},
+		},
 	}
 }
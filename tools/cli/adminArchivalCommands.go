@@ -0,0 +1,173 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminArchivalVerify verifies the archived history of a workflow execution, or of all archived
+// workflow executions matching the archived visibility query, and reports missing or corrupted ones.
+func AdminArchivalVerify(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	table := newArchivalTable("Verification Status")
+	var verified, missing, corrupted int
+	forEachArchivedExecution(c, domain, func(execution *s.WorkflowExecution) {
+		status := verifyArchivedExecution(c, adminClient, domain, execution)
+		switch status {
+		case admin.ArchivalVerificationStatusVerified:
+			verified++
+			return
+		case admin.ArchivalVerificationStatusMissing:
+			missing++
+		case admin.ArchivalVerificationStatusCorrupted:
+			corrupted++
+		}
+		table.Append([]string{execution.GetWorkflowId(), execution.GetRunId(), status.String()})
+	})
+
+	if table.NumLines() > 0 {
+		table.Render()
+		fmt.Printf("\n")
+	}
+	fmt.Printf("Verified: %v, Missing: %v, Corrupted: %v\n", verified, missing, corrupted)
+}
+
+// AdminArchivalRearchive archives the history of a workflow execution again, or of all archived workflow
+// executions matching the archived visibility query whose archive is missing or corrupted. Only workflow
+// executions whose history has not been deleted yet can be rearchived.
+func AdminArchivalRearchive(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scan := !c.IsSet(FlagWorkflowID)
+
+	table := newArchivalTable("Rearchive Result")
+	var rearchived, failed int
+	forEachArchivedExecution(c, domain, func(execution *s.WorkflowExecution) {
+		if scan && verifyArchivedExecution(c, adminClient, domain, execution) == admin.ArchivalVerificationStatusVerified {
+			return
+		}
+
+		ctx, cancel := newContext(c)
+		defer cancel()
+		err := adminClient.RearchiveWorkflowExecution(ctx, &admin.RearchiveWorkflowExecutionRequest{
+			Domain:    common.StringPtr(domain),
+			Execution: execution,
+		})
+		result := "rearchived"
+		if err != nil {
+			result = err.Error()
+			failed++
+		} else {
+			rearchived++
+		}
+		table.Append([]string{execution.GetWorkflowId(), execution.GetRunId(), result})
+	})
+
+	if table.NumLines() > 0 {
+		table.Render()
+		fmt.Printf("\n")
+	}
+	fmt.Printf("Rearchived: %v, Failed: %v\n", rearchived, failed)
+}
+
+// forEachArchivedExecution calls fn with the workflow execution given by flags, or with every workflow
+// execution returned by the archived visibility query if no workflow execution is given
+func forEachArchivedExecution(c *cli.Context, domain string, fn func(*s.WorkflowExecution)) {
+	if c.IsSet(FlagWorkflowID) {
+		fn(&s.WorkflowExecution{
+			WorkflowId: common.StringPtr(c.String(FlagWorkflowID)),
+			RunId:      common.StringPtr(getRequiredOption(c, FlagRunID)),
+		})
+		return
+	}
+
+	frontendClient := cFactory.ServerFrontendClient(c)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+	request := &s.ListArchivedWorkflowExecutionsRequest{
+		Domain:   common.StringPtr(domain),
+		PageSize: common.Int32Ptr(int32(pageSize)),
+		Query:    common.StringPtr(getRequiredOption(c, FlagListQuery)),
+	}
+	contextTimeout := defaultContextTimeoutForListArchivedWorkflow
+	if c.GlobalIsSet(FlagContextTimeout) {
+		contextTimeout = time.Duration(c.GlobalInt(FlagContextTimeout)) * time.Second
+	}
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+		response, err := frontendClient.ListArchivedWorkflowExecutions(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list archived workflow.", err)
+		}
+		for _, info := range response.GetExecutions() {
+			fn(info.GetExecution())
+		}
+		if len(response.NextPageToken) == 0 {
+			return
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+func verifyArchivedExecution(
+	c *cli.Context,
+	adminClient adminserviceclient.Interface,
+	domain string,
+	execution *s.WorkflowExecution,
+) admin.ArchivalVerificationStatus {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.VerifyArchivedWorkflowExecution(ctx, &admin.VerifyArchivedWorkflowExecutionRequest{
+		Domain:    common.StringPtr(domain),
+		Execution: execution,
+	})
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to verify archived history of workflow %v, run %v.", execution.GetWorkflowId(), execution.GetRunId()), err)
+	}
+	return response.GetStatus()
+}
+
+func newArchivalTable(resultHeader string) *tablewriter.Table {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Workflow ID", "Run ID", resultHeader})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	return table
+}
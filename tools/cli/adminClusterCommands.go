@@ -24,10 +24,13 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
 	"os"
+	"sort"
 	"strings"
 )
 
@@ -39,10 +42,17 @@ func AdminAddSearchAttribute(c *cli.Context) {
 		ErrorAndExit("Unknown Search Attributes value type.", nil)
 	}
 
+	domain := c.String(FlagDomain)
+
 	// ask user for confirmation
 	reader := bufio.NewReader(os.Stdin)
-	fmt.Printf("Are you trying to add key [%s] with Type [%s]? Y/N\n",
-		color.YellowString(key), color.YellowString(intValTypeToString(valType)))
+	if len(domain) != 0 {
+		fmt.Printf("Are you trying to add key [%s] with Type [%s] to domain [%s]? Y/N\n",
+			color.YellowString(key), color.YellowString(intValTypeToString(valType)), color.YellowString(domain))
+	} else {
+		fmt.Printf("Are you trying to add key [%s] with Type [%s]? Y/N\n",
+			color.YellowString(key), color.YellowString(intValTypeToString(valType)))
+	}
 	text, _ := reader.ReadString('\n')
 	textLower := strings.ToLower(strings.TrimRight(text, "\n"))
 	if textLower != "y" && textLower != "yes" {
@@ -57,6 +67,9 @@ func AdminAddSearchAttribute(c *cli.Context) {
 			key: shared.IndexedValueType(valType),
 		},
 	}
+	if len(domain) != 0 {
+		request.Domain = common.StringPtr(domain)
+	}
 
 	err := adminClient.AddSearchAttribute(ctx, request)
 	if err != nil {
@@ -65,6 +78,58 @@ func AdminAddSearchAttribute(c *cli.Context) {
 	fmt.Println("Success")
 }
 
+// AdminRemoveSearchAttribute removes search attributes registered in a domain
+func AdminRemoveSearchAttribute(c *cli.Context) {
+	domain := getRequiredOption(c, FlagDomain)
+	keys := strings.Split(getRequiredOption(c, FlagSearchAttributesKey), ",")
+	for i := range keys {
+		keys[i] = strings.TrimSpace(keys[i])
+	}
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &admin.RemoveSearchAttributeRequest{
+		Keys:   keys,
+		Domain: common.StringPtr(domain),
+	}
+
+	err := adminClient.RemoveSearchAttribute(ctx, request)
+	if err != nil {
+		ErrorAndExit("Remove search attribute failed.", err)
+	}
+	fmt.Println("Success")
+}
+
+// AdminListSearchAttributes lists search attributes registered in a domain
+func AdminListSearchAttributes(c *cli.Context) {
+	domain := getRequiredOption(c, FlagDomain)
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &admin.ListSearchAttributesRequest{
+		Domain: common.StringPtr(domain),
+	}
+
+	resp, err := adminClient.ListSearchAttributes(ctx, request)
+	if err != nil {
+		ErrorAndExit("List search attributes failed.", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	header := []string{"Key", "Value type"}
+	table.SetHeader(header)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
+	rows := [][]string{}
+	for k, v := range resp.Keys {
+		rows = append(rows, []string{k, v.String()})
+	}
+	sort.Sort(byKey(rows))
+	table.AppendBulk(rows)
+	table.Render()
+}
+
 func intValTypeToString(valType int) string {
 	switch valType {
 	case 0:
@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// exportedWorkflowVersion is the version of the file format written by workflow export,
+// it must be bumped whenever the format changes in a way older importers cannot read
+const exportedWorkflowVersion = 1
+
+type (
+	// exportedWorkflow is the file format used to move a workflow execution between clusters
+	exportedWorkflow struct {
+		Version        int           `json:"version"`
+		Domain         string        `json:"domain"`
+		WorkflowID     string        `json:"workflowId"`
+		RunID          string        `json:"runId"`
+		WorkflowType   string        `json:"workflowType"`
+		StartTime      int64         `json:"startTime"`
+		CloseTime      int64         `json:"closeTime,omitempty"`
+		HistoryLength  int64         `json:"historyLength"`
+		ExportTime     int64         `json:"exportTime"`
+		HistoryBatches []*s.DataBlob `json:"historyBatches"`
+	}
+)
+
+// AdminExportWorkflow writes the history of a workflow execution with its metadata to a file,
+// which can be imported into another cluster by AdminImportWorkflow
+func AdminExportWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	outputFileName := getRequiredOption(c, FlagOutputFilename)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	describeResp, err := frontendClient.DescribeWorkflowExecution(ctx, &s.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Describe workflow execution failed", err)
+	}
+	info := describeResp.WorkflowExecutionInfo
+	exported := &exportedWorkflow{
+		Version:       exportedWorkflowVersion,
+		Domain:        domain,
+		WorkflowID:    wid,
+		RunID:         info.Execution.GetRunId(),
+		WorkflowType:  info.Type.GetName(),
+		StartTime:     info.GetStartTime(),
+		CloseTime:     info.GetCloseTime(),
+		HistoryLength: info.GetHistoryLength(),
+	}
+
+	request := &admin.GetWorkflowExecutionRawHistoryRequest{
+		Domain:          common.StringPtr(domain),
+		Execution:       info.Execution,
+		FirstEventId:    common.Int64Ptr(common.FirstEventID),
+		NextEventId:     common.Int64Ptr(common.EndEventID),
+		MaximumPageSize: common.Int32Ptr(int32(c.Int(FlagPageSize))),
+	}
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.GetWorkflowExecutionRawHistory(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Get workflow execution raw history failed", err)
+		}
+		exported.HistoryBatches = append(exported.HistoryBatches, resp.HistoryBatches...)
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	if len(exported.HistoryBatches) == 0 {
+		ErrorAndExit("Workflow execution has no history to export.", nil)
+	}
+	exported.ExportTime = time.Now().UnixNano()
+
+	data, err := json.Marshal(exported)
+	if err != nil {
+		ErrorAndExit("Failed to serialize exported workflow.", err)
+	}
+	if err := ioutil.WriteFile(outputFileName, data, 0644); err != nil {
+		ErrorAndExit("Failed to write exported workflow file.", err)
+	}
+	fmt.Printf("Exported %v history batches of workflow execution, WorkflowID: %v, RunID: %v\n",
+		len(exported.HistoryBatches), exported.WorkflowID, exported.RunID)
+}
+
+// AdminImportWorkflow creates a workflow execution in the domain from a file written by AdminExportWorkflow
+func AdminImportWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	inputFileName := getRequiredOption(c, FlagInputFile)
+
+	exported, err := readExportedWorkflow(inputFileName)
+	if err != nil {
+		ErrorAndExit("Failed to read exported workflow file.", err)
+	}
+	rid := exported.RunID
+	if c.Bool(FlagNewRunID) {
+		rid = ""
+	}
+
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+	resp, err := adminClient.ImportWorkflowExecution(ctx, &admin.ImportWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &s.WorkflowExecution{
+			WorkflowId: common.StringPtr(exported.WorkflowID),
+			RunId:      common.StringPtr(rid),
+		},
+		HistoryBatches: exported.HistoryBatches,
+	})
+	if err != nil {
+		ErrorAndExit("Import workflow execution failed", err)
+	}
+	fmt.Printf("Imported workflow execution, WorkflowID: %v, RunID: %v\n", exported.WorkflowID, resp.GetRunId())
+}
+
+func readExportedWorkflow(fileName string) (*exportedWorkflow, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	exported := &exportedWorkflow{}
+	if err := json.Unmarshal(data, exported); err != nil {
+		return nil, err
+	}
+	if exported.Version != exportedWorkflowVersion {
+		return nil, fmt.Errorf("unsupported exported workflow version %v, expecting %v", exported.Version, exportedWorkflowVersion)
+	}
+	if exported.WorkflowID == "" || len(exported.HistoryBatches) == 0 {
+		return nil, fmt.Errorf("exported workflow has no workflow ID or history")
+	}
+	return exported, nil
+}
@@ -63,6 +63,11 @@ func AdminDescribeTaskList(c *cli.Context) {
 	printTaskListStatus(taskListStatus)
 	fmt.Printf("\n")
 
+	if limits := response.GetLimits(); limits != nil {
+		printTaskListLimits(limits, taskListStatus)
+		fmt.Printf("\n")
+	}
+
 	pollers := response.Pollers
 	if len(pollers) == 0 {
 		ErrorAndExit(colorMagenta("No poller for tasklist: "+taskList), nil)
@@ -70,6 +75,96 @@ func AdminDescribeTaskList(c *cli.Context) {
 	printPollerInfo(pollers, taskListType)
 }
 
+// AdminListTaskListPartitions displays the partition config of task list and the hosts owning its partitions.
+func AdminListTaskListPartitions(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := s.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = s.TaskListTypeActivity
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &s.DescribeTaskListRequest{
+		Domain:                    common.StringPtr(domain),
+		TaskList:                  &s.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType:              &taskListType,
+		IncludeTaskListPartitions: common.BoolPtr(true),
+	}
+
+	response, err := frontendClient.DescribeTaskList(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation DescribeTaskList failed.", err)
+	}
+
+	partitionConfig := response.GetPartitionConfig()
+	if partitionConfig == nil {
+		ErrorAndExit(colorMagenta("No partition information for tasklist: "+taskList), nil)
+	}
+	fmt.Printf("Read partitions: %v, Write partitions: %v\n\n",
+		partitionConfig.GetNumReadPartitions(), partitionConfig.GetNumWritePartitions())
+	printTaskListPartitions(response.Partitions)
+}
+
+// AdminUpdateTaskListLimits pins the dispatch rate and the max number of outstanding tasks of task list.
+func AdminUpdateTaskListLimits(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := s.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = s.TaskListTypeActivity
+	}
+	if !c.IsSet(FlagMaxTasksPerSecond) && !c.IsSet(FlagMaxOutstandingTasks) {
+		ErrorAndExit(fmt.Sprintf("At least one of %v and %v is required", FlagMaxTasksPerSecond, FlagMaxOutstandingTasks), nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &s.UpdateTaskListLimitsRequest{
+		Domain:       common.StringPtr(domain),
+		TaskList:     &s.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType: &taskListType,
+		Limits: &s.TaskListLimits{
+			MaxTasksPerSecond:   common.Float64Ptr(c.Float64(FlagMaxTasksPerSecond)),
+			MaxOutstandingTasks: common.Int32Ptr(int32(c.Int(FlagMaxOutstandingTasks))),
+		},
+	}
+
+	if err := adminClient.UpdateTaskListLimits(ctx, request); err != nil {
+		ErrorAndExit("Operation UpdateTaskListLimits failed.", err)
+	}
+	fmt.Println("Limits updated for tasklist: " + taskList)
+}
+
+func printTaskListLimits(limits *s.TaskListLimits, taskListStatus *s.TaskListStatus) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Max Tasks Per Second", "Max Outstanding Tasks", "Outstanding Tasks"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.Append([]string{strconv.FormatFloat(limits.GetMaxTasksPerSecond(), 'f', -1, 64),
+		strconv.FormatInt(int64(limits.GetMaxOutstandingTasks()), 10),
+		strconv.FormatInt(int64(taskListStatus.GetOutstandingTasks()), 10)})
+	table.Render()
+}
+
+func printTaskListPartitions(partitions []*s.TaskListPartitionMetadata) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Partition", "Owner Host", "Draining"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, partition := range partitions {
+		table.Append([]string{partition.GetKey(), partition.GetOwnerHostName(), strconv.FormatBool(partition.GetDraining())})
+	}
+	table.Render()
+}
+
 func printTaskListStatus(taskListStatus *s.TaskListStatus) {
 	taskIDBlock := taskListStatus.GetTaskIDBlock()
 
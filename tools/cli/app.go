@@ -59,6 +59,36 @@ func NewCliApp() *cli.App {
 			Usage:  "optional timeout for context of RPC call in seconds",
 			EnvVar: "CADENCE_CONTEXT_TIMEOUT",
 		},
+		cli.BoolFlag{
+			Name:   FlagTLS,
+			Usage:  "connect to cadence frontend over TLS, implied by the other tls options",
+			EnvVar: "CADENCE_CLI_TLS",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSCertPath,
+			Usage:  "path to the client certificate presented to a frontend requiring mutual TLS",
+			EnvVar: "CADENCE_CLI_TLS_CERT_PATH",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSKeyPath,
+			Usage:  "path to the private key of the client certificate",
+			EnvVar: "CADENCE_CLI_TLS_KEY_PATH",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSCaPath,
+			Usage:  "path to the CA certificates used to verify the frontend, the system roots are used by default",
+			EnvVar: "CADENCE_CLI_TLS_CA_PATH",
+		},
+		cli.StringFlag{
+			Name:   FlagTLSServerName,
+			Usage:  "server name verified against the frontend certificate, the address host is used by default",
+			EnvVar: "CADENCE_CLI_TLS_SERVER_NAME",
+		},
+		cli.StringFlag{
+			Name:   FlagAuthToken,
+			Usage:  "token identifying the caller to a frontend which authorizes requests",
+			EnvVar: "CADENCE_CLI_AUTH_TOKEN",
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -132,6 +162,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on cluster",
 					Subcommands: newAdminClusterCommands(),
 				},
+				{
+					Name:        "archival",
+					Aliases:     []string{"arc"},
+					Usage:       "Run admin operation on archived workflow histories",
+					Subcommands: newAdminArchivalCommands(),
+				},
 			},
 		},
 		{
@@ -140,6 +176,12 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence cluster",
 			Subcommands: newClusterCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"s"},
+			Usage:       "Operate cadence schedule",
+			Subcommands: newScheduleCommands(),
+		},
 	}
 
 	// set builder if not customized
@@ -21,6 +21,10 @@
 package cli
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -40,6 +44,7 @@ import (
 	clientFrontend "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	clientFrontendTest "go.uber.org/cadence/.gen/go/cadence/workflowservicetest"
 	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/yarpc"
 )
 
 type cliAppSuite struct {
@@ -352,6 +357,21 @@ func (s *cliAppSuite) TestSignalWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestUpdateWorkflow() {
+	resp := &serverShared.UpdateWorkflowExecutionResponse{
+		Result: []byte("update-result"),
+	}
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow_Rejected() {
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &serverShared.WorkflowUpdateRejectedError{Message: "rejected"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestQueryWorkflow() {
 	resp := &shared.QueryWorkflowResponse{
 		QueryResult: []byte("query-result"),
@@ -519,11 +539,206 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestPauseActivity() {
+	s.serverAdminClient.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid", "-aid", "aid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseActivity_Failed() {
+	s.serverAdminClient.EXPECT().PauseActivity(gomock.Any(), gomock.Any()).Return(&serverShared.EntityNotExistsError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "activity", "pause", "-w", "wid", "-aid", "aid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestResetActivity() {
+	s.serverAdminClient.EXPECT().ResetActivity(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *serverShared.ResetActivityRequest) error {
+			s.True(request.GetResetHeartbeatDetails())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "reset", "-w", "wid", "-aid", "aid", "--reset_heartbeat_details"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateActivityTimeouts() {
+	s.serverAdminClient.EXPECT().UpdateActivityTimeouts(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *serverShared.UpdateActivityTimeoutsRequest) error {
+			s.Equal(int32(30), request.GetStartToCloseTimeoutSeconds())
+			s.Equal(int32(0), request.GetHeartbeatTimeoutSeconds())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "activity", "update-timeouts", "-w", "wid", "-aid", "aid", "--start_to_close_timeout", "30"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	err := s.app.Run([]string{"", "--do", domainName, "admin", "cl", "asa", "--search_attr_key", "testKey", "--search_attr_type", "1"})
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminRemoveSearchAttribute() {
+	s.serverAdminClient.EXPECT().RemoveSearchAttribute(gomock.Any(), &admin.RemoveSearchAttributeRequest{
+		Keys:   []string{"key1", "key2"},
+		Domain: common.StringPtr(domainName),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "admin", "cl", "rsa", "--domain", domainName, "--search_attr_key", "key1, key2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListSearchAttributes() {
+	resp := &serverShared.GetSearchAttributesResponse{
+		Keys: map[string]serverShared.IndexedValueType{"key1": serverShared.IndexedValueTypeKeyword},
+	}
+	s.serverAdminClient.EXPECT().ListSearchAttributes(gomock.Any(), &admin.ListSearchAttributesRequest{
+		Domain: common.StringPtr(domainName),
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "admin", "cl", "lsa", "--domain", domainName})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminListTaskListPartitions() {
+	resp := &serverShared.DescribeTaskListResponse{
+		PartitionConfig: &serverShared.TaskListPartitionConfig{
+			NumReadPartitions:  common.Int32Ptr(2),
+			NumWritePartitions: common.Int32Ptr(1),
+		},
+		Partitions: []*serverShared.TaskListPartitionMetadata{
+			{Key: common.StringPtr("test-taskList"), OwnerHostName: common.StringPtr("host1"), Draining: common.BoolPtr(false)},
+			{Key: common.StringPtr("/__cadence_sys/test-taskList/1"), OwnerHostName: common.StringPtr("host2"), Draining: common.BoolPtr(true)},
+		},
+	}
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *serverShared.DescribeTaskListRequest, _ ...yarpc.CallOption) (*serverShared.DescribeTaskListResponse, error) {
+			s.True(request.GetIncludeTaskListPartitions())
+			s.Equal(serverShared.TaskListTypeActivity, request.GetTaskListType())
+			return resp, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tl", "lp", "-tl", "test-taskList", "-tlt", "activity"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminUpdateTaskListLimits() {
+	s.serverAdminClient.EXPECT().UpdateTaskListLimits(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *serverShared.UpdateTaskListLimitsRequest, _ ...yarpc.CallOption) error {
+			s.Equal("test-taskList", request.TaskList.GetName())
+			s.Equal(serverShared.TaskListTypeActivity, request.GetTaskListType())
+			s.Equal(25.5, request.Limits.GetMaxTasksPerSecond())
+			s.Equal(int32(0), request.Limits.GetMaxOutstandingTasks())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tl", "update-limits", "-tl", "test-taskList", "-tlt", "activity", "-mtps", "25.5"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminArchivalVerify() {
+	s.serverAdminClient.EXPECT().VerifyArchivedWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *admin.VerifyArchivedWorkflowExecutionRequest, _ ...yarpc.CallOption) (*admin.VerifyArchivedWorkflowExecutionResponse, error) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal("wid", request.Execution.GetWorkflowId())
+			s.Equal("rid", request.Execution.GetRunId())
+			return &admin.VerifyArchivedWorkflowExecutionResponse{Status: admin.ArchivalVerificationStatusCorrupted.Ptr()}, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "archival", "verify", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminArchivalVerify_Scan() {
+	s.serverFrontendClient.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *serverShared.ListArchivedWorkflowExecutionsRequest, _ ...yarpc.CallOption) (*serverShared.ListArchivedWorkflowExecutionsResponse, error) {
+			s.Equal("CloseTime > 0", request.GetQuery())
+			return &serverShared.ListArchivedWorkflowExecutionsResponse{
+				Executions: []*serverShared.WorkflowExecutionInfo{
+					{Execution: &serverShared.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid1")}},
+					{Execution: &serverShared.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid2")}},
+				},
+			}, nil
+		})
+	gomock.InOrder(
+		s.serverAdminClient.EXPECT().VerifyArchivedWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&admin.VerifyArchivedWorkflowExecutionResponse{Status: admin.ArchivalVerificationStatusVerified.Ptr()}, nil),
+		s.serverAdminClient.EXPECT().VerifyArchivedWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&admin.VerifyArchivedWorkflowExecutionResponse{Status: admin.ArchivalVerificationStatusMissing.Ptr()}, nil),
+	)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "archival", "verify", "-q", "CloseTime > 0"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminArchivalRearchive_Scan() {
+	s.serverFrontendClient.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(
+		&serverShared.ListArchivedWorkflowExecutionsResponse{
+			Executions: []*serverShared.WorkflowExecutionInfo{
+				{Execution: &serverShared.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid1")}},
+				{Execution: &serverShared.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid2")}},
+			},
+		}, nil)
+	gomock.InOrder(
+		s.serverAdminClient.EXPECT().VerifyArchivedWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&admin.VerifyArchivedWorkflowExecutionResponse{Status: admin.ArchivalVerificationStatusVerified.Ptr()}, nil),
+		s.serverAdminClient.EXPECT().VerifyArchivedWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&admin.VerifyArchivedWorkflowExecutionResponse{Status: admin.ArchivalVerificationStatusCorrupted.Ptr()}, nil),
+	)
+	s.serverAdminClient.EXPECT().RearchiveWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *admin.RearchiveWorkflowExecutionRequest, _ ...yarpc.CallOption) error {
+			s.Equal("rid2", request.Execution.GetRunId())
+			return nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "archival", "rearchive", "-q", "CloseTime > 0"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminExportImportWorkflow() {
+	historyBatches := []*serverShared.DataBlob{
+		{EncodingType: serverShared.EncodingTypeThriftRW.Ptr(), Data: []byte("batch1")},
+		{EncodingType: serverShared.EncodingTypeThriftRW.Ptr(), Data: []byte("batch2")},
+	}
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&serverShared.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &serverShared.WorkflowExecutionInfo{
+				Execution: &serverShared.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+				Type:      &serverShared.WorkflowType{Name: common.StringPtr("workflow-type")},
+			},
+		}, nil)
+	gomock.InOrder(
+		s.serverAdminClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).Return(
+			&admin.GetWorkflowExecutionRawHistoryResponse{
+				HistoryBatches: historyBatches[:1],
+				NextPageToken:  []byte("token"),
+			}, nil),
+		s.serverAdminClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *admin.GetWorkflowExecutionRawHistoryRequest, _ ...yarpc.CallOption) (*admin.GetWorkflowExecutionRawHistoryResponse, error) {
+				s.Equal("rid", request.Execution.GetRunId())
+				s.Equal([]byte("token"), request.NextPageToken)
+				return &admin.GetWorkflowExecutionRawHistoryResponse{HistoryBatches: historyBatches[1:]}, nil
+			}),
+	)
+
+	dir, err := ioutil.TempDir("", "TestAdminExportImportWorkflow")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	fileName := filepath.Join(dir, "workflow.json")
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "workflow", "export", "-w", "wid", "-of", fileName})
+	s.Nil(err)
+
+	exported, err := readExportedWorkflow(fileName)
+	s.NoError(err)
+	s.Equal(exportedWorkflowVersion, exported.Version)
+	s.Equal("rid", exported.RunID)
+	s.Equal("workflow-type", exported.WorkflowType)
+	s.Equal(historyBatches, exported.HistoryBatches)
+
+	s.serverAdminClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *admin.ImportWorkflowExecutionRequest, _ ...yarpc.CallOption) (*admin.ImportWorkflowExecutionResponse, error) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal("wid", request.Execution.GetWorkflowId())
+			s.Equal("", request.Execution.GetRunId())
+			s.Equal(historyBatches, request.HistoryBatches)
+			return &admin.ImportWorkflowExecutionResponse{RunId: common.StringPtr("new-rid")}, nil
+		})
+	err = s.app.Run([]string{"", "--do", domainName, "admin", "workflow", "import", "-if", fileName, "--new_run_id"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -641,8 +856,8 @@ func (s *cliAppSuite) TestGetWorkflowIdReusePolicy_Failed_Negative() {
 }
 
 func (s *cliAppSuite) TestGetSearchAttributes() {
-	resp := &shared.GetSearchAttributesResponse{}
-	s.clientFrontendClient.EXPECT().GetSearchAttributes(gomock.Any(), callOptions...).Return(resp, nil).Times(2)
+	resp := &serverShared.GetSearchAttributesResponse{}
+	s.serverFrontendClient.EXPECT().GetSearchAttributes(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
 	err := s.app.Run([]string{"", "cluster", "get-search-attr"})
 	s.Nil(err)
 	err = s.app.Run([]string{"", "--do", domainName, "cluster", "get-search-attr"})
@@ -25,16 +25,22 @@ import (
 	"sort"
 
 	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/urfave/cli"
 )
 
-// GetSearchAttributes get valid search attributes
+// GetSearchAttributes get valid search attributes, including the ones registered in the domain if provided
 func GetSearchAttributes(c *cli.Context) {
-	wfClient := getWorkflowClientWithOptionalDomain(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
 	ctx, cancel := newContext(c)
 	defer cancel()
 
-	resp, err := wfClient.GetSearchAttributes(ctx)
+	request := &shared.GetSearchAttributesRequest{}
+	if c.GlobalIsSet(FlagDomain) {
+		request.Domain = common.StringPtr(c.GlobalString(FlagDomain))
+	}
+	resp, err := frontendClient.GetSearchAttributes(ctx, request)
 	if err != nil {
 		ErrorAndExit("Failed to get search attributes.", err)
 	}
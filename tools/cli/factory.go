@@ -26,6 +26,8 @@ import (
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/urfave/cli"
 	clientFrontend "go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/yarpc"
@@ -92,7 +94,21 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 		b.hostPort = addr
 	}
 
-	ch, err := tchannel.NewChannelTransport(tchannel.ServiceName(cadenceClientName), tchannel.ListenAddr("127.0.0.1:0"))
+	tlsConfig := config.TLS{
+		Enabled:    c.GlobalBool(FlagTLS),
+		CertFile:   c.GlobalString(FlagTLSCertPath),
+		KeyFile:    c.GlobalString(FlagTLSKeyPath),
+		CaFile:     c.GlobalString(FlagTLSCaPath),
+		ServerName: c.GlobalString(FlagTLSServerName),
+	}
+	if tlsConfig.CertFile != "" || tlsConfig.KeyFile != "" || tlsConfig.CaFile != "" || tlsConfig.ServerName != "" {
+		tlsConfig.Enabled = true
+	}
+	tchan, err := tlsConfig.NewChannel(cadenceClientName)
+	if err != nil {
+		b.logger.Fatal("Failed to create tchannel", zap.Error(err))
+	}
+	ch, err := tchannel.NewChannelTransport(tchannel.WithChannel(tchan), tchannel.ListenAddr("127.0.0.1:0"))
 	if err != nil {
 		b.logger.Fatal("Failed to create transport channel", zap.Error(err))
 	}
@@ -103,7 +119,9 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 			cadenceFrontendService: {Unary: ch.NewSingleOutbound(b.hostPort)},
 		},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
-			Unary: &versionMiddleware{},
+			Unary: &versionMiddleware{
+				authToken: c.GlobalString(FlagAuthToken),
+			},
 		},
 	})
 
@@ -114,9 +132,13 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 }
 
 type versionMiddleware struct {
+	authToken string
 }
 
 func (vm *versionMiddleware) Call(ctx context.Context, request *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
 	request.Headers = request.Headers.With(common.LibraryVersionHeaderName, "1.0.0").With(common.FeatureVersionHeaderName, "1.0.0").With(common.ClientImplHeaderName, "cli")
+	if vm.authToken != "" {
+		request.Headers = request.Headers.With(authorization.TokenHeaderName, vm.authToken)
+	}
 	return out.Call(ctx, request)
 }
@@ -186,8 +186,13 @@ const (
 	FlagRemoveTaskID                      = "task_id"
 	FlagRemoveTypeID                      = "type_id"
 	FlagRPS                               = "rps"
+	FlagMinRPS                            = "min_rps"
+	FlagMaxRPS                            = "max_rps"
+	FlagTargetLatency                     = "target_latency"
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
+	FlagRetryJobID                        = "retry_job_id"
+	FlagDryRun                            = "dry_run"
 	FlagYes                               = "yes"
 	FlagServiceConfigDir                  = "service_config_dir"
 	FlagServiceConfigDirWithAlias         = FlagServiceConfigDir + ", scd"
@@ -195,6 +200,34 @@ const (
 	FlagServiceEnvWithAlias               = FlagServiceEnv + ", se"
 	FlagServiceZone                       = "service_zone"
 	FlagServiceZoneWithAlias              = FlagServiceZone + ", sz"
+	FlagScheduleID                        = "schedule_id"
+	FlagScheduleIDWithAlias               = FlagScheduleID + ", sid"
+	FlagJitter                            = "jitter"
+	FlagOverlapPolicy                     = "overlap_policy"
+	FlagOverlapPolicyWithAlias            = FlagOverlapPolicy + ", op"
+	FlagCatchupWindow                     = "catchup_window"
+	FlagCatchupWindowWithAlias            = FlagCatchupWindow + ", cw"
+	FlagPaused                            = "paused"
+	FlagStartTime                         = "start_time"
+	FlagStartTimeWithAlias                = FlagStartTime + ", st"
+	FlagEndTime                           = "end_time"
+	FlagEndTimeWithAlias                  = FlagEndTime + ", end"
+	FlagResetHeartbeatDetails             = "reset_heartbeat_details"
+	FlagScheduleToStartTimeout            = "schedule_to_start_timeout"
+	FlagScheduleToCloseTimeout            = "schedule_to_close_timeout"
+	FlagStartToCloseTimeout               = "start_to_close_timeout"
+	FlagHeartbeatTimeout                  = "heartbeat_timeout"
+	FlagMaxTasksPerSecond                 = "max_tasks_per_second"
+	FlagMaxTasksPerSecondWithAlias        = FlagMaxTasksPerSecond + ", mtps"
+	FlagMaxOutstandingTasks               = "max_outstanding_tasks"
+	FlagMaxOutstandingTasksWithAlias      = FlagMaxOutstandingTasks + ", mot"
+	FlagNewRunID                          = "new_run_id"
+	FlagTLS                               = "tls"
+	FlagTLSCertPath                       = "tls_cert_path"
+	FlagTLSKeyPath                        = "tls_key_path"
+	FlagTLSCaPath                         = "tls_ca_path"
+	FlagTLSServerName                     = "tls_server_name"
+	FlagAuthToken                         = "auth_token"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -0,0 +1,225 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a schedule which periodically starts a workflow",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.BoolFlag{
+					Name:  FlagPaused,
+					Usage: "Optional flag to create the schedule in paused state",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Optional notes of the schedule state",
+				},
+			}, getFlagsForScheduleSpecAndAction()...),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the spec, action, policies, state and info of a schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Update a schedule, only the spec, action or policies given in the flags are replaced",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			}, getFlagsForScheduleSpecAndAction()...),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule so that it takes no actions until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for pausing the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseSchedule(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a schedule, the actions due while it was paused are not taken",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for unpausing the schedule",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseSchedule(c)
+			},
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete a schedule, the workflows already started by the schedule are not affected",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteSchedule(c)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Take the actions of a schedule which are scheduled in the given time range",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagStartTimeWithAlias,
+					Usage: "Start of the time range (inclusive), supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagEndTimeWithAlias,
+					Usage: "End of the time range (inclusive), supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy for the backfilled actions, defaults to the policy of the schedule: [SkipNew|BufferOne|CancelOther|AllowAll]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a domain",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultPageSizeForList,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListSchedules(c)
+			},
+		},
+	}
+}
+
+func getFlagsForScheduleSpecAndAction() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagCronSchedule,
+			Usage: "Cron expression of the schedule, see 'workflow start' for the cron spec",
+		},
+		cli.IntFlag{
+			Name:  FlagJitter,
+			Usage: "Optional maximum delay in seconds added to each action of the schedule",
+		},
+		cli.StringFlag{
+			Name:  FlagStartTimeWithAlias,
+			Usage: "Optional time before which the schedule takes no actions, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagEndTimeWithAlias,
+			Usage: "Optional time after which the schedule takes no actions, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicyWithAlias,
+			Usage: "Optional policy when the workflow started by the previous action is still running: [SkipNew|BufferOne|CancelOther|AllowAll]",
+		},
+		cli.IntFlag{
+			Name:  FlagCatchupWindowWithAlias,
+			Usage: "Optional time in seconds after which a missed action is no longer taken",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList of the started workflows",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDWithAlias,
+			Usage: "WorkflowID prefix of the started workflows, the scheduled time is appended to it",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName of the started workflows",
+		},
+		cli.IntFlag{
+			Name:  FlagExecutionTimeoutWithAlias,
+			Usage: "Execution start to close timeout in seconds of the started workflows",
+		},
+		cli.IntFlag{
+			Name:  FlagDecisionTimeoutWithAlias,
+			Value: defaultDecisionTimeoutInSeconds,
+			Usage: "Decision task start to close timeout in seconds of the started workflows",
+		},
+		cli.StringFlag{
+			Name:  FlagInputWithAlias,
+			Usage: "Optional input for the started workflows, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+		},
+		cli.StringFlag{
+			Name: FlagInputFileWithAlias,
+			Usage: "Optional input for the started workflows from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+				"Input from file will be overwrite by input from command line",
+		},
+	}
+}
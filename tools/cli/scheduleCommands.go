@@ -0,0 +1,301 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pborman/uuid"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	spec := getScheduleSpec(c)
+	if spec == nil {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagCronSchedule), nil)
+	}
+	action := getScheduleAction(c, domain)
+	if action == nil {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagWorkflowType), nil)
+	}
+
+	request := &s.CreateScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Spec:       spec,
+		Action:     action,
+		Policies:   getSchedulePolicies(c),
+		Identity:   common.StringPtr(getCliIdentity()),
+		RequestId:  common.StringPtr(uuid.New()),
+	}
+	if c.Bool(FlagPaused) {
+		request.State = &s.ScheduleState{
+			Paused: common.BoolPtr(true),
+			Notes:  getPtrOrNilIfEmpty(c.String(FlagReason)),
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := frontendClient.CreateSchedule(ctx, request); err != nil {
+		ErrorAndExit("Failed to create schedule.", err)
+	}
+	fmt.Printf("Schedule %s created.\n", scheduleID)
+}
+
+// DescribeSchedule describes a schedule
+func DescribeSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := frontendClient.DescribeSchedule(ctx, &s.DescribeScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe schedule.", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// UpdateSchedule updates the spec, action or policies of a schedule
+func UpdateSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UpdateSchedule(ctx, &s.UpdateScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Spec:       getScheduleSpec(c),
+		Action:     getScheduleAction(c, domain),
+		Policies:   getSchedulePolicies(c),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to update schedule.", err)
+	}
+	fmt.Printf("Schedule %s updated.\n", scheduleID)
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.PauseSchedule(ctx, &s.PauseScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Reason:     getPtrOrNilIfEmpty(c.String(FlagReason)),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to pause schedule.", err)
+	}
+	fmt.Printf("Schedule %s paused.\n", scheduleID)
+}
+
+// UnpauseSchedule unpauses a schedule
+func UnpauseSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UnpauseSchedule(ctx, &s.UnpauseScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Reason:     getPtrOrNilIfEmpty(c.String(FlagReason)),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to unpause schedule.", err)
+	}
+	fmt.Printf("Schedule %s unpaused.\n", scheduleID)
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.DeleteSchedule(ctx, &s.DeleteScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to delete schedule.", err)
+	}
+	fmt.Printf("Schedule %s deleted.\n", scheduleID)
+}
+
+// BackfillSchedule takes the actions of a schedule in a time range
+func BackfillSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	startTime := parseTime(getRequiredOption(c, FlagStartTime), 0)
+	endTime := parseTime(getRequiredOption(c, FlagEndTime), 0)
+
+	request := &s.BackfillScheduleRequest{
+		Domain:         common.StringPtr(domain),
+		ScheduleId:     common.StringPtr(scheduleID),
+		StartTimestamp: common.Int64Ptr(startTime),
+		EndTimestamp:   common.Int64Ptr(endTime),
+		Identity:       common.StringPtr(getCliIdentity()),
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		request.OverlapPolicy = getScheduleOverlapPolicy(c.String(FlagOverlapPolicy)).Ptr()
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := frontendClient.BackfillSchedule(ctx, request); err != nil {
+		ErrorAndExit("Failed to backfill schedule.", err)
+	}
+	fmt.Printf("Schedule %s backfill requested.\n", scheduleID)
+}
+
+// ListSchedules lists the schedules of a domain
+func ListSchedules(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	pageSize := c.Int(FlagPageSize)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Schedule Id"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue)
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := frontendClient.ListSchedules(ctx, &s.ListSchedulesRequest{
+			Domain:        common.StringPtr(domain),
+			PageSize:      common.Int32Ptr(int32(pageSize)),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list schedules.", err)
+		}
+		for _, schedule := range resp.Schedules {
+			table.Append([]string{schedule.GetScheduleId()})
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	table.Render()
+}
+
+func getScheduleSpec(c *cli.Context) *s.ScheduleSpec {
+	if !c.IsSet(FlagCronSchedule) {
+		return nil
+	}
+	spec := &s.ScheduleSpec{
+		CronExpression: common.StringPtr(c.String(FlagCronSchedule)),
+	}
+	if c.IsSet(FlagJitter) {
+		spec.JitterInSeconds = common.Int32Ptr(int32(c.Int(FlagJitter)))
+	}
+	if c.IsSet(FlagStartTime) {
+		spec.StartTimestamp = common.Int64Ptr(parseTime(c.String(FlagStartTime), 0))
+	}
+	if c.IsSet(FlagEndTime) {
+		spec.EndTimestamp = common.Int64Ptr(parseTime(c.String(FlagEndTime), 0))
+	}
+	return spec
+}
+
+func getScheduleAction(c *cli.Context, domain string) *s.ScheduleAction {
+	if !c.IsSet(FlagWorkflowType) {
+		return nil
+	}
+	workflowType := c.String(FlagWorkflowType)
+	taskList := getRequiredOption(c, FlagTaskList)
+	et := c.Int(FlagExecutionTimeout)
+	if et == 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagExecutionTimeout), nil)
+	}
+	wid := c.String(FlagWorkflowID)
+	if len(wid) == 0 {
+		wid = workflowType
+	}
+	return &s.ScheduleAction{
+		StartWorkflow: &s.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domain),
+			WorkflowId:                          common.StringPtr(wid),
+			WorkflowType:                        &s.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:                            &s.TaskList{Name: common.StringPtr(taskList)},
+			Input:                               []byte(processJSONInput(c)),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(c.Int(FlagDecisionTimeout))),
+		},
+	}
+}
+
+func getSchedulePolicies(c *cli.Context) *s.SchedulePolicies {
+	if !c.IsSet(FlagOverlapPolicy) && !c.IsSet(FlagCatchupWindow) {
+		return nil
+	}
+	policies := &s.SchedulePolicies{}
+	if c.IsSet(FlagOverlapPolicy) {
+		policies.OverlapPolicy = getScheduleOverlapPolicy(c.String(FlagOverlapPolicy)).Ptr()
+	}
+	if c.IsSet(FlagCatchupWindow) {
+		policies.CatchupWindowInSeconds = common.Int32Ptr(int32(c.Int(FlagCatchupWindow)))
+	}
+	return policies
+}
+
+func getScheduleOverlapPolicy(value string) s.ScheduleOverlapPolicy {
+	var policy s.ScheduleOverlapPolicy
+	if err := policy.UnmarshalText([]byte(value)); err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagOverlapPolicy), err)
+	}
+	return policy
+}
@@ -22,6 +22,7 @@ package cli
 
 import (
 	"strings"
+	"time"
 
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/urfave/cli"
@@ -107,6 +108,35 @@ func newWorkflowCommands() []cli.Command {
 				SignalWorkflow(c)
 			},
 		},
+		{
+			Name:  "update",
+			Usage: "send an update to a workflow execution and wait for its result",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "UpdateName",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Input for the update, in JSON format.",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Input for the update from JSON file.",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateWorkflow(c)
+			},
+		},
 		{
 			Name:    "terminate",
 			Aliases: []string{"term"},
@@ -265,7 +295,8 @@ func newWorkflowCommands() []cli.Command {
 		{
 			Name: "reset-batch",
 			Usage: "reset workflow in batch by resetType: " + strings.Join(mapKeysToArray(resetTypesMap), ",") +
-				"batch source is from input file or visibility query.",
+				"batch source is from input file or visibility query. " +
+				"To run a durable reset on the server side, use 'workflow batch start' with batch type reset.",
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagInputFileWithAlias,
@@ -384,6 +415,126 @@ func newActivityCommands() []cli.Command {
 				FailActivity(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause an activity, no further attempts are dispatched until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, default to the current run",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseActivity(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause an activity",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, default to the current run",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseActivity(c)
+			},
+		},
+		{
+			Name:  "reset",
+			Usage: "reset the attempt counter and retry state of an activity waiting for its next attempt",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, default to the current run",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+				cli.BoolFlag{
+					Name:  FlagResetHeartbeatDetails,
+					Usage: "Also clear the heartbeat details recorded by previous attempts",
+				},
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResetActivity(c)
+			},
+		},
+		{
+			Name:  "update-timeouts",
+			Usage: "update the timeouts of an activity",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, default to the current run",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityID to operate on",
+				},
+				cli.IntFlag{
+					Name:  FlagScheduleToStartTimeout,
+					Usage: "Optional new schedule to start timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagScheduleToCloseTimeout,
+					Usage: "Optional new schedule to close timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagStartToCloseTimeout,
+					Usage: "Optional new start to close timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagHeartbeatTimeout,
+					Usage: "Optional new heartbeat timeout in seconds",
+				},
+				cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateActivityTimeouts(c)
+			},
+		},
 	}
 }
 
@@ -398,6 +549,10 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagJobIDWithAlias,
 					Usage: "Batch Job ID",
 				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Optional file to write the failed workflows of a finished batch job, one 'workflowID,runID,error' per line",
+				},
 			},
 			Action: func(c *cli.Context) {
 				DescribeBatchJob(c)
@@ -420,6 +575,40 @@ func newBatchCommands() []cli.Command {
 				TerminateBatchJob(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause the processing of a batch operation job, a job querying by visibility fails when resumed after more than 5 minutes",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Batch Job ID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to pause this batch job",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseBatchJob(c)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "resume the processing of a paused batch operation job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Batch Job ID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to resume this batch job",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResumeBatchJob(c)
+			},
+		},
 		{
 			Name:    "list",
 			Aliases: []string{"l"},
@@ -441,7 +630,7 @@ func newBatchCommands() []cli.Command {
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagListQueryWithAlias,
-					Usage: "Query to get workflows for being executed this batch operation",
+					Usage: "Query to get workflows for being executed this batch operation, not required with retry_job_id",
 				},
 				cli.StringFlag{
 					Name:  FlagReasonWithAlias,
@@ -449,7 +638,7 @@ func newBatchCommands() []cli.Command {
 				},
 				cli.StringFlag{
 					Name:  FlagBatchTypeWithAlias,
-					Usage: "Types supported: " + strings.Join(batcher.AllBatchTypes, ","),
+					Usage: "Types supported: " + strings.Join(batcher.AllBatchTypes, ",") + ". Batch delete only deletes closed workflows",
 				},
 				//below are optional
 				cli.StringFlag{
@@ -460,10 +649,54 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagInputWithAlias,
 					Usage: "Optional input of signal",
 				},
+				cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, where to reset. Support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Binary checksum for batch reset with reset type of BadBinary",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipCurrent,
+					Usage: "Skip the workflow for batch reset if the current run is open",
+				},
+				cli.StringFlag{
+					Name: FlagSearchAttributesKey,
+					Usage: "Search attributes keys to upsert for batch update_search_attributes. If there are multiple keys, concatenate them and separate by |. " +
+						"Use 'cluster get-search-attr' cmd to list legal keys.",
+				},
+				cli.StringFlag{
+					Name: FlagSearchAttributesVal,
+					Usage: "Search attributes values to upsert for batch update_search_attributes. If there are multiple values, concatenate them and separate by |. " +
+						"The order must be same as search_attr_key",
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
-					Usage: "RPS of processing",
+					Usage: "Initial RPS of processing, the rate adapts to the pressure of the backend",
+				},
+				cli.IntFlag{
+					Name:  FlagMinRPS,
+					Value: batcher.DefaultMinRPS,
+					Usage: "Floor of the adaptive RPS of processing",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxRPS,
+					Usage: "Ceiling of the adaptive RPS of processing, default to the initial RPS",
+				},
+				cli.IntFlag{
+					Name:  FlagTargetLatency,
+					Value: int(batcher.DefaultTargetLatency / time.Millisecond),
+					Usage: "Latency in milliseconds above which the RPS of processing is decreased",
+				},
+				cli.StringFlag{
+					Name:  FlagRetryJobID,
+					Usage: "Operate on the workflows which failed in the given finished batch job instead of the workflows from query",
+				},
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only report the count and a sample of the workflows that this batch job would operate on, use 'batch describe' to get the report",
 				},
 				cli.BoolFlag{
 					Name:  FlagYes,
@@ -22,11 +22,14 @@ package cli
 
 import (
 	"bufio"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/urfave/cli"
@@ -71,8 +74,21 @@ func DescribeBatchJob(c *cli.Context) {
 			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetCloseStatus().String()
 		} else {
 			output["msg"] = "batch job is finished successfully"
+			result := getBatchJobResult(c, client, jobID, wf.WorkflowExecutionInfo.Execution.GetRunId())
+			output["result"] = result
+			if outputFile := c.String(FlagOutputFilename); outputFile != "" {
+				writeBatchJobFailures(outputFile, result.Failures)
+			}
+		}
+	} else if result, err := queryBatchJobProgress(c, client, jobID); err == nil {
+		if result.Paused {
+			output["msg"] = "batch job is paused"
+		} else {
+			output["msg"] = "batch job is running"
 		}
+		output["progress"] = result.Progress
 	} else {
+		// batch jobs started before the progress query was supported only report their progress by heartbeat
 		output["msg"] = "batch job is running"
 		if len(wf.PendingActivities) > 0 {
 			hbdBinary := wf.PendingActivities[0].HeartbeatDetails
@@ -87,6 +103,102 @@ func DescribeBatchJob(c *cli.Context) {
 	prettyPrintJSONObject(output)
 }
 
+// getBatchJobResult returns the result of a batch job which is finished successfully, it contains the failure report
+// of the job, or the dry run report if the job is started in dry run mode
+func getBatchJobResult(c *cli.Context, client cclient.Client, jobID, runID string) batcher.HeartBeatDetails {
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	var result batcher.HeartBeatDetails
+	if err := client.GetWorkflow(tcCtx, jobID, runID).Get(tcCtx, &result); err != nil {
+		ErrorAndExit("Failed to get the result of batch job", err)
+	}
+	return result
+}
+
+func writeBatchJobFailures(outputFile string, failures []batcher.FailedWorkflow) {
+	f, err := os.Create(outputFile)
+	if err != nil {
+		ErrorAndExit("Failed to create output file", err)
+	}
+	defer f.Close()
+	writer := csv.NewWriter(f)
+	for _, failure := range failures {
+		if err := writer.Write([]string{failure.WorkflowID, failure.RunID, failure.Error}); err != nil {
+			ErrorAndExit("Failed to write output file", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		ErrorAndExit("Failed to write output file", err)
+	}
+}
+
+// getBatchJobFailures returns the workflows failed in a batch job which is finished successfully
+func getBatchJobFailures(c *cli.Context, client cclient.Client, jobID string) []s.WorkflowExecution {
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	wf, err := client.DescribeWorkflowExecution(tcCtx, jobID, "")
+	if err != nil {
+		ErrorAndExit("Failed to describe batch job to retry", err)
+	}
+	if wf.WorkflowExecutionInfo.GetCloseStatus() != shared.WorkflowExecutionCloseStatusCompleted {
+		ErrorAndExit("Only the failures of a batch job which is finished successfully can be retried", nil)
+	}
+	result := getBatchJobResult(c, client, jobID, wf.WorkflowExecutionInfo.Execution.GetRunId())
+	if result.ErrorCount > len(result.Failures) {
+		fmt.Printf("Only %v of the %v failed workflows are recorded and will be retried.\n", len(result.Failures), result.ErrorCount)
+	}
+	executions := make([]s.WorkflowExecution, 0, len(result.Failures))
+	for _, failure := range result.Failures {
+		executions = append(executions, s.WorkflowExecution{
+			WorkflowId: common.StringPtr(failure.WorkflowID),
+			RunId:      common.StringPtr(failure.RunID),
+		})
+	}
+	return executions
+}
+
+func queryBatchJobProgress(c *cli.Context, client cclient.Client, jobID string) (*batcher.ProgressQueryResult, error) {
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	value, err := client.QueryWorkflow(tcCtx, jobID, "", batcher.QueryTypeProgress)
+	if err != nil {
+		return nil, err
+	}
+	var result batcher.ProgressQueryResult
+	if err := value.Get(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PauseBatchJob pauses the processing of a batch job
+func PauseBatchJob(c *cli.Context) {
+	signalBatchJob(c, batcher.SignalNamePause, "batch job is paused")
+}
+
+// ResumeBatchJob resumes the processing of a paused batch job
+func ResumeBatchJob(c *cli.Context) {
+	signalBatchJob(c, batcher.SignalNameResume, "batch job is resumed")
+}
+
+func signalBatchJob(c *cli.Context, signalName string, msg string) {
+	jobID := getRequiredOption(c, FlagJobID)
+	reason := getRequiredOption(c, FlagReason)
+	svcClient := cFactory.ClientFrontendClient(c)
+	client := cclient.NewClient(svcClient, common.SystemLocalDomainName, &cclient.Options{})
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err := client.SignalWorkflow(tcCtx, jobID, "", signalName, reason)
+	if err != nil {
+		ErrorAndExit("Failed to signal batch job", err)
+	}
+	output := map[string]interface{}{
+		"msg": msg,
+	}
+	prettyPrintJSONObject(output)
+}
+
 // ListBatchJobs list the started batch jobs
 func ListBatchJobs(c *cli.Context) {
 	domain := getRequiredGlobalOption(c, FlagDomain)
@@ -127,7 +239,11 @@ func ListBatchJobs(c *cli.Context) {
 // StartBatchJob starts a batch job
 func StartBatchJob(c *cli.Context) {
 	domain := getRequiredGlobalOption(c, FlagDomain)
-	query := getRequiredOption(c, FlagListQuery)
+	retryJobID := c.String(FlagRetryJobID)
+	var query string
+	if retryJobID == "" {
+		query = getRequiredOption(c, FlagListQuery)
+	}
 	reason := getRequiredOption(c, FlagReason)
 	batchType := getRequiredOption(c, FlagBatchType)
 	if !validateBatchType(batchType) {
@@ -139,21 +255,51 @@ func StartBatchJob(c *cli.Context) {
 		sigName = getRequiredOption(c, FlagSignalName)
 		sigVal = getRequiredOption(c, FlagInput)
 	}
+	var resetParams batcher.ResetParams
+	if batchType == batcher.BatchTypeReset {
+		resetParams.ResetType = getRequiredOption(c, FlagResetType)
+		if resetParams.ResetType == batcher.ResetTypeBadBinary {
+			resetParams.BadBinaryChecksum = getRequiredOption(c, FlagResetBadBinaryChecksum)
+		}
+		resetParams.SkipCurrentOpen = c.Bool(FlagSkipCurrent)
+	}
+	var updateSearchAttributesParams batcher.UpdateSearchAttributesParams
+	if batchType == batcher.BatchTypeUpdateSearchAttributes {
+		updateSearchAttributesParams.UpsertSearchAttributes = processSearchAttr(c)
+		if len(updateSearchAttributesParams.UpsertSearchAttributes) == 0 {
+			ErrorAndExit("Must provide search attributes to upsert", nil)
+		}
+	}
 	rps := c.Int(FlagRPS)
+	minRPS := c.Int(FlagMinRPS)
+	maxRPS := c.Int(FlagMaxRPS)
+	targetLatency := time.Duration(c.Int(FlagTargetLatency)) * time.Millisecond
+	dryRun := c.Bool(FlagDryRun)
 
 	svcClient := cFactory.ClientFrontendClient(c)
 	client := cclient.NewClient(svcClient, common.SystemLocalDomainName, &cclient.Options{})
-	tcCtx, cancel := newContext(c)
-	defer cancel()
-	resp, err := client.CountWorkflow(tcCtx, &shared.CountWorkflowExecutionsRequest{
-		Domain: common.StringPtr(domain),
-		Query:  common.StringPtr(query),
-	})
-	if err != nil {
-		ErrorAndExit("Failed to count impacting workflows for starting a batch job", err)
+	var executions []s.WorkflowExecution
+	if retryJobID != "" {
+		executions = getBatchJobFailures(c, client, retryJobID)
+		if len(executions) == 0 {
+			fmt.Println("Batch job is not started, there is no failed workflow to retry")
+			return
+		}
+		fmt.Printf("This batch job will be operating on %v workflows.\n", len(executions))
+	} else {
+		tcCtx, cancel := newContext(c)
+		defer cancel()
+		resp, err := client.CountWorkflow(tcCtx, &shared.CountWorkflowExecutionsRequest{
+			Domain: common.StringPtr(domain),
+			Query:  common.StringPtr(query),
+		})
+		if err != nil {
+			ErrorAndExit("Failed to count impacting workflows for starting a batch job", err)
+		}
+		fmt.Printf("This batch job will be operating on %v workflows.\n", resp.GetCount())
 	}
-	fmt.Printf("This batch job will be operating on %v workflows.\n", resp.GetCount())
-	if !c.Bool(FlagYes) {
+	// a dry run doesn't operate on any workflow
+	if !c.Bool(FlagYes) && !dryRun {
 		reader := bufio.NewReader(os.Stdin)
 		for {
 			fmt.Print("Please confirm[Yes/No]:")
@@ -170,7 +316,7 @@ func StartBatchJob(c *cli.Context) {
 		}
 
 	}
-	tcCtx, cancel = newContext(c)
+	tcCtx, cancel := newContext(c)
 	defer cancel()
 	options := cclient.StartWorkflowOptions{
 		TaskList:                     batcher.BatcherTaskListName,
@@ -188,11 +334,18 @@ func StartBatchJob(c *cli.Context) {
 		Query:      query,
 		Reason:     reason,
 		BatchType:  batchType,
+		Executions: executions,
+		DryRun:     dryRun,
 		SignalParams: batcher.SignalParams{
 			SignalName: sigName,
 			Input:      sigVal,
 		},
-		RPS: rps,
+		ResetParams:                  resetParams,
+		UpdateSearchAttributesParams: updateSearchAttributesParams,
+		RPS:                          rps,
+		MinRPS:                       minRPS,
+		MaxRPS:                       maxRPS,
+		TargetLatency:                targetLatency,
 	}
 	wf, err := client.StartWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
 	if err != nil {
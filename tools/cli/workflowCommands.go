@@ -466,6 +466,36 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// UpdateWorkflow sends an update to a workflow execution and prints the result
+func UpdateWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	name := getRequiredOption(c, FlagName)
+	input := processJSONInput(c)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	resp, err := frontendClient.UpdateWorkflowExecution(tcCtx, &shared.UpdateWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		UpdateName: common.StringPtr(name),
+		Input:      []byte(input),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Update workflow failed.", err)
+	} else {
+		fmt.Printf("Update result as JSON:\n%v\n", string(resp.Result))
+	}
+}
+
 // QueryWorkflow query workflow execution
 func QueryWorkflow(c *cli.Context) {
 	getRequiredGlobalOption(c, FlagDomain) // for pre-check and alert if not provided
@@ -930,7 +960,9 @@ func convertSearchAttributesToMapOfInterface(searchAttributes *shared.SearchAttr
 	result := make(map[string]interface{})
 	ctx, cancel := newContext(c)
 	defer cancel()
-	validSearchAttributes, err := wfClient.GetSearchAttributes(ctx)
+	validSearchAttributes, err := wfClient.GetSearchAttributes(ctx, &shared.GetSearchAttributesRequest{
+		Domain: common.StringPtr(c.GlobalString(FlagDomain)),
+	})
 	if err != nil {
 		ErrorAndExit("Error when get search attributes", err)
 	}
@@ -1879,6 +1911,110 @@ func FailActivity(c *cli.Context) {
 	}
 }
 
+// PauseActivity pauses an activity
+func PauseActivity(c *cli.Context) {
+	domain, execution, activityID, identity := getActivityOptions(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := cFactory.ServerAdminClient(c)
+	err := adminClient.PauseActivity(ctx, &shared.PauseActivityRequest{
+		Domain:            common.StringPtr(domain),
+		WorkflowExecution: execution,
+		ActivityID:        common.StringPtr(activityID),
+		Identity:          common.StringPtr(identity),
+	})
+	if err != nil {
+		ErrorAndExit("Pausing activity failed", err)
+	} else {
+		fmt.Println("Pause activity successfully.")
+	}
+}
+
+// UnpauseActivity unpauses an activity
+func UnpauseActivity(c *cli.Context) {
+	domain, execution, activityID, identity := getActivityOptions(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := cFactory.ServerAdminClient(c)
+	err := adminClient.UnpauseActivity(ctx, &shared.UnpauseActivityRequest{
+		Domain:            common.StringPtr(domain),
+		WorkflowExecution: execution,
+		ActivityID:        common.StringPtr(activityID),
+		Identity:          common.StringPtr(identity),
+	})
+	if err != nil {
+		ErrorAndExit("Unpausing activity failed", err)
+	} else {
+		fmt.Println("Unpause activity successfully.")
+	}
+}
+
+// ResetActivity resets the attempt counter and retry state of an activity
+func ResetActivity(c *cli.Context) {
+	domain, execution, activityID, identity := getActivityOptions(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := cFactory.ServerAdminClient(c)
+	err := adminClient.ResetActivity(ctx, &shared.ResetActivityRequest{
+		Domain:                common.StringPtr(domain),
+		WorkflowExecution:     execution,
+		ActivityID:            common.StringPtr(activityID),
+		ResetHeartbeatDetails: common.BoolPtr(c.Bool(FlagResetHeartbeatDetails)),
+		Identity:              common.StringPtr(identity),
+	})
+	if err != nil {
+		ErrorAndExit("Resetting activity failed", err)
+	} else {
+		fmt.Println("Reset activity successfully.")
+	}
+}
+
+// UpdateActivityTimeouts updates the timeouts of an activity
+func UpdateActivityTimeouts(c *cli.Context) {
+	domain, execution, activityID, identity := getActivityOptions(c)
+	scheduleToStartTimeout := c.Int(FlagScheduleToStartTimeout)
+	scheduleToCloseTimeout := c.Int(FlagScheduleToCloseTimeout)
+	startToCloseTimeout := c.Int(FlagStartToCloseTimeout)
+	heartbeatTimeout := c.Int(FlagHeartbeatTimeout)
+	if scheduleToStartTimeout <= 0 && scheduleToCloseTimeout <= 0 && startToCloseTimeout <= 0 && heartbeatTimeout <= 0 {
+		ErrorAndExit("Invalid timeouts", fmt.Errorf("at least one positive timeout is required"))
+	}
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := cFactory.ServerAdminClient(c)
+	err := adminClient.UpdateActivityTimeouts(ctx, &shared.UpdateActivityTimeoutsRequest{
+		Domain:                        common.StringPtr(domain),
+		WorkflowExecution:             execution,
+		ActivityID:                    common.StringPtr(activityID),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(int32(scheduleToStartTimeout)),
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(int32(scheduleToCloseTimeout)),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(int32(startToCloseTimeout)),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(int32(heartbeatTimeout)),
+		Identity:                      common.StringPtr(identity),
+	})
+	if err != nil {
+		ErrorAndExit("Updating activity timeouts failed", err)
+	} else {
+		fmt.Println("Update activity timeouts successfully.")
+	}
+}
+
+func getActivityOptions(c *cli.Context) (string, *shared.WorkflowExecution, string, string) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+	identity := c.String(FlagIdentity)
+	return domain, &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(wid),
+		RunId:      common.StringPtr(rid),
+	}, activityID, identity
+}
+
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) {
 	if !c.Args().Present() {
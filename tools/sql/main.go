@@ -24,6 +24,7 @@ import (
 	"os"
 
 	_ "github.com/go-sql-driver/mysql" // needed to load the mysql driver
+	_ "github.com/lib/pq"              // needed to load the postgres driver
 	"github.com/uber/cadence/tools/common/schema"
 	"github.com/urfave/cli"
 )
@@ -84,7 +85,7 @@ func buildCLIOptions() *cli.App {
 		cli.StringFlag{
 			Name:   schema.CLIFlagDriverName,
 			Value:  "mysql",
-			Usage:  "name of the sql driver",
+			Usage:  "name of the sql driver, one of mysql or postgres",
 			EnvVar: "SQL_DRIVER",
 		},
 		cli.BoolFlag{
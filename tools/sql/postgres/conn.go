@@ -0,0 +1,59 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgres
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	// DriverName refers to the name of the postgres driver
+	DriverName = "postgres"
+	// DefaultDatabase is the database that always exists in a postgres
+	// instance, connections that are not tied to a database e.g. to
+	// create or drop a database are made against it
+	DefaultDatabase = "postgres"
+)
+
+// NewConnection returns a new connection to the postgres database
+func NewConnection(host string, port int, user string, passwd string, database string) (*sqlx.DB, error) {
+	if database == "" {
+		database = DefaultDatabase
+	}
+	dsn := url.URL{
+		Scheme:   DriverName,
+		User:     url.UserPassword(user, passwd),
+		Host:     fmt.Sprintf("%v:%v", host, port),
+		Path:     "/" + database,
+		RawQuery: "sslmode=disable",
+	}
+	db, err := sqlx.Connect(DriverName, dsn.String())
+	if err != nil {
+		return nil, err
+	}
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}